@@ -31,6 +31,7 @@ Examples:
   gh-sweep analytics --repo owner/repo --errors`,
 	Run: func(cmd *cobra.Command, args []string) {
 		repo, _ := cmd.Flags().GetString("repo")
+		repo = resolveRepo(cmd, repo)
 		flaky, _ := cmd.Flags().GetBool("flaky")
 		errors, _ := cmd.Flags().GetBool("errors")
 
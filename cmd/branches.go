@@ -1,15 +1,20 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/KyleKing/gh-sweep/internal/git"
+	"github.com/KyleKing/gh-sweep/internal/github"
 	"github.com/spf13/cobra"
 )
 
 var branchesCmd = &cobra.Command{
-	Use:   "branches",
-	Short: "Interactive branch management",
+	Use:     "branches",
+	Aliases: []string{"branch"},
+	Short:   "Interactive branch management",
 	Long: `Interactive branch management with dependency visualization.
 
 Features:
@@ -27,12 +32,25 @@ Examples:
   gh-sweep branches --repo owner/repo --tree
 
   # Create stacked PRs
-  gh-sweep branches --repo owner/repo --stacked-prs`,
+  gh-sweep branches --repo owner/repo --stacked-prs
+
+  # Warn if the current checkout is in detached HEAD
+  gh-sweep branches --check-detached
+
+  # Recreate a branch deleted in the last 30 days
+  gh-sweep branch restore --repo owner/repo --branch feature/login`,
 	Run: func(cmd *cobra.Command, args []string) {
 		repo, _ := cmd.Flags().GetString("repo")
 		tree, _ := cmd.Flags().GetBool("tree")
 		stackedPRs, _ := cmd.Flags().GetBool("stacked-prs")
+		checkDetached, _ := cmd.Flags().GetBool("check-detached")
+
+		if checkDetached {
+			runCheckDetached(".")
+			return
+		}
 
+		repo = resolveRepo(cmd, repo)
 		if repo == "" {
 			fmt.Println("Error: --repo flag is required")
 			fmt.Println("\nUsage: gh-sweep branches --repo owner/repo")
@@ -60,10 +78,93 @@ Examples:
 	},
 }
 
+var branchesRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Recreate a deleted remote branch",
+	Long: `Recreate a remote branch that was deleted by mistake.
+
+The repository events API is searched for the branch's deletion, and the
+branch is recreated at the last SHA pushed to it. GitHub keeps events for
+at most 90 days, so older deletions cannot be recovered this way.`,
+	Run: runBranchesRestore,
+}
+
 func init() {
 	rootCmd.AddCommand(branchesCmd)
 
+	branchesCmd.AddCommand(branchesRestoreCmd)
+	branchesRestoreCmd.Flags().String("repo", "", "Repository (owner/repo)")
+	branchesRestoreCmd.Flags().String("branch", "", "Name of the deleted branch")
+	branchesRestoreCmd.Flags().Int("days", int(github.DefaultRestoreWindow.Hours()/24), "How many days back to search for the deletion")
+	branchesRestoreCmd.Flags().Bool("dry-run", false, "Show the recovered SHA without recreating the branch")
+
 	branchesCmd.Flags().String("repo", "", "Repository (owner/repo)")
 	branchesCmd.Flags().Bool("tree", false, "Show branch tree visualization")
 	branchesCmd.Flags().Bool("stacked-prs", false, "Create stacked PRs from selected branches")
+	branchesCmd.Flags().Bool("check-detached", false, "Check whether the local repo is in detached HEAD")
+}
+
+func runBranchesRestore(cmd *cobra.Command, _ []string) {
+	repo, _ := cmd.Flags().GetString("repo")
+	branch, _ := cmd.Flags().GetString("branch")
+	days, _ := cmd.Flags().GetInt("days")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	repo = resolveRepo(cmd, repo)
+	if repo == "" || branch == "" {
+		fmt.Println("Error: --repo and --branch are required")
+		return
+	}
+
+	parts := strings.Split(repo, "/")
+	if len(parts) != 2 {
+		fmt.Println("Error: repo must be in format owner/repo")
+		return
+	}
+
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	if dryRun {
+		sha, err := client.GetDeletedBranchRef(parts[0], parts[1], branch, since)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Printf("[DRY RUN] Would restore %s/%s at %s\n", repo, branch, sha)
+		return
+	}
+
+	sha, err := client.RestoreDeletedBranch(parts[0], parts[1], branch, since)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("✓ Restored %s/%s at %s\n", repo, branch, sha)
+}
+
+func runCheckDetached(path string) {
+	repo := git.NewLocalRepo(path)
+	if !repo.IsInsideWorkTree() {
+		fmt.Printf("Error: %s is not inside a Git repository\n", path)
+		return
+	}
+
+	detached, sha, err := repo.IsDetachedHEAD()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	if detached {
+		fmt.Printf("⚠️  Detached HEAD at %s\n", sha)
+		fmt.Println("   Commits made here can be lost; create a branch with: git switch -c <name>")
+		return
+	}
+
+	fmt.Println("✓ HEAD is on a branch")
 }
@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect, clear, or pre-warm the gha-perf run cache",
+	Long: `Manage the workflow run cache used by gha-perf.
+
+Cached runs live in ~/.cache/gh-sweep/gha-perf, one file per repository.
+Warming the cache in CI means interactive sessions only fetch new runs.
+
+Examples:
+  # Show cached repositories with their size, run count, and age
+  gh-sweep cache info
+
+  # Clear the cache for one repository, or for all of them
+  gh-sweep cache clear --repo owner/repo
+  gh-sweep cache clear
+
+  # Fetch the last 14 days of CI runs for every configured repository
+  gh-sweep cache warm --days 14 --workflow ci.yml`,
+}
+
+var cacheInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Show size, run count, and age of each cached repository",
+	Run:   runCacheInfo,
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Delete cached runs for --repo, or for every repository",
+	Run:   runCacheClear,
+}
+
+var cacheWarmCmd = &cobra.Command{
+	Use:   "warm",
+	Short: "Fetch recent runs for every configured repository into the cache",
+	Run:   runCacheWarm,
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheInfoCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+	cacheCmd.AddCommand(cacheWarmCmd)
+	supportsGroup(cacheWarmCmd)
+
+	cacheClearCmd.Flags().String("repo", "", "Repository (owner/repo) to clear; clears every repository when omitted")
+	cacheWarmCmd.Flags().StringP("workflow", "w", "", "Workflow file to fetch runs for")
+	cacheWarmCmd.Flags().IntP("limit", "l", 100, "Number of runs to fetch per repository")
+	cacheWarmCmd.Flags().Int("days", 30, "Lookback period in days")
+	cacheWarmCmd.Flags().Int("workers", github.DefaultDetailWorkers, "Run details to fetch concurrently")
+}
+
+func runCacheInfo(_ *cobra.Command, _ []string) {
+	manager, err := cache.NewGHAPerfCacheManager("")
+	if err != nil {
+		fmt.Printf("Error: failed to create cache manager: %v\n", err)
+		return
+	}
+
+	ttl := config.DefaultConfig().GHAPerf.CacheTTLDuration()
+	var maxSize int64
+	if cfg, err := config.Load(); err == nil {
+		ttl = cfg.GHAPerf.CacheTTLDuration()
+		maxSize = cfg.Cache.MaxSizeBytes
+	}
+
+	entries, err := manager.ListCaches()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if len(entries) == 0 {
+		fmt.Println("No cached repositories")
+		return
+	}
+
+	fmt.Printf("%-40s %10s %6s  %s\n", "Repository", "Size", "Runs", "Age")
+	var totalSize int64
+	for _, entry := range entries {
+		name := entry.Owner + "/" + entry.Repo
+		totalSize += entry.Size
+		cached, err := manager.Load(entry.Owner, entry.Repo)
+		if err != nil {
+			fmt.Printf("%-40s error: %v\n", name, err)
+			continue
+		}
+
+		age := time.Since(entry.UpdatedAt)
+		status := ""
+		if ttl > 0 && age > ttl {
+			status = " (expired)"
+		}
+		fmt.Printf("%-40s %10s %6d  %s%s\n", name, formatBytes(entry.Size), len(cached.Runs), formatCacheAge(age), status)
+	}
+
+	summary := fmt.Sprintf("%d repositories, %s total", len(entries), formatBytes(totalSize))
+	if maxSize > 0 {
+		summary += fmt.Sprintf(" (limit %s)", formatBytes(maxSize))
+	}
+	fmt.Printf("\n%s\n", summary)
+}
+
+func runCacheClear(cmd *cobra.Command, _ []string) {
+	repo, _ := cmd.Flags().GetString("repo")
+
+	manager, err := cache.NewGHAPerfCacheManager("")
+	if err != nil {
+		fmt.Printf("Error: failed to create cache manager: %v\n", err)
+		return
+	}
+
+	if repo == "" {
+		if err := manager.ClearAll(); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Println("✓ Cleared all cached repositories")
+		return
+	}
+
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		fmt.Println("Error: repo must be in format owner/repo")
+		return
+	}
+	if err := manager.Clear(owner, name); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("✓ Cleared cache for %s\n", repo)
+}
+
+func runCacheWarm(cmd *cobra.Command, _ []string) {
+	workflow, _ := cmd.Flags().GetString("workflow")
+	limit, _ := cmd.Flags().GetInt("limit")
+	days, _ := cmd.Flags().GetInt("days")
+	workers, _ := cmd.Flags().GetInt("workers")
+	group, _ := cmd.Flags().GetString("group")
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error: failed to load config: %v\n", err)
+		return
+	}
+	repos, err := configuredRepos(cfg, group)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (add repositories to .gh-sweep.yaml)")
+		return
+	}
+
+	manager, err := cache.NewGHAPerfCacheManager("", cache.WithMaxSize(cfg.Cache.MaxSizeBytes))
+	if err != nil {
+		fmt.Printf("Error: failed to create cache manager: %v\n", err)
+		return
+	}
+	manager.MaxAge = cfg.GHAPerf.CacheTTLDuration()
+	remote, err := cache.NewRemoteCacheBackend(cfg.Cache.Backend, cfg.Cache.RemoteURL)
+	if err != nil {
+		fmt.Printf("Warning: remote cache unavailable, using local cache: %v\n", err)
+	} else if remote != nil {
+		manager.SetRemote(remote)
+	}
+
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	opts := github.FetchWorkflowRunsOptions{
+		WorkflowFile: workflow,
+		Limit:        limit,
+		CreatedAfter: time.Now().AddDate(0, 0, -days),
+		Workers:      workers,
+	}
+
+	failed := 0
+	for i, repo := range repos {
+		fmt.Printf("[%d/%d] %s... ", i+1, len(repos), repo)
+		total, added, err := warmGHAPerfCache(client, manager, repo, opts)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %d runs (%d new)\n", total, added)
+	}
+
+	fmt.Printf("\nWarmed %d/%d repositories\n", len(repos)-failed, len(repos))
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// workflowRunFetcher fetches runs with job details; implemented by *github.Client
+type workflowRunFetcher interface {
+	FetchWorkflowRunsWithDetails(owner, repo string, opts github.FetchWorkflowRunsOptions) ([]github.RunTiming, error)
+}
+
+// warmGHAPerfCache merges freshly fetched runs for repo into its cache
+// Returns the cached run count and how many of those runs were not cached before
+func warmGHAPerfCache(fetcher workflowRunFetcher, manager *cache.GHAPerfCacheManager, repo string, opts github.FetchWorkflowRunsOptions) (total, added int, err error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("expected owner/repo")
+	}
+
+	existing, err := manager.Load(owner, name)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	runs, err := fetcher.FetchWorkflowRunsWithDetails(owner, name, opts)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Only completed runs are fetched, so runs still in progress are unknown and
+	// incremental fetches must keep resuming from the previous newest run
+	previous := len(existing.Runs)
+	existing.UpdatePending(0, true)
+	existing.Runs = manager.MergeRuns(existing.Runs, runs)
+	if err := manager.Save(owner, name, existing); err != nil {
+		return 0, 0, err
+	}
+
+	return len(existing.Runs), len(existing.Runs) - previous, nil
+}
+
+// formatCacheAge renders how long ago a cache was updated, e.g. 3d, 5h, or 12m
+func formatCacheAge(age time.Duration) string {
+	switch {
+	case age >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(age.Hours()/24))
+	case age >= time.Hour:
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	case age >= time.Minute:
+		return fmt.Sprintf("%dm", int(age.Minutes()))
+	}
+	return "just now"
+}
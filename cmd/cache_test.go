@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+type fakeRunFetcher struct {
+	runs []github.RunTiming
+	err  error
+	opts github.FetchWorkflowRunsOptions
+}
+
+func (f *fakeRunFetcher) FetchWorkflowRunsWithDetails(_, _ string, opts github.FetchWorkflowRunsOptions) ([]github.RunTiming, error) {
+	f.opts = opts
+	return f.runs, f.err
+}
+
+func TestWarmGHAPerfCache(t *testing.T) {
+	manager, err := cache.NewGHAPerfCacheManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+	if err := manager.Save("owner", "repo", &cache.GHAPerfCache{Runs: []github.RunTiming{{RunID: 1}}}); err != nil {
+		t.Fatalf("Failed to seed cache: %v", err)
+	}
+
+	fetcher := &fakeRunFetcher{runs: []github.RunTiming{{RunID: 1}, {RunID: 2}, {RunID: 3}}}
+	opts := github.FetchWorkflowRunsOptions{WorkflowFile: "ci.yml", Limit: 100}
+	total, added, err := warmGHAPerfCache(fetcher, manager, "owner/repo", opts)
+	if err != nil {
+		t.Fatalf("warmGHAPerfCache failed: %v", err)
+	}
+
+	if total != 3 || added != 2 {
+		t.Errorf("Expected 3 runs with 2 new, got %d with %d new", total, added)
+	}
+	if fetcher.opts.WorkflowFile != "ci.yml" {
+		t.Errorf("Expected fetch options to be passed through, got %+v", fetcher.opts)
+	}
+
+	runs, updatedAt, _, err := manager.Stats("owner", "repo")
+	if err != nil || runs != 3 || time.Since(updatedAt) > time.Minute {
+		t.Errorf("Expected 3 freshly cached runs, got %d updated %v (err: %v)", runs, updatedAt, err)
+	}
+
+	// Warming cannot see in-progress runs, so incremental fetches resume from the previous newest run
+	if warmed, err := manager.Load("owner", "repo"); err != nil || warmed.ResumeRunID() != 1 {
+		t.Errorf("Expected to resume from run 1 after warming, got %+v (err: %v)", warmed, err)
+	}
+}
+
+func TestWarmGHAPerfCacheFetchError(t *testing.T) {
+	manager, err := cache.NewGHAPerfCacheManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+
+	fetcher := &fakeRunFetcher{err: errors.New("rate limited")}
+	if _, _, err := warmGHAPerfCache(fetcher, manager, "owner/repo", github.FetchWorkflowRunsOptions{}); err == nil {
+		t.Error("Expected fetch error to be returned")
+	}
+
+	if names, _ := manager.ListCaches(); len(names) != 0 {
+		t.Errorf("Expected no cache written on error, got %v", names)
+	}
+}
+
+func TestFormatCacheAge(t *testing.T) {
+	tests := []struct {
+		age  time.Duration
+		want string
+	}{
+		{30 * time.Second, "just now"},
+		{12 * time.Minute, "12m"},
+		{5 * time.Hour, "5h"},
+		{80 * time.Hour, "3d"},
+	}
+
+	for _, tt := range tests {
+		if got := formatCacheAge(tt.age); got != tt.want {
+			t.Errorf("formatCacheAge(%v) = %q, want %q", tt.age, got, tt.want)
+		}
+	}
+}
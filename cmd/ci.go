@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+	"github.com/KyleKing/gh-sweep/internal/tui"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+// runProgram runs a TUI model full-screen; tests replace it to observe launches
+var runProgram = func(m tea.Model) error {
+	_, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	return err
+}
+
+// interactive reports whether a command should launch its TUI
+// False when --ci is set or stdout is not a terminal
+func interactive(cmd *cobra.Command) bool {
+	ci, _ := cmd.Flags().GetBool("ci")
+	return !ci && tui.IsTTY()
+}
+
+// exitOnFindings exits with status 1 when --exit-code-on-findings is set and findings exceed max
+func exitOnFindings(cmd *cobra.Command, label string, findings, max int) {
+	enabled, _ := cmd.Flags().GetBool("exit-code-on-findings")
+	if enabled && findings > max {
+		fmt.Fprintf(os.Stderr, "Error: found %d %s (max %d)\n", findings, label, max)
+		os.Exit(1)
+	}
+}
+
+// HealthSummary holds per-repo results of the non-interactive health check
+type HealthSummary struct {
+	Repos            []string
+	PolicyStatus     map[string]string // ok, missing, too_short, error
+	Orphans          map[string]int
+	SecurityFindings int
+	TotalOrphans     int
+}
+
+// runHealthSummary checks configured repos and prints a summary instead of launching the TUI
+func runHealthSummary(cmd *cobra.Command, cfg *config.Config, repos []string) {
+	if len(repos) == 0 {
+		writeHealthSummary(os.Stdout, &HealthSummary{})
+		fmt.Println("No repositories configured (use --group or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to create GitHub client: %v\n", err)
+		os.Exit(1)
+	}
+
+	summary := &HealthSummary{
+		Repos:        repos,
+		PolicyStatus: make(map[string]string),
+		Orphans:      make(map[string]int),
+	}
+
+	policies := make(map[string]string)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			continue
+		}
+		policy, err := client.GetSecurityPolicy(parts[0], parts[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %s: %v\n", repoStr, err)
+			summary.PolicyStatus[repoStr] = "error"
+			continue
+		}
+		policies[repoStr] = policy
+	}
+
+	report := github.AuditSecurityPolicyWithMinLength(policies, cfg.Security.MinPolicyLength)
+	for _, repo := range report.Compliant {
+		summary.PolicyStatus[repo] = "ok"
+	}
+	for _, repo := range report.Missing {
+		summary.PolicyStatus[repo] = "missing"
+	}
+	for _, repo := range report.TooShort {
+		summary.PolicyStatus[repo] = "too_short"
+	}
+	summary.SecurityFindings = len(report.Missing) + len(report.TooShort)
+
+	options := orphans.DefaultScanOptions()
+	if cfg.Orphans.StaleDaysThreshold > 0 {
+		options.StaleDaysThreshold = cfg.Orphans.StaleDaysThreshold
+	}
+	options.ExcludePatterns = append(options.ExcludePatterns, cfg.Orphans.ExcludePatterns...)
+	namespace := strings.SplitN(repos[0], "/", 2)[0]
+	result := orphans.NewNamespaceScanner(client, options).ScanRepos(ctx, namespace, resolveRepositories(client, repos))
+	for _, scanResult := range result.Results {
+		summary.Orphans[scanResult.Repository.FullName] = len(scanResult.Orphans)
+	}
+	summary.TotalOrphans = result.TotalOrphans
+
+	writeHealthSummary(os.Stdout, summary)
+
+	exitOnFindings(cmd, "security findings", summary.SecurityFindings, cfg.CI.MaxSecurityFindings)
+	exitOnFindings(cmd, "orphaned branches", summary.TotalOrphans, cfg.CI.MaxOrphans)
+}
+
+// writeHealthSummary prints one tab-separated line per repo followed by totals
+func writeHealthSummary(w io.Writer, summary *HealthSummary) {
+	fmt.Fprintf(w, "repository\tsecurity_policy\torphans\n")
+	for _, repo := range summary.Repos {
+		status := summary.PolicyStatus[repo]
+		if status == "" {
+			status = "unknown"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\n", repo, status, summary.Orphans[repo])
+	}
+	fmt.Fprintf(w, "total\tsecurity_findings=%d\torphans=%d\n", summary.SecurityFindings, summary.TotalOrphans)
+}
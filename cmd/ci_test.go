@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// captureStdout returns everything written to os.Stdout while fn runs
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Failed to create pipe: %v", err)
+	}
+
+	original := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = original }()
+
+	fn()
+	w.Close()
+
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	return buf.String()
+}
+
+// TestCIFlagSkipsTUI tests that --ci prints the health summary instead of launching a program
+func TestCIFlagSkipsTUI(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Chdir(dir)
+
+	launched := 0
+	original := runProgram
+	runProgram = func(tea.Model) error {
+		launched++
+		return nil
+	}
+	t.Cleanup(func() {
+		runProgram = original
+		rootCmd.PersistentFlags().Set("ci", "false")
+		rootCmd.SetArgs(nil)
+	})
+
+	rootCmd.SetArgs([]string{"--ci"})
+	output := captureStdout(t, func() {
+		if err := rootCmd.Execute(); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+	})
+
+	if launched != 0 {
+		t.Errorf("Expected no TUI launch with --ci, got %d", launched)
+	}
+	if !strings.Contains(output, "repository\tsecurity_policy\torphans") {
+		t.Errorf("Expected text summary header, got %q", output)
+	}
+	if !strings.Contains(output, "No repositories configured") {
+		t.Errorf("Expected empty repository notice, got %q", output)
+	}
+}
+
+// TestWriteHealthSummary tests the tab-separated summary format
+func TestWriteHealthSummary(t *testing.T) {
+	summary := &HealthSummary{
+		Repos:            []string{"owner/api", "owner/web"},
+		PolicyStatus:     map[string]string{"owner/api": "ok", "owner/web": "missing"},
+		Orphans:          map[string]int{"owner/api": 3},
+		SecurityFindings: 1,
+		TotalOrphans:     3,
+	}
+
+	var buf bytes.Buffer
+	writeHealthSummary(&buf, summary)
+
+	expected := "repository\tsecurity_policy\torphans\n" +
+		"owner/api\tok\t3\n" +
+		"owner/web\tmissing\t0\n" +
+		"total\tsecurity_findings=1\torphans=3\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, buf.String())
+	}
+}
@@ -0,0 +1,295 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/audit"
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+	"github.com/spf13/cobra"
+)
+
+var cleanupCmd = &cobra.Command{
+	Use:   "cleanup",
+	Short: "Delete orphaned branches matching the configured cleanup policy",
+	Long: `Scan for orphaned branches and delete those matching the 'cleanup' section of
+.gh-sweep.yaml, without any interaction. Flags override the config.
+
+A JSON summary is written to stdout and progress to stderr, so the output can be
+piped. Every deletion is appended to the audit log. Exits with status 1 when any
+deletion fails, or 2 when some repositories could not be scanned.
+
+Config:
+  cleanup:
+    auto_clean_types: [merged_pr, closed_pr]  # merged_pr, closed_pr, stale, recent_no_pr
+    min_age_days: 30
+    dry_run: true                             # set false to actually delete
+    max_delete: 50                            # 0 means unlimited
+
+Examples:
+  # Preview what the configured policy would delete
+  gh-sweep cleanup --dry-run
+
+  # Delete merged and closed PR branches older than 60 days in an org
+  gh-sweep cleanup --org mycompany --types merged_pr,closed_pr --min-age-days 60 --dry-run=false
+
+  # Count deletions from a scheduled job
+  gh-sweep cleanup --repos owner/repo --dry-run=false | jq '.deleted | length'`,
+	Run: runCleanupCommand,
+}
+
+func init() {
+	rootCmd.AddCommand(cleanupCmd)
+	supportsGroup(cleanupCmd)
+
+	cleanupCmd.Flags().String("org", "", "Organization to scan (default: default_org, then the authenticated user)")
+	cleanupCmd.Flags().StringSlice("repos", nil, "Specific repos to clean up (default: 'repositories' from the config)")
+	cleanupCmd.Flags().StringSlice("types", nil, "Orphan types to delete (overrides cleanup.auto_clean_types)")
+	cleanupCmd.Flags().Int("min-age-days", 0, "Days since last activity before deleting (overrides cleanup.min_age_days)")
+	cleanupCmd.Flags().Int("max-delete", 0, "Maximum branches to delete, 0 for unlimited (overrides cleanup.max_delete)")
+	cleanupCmd.Flags().Bool("dry-run", false, "Report what would be deleted without deleting (overrides cleanup.dry_run)")
+	cleanupCmd.Flags().String("audit-log", "", "Audit log path (default: ~/.cache/gh-sweep/audit.log)")
+}
+
+// branchDeleter deletes a branch; implemented by *github.Client
+type branchDeleter interface {
+	DeleteBranch(owner, repo, branch string) error
+}
+
+// cleanupBranch is one branch in the cleanup summary
+type cleanupBranch struct {
+	Repository        string             `json:"repository"`
+	Branch            string             `json:"branch"`
+	SHA               string             `json:"sha"`
+	Type              orphans.OrphanType `json:"type"`
+	DaysSinceActivity int                `json:"days_since_activity"`
+	Error             string             `json:"error,omitempty"`
+}
+
+// cleanupSummary is the JSON written to stdout by the cleanup command
+type cleanupSummary struct {
+	Namespace    string               `json:"namespace"`
+	DryRun       bool                 `json:"dry_run"`
+	Types        []orphans.OrphanType `json:"types"`
+	MinAgeDays   int                  `json:"min_age_days"`
+	MaxDelete    int                  `json:"max_delete"`
+	ScannedRepos int                  `json:"scanned_repos"`
+	ScanErrors   int                  `json:"scan_errors"`
+	Candidates   int                  `json:"candidates"`
+	OverLimit    int                  `json:"over_limit"` // Matches skipped because of max_delete
+	Deleted      []cleanupBranch      `json:"deleted"`    // Would be deleted when dry_run is set
+	Failed       []cleanupBranch      `json:"failed"`
+}
+
+func runCleanupCommand(cmd *cobra.Command, _ []string) {
+	org, _ := cmd.Flags().GetString("org")
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	auditPath, _ := cmd.Flags().GetString("audit-log")
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	criteria, dryRun, err := cleanupCriteria(cmd, cfg.Cleanup)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(repos) == 0 {
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	logger, err := audit.NewLogger(auditPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to create GitHub client: %v\n", err)
+		os.Exit(1)
+	}
+
+	namespace := org
+	if namespace == "" && len(repos) > 0 {
+		namespace = strings.SplitN(repos[0], "/", 2)[0]
+	}
+	if namespace == "" {
+		namespace = cfg.DefaultOrg
+	}
+	if namespace == "" {
+		namespace, err = client.GetAuthenticatedUser()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to get authenticated user: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	options := orphans.DefaultScanOptions()
+	if cfg.Orphans.StaleDaysThreshold > 0 {
+		options.StaleDaysThreshold = cfg.Orphans.StaleDaysThreshold
+	}
+	if len(cfg.Orphans.ExcludePatterns) > 0 {
+		options.ExcludePatterns = cfg.Orphans.ExcludePatterns
+	}
+	for _, t := range criteria.Types {
+		if t == orphans.OrphanTypeRecentNoPR {
+			options.IncludeRecentNoPR = true
+		}
+	}
+
+	scanner := orphans.NewNamespaceScanner(client, options)
+	var result *orphans.NamespaceScanResult
+	if len(repos) > 0 {
+		fmt.Fprintf(os.Stderr, "Scanning repositories: %s\n", strings.Join(repos, ", "))
+		result = scanner.ScanRepos(ctx, namespace, resolveRepositories(client, repos))
+	} else {
+		fmt.Fprintf(os.Stderr, "Scanning namespace: %s\n", namespace)
+		result, err = scanner.ScanNamespace(ctx, namespace)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to scan namespace: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	summary := autoCleanup(client, result, criteria, dryRun, logger)
+
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to marshal JSON: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+
+	if len(summary.Failed) > 0 {
+		fmt.Fprintf(os.Stderr, "Error: %d of %d deletions failed\n", len(summary.Failed), len(summary.Failed)+len(summary.Deleted))
+		os.Exit(1)
+	}
+	if code := scanErrorExitCode(result); code != 0 {
+		for _, failed := range result.FailedResults() {
+			fmt.Fprintf(os.Stderr, "Warning: %s: %v\n", failed.Repository.FullName, failed.Error)
+		}
+		os.Exit(code)
+	}
+}
+
+// cleanupCriteria merges the cleanup config with any flags set on cmd
+func cleanupCriteria(cmd *cobra.Command, cfg config.CleanupConfig) (orphans.CleanupCriteria, bool, error) {
+	criteria := orphans.CleanupCriteria{
+		MinAgeDays: cfg.MinAgeDays,
+		MaxDelete:  cfg.MaxDelete,
+	}
+	for _, t := range cfg.AutoCleanTypes {
+		criteria.Types = append(criteria.Types, orphans.OrphanType(t))
+	}
+	dryRun := cfg.DryRun
+
+	if cmd.Flags().Changed("types") {
+		types, _ := cmd.Flags().GetStringSlice("types")
+		criteria.Types = nil
+		for _, t := range types {
+			switch orphanType := orphans.OrphanType(t); orphanType {
+			case orphans.OrphanTypeMergedPR, orphans.OrphanTypeClosedPR, orphans.OrphanTypeStale, orphans.OrphanTypeRecentNoPR:
+				criteria.Types = append(criteria.Types, orphanType)
+			default:
+				return criteria, dryRun, fmt.Errorf("--types: unknown orphan type %q (use merged_pr, closed_pr, stale, or recent_no_pr)", t)
+			}
+		}
+	}
+	if cmd.Flags().Changed("min-age-days") {
+		criteria.MinAgeDays, _ = cmd.Flags().GetInt("min-age-days")
+	}
+	if cmd.Flags().Changed("max-delete") {
+		criteria.MaxDelete, _ = cmd.Flags().GetInt("max-delete")
+	}
+	if cmd.Flags().Changed("dry-run") {
+		dryRun, _ = cmd.Flags().GetBool("dry-run")
+	}
+
+	if len(criteria.Types) == 0 {
+		return criteria, dryRun, fmt.Errorf("no orphan types to clean up (set cleanup.auto_clean_types or --types)")
+	}
+	return criteria, dryRun, nil
+}
+
+// autoCleanup deletes the orphans in result selected by criteria, logging each deletion to logger
+func autoCleanup(deleter branchDeleter, result *orphans.NamespaceScanResult, criteria orphans.CleanupCriteria, dryRun bool, logger *audit.Logger) cleanupSummary {
+	selected, overLimit := orphans.SelectForCleanup(result.AllOrphans(), criteria)
+
+	summary := cleanupSummary{
+		Namespace:    result.Namespace,
+		DryRun:       dryRun,
+		Types:        criteria.Types,
+		MinAgeDays:   criteria.MinAgeDays,
+		MaxDelete:    criteria.MaxDelete,
+		ScannedRepos: len(result.Results),
+		ScanErrors:   result.ErrorCount,
+		Candidates:   len(selected) + overLimit,
+		OverLimit:    overLimit,
+		Deleted:      []cleanupBranch{},
+		Failed:       []cleanupBranch{},
+	}
+
+	for _, orphan := range selected {
+		entry := cleanupBranch{
+			Repository:        orphan.Repository,
+			Branch:            orphan.BranchName,
+			SHA:               orphan.SHA,
+			Type:              orphan.Type,
+			DaysSinceActivity: orphan.DaysSinceActivity,
+		}
+
+		if !dryRun {
+			parts := strings.SplitN(orphan.Repository, "/", 2)
+			var err error
+			if len(parts) != 2 {
+				err = fmt.Errorf("invalid repository %q", orphan.Repository)
+			} else {
+				err = deleter.DeleteBranch(parts[0], parts[1], orphan.BranchName)
+			}
+			if err != nil {
+				entry.Error = err.Error()
+			}
+		}
+
+		if err := logger.Log(audit.Entry{
+			Action:     "delete_branch",
+			Repository: orphan.Repository,
+			Target:     orphan.BranchName,
+			SHA:        orphan.SHA,
+			DryRun:     dryRun,
+			Error:      entry.Error,
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+
+		if entry.Error != "" {
+			fmt.Fprintf(os.Stderr, "  [FAILED] %s/%s: %s\n", orphan.Repository, orphan.BranchName, entry.Error)
+			summary.Failed = append(summary.Failed, entry)
+			continue
+		}
+		if dryRun {
+			fmt.Fprintf(os.Stderr, "  [DRY RUN] Would delete %s/%s\n", orphan.Repository, orphan.BranchName)
+		} else {
+			fmt.Fprintf(os.Stderr, "  [DELETED] %s/%s\n", orphan.Repository, orphan.BranchName)
+		}
+		summary.Deleted = append(summary.Deleted, entry)
+	}
+
+	return summary
+}
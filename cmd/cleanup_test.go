@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/audit"
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+)
+
+// fakeDeleter records the DELETE requests the GitHub client would make
+type fakeDeleter struct {
+	calls []string
+	fail  map[string]bool // branch names whose deletion fails
+}
+
+func (f *fakeDeleter) DeleteBranch(owner, repo, branch string) error {
+	f.calls = append(f.calls, fmt.Sprintf("DELETE repos/%s/%s/git/refs/heads/%s", owner, repo, branch))
+	if f.fail[branch] {
+		return errors.New("422 Reference does not exist")
+	}
+	return nil
+}
+
+func cleanupFixture() *orphans.NamespaceScanResult {
+	var branches []orphans.OrphanedBranch
+	for i := 0; i < 5; i++ {
+		branches = append(branches, orphans.OrphanedBranch{
+			Repository:        "acme/api",
+			BranchName:        fmt.Sprintf("feature/%d", i),
+			SHA:               fmt.Sprintf("sha%d", i),
+			Type:              orphans.OrphanTypeMergedPR,
+			DaysSinceActivity: 40 + i,
+		})
+	}
+	branches = append(branches,
+		orphans.OrphanedBranch{Repository: "acme/api", BranchName: "too-new", Type: orphans.OrphanTypeMergedPR, DaysSinceActivity: 3},
+		orphans.OrphanedBranch{Repository: "acme/api", BranchName: "stale", Type: orphans.OrphanTypeStale, DaysSinceActivity: 400},
+	)
+
+	return &orphans.NamespaceScanResult{
+		Namespace: "acme",
+		Results:   []orphans.ScanResult{{Orphans: branches}},
+	}
+}
+
+func newTestAuditLogger(t *testing.T) *audit.Logger {
+	t.Helper()
+
+	logger, err := audit.NewLogger(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatalf("NewLogger failed: %v", err)
+	}
+	return logger
+}
+
+// TestAutoCleanupDeletesMatchingOrphans tests one DELETE per matching orphan, each audited
+func TestAutoCleanupDeletesMatchingOrphans(t *testing.T) {
+	deleter := &fakeDeleter{}
+	logger := newTestAuditLogger(t)
+	criteria := orphans.CleanupCriteria{Types: []orphans.OrphanType{orphans.OrphanTypeMergedPR}, MinAgeDays: 30}
+
+	summary := autoCleanup(deleter, cleanupFixture(), criteria, false, logger)
+
+	if len(deleter.calls) != 5 {
+		t.Fatalf("Expected 5 DELETE calls, got %d: %v", len(deleter.calls), deleter.calls)
+	}
+	if len(summary.Deleted) != 5 || len(summary.Failed) != 0 {
+		t.Errorf("Expected 5 deleted and 0 failed, got %d and %d", len(summary.Deleted), len(summary.Failed))
+	}
+
+	entries, err := audit.ReadEntries(logger.Path())
+	if err != nil {
+		t.Fatalf("ReadEntries failed: %v", err)
+	}
+	if len(entries) != 5 || entries[0].Action != "delete_branch" || entries[0].DryRun {
+		t.Errorf("Expected 5 audited deletions, got %+v", entries)
+	}
+}
+
+// TestAutoCleanupDryRun tests that a dry run reports matches without deleting
+func TestAutoCleanupDryRun(t *testing.T) {
+	deleter := &fakeDeleter{}
+	criteria := orphans.CleanupCriteria{Types: []orphans.OrphanType{orphans.OrphanTypeMergedPR}, MinAgeDays: 30}
+
+	summary := autoCleanup(deleter, cleanupFixture(), criteria, true, newTestAuditLogger(t))
+
+	if len(deleter.calls) != 0 {
+		t.Errorf("Expected no DELETE calls in dry run, got %v", deleter.calls)
+	}
+	if !summary.DryRun || len(summary.Deleted) != 5 {
+		t.Errorf("Expected 5 branches reported for dry run, got %+v", summary)
+	}
+}
+
+// TestAutoCleanupPartialFailure tests max_delete and failed deletions in the summary
+func TestAutoCleanupPartialFailure(t *testing.T) {
+	deleter := &fakeDeleter{fail: map[string]bool{"feature/1": true}}
+	criteria := orphans.CleanupCriteria{Types: []orphans.OrphanType{orphans.OrphanTypeMergedPR}, MinAgeDays: 30, MaxDelete: 3}
+
+	summary := autoCleanup(deleter, cleanupFixture(), criteria, false, newTestAuditLogger(t))
+
+	if len(deleter.calls) != 3 || summary.OverLimit != 2 || summary.Candidates != 5 {
+		t.Errorf("Expected 3 calls with 2 over the limit, got %d calls and %+v", len(deleter.calls), summary)
+	}
+	if len(summary.Failed) != 1 || summary.Failed[0].Branch != "feature/1" || summary.Failed[0].Error == "" {
+		t.Errorf("Expected feature/1 to fail, got %+v", summary.Failed)
+	}
+}
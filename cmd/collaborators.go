@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/export"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var collaboratorsCmd = &cobra.Command{
+	Use:   "collaborators",
+	Short: "Audit collaborator access across repositories",
+	Long: `List collaborators and their permission level for each repository.
+
+The matrix format writes a CSV with one row per user and one column per
+repository, which makes cross-repo access easy to review in a spreadsheet.
+
+Examples:
+  # Collaborators for configured repos
+  gh-sweep collaborators
+
+  # Export a user x repo access matrix
+  gh-sweep collaborators --repos owner/repo1,owner/repo2 --format matrix --output access.csv
+
+  # Invitations that have not been accepted yet
+  gh-sweep collaborators --list-invitations
+
+  # Cancel a stale invitation
+  gh-sweep collaborators --repos owner/repo --cancel-invitation-id 12345`,
+	Run: runCollaborators,
+}
+
+func init() {
+	rootCmd.AddCommand(collaboratorsCmd)
+	supportsGroup(collaboratorsCmd)
+
+	collaboratorsCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	collaboratorsCmd.Flags().String("format", "table", "Output format: table or matrix")
+	collaboratorsCmd.Flags().String("output", "collaborators-matrix.csv", "Output file for --format matrix")
+	collaboratorsCmd.Flags().Bool("list-invitations", false, "List pending collaborator invitations")
+	collaboratorsCmd.Flags().Int("cancel-invitation-id", 0, "Cancel a pending invitation (requires a single --repos entry)")
+}
+
+func runCollaborators(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	format, _ := cmd.Flags().GetString("format")
+	output, _ := cmd.Flags().GetString("output")
+	listInvitations, _ := cmd.Flags().GetBool("list-invitations")
+	cancelInvitationID, _ := cmd.Flags().GetInt("cancel-invitation-id")
+
+	if format != "table" && format != "matrix" {
+		fmt.Printf("Error: unsupported format %q (use table or matrix)\n", format)
+		return
+	}
+
+	if cancelInvitationID != 0 && len(repos) != 1 {
+		fmt.Println("Error: --cancel-invitation-id requires exactly one repo in --repos")
+		return
+	}
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	if cancelInvitationID != 0 {
+		parts := strings.Split(repos[0], "/")
+		if len(parts) != 2 {
+			fmt.Printf("Error: invalid repo %q (expected owner/repo)\n", repos[0])
+			return
+		}
+		if err := client.CancelInvitation(parts[0], parts[1], cancelInvitationID); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Printf("Cancelled invitation %d on %s\n", cancelInvitationID, repos[0])
+		return
+	}
+
+	if listInvitations {
+		printPendingInvitations(client, repos)
+		return
+	}
+
+	collaborators := make(map[string][]github.Collaborator)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		collabs, err := client.ListCollaborators(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+		collaborators[repoStr] = collabs
+	}
+
+	if format == "matrix" {
+		if err := export.ExportCollaboratorMatrix(collaborators, output); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Printf("Exported to %s\n", output)
+		return
+	}
+
+	repoNames := make([]string, 0, len(collaborators))
+	for repo := range collaborators {
+		repoNames = append(repoNames, repo)
+	}
+	sort.Strings(repoNames)
+
+	fmt.Printf("%-35s %-25s %s\n", "Repository", "User", "Permission")
+	fmt.Println(strings.Repeat("-", 75))
+	for _, repo := range repoNames {
+		for _, collab := range collaborators[repo] {
+			fmt.Printf("%-35s %-25s %s\n", truncate(repo, 35), truncate(collab.Login, 25), collab.Permission)
+		}
+	}
+}
+
+func printPendingInvitations(client *github.Client, repos []string) {
+	fmt.Printf("%-10s %-35s %-25s %-10s %s\n", "ID", "Repository", "Invitee", "Permission", "Pending")
+	fmt.Println(strings.Repeat("-", 95))
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		invitations, err := client.ListPendingInvitations(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+
+		for _, inv := range invitations {
+			invitee := inv.Login
+			if invitee == "" {
+				invitee = inv.Email
+			}
+			fmt.Printf("%-10d %-35s %-25s %-10s %dd\n", inv.ID, truncate(repoStr, 35), truncate(invitee, 25), inv.Permission, inv.DaysPending)
+		}
+	}
+}
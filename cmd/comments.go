@@ -32,6 +32,7 @@ Examples:
   gh-sweep comments --search "TODO|FIXME"`,
 	Run: func(cmd *cobra.Command, args []string) {
 		repo, _ := cmd.Flags().GetString("repo")
+		repo = resolveRepo(cmd, repo)
 		author, _ := cmd.Flags().GetString("author")
 		since, _ := cmd.Flags().GetString("since")
 		search, _ := cmd.Flags().GetString("search")
@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+	"github.com/spf13/cobra"
+)
+
+// Snapshot types saved after scans and compared by the diff command
+const (
+	snapshotOrphans  = "orphans"
+	snapshotSecurity = "security"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show what changed since the last gh-sweep run",
+	Long: `Run a scan and compare it against the snapshot saved by the previous run.
+
+Snapshots are saved to ~/.local/share/gh-sweep/snapshots/<date>_<type>_<scope>.json
+after every 'orphans' (except --dry-run) and 'security' CLI scan, and after every diff.
+The scope fingerprints the namespace and repos scanned, so only runs over the same
+repos are compared. Findings of repos that fail to scan are kept from the previous
+snapshot. New findings are prefixed with '+', resolved findings with '-'.
+
+Snapshot types:
+  orphans:   Orphaned branches and tags
+  security:  Repositories with a missing or too-short security policy
+
+Examples:
+  # New and resolved orphans for configured repos
+  gh-sweep diff
+
+  # Security policy changes for specific repos
+  gh-sweep diff --type security --repos owner/repo1,owner/repo2`,
+	Run: runDiff,
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+	supportsGroup(diffCmd)
+
+	diffCmd.Flags().String("type", snapshotOrphans, "Snapshot type: orphans, security")
+	diffCmd.Flags().StringSlice("repos", nil, "Specific repos to scan (comma-separated)")
+}
+
+func runDiff(cmd *cobra.Command, _ []string) {
+	snapshotType, _ := cmd.Flags().GetString("type")
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+
+	if snapshotType != snapshotOrphans && snapshotType != snapshotSecurity {
+		fmt.Printf("Error: --type must be orphans or security, got %q\n", snapshotType)
+		return
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error: failed to load config: %v\n", err)
+		return
+	}
+
+	if len(repos) == 0 {
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	dir, err := cache.DefaultSnapshotDir()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	// Find the previous snapshot before the scan below replaces today's
+	scope := cache.SnapshotScope("", repos)
+	previousPath, err := cache.LatestSnapshot(dir, snapshotType, scope)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var previous cache.Snapshot
+	if previousPath != "" {
+		if err := cache.LoadSnapshot(previousPath, &previous); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	var entries, failedRepos []string
+	switch snapshotType {
+	case snapshotOrphans:
+		entries, failedRepos = scanOrphanEntries(client, cfg, repos)
+	case snapshotSecurity:
+		entries, failedRepos = scanSecurityEntries(client, cfg, repos)
+	}
+
+	entries = cache.CarryForward(previous.Entries, entries, failedRepos)
+	current := cache.Snapshot{Type: snapshotType, Scope: scope, CreatedAt: time.Now(), Entries: entries}
+	defer saveSnapshot(current.Type, scope, current.Entries, nil)
+
+	if previousPath == "" {
+		fmt.Printf("No previous %s snapshot; saving %d finding(s) as the baseline\n", snapshotType, len(entries))
+		return
+	}
+
+	diff := cache.DiffSnapshots(previous, current)
+	if !diff.HasChanges() {
+		fmt.Printf("No changes since %s\n", previous.CreatedAt.Format("2006-01-02 15:04"))
+		return
+	}
+
+	fmt.Print(cache.FormatUnifiedDiff(previous, current, diff))
+	fmt.Printf("\n%d added, %d removed\n", len(diff.Added), len(diff.Removed))
+}
+
+// saveSnapshot records scan findings for the next diff of the same scope; failures only warn
+// Entries of failedRepos are carried forward from the previous snapshot of the scope
+func saveSnapshot(snapshotType, scope string, entries, failedRepos []string) {
+	dir, err := cache.DefaultSnapshotDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to save snapshot: %v\n", err)
+		return
+	}
+
+	if len(failedRepos) > 0 {
+		var previous cache.Snapshot
+		if path, err := cache.LatestSnapshot(dir, snapshotType, scope); err == nil && path != "" {
+			if err := cache.LoadSnapshot(path, &previous); err == nil {
+				entries = cache.CarryForward(previous.Entries, entries, failedRepos)
+			}
+		}
+	}
+
+	now := time.Now()
+	snapshot := cache.Snapshot{Type: snapshotType, Scope: scope, CreatedAt: now, Entries: entries}
+	if err := cache.SaveSnapshot(cache.SnapshotPath(dir, snapshotType, scope, now), snapshot); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to save snapshot: %v\n", err)
+	}
+}
+
+// scanOrphanEntries returns the orphan snapshot entries for repos and the repos that failed to scan
+func scanOrphanEntries(client *github.Client, cfg *config.Config, repos []string) ([]string, []string) {
+	options := orphans.DefaultScanOptions()
+	if cfg.Orphans.StaleDaysThreshold > 0 {
+		options.StaleDaysThreshold = cfg.Orphans.StaleDaysThreshold
+	}
+	options.ExcludePatterns = append(options.ExcludePatterns, cfg.Orphans.ExcludePatterns...)
+
+	namespace := strings.SplitN(repos[0], "/", 2)[0]
+	result := orphans.NewNamespaceScanner(client, options).ScanRepos(context.Background(), namespace, resolveRepositories(client, repos))
+	return orphans.SnapshotEntries(result), failedOrphanRepos(result)
+}
+
+// failedOrphanRepos lists the full names of repos whose orphan scan failed
+func failedOrphanRepos(result *orphans.NamespaceScanResult) []string {
+	var failed []string
+	for _, scanResult := range result.FailedResults() {
+		failed = append(failed, scanResult.Repository.FullName)
+	}
+	return failed
+}
+
+// scanSecurityEntries returns the security snapshot entries for repos and the repos that failed to scan
+func scanSecurityEntries(client *github.Client, cfg *config.Config, repos []string) ([]string, []string) {
+	var failed []string
+	policies := make(map[string]string)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		policy, err := client.GetSecurityPolicy(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			failed = append(failed, repoStr)
+			continue
+		}
+		policies[repoStr] = policy
+	}
+
+	return securitySnapshotEntries(github.AuditSecurityPolicyWithMinLength(policies, cfg.Security.MinPolicyLength)), failed
+}
+
+// securitySnapshotEntries lists each non-compliant repository as "repo [finding]"
+func securitySnapshotEntries(report github.SecurityPolicyReport) []string {
+	var entries []string
+	for _, repo := range report.Missing {
+		entries = append(entries, repo+" [missing_policy]")
+	}
+	for _, repo := range report.TooShort {
+		entries = append(entries, repo+" [short_policy]")
+	}
+	return entries
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var forksCmd = &cobra.Command{
+	Use:   "forks",
+	Short: "Show fork divergence from upstream repositories",
+	Long: `List forks across the configured repositories sorted by how far they
+have fallen behind their upstream default branch.
+
+Repositories default to the 'repositories' list in .gh-sweep.yaml.
+Non-fork repositories are skipped.
+
+Examples:
+  # List forks from the config file
+  gh-sweep forks
+
+  # List specific forks
+  gh-sweep forks --repos me/fork1,me/fork2
+
+  # Sync all forks that are behind upstream
+  gh-sweep forks --sync`,
+	Run: runForks,
+}
+
+func init() {
+	rootCmd.AddCommand(forksCmd)
+	supportsGroup(forksCmd)
+
+	forksCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	forksCmd.Flags().Bool("sync", false, "Merge upstream changes into forks that are behind")
+}
+
+func runForks(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	sync, _ := cmd.Flags().GetBool("sync")
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	var forks []github.ForkInfo
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		info, err := client.GetRepoForkInfo(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+		if info == nil {
+			continue
+		}
+		forks = append(forks, *info)
+	}
+
+	if len(forks) == 0 {
+		fmt.Println("No forks found.")
+		return
+	}
+
+	forks = github.SortForksByDivergence(forks)
+
+	fmt.Printf("%-35s %-35s %8s %8s  %s\n", "Fork", "Upstream", "Behind", "Ahead", "Last Push")
+	fmt.Println(strings.Repeat("-", 110))
+	for _, f := range forks {
+		fmt.Printf("%-35s %-35s %8d %8d  %s\n",
+			truncate(f.Repository, 35),
+			truncate(f.ParentRepo, 35),
+			f.BehindByCommits,
+			f.AheadByCommits,
+			f.LastSynced.Format("2006-01-02"))
+	}
+
+	if !sync {
+		return
+	}
+
+	fmt.Println()
+	for _, f := range forks {
+		if f.BehindByCommits == 0 {
+			continue
+		}
+
+		parts := strings.SplitN(f.Repository, "/", 2)
+		if err := client.SyncFork(parts[0], parts[1]); err != nil {
+			fmt.Printf("  [FAILED] %s: %v\n", f.Repository, err)
+			continue
+		}
+		fmt.Printf("  [SYNCED] %s (%d commits)\n", f.Repository, f.BehindByCommits)
+	}
+}
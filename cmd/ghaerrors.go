@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var ghaErrorsCmd = &cobra.Command{
+	Use:   "gha-errors",
+	Short: "Extract error lines from failed GitHub Actions job logs",
+	Long: `Download logs for recent failed workflow runs and extract the lines that explain the failure.
+
+Error lines are matched by built-in patterns (error:, failed:, fatal:, ...)
+plus any regexes in 'gha_perf.error_patterns' in .gh-sweep.yaml and
+--extra-patterns.
+
+Examples:
+  # Errors from the 5 most recent failed runs
+  gh-sweep gha-errors --repo owner/repo
+
+  # Also match a project-specific marker
+  gh-sweep gha-errors --repo owner/repo --extra-patterns 'CUSTOM_ERROR\[\d+\]'
+
+  # Markdown report for one workflow
+  gh-sweep gha-errors --repo owner/repo --workflow ci.yml --format markdown
+
+  # Also list ::error/::warning/::notice annotations, grouped by file
+  gh-sweep gha-errors --repo owner/repo --annotations`,
+	Run: runGHAErrors,
+}
+
+func init() {
+	rootCmd.AddCommand(ghaErrorsCmd)
+
+	ghaErrorsCmd.Flags().String("repo", "", "Repository (owner/repo)")
+	ghaErrorsCmd.Flags().StringP("workflow", "w", "", "Workflow file to analyze")
+	ghaErrorsCmd.Flags().StringP("branch", "b", "", "Filter by branch name")
+	ghaErrorsCmd.Flags().Int("runs", 5, "Number of recent failed runs to analyze")
+	ghaErrorsCmd.Flags().StringSlice("extra-patterns", nil, "Additional error regexes (comma-separated)")
+	ghaErrorsCmd.Flags().String("format", "text", "Output format: text, json, markdown")
+	ghaErrorsCmd.Flags().Bool("annotations", false, "Show workflow annotations grouped by file (text format)")
+}
+
+func runGHAErrors(cmd *cobra.Command, _ []string) {
+	repo, _ := cmd.Flags().GetString("repo")
+	workflow, _ := cmd.Flags().GetString("workflow")
+	branch, _ := cmd.Flags().GetString("branch")
+	runLimit, _ := cmd.Flags().GetInt("runs")
+	extraPatterns, _ := cmd.Flags().GetStringSlice("extra-patterns")
+	format, _ := cmd.Flags().GetString("format")
+	showAnnotations, _ := cmd.Flags().GetBool("annotations")
+
+	repo = resolveRepo(cmd, repo)
+	parts := strings.Split(repo, "/")
+	if len(parts) != 2 {
+		fmt.Println("Error: --repo must be in format owner/repo")
+		return
+	}
+	owner, repoName := parts[0], parts[1]
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error: failed to load config: %v\n", err)
+		return
+	}
+
+	patterns := append(append([]string{}, cfg.GHAPerf.ErrorPatterns...), extraPatterns...)
+	logConfig, err := github.DefaultLogConfig().WithExtraPatterns(patterns)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	runs, err := client.FetchWorkflowRunsWithDetails(owner, repoName, github.FetchWorkflowRunsOptions{
+		WorkflowFile: workflow,
+		Branch:       branch,
+		Limit:        100,
+	})
+	if err != nil {
+		fmt.Printf("Error: failed to fetch workflow runs: %v\n", err)
+		return
+	}
+
+	var contexts []*github.ErrorContext
+	for _, run := range github.SelectFailedRuns(runs, runLimit) {
+		for _, job := range run.Jobs {
+			if job.ID == 0 || job.Conclusion == "success" || job.Conclusion == "skipped" {
+				continue
+			}
+
+			lines, err := client.GetJobLog(owner, repoName, job.ID)
+			if err != nil {
+				fmt.Printf("Warning: run %d job %s: %v\n", run.RunID, job.Name, err)
+				continue
+			}
+
+			jobLog := github.JobLog{
+				JobID:      job.ID,
+				JobName:    job.Name,
+				WorkflowID: run.WorkflowID,
+				Repository: repo,
+				Conclusion: job.Conclusion,
+				Lines:      lines,
+				Timestamp:  job.CompletedAt,
+			}
+			if errCtx := github.ExtractErrorContext(jobLog, run.Workflow, logConfig); errCtx != nil {
+				contexts = append(contexts, errCtx)
+			}
+		}
+	}
+
+	switch format {
+	case "json":
+		output, err := github.FormatAsJSON(contexts)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Println(output)
+	case "markdown":
+		fmt.Print(github.FormatAsMarkdown(contexts))
+	default:
+		printErrorContexts(contexts, showAnnotations)
+	}
+}
+
+func printErrorContexts(contexts []*github.ErrorContext, showAnnotations bool) {
+	if len(contexts) == 0 {
+		fmt.Println("No failed jobs found")
+		return
+	}
+
+	for _, errCtx := range contexts {
+		fmt.Printf("%s / %s [%s]\n", errCtx.WorkflowName, errCtx.JobName, errCtx.ErrorType)
+		fmt.Printf("  %s\n", errCtx.Summary)
+		for _, line := range errCtx.ErrorLines {
+			fmt.Printf("    %s\n", strings.TrimSpace(line))
+		}
+		if showAnnotations {
+			printAnnotations(errCtx.Annotations)
+		}
+		fmt.Println()
+	}
+}
+
+func printAnnotations(annotations []github.Annotation) {
+	if len(annotations) == 0 {
+		return
+	}
+
+	fmt.Printf("  Annotations (%d):\n", len(annotations))
+	for _, group := range github.GroupAnnotationsByFile(annotations) {
+		file := group.File
+		if file == "" {
+			file = "(no file)"
+		}
+		fmt.Printf("    %s\n", file)
+		for _, a := range group.Annotations {
+			fmt.Printf("      %-7s %s  %s\n", a.Level, github.FormatAnnotationLocation(a), a.Message)
+		}
+	}
+}
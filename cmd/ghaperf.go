@@ -5,11 +5,14 @@ import (
 	"encoding/csv"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/export"
 	"github.com/KyleKing/gh-sweep/internal/github"
 	"github.com/spf13/cobra"
 )
@@ -36,9 +39,57 @@ Examples:
   # Compare branches
   gh-sweep gha-perf --repo owner/repo --compare main
 
+  # Fail a CI branch gate when the branch is slower than main beyond the threshold
+  gh-sweep gha-perf --repo owner/repo --compare my-branch --fail-on-regression
+
   # Export to CSV
   gh-sweep gha-perf --repo owner/repo --csv output.csv
 
+  # Smaller CSV with job-level rows only, keeping cancelled runs
+  gh-sweep gha-perf --repo owner/repo --csv output.csv --no-steps --include-cancelled
+
+  # Export to <dir>/owner_repo_gha-perf.csv
+  gh-sweep gha-perf --repo owner/repo --output-dir reports/
+
+  # Weight recent runs more heavily in the average (half-life in days)
+  gh-sweep gha-perf --repo owner/repo --time-weighted --half-life 3
+
+  # Chart average duration per workflow
+  gh-sweep gha-perf --repo owner/repo --chart
+
+  # One-line duration trend per workflow over the lookback period
+  gh-sweep gha-perf --repo owner/repo --sparklines
+
+  # Min, max, average and p95 of one step across runs
+  gh-sweep gha-perf --repo owner/repo --step-timing build:"Run tests"
+
+  # Runs unusually slow or fast for their workflow (beyond 2.5 standard deviations)
+  gh-sweep gha-perf --repo owner/repo --anomalies
+
+  # Show when failures happen by day and hour
+  gh-sweep gha-perf --repo owner/repo --heatmap
+
+  # Markdown summary of failures by workflow, for Slack or email
+  gh-sweep gha-perf --repo owner/repo --failure-summary
+
+  # Cache hit rate of each caching step, from the logs of the latest runs
+  gh-sweep gha-perf --repo owner/repo --cache-hit-report
+
+  # All workflow runs for a deployment commit
+  gh-sweep gha-perf --repo owner/repo --commit 0123456789abcdef0123456789abcdef01234567
+
+  # Print per-workflow stats as InfluxDB line protocol
+  gh-sweep gha-perf --repo owner/repo --influx
+
+  # Write per-workflow stats directly to InfluxDB v2 (token defaults to $INFLUX_TOKEN)
+  gh-sweep gha-perf --repo owner/repo --influx-url http://localhost:8086 --influx-org sre --influx-bucket ci
+
+  # Write workflow and job stats for the Prometheus node_exporter textfile collector
+  gh-sweep gha-perf --repo owner/repo --prometheus /var/lib/node_exporter/gh_sweep.prom
+
+  # Preview deleting all but the 2 newest coverage artifacts
+  gh-sweep gha-perf --repo owner/repo --cleanup-artifacts --artifact-pattern 'coverage-report-*' --keep-artifacts 2 --dry-run
+
   # Use cached data only
   gh-sweep gha-perf --repo owner/repo --cache-only`,
 	Run: runGHAPerf,
@@ -52,14 +103,41 @@ func init() {
 	ghaPerfCmd.Flags().StringP("branch", "b", "", "Filter by branch name")
 	ghaPerfCmd.Flags().IntP("limit", "l", 30, "Number of runs to fetch")
 	ghaPerfCmd.Flags().Int("days", 30, "Lookback period in days")
+	ghaPerfCmd.Flags().Int("workers", github.DefaultDetailWorkers, "Run details to fetch concurrently")
 	ghaPerfCmd.Flags().StringP("compare", "c", "", "Compare current runs against another branch")
 	ghaPerfCmd.Flags().String("base-branch", "main", "Base branch for comparisons")
+	ghaPerfCmd.Flags().Bool("fail-on-regression", false, "With --compare, exit with status 2 when a workflow is slower than base by more than gha_perf.regression_threshold percent")
 	ghaPerfCmd.Flags().String("csv", "", "Export detailed data to CSV file")
+	ghaPerfCmd.Flags().String("output-dir", "", "Export detailed data to a per-repo CSV file in this directory")
+	ghaPerfCmd.Flags().Bool("include-cancelled", false, "Include cancelled runs in the CSV export (omitted by default)")
+	ghaPerfCmd.Flags().Bool("no-steps", false, "Emit only job-level rows in the CSV export")
 	ghaPerfCmd.Flags().StringP("job", "j", "", "Show step breakdown for specific job name")
+	ghaPerfCmd.Flags().String("step-timing", "", "Show aggregated timing for one step, as job:step")
 	ghaPerfCmd.Flags().Bool("by-branch", false, "Group runs by branch and compare against base")
+	ghaPerfCmd.Flags().Bool("heatmap", false, "Show failure heatmap by day of week and hour")
+	ghaPerfCmd.Flags().Bool("failure-summary", false, "Print a Markdown summary of run conclusions grouped by workflow")
+	ghaPerfCmd.Flags().Bool("cache-hit-report", false, fmt.Sprintf("Report step cache hit rates from the logs of the %d most recent runs", github.DefaultCacheLogRuns))
+	ghaPerfCmd.Flags().Bool("anomalies", false, "List runs whose duration is unusual for their workflow")
+	ghaPerfCmd.Flags().Float64("anomaly-threshold", github.DefaultAnomalyZScore, "Standard deviations from the workflow mean flagged by --anomalies")
+	ghaPerfCmd.Flags().Bool("time-weighted", false, "Also show an average that weights recent runs more heavily")
+	ghaPerfCmd.Flags().Float64("half-life", github.DefaultHalfLifeDays, "Days for a run's weight to halve with --time-weighted")
+	ghaPerfCmd.Flags().Bool("chart", false, "Show average workflow duration as a bar chart")
+	ghaPerfCmd.Flags().Bool("sparklines", false, "Show a duration trend sparkline per workflow")
 	ghaPerfCmd.Flags().Bool("cache-only", false, "Use cached data only, do not fetch new runs")
 	ghaPerfCmd.Flags().Bool("no-cache", false, "Do not use or update the cache")
+	ghaPerfCmd.Flags().String("cache-ttl", "", "Refetch cached runs older than this, e.g. 7d or 12h (overrides gha_perf.cache_ttl)")
 	ghaPerfCmd.Flags().Bool("list-workflows", false, "List available workflows and exit")
+	ghaPerfCmd.Flags().Bool("cleanup-artifacts", false, "Delete all but the newest artifacts per --artifact-pattern and exit")
+	ghaPerfCmd.Flags().StringSlice("artifact-pattern", nil, "Artifact name patterns for --cleanup-artifacts (default: group by exact name)")
+	ghaPerfCmd.Flags().Int("keep-artifacts", 3, "Artifacts to keep per pattern with --cleanup-artifacts")
+	ghaPerfCmd.Flags().Bool("dry-run", false, "Preview --cleanup-artifacts without deleting")
+	ghaPerfCmd.Flags().String("commit", "", "Only show runs for this commit SHA (fetches every workflow run for it)")
+	ghaPerfCmd.Flags().Bool("influx", false, "Print workflow stats as InfluxDB line protocol to stdout")
+	ghaPerfCmd.Flags().String("influx-url", "", "Write workflow stats to this InfluxDB v2 server")
+	ghaPerfCmd.Flags().String("influx-token", "", "InfluxDB API token (defaults to $INFLUX_TOKEN)")
+	ghaPerfCmd.Flags().String("influx-org", "", "InfluxDB organization for --influx-url")
+	ghaPerfCmd.Flags().String("influx-bucket", "", "InfluxDB bucket for --influx-url")
+	ghaPerfCmd.Flags().String("prometheus", "", "Write workflow and job stats in Prometheus text format to this file")
 }
 
 func runGHAPerf(cmd *cobra.Command, _ []string) {
@@ -68,15 +146,47 @@ func runGHAPerf(cmd *cobra.Command, _ []string) {
 	branch, _ := cmd.Flags().GetString("branch")
 	limit, _ := cmd.Flags().GetInt("limit")
 	days, _ := cmd.Flags().GetInt("days")
+	workers, _ := cmd.Flags().GetInt("workers")
 	compare, _ := cmd.Flags().GetString("compare")
 	baseBranch, _ := cmd.Flags().GetString("base-branch")
+	failOnRegression, _ := cmd.Flags().GetBool("fail-on-regression")
 	csvPath, _ := cmd.Flags().GetString("csv")
+	outputDir, _ := cmd.Flags().GetString("output-dir")
+	includeCancelled, _ := cmd.Flags().GetBool("include-cancelled")
+	noSteps, _ := cmd.Flags().GetBool("no-steps")
 	jobFilter, _ := cmd.Flags().GetString("job")
+	stepTiming, _ := cmd.Flags().GetString("step-timing")
 	byBranch, _ := cmd.Flags().GetBool("by-branch")
+	heatmap, _ := cmd.Flags().GetBool("heatmap")
+	failureSummary, _ := cmd.Flags().GetBool("failure-summary")
+	cacheHitReport, _ := cmd.Flags().GetBool("cache-hit-report")
+	anomalies, _ := cmd.Flags().GetBool("anomalies")
+	anomalyThreshold, _ := cmd.Flags().GetFloat64("anomaly-threshold")
+	chart, _ := cmd.Flags().GetBool("chart")
+	sparklines, _ := cmd.Flags().GetBool("sparklines")
+	timeWeighted, _ := cmd.Flags().GetBool("time-weighted")
+	halfLife, _ := cmd.Flags().GetFloat64("half-life")
 	cacheOnly, _ := cmd.Flags().GetBool("cache-only")
 	noCache, _ := cmd.Flags().GetBool("no-cache")
+	cacheTTL, _ := cmd.Flags().GetString("cache-ttl")
 	listWorkflows, _ := cmd.Flags().GetBool("list-workflows")
+	cleanupArtifacts, _ := cmd.Flags().GetBool("cleanup-artifacts")
+	artifactPatterns, _ := cmd.Flags().GetStringSlice("artifact-pattern")
+	keepArtifacts, _ := cmd.Flags().GetInt("keep-artifacts")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	commit, _ := cmd.Flags().GetString("commit")
+	influx, _ := cmd.Flags().GetBool("influx")
+	prometheusPath, _ := cmd.Flags().GetString("prometheus")
+	influxOpts := export.InfluxWriteOptions{}
+	influxOpts.URL, _ = cmd.Flags().GetString("influx-url")
+	influxOpts.Token, _ = cmd.Flags().GetString("influx-token")
+	influxOpts.Org, _ = cmd.Flags().GetString("influx-org")
+	influxOpts.Bucket, _ = cmd.Flags().GetString("influx-bucket")
+	if influxOpts.Token == "" {
+		influxOpts.Token = os.Getenv("INFLUX_TOKEN")
+	}
 
+	repo = resolveRepo(cmd, repo)
 	if repo == "" {
 		fmt.Println("Error: --repo flag is required")
 		return
@@ -89,6 +199,20 @@ func runGHAPerf(cmd *cobra.Command, _ []string) {
 	}
 	owner, repoName := parts[0], parts[1]
 
+	if influxOpts.URL != "" && (influxOpts.Org == "" || influxOpts.Bucket == "") {
+		fmt.Println("Error: --influx-url requires --influx-org and --influx-bucket")
+		return
+	}
+
+	var stepJob, stepName string
+	if stepTiming != "" {
+		var ok bool
+		if stepJob, stepName, ok = parseStepTiming(stepTiming); !ok {
+			fmt.Println("Error: --step-timing must be in format job:step")
+			return
+		}
+	}
+
 	ctx := context.Background()
 	client, err := github.NewClient(ctx)
 	if err != nil {
@@ -114,26 +238,67 @@ func runGHAPerf(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	if cleanupArtifacts {
+		runArtifactCleanup(client, owner, repoName, artifactPatterns, keepArtifacts, dryRun)
+		return
+	}
+
 	cacheManager, err := cache.NewGHAPerfCacheManager("")
 	if err != nil {
 		fmt.Printf("Error: failed to create cache manager: %v\n", err)
 		return
 	}
+	regressionThreshold := config.DefaultConfig().GHAPerf.RegressionThreshold
+	cacheManager.MaxAge = config.DefaultConfig().GHAPerf.CacheTTLDuration()
+	if cfg, err := config.Load(); err == nil {
+		regressionThreshold = cfg.GHAPerf.RegressionThreshold
+		cacheManager.MaxAge = cfg.GHAPerf.CacheTTLDuration()
+		cacheManager.MaxSizeBytes = cfg.Cache.MaxSizeBytes
+		remote, err := cache.NewRemoteCacheBackend(cfg.Cache.Backend, cfg.Cache.RemoteURL)
+		if err != nil {
+			fmt.Printf("Warning: remote cache unavailable, using local cache: %v\n", err)
+		} else if remote != nil {
+			cacheManager.SetRemote(remote)
+		}
+	}
+	if cacheTTL != "" {
+		ttl, err := config.ParseDuration(cacheTTL)
+		if err != nil {
+			fmt.Printf("Error: --cache-ttl: %v\n", err)
+			return
+		}
+		cacheManager.MaxAge = ttl
+	}
 
 	var allRuns []github.RunTiming
 	var cachedCount, newCount int
+	cachedData := &cache.GHAPerfCache{}
 
 	if !noCache {
-		cachedData, err := cacheManager.Load(owner, repoName)
+		loaded, err := cacheManager.Load(owner, repoName)
 		if err != nil {
 			fmt.Printf("Warning: failed to load cache: %v\n", err)
 		} else {
+			cachedData = loaded
+			if loaded.Expired {
+				fmt.Printf("Discarded cache older than %s; refetching runs\n", cacheManager.MaxAge)
+			}
 			cachedCount = len(cachedData.Runs)
 			allRuns = cachedData.Runs
 		}
 	}
 
-	if !cacheOnly {
+	if commit != "" && !cacheOnly {
+		// Runs for one commit may be older than the lookback window, so fetch them directly
+		fmt.Printf("Fetching workflow runs for commit %s...\n", commit)
+		commitRuns, err := client.ListRunsForCommitWithDetails(owner, repoName, commit)
+		if err != nil {
+			fmt.Printf("Warning: failed to fetch runs for commit, using cache: %v\n", err)
+		} else {
+			newCount = len(commitRuns)
+			allRuns = cacheManager.MergeRuns(allRuns, commitRuns)
+		}
+	} else if !cacheOnly {
 		cachedIDs := make(map[int]bool)
 		for _, r := range allRuns {
 			cachedIDs[r.RunID] = true
@@ -145,6 +310,7 @@ func runGHAPerf(cmd *cobra.Command, _ []string) {
 			Branch:       branch,
 			Limit:        limit,
 			CreatedAfter: since,
+			Workers:      workers,
 		}
 
 		if compare != "" {
@@ -152,8 +318,15 @@ func runGHAPerf(cmd *cobra.Command, _ []string) {
 			opts.Branch = ""
 		}
 
+		// Filtered fetches may not have cached every run below the resume point
+		filtered := opts.WorkflowFile != "" || opts.Branch != ""
+		resumeRunID := cachedData.ResumeRunID()
+		if filtered {
+			resumeRunID = 0
+		}
+
 		fmt.Printf("Fetching workflow runs for %s...\n", repo)
-		newRuns, err := client.FetchWorkflowRunsWithDetails(owner, repoName, opts)
+		newRuns, pendingRunID, err := client.FetchWorkflowRunsSinceWithDetails(owner, repoName, opts, resumeRunID)
 		if err != nil {
 			if cachedCount > 0 {
 				fmt.Printf("Warning: failed to fetch new runs, using cache: %v\n", err)
@@ -172,8 +345,10 @@ func runGHAPerf(cmd *cobra.Command, _ []string) {
 
 			allRuns = cacheManager.MergeRuns(allRuns, newRuns)
 
-			if !noCache && newCount > 0 {
-				cachedData := &cache.GHAPerfCache{Runs: allRuns}
+			previousPending := cachedData.PendingRunID
+			cachedData.UpdatePending(pendingRunID, filtered)
+			if !noCache && (newCount > 0 || cachedData.PendingRunID != previousPending) {
+				cachedData.Runs = allRuns
 				if err := cacheManager.Save(owner, repoName, cachedData); err != nil {
 					fmt.Printf("Warning: failed to save cache: %v\n", err)
 				} else {
@@ -190,25 +365,79 @@ func runGHAPerf(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	since := time.Now().AddDate(0, 0, -days)
-	allRuns = github.FilterRunsByTimeRange(allRuns, since, time.Time{})
+	if commit != "" {
+		allRuns = github.FilterRunsByCommit(allRuns, commit)
+	} else {
+		since := time.Now().AddDate(0, 0, -days)
+		allRuns = github.FilterRunsByTimeRange(allRuns, since, time.Time{})
+	}
 
 	if branch != "" && compare == "" {
 		allRuns = github.FilterRunsByBranch(allRuns, branch)
 	}
 
+	if outputDir != "" {
+		csvPath = filepath.Join(outputDir, FormatBulkFilename(owner, repoName, "gha-perf", "csv"))
+	}
+
 	if csvPath != "" {
-		if err := exportCSV(allRuns, csvPath); err != nil {
+		csvOpts := csvExportOptions{IncludeCancelled: includeCancelled, NoSteps: noSteps}
+		if err := exportCSV(allRuns, csvPath, csvOpts); err != nil {
 			fmt.Printf("Error: failed to export CSV: %v\n", err)
 		} else {
 			fmt.Printf("Exported to %s\n", csvPath)
 		}
 	}
 
+	if prometheusPath != "" {
+		if err := exportPrometheus(allRuns, repo, prometheusPath); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		} else {
+			fmt.Printf("Exported Prometheus metrics to %s\n", prometheusPath)
+		}
+	}
+
+	if influx || influxOpts.URL != "" {
+		lines := export.FormatInfluxLineProtocol(github.ComputeWorkflowStats(allRuns), repo, time.Now())
+
+		if influxOpts.URL != "" {
+			if err := export.WriteInfluxLineProtocol(influxOpts, lines); err != nil {
+				fmt.Printf("Error: %v\n", err)
+			} else {
+				fmt.Printf("Wrote %d points to %s\n", strings.Count(lines, "\n"), influxOpts.URL)
+			}
+		}
+
+		if influx {
+			fmt.Print(lines)
+			return
+		}
+	}
+
+	if failureSummary {
+		fmt.Println()
+		fmt.Print(github.FormatFailureSummary(github.GroupRunsByWorkflowAndConclusion(allRuns)))
+		return
+	}
+
+	if cacheHitReport {
+		printCacheHitReport(client, owner, repoName, allRuns)
+		return
+	}
+
+	if stepTiming != "" {
+		printStepTiming(allRuns, stepJob, stepName)
+		return
+	}
+
 	if compare != "" {
 		currentRuns := github.FilterRunsByBranch(allRuns, compare)
 		baseRuns := github.FilterRunsByBranch(allRuns, baseBranch)
-		printComparison(currentRuns, baseRuns, compare, baseBranch)
+		regressions := printComparison(currentRuns, baseRuns, compare, baseBranch, regressionThreshold)
+		if code := regressionExitCode(regressions, failOnRegression); code != 0 {
+			fmt.Fprintf(os.Stderr, "Error: %d workflow(s) regressed by more than %.0f%% vs %s\n", len(regressions), regressionThreshold, baseBranch)
+			os.Exit(code)
+		}
 		return
 	}
 
@@ -217,11 +446,40 @@ func runGHAPerf(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	printSummary(allRuns)
+	if heatmap {
+		printHeatmap(allRuns)
+		return
+	}
+
+	if anomalies {
+		printAnomalies(allRuns, anomalyThreshold)
+		return
+	}
+
+	if !timeWeighted {
+		halfLife = 0
+	}
+	printSummary(allRuns, halfLife)
+	if chart {
+		printWorkflowChart(allRuns)
+	}
+	if sparklines {
+		printWorkflowSparklines(allRuns)
+	}
 	printJobSummary(allRuns, jobFilter)
 }
 
-func exportCSV(runs []github.RunTiming, path string) error {
+// csvExportOptions controls which rows exportCSV emits
+type csvExportOptions struct {
+	IncludeCancelled bool
+	NoSteps          bool
+}
+
+func exportCSV(runs []github.RunTiming, path string, opts csvExportOptions) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
 	f, err := os.Create(path)
 	if err != nil {
 		return err
@@ -232,7 +490,7 @@ func exportCSV(runs []github.RunTiming, path string) error {
 	defer w.Flush()
 
 	header := []string{
-		"run_id", "workflow", "branch", "conclusion", "created_at",
+		"run_id", "run_attempt", "workflow", "branch", "conclusion", "created_at",
 		"run_duration_s", "job_name", "job_duration_s", "step_name", "step_duration_s",
 	}
 	if err := w.Write(header); err != nil {
@@ -240,20 +498,32 @@ func exportCSV(runs []github.RunTiming, path string) error {
 	}
 
 	for _, r := range runs {
+		if r.Conclusion == "cancelled" && !opts.IncludeCancelled {
+			continue
+		}
+
+		runCols := []string{
+			fmt.Sprintf("%d", r.RunID),
+			fmt.Sprintf("%d", r.RunAttempt),
+			r.Workflow,
+			r.Branch,
+			r.Conclusion,
+			r.CreatedAt.Format(time.RFC3339),
+			fmt.Sprintf("%.1f", r.DurationSeconds),
+		}
+
 		for _, j := range r.Jobs {
-			for _, s := range j.Steps {
-				row := []string{
-					fmt.Sprintf("%d", r.RunID),
-					r.Workflow,
-					r.Branch,
-					r.Conclusion,
-					r.CreatedAt.Format(time.RFC3339),
-					fmt.Sprintf("%.1f", r.DurationSeconds),
-					j.Name,
-					fmt.Sprintf("%.1f", j.DurationSeconds),
-					s.Name,
-					fmt.Sprintf("%.1f", s.DurationSeconds),
+			jobCols := append(append([]string{}, runCols...), j.Name, fmt.Sprintf("%.1f", j.DurationSeconds))
+
+			if opts.NoSteps {
+				if err := w.Write(append(jobCols, "", "")); err != nil {
+					return err
 				}
+				continue
+			}
+
+			for _, s := range j.Steps {
+				row := append(append([]string{}, jobCols...), s.Name, fmt.Sprintf("%.1f", s.DurationSeconds))
 				if err := w.Write(row); err != nil {
 					return err
 				}
@@ -264,13 +534,180 @@ func exportCSV(runs []github.RunTiming, path string) error {
 	return nil
 }
 
-func printSummary(runs []github.RunTiming) {
+func printHeatmap(runs []github.RunTiming) {
+	heatmap := github.ComputeFailureHeatmap(runs)
+	maxCount := github.MaxHeatmapCount(heatmap)
+
+	fmt.Println()
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("FAILURE HEATMAP (UTC)")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println()
+
+	fmt.Print("     ")
+	for hour := 0; hour < 24; hour++ {
+		fmt.Printf("%3d", hour)
+	}
+	fmt.Println()
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		fmt.Printf("%s  ", day.String()[:3])
+		for hour := 0; hour < 24; hour++ {
+			count := heatmap[day][hour]
+			if count == 0 {
+				fmt.Printf("%3s", ".")
+			} else {
+				fmt.Printf("%3d", count)
+			}
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("\nPeak: %d failures in a single hour slot\n", maxCount)
+}
+
+func runArtifactCleanup(client *github.Client, owner, repo string, patterns []string, keepLatest int, dryRun bool) {
+	artifacts, err := client.ListArtifacts(owner, repo)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	plan := github.PlanArtifactCleanup(artifacts, keepLatest, patterns)
+	fmt.Printf("%d artifact(s): %d to delete, %d to keep (frees %s)\n\n",
+		len(artifacts), len(plan.ToDelete), len(plan.ToKeep), formatBytes(plan.FreedBytes))
+
+	deleted := 0
+	var freed int64
+	for _, a := range plan.ToDelete {
+		if dryRun {
+			fmt.Printf("  [DRY RUN] Would delete %s (%s, %s)\n", a.Name, formatBytes(a.SizeInBytes), a.CreatedAt.Format("2006-01-02"))
+			continue
+		}
+
+		if err := client.DeleteArtifact(owner, repo, a.ID); err != nil {
+			fmt.Printf("  ✗ %s: %v\n", a.Name, err)
+			continue
+		}
+		fmt.Printf("  ✓ Deleted %s\n", a.Name)
+		deleted++
+		freed += a.SizeInBytes
+	}
+
+	if !dryRun {
+		fmt.Printf("\nDeleted %d/%d artifact(s), freed %s\n", deleted, len(plan.ToDelete), formatBytes(freed))
+	}
+}
+
+// exportPrometheus writes workflow and job stats for runs to path in Prometheus text format
+func exportPrometheus(runs []github.RunTiming, repo, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create Prometheus file: %w", err)
+	}
+
+	if err := export.ExportPrometheus(github.ComputeWorkflowStats(runs), github.ComputeJobStats(runs), repo, file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// formatBytes renders a byte count with a binary unit, e.g. 1.5 MiB
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// printCacheHitReport downloads logs for the most recent runs and prints step cache hit rates by workflow
+func printCacheHitReport(client *github.Client, owner, repo string, runs []github.RunTiming) {
+	github.SortRunsByDate(runs, false)
+	if len(runs) > github.DefaultCacheLogRuns {
+		runs = runs[:github.DefaultCacheLogRuns]
+	}
+
+	fmt.Printf("Fetching job logs for %d runs...\n", len(runs))
+	stats := github.ComputeWorkflowCacheStats(runs, client.GetRunLogs(owner, repo, runs))
+
+	fmt.Println()
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("CACHE HIT RATE BY STEP")
+	fmt.Println(strings.Repeat("=", 60))
+
+	var workflows []*github.WorkflowCacheStats
+	for _, ws := range stats {
+		if len(ws.Steps) > 0 {
+			workflows = append(workflows, ws)
+		}
+	}
+	if len(workflows) == 0 {
+		fmt.Println("\nNo cache restore or miss messages found in job logs")
+		return
+	}
+	sort.Slice(workflows, func(i, j int) bool {
+		return workflows[i].Workflow < workflows[j].Workflow
+	})
+
+	for _, ws := range workflows {
+		fmt.Printf("\n%s (%d runs):\n", ws.Workflow, ws.Runs)
+
+		var steps []*github.StepCacheStats
+		for _, s := range ws.Steps {
+			steps = append(steps, s)
+		}
+		sort.Slice(steps, func(i, j int) bool {
+			return steps[i].Step < steps[j].Step
+		})
+
+		for _, s := range steps {
+			fmt.Printf("  %-40s %5.0f%%  (%d hit, %d miss)\n", truncate(s.Step, 40), s.HitRate(), s.Hits, s.Misses)
+		}
+	}
+}
+
+func printAnomalies(runs []github.RunTiming, zScoreThreshold float64) {
+	fmt.Println()
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("ANOMALOUS RUNS (|z| > %.1f)\n", zScoreThreshold)
+	fmt.Println(strings.Repeat("=", 60))
+
+	anomalies := github.ComputeAnomalousRuns(runs, zScoreThreshold)
+	if len(anomalies) == 0 {
+		fmt.Println("\nNo anomalous runs found")
+		return
+	}
+
+	fmt.Printf("\n%-12s %-30s %-20s %10s %7s  %s\n", "Run", "Workflow", "Branch", "Duration", "Z", "Deviation")
+	for _, a := range anomalies {
+		fmt.Printf("%-12d %-30s %-20s %10s %+7.2f  %s\n",
+			a.RunID,
+			truncate(a.Workflow, 30),
+			truncate(a.Branch, 20),
+			github.FormatDuration(a.Duration),
+			a.ZScore,
+			a.Deviation)
+	}
+}
+
+// printSummary prints per-workflow stats, adding a time-weighted average when halfLifeDays > 0
+func printSummary(runs []github.RunTiming, halfLifeDays float64) {
 	fmt.Println()
 	fmt.Println(strings.Repeat("=", 60))
 	fmt.Println("WORKFLOW PERFORMANCE SUMMARY")
 	fmt.Println(strings.Repeat("=", 60))
 
 	stats := github.ComputeWorkflowStats(runs)
+	if halfLifeDays > 0 {
+		stats = github.ComputeTimeWeightedWorkflowStats(runs, halfLifeDays)
+	}
 
 	var workflows []*github.WorkflowStats
 	for _, s := range stats {
@@ -284,12 +721,86 @@ func printSummary(runs []github.RunTiming) {
 		fmt.Printf("\n%s:\n", s.Workflow)
 		fmt.Printf("  Runs: %d\n", s.TotalRuns)
 		fmt.Printf("  Avg:  %s\n", github.FormatDuration(s.AvgDuration))
+		fmt.Printf("  P50: %s | P75: %s | P95: %s\n",
+			github.FormatDuration(s.P50), github.FormatDuration(s.P75), github.FormatDuration(s.P95))
+		fmt.Printf("  Queue: %s | Exec: %s\n", github.FormatDuration(s.AvgQueue), github.FormatDuration(s.AvgExecution))
+		if halfLifeDays > 0 {
+			fmt.Printf("  Weighted avg: %s (half-life %gd)\n", github.FormatDuration(s.TimeWeightedAvg), halfLifeDays)
+		}
 		fmt.Printf("  Min:  %s\n", github.FormatDuration(s.MinDuration))
 		fmt.Printf("  Max:  %s\n", github.FormatDuration(s.MaxDuration))
 		fmt.Printf("  Success: %.0f%%\n", s.SuccessRate)
 	}
 }
 
+func printWorkflowChart(runs []github.RunTiming) {
+	fmt.Println()
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("AVERAGE WORKFLOW DURATION")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println()
+
+	avgDurations := make(map[string]time.Duration)
+	for workflow, s := range github.ComputeWorkflowStats(runs) {
+		avgDurations[workflow] = s.AvgDuration
+	}
+
+	fmt.Print(export.RenderBarChart(avgDurations, 40))
+}
+
+// sparklineWidth is the number of time buckets in each --sparklines trend
+const sparklineWidth = 40
+
+func printWorkflowSparklines(runs []github.RunTiming) {
+	fmt.Println()
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("WORKFLOW DURATION TREND (oldest to newest)")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println()
+
+	stats := github.ComputeWorkflowStats(runs)
+	workflows := make([]string, 0, len(stats))
+	for workflow := range stats {
+		workflows = append(workflows, workflow)
+	}
+	sort.Strings(workflows)
+
+	for _, workflow := range workflows {
+		s := stats[workflow]
+		series := github.GetWorkflowDurationTimeSeries(runs, workflow, sparklineWidth)
+		fmt.Printf("%-30s %s  %s - %s\n",
+			truncate(workflow, 30),
+			export.RenderSparkline(series, sparklineWidth),
+			github.FormatDuration(s.MinDuration),
+			github.FormatDuration(s.MaxDuration))
+	}
+}
+
+// parseStepTiming splits a job:step argument at the first colon
+func parseStepTiming(value string) (job, step string, ok bool) {
+	job, step, ok = strings.Cut(value, ":")
+	job, step = strings.TrimSpace(job), strings.TrimSpace(step)
+	return job, step, ok && job != "" && step != ""
+}
+
+func printStepTiming(runs []github.RunTiming, jobName, stepName string) {
+	agg := github.AggregateStepTimings(runs, jobName, stepName)
+	if agg == nil {
+		fmt.Printf("No timings found for step %q in job %q\n", stepName, jobName)
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("STEP TIMING: %s / %s\n", jobName, stepName)
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("  Runs: %d (%d samples)\n", agg.RunCount, len(agg.Samples))
+	fmt.Printf("  Min:  %s\n", github.FormatDuration(agg.Min))
+	fmt.Printf("  Avg:  %s\n", github.FormatDuration(agg.Avg))
+	fmt.Printf("  P95:  %s\n", github.FormatDuration(agg.P95))
+	fmt.Printf("  Max:  %s\n", github.FormatDuration(agg.Max))
+}
+
 func printJobSummary(runs []github.RunTiming, jobFilter string) {
 	fmt.Println()
 	fmt.Println(strings.Repeat("=", 60))
@@ -408,7 +919,20 @@ func printByBranch(runs []github.RunTiming, baseBranch string) {
 	}
 }
 
-func printComparison(runsA, runsB []github.RunTiming, labelA, labelB string) {
+// exitCodeRegression is returned by --fail-on-regression so CI can tell regressions from other failures
+const exitCodeRegression = 2
+
+// regressionExitCode returns the process exit code for the regressions found by printComparison
+func regressionExitCode(regressions []string, failOnRegression bool) int {
+	if failOnRegression && len(regressions) > 0 {
+		return exitCodeRegression
+	}
+	return 0
+}
+
+// printComparison prints per-workflow averages for labelA against labelB
+// Returns the workflows where labelA is slower than labelB by more than thresholdPct percent
+func printComparison(runsA, runsB []github.RunTiming, labelA, labelB string, thresholdPct float64) []string {
 	fmt.Println()
 	fmt.Println(strings.Repeat("=", 70))
 	fmt.Printf("BRANCH COMPARISON: %s vs %s\n", labelA, labelB)
@@ -431,6 +955,7 @@ func printComparison(runsA, runsB []github.RunTiming, labelA, labelB string) {
 	}
 	sort.Strings(workflows)
 
+	var regressions []string
 	for _, wf := range workflows {
 		fmt.Printf("\n%s:\n", wf)
 
@@ -457,6 +982,10 @@ func printComparison(runsA, runsB []github.RunTiming, labelA, labelB string) {
 			fmt.Printf("  %s: %s avg (%d runs)\n", labelB, github.FormatDuration(sB.AvgDuration), sB.TotalRuns)
 			fmt.Printf("  Delta: %s%s (%s%.1f%%) - %s\n",
 				sign, github.FormatDuration(abs(diff)), sign, pct, indicator)
+			if github.IsRegression(pct, thresholdPct) {
+				fmt.Printf("  ⚠️ REGRESSION: more than %.0f%% slower than %s\n", thresholdPct, labelB)
+				regressions = append(regressions, wf)
+			}
 		} else if okA {
 			fmt.Printf("  %s: %s avg (%d runs)\n", labelA, github.FormatDuration(sA.AvgDuration), sA.TotalRuns)
 			fmt.Printf("  %s: No data\n", labelB)
@@ -465,6 +994,8 @@ func printComparison(runsA, runsB []github.RunTiming, labelA, labelB string) {
 			fmt.Printf("  %s: %s avg (%d runs)\n", labelB, github.FormatDuration(sB.AvgDuration), sB.TotalRuns)
 		}
 	}
+
+	return regressions
 }
 
 func truncate(s string, maxLen int) string {
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// testCSVRuns returns one successful and one cancelled run, each with a single two-step job
+func testCSVRuns() []github.RunTiming {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	jobs := []github.JobTiming{
+		{
+			Name:            "build",
+			DurationSeconds: 60,
+			Steps: []github.StepTiming{
+				{Name: "checkout", DurationSeconds: 5},
+				{Name: "compile", DurationSeconds: 55},
+			},
+		},
+	}
+
+	return []github.RunTiming{
+		{RunID: 1, RunAttempt: 2, Workflow: "ci.yml", Branch: "main", Conclusion: "success", CreatedAt: created, Jobs: jobs},
+		{RunID: 2, RunAttempt: 1, Workflow: "ci.yml", Branch: "main", Conclusion: "cancelled", CreatedAt: created, Jobs: jobs},
+	}
+}
+
+// readCSV exports runs with opts and returns the parsed records
+func readCSV(t *testing.T, runs []github.RunTiming, opts csvExportOptions) [][]string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "runs.csv")
+	if err := exportCSV(runs, path, opts); err != nil {
+		t.Fatalf("exportCSV failed: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open CSV: %v", err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to parse CSV: %v", err)
+	}
+
+	return records
+}
+
+// TestExportCSV tests row granularity and cancelled-run filtering
+func TestExportCSV(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         csvExportOptions
+		expectedRows int
+		expectSteps  bool
+	}{
+		{"steps without cancelled", csvExportOptions{}, 2, true},
+		{"steps with cancelled", csvExportOptions{IncludeCancelled: true}, 4, true},
+		{"jobs only", csvExportOptions{NoSteps: true}, 1, false},
+		{"jobs only with cancelled", csvExportOptions{NoSteps: true, IncludeCancelled: true}, 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := readCSV(t, testCSVRuns(), tt.opts)
+
+			header := records[0]
+			if header[1] != "run_attempt" {
+				t.Errorf("Expected run_attempt column, got header %v", header)
+			}
+
+			rows := records[1:]
+			if len(rows) != tt.expectedRows {
+				t.Fatalf("Expected %d rows, got %d", tt.expectedRows, len(rows))
+			}
+
+			stepCol := len(header) - 2
+			for _, row := range rows {
+				if tt.expectSteps && row[stepCol] == "" {
+					t.Errorf("Expected step name in row %v", row)
+				}
+				if !tt.expectSteps && row[stepCol] != "" {
+					t.Errorf("Expected no step rows, got %v", row)
+				}
+				if row[4] == "cancelled" && !tt.opts.IncludeCancelled {
+					t.Errorf("Expected cancelled runs to be omitted, got %v", row)
+				}
+			}
+		})
+	}
+
+	records := readCSV(t, testCSVRuns(), csvExportOptions{})
+	if records[1][1] != "2" {
+		t.Errorf("Expected run_attempt 2, got %s", records[1][1])
+	}
+}
+
+// TestFailOnRegression tests that a branch 25% slower than base is flagged and fails the gate
+func TestFailOnRegression(t *testing.T) {
+	created := time.Now()
+	base := []github.RunTiming{
+		{RunID: 1, Workflow: "ci.yml", Branch: "main", Conclusion: "success", CreatedAt: created, Duration: 8 * time.Minute},
+		{RunID: 2, Workflow: "ci.yml", Branch: "main", Conclusion: "success", CreatedAt: created, Duration: 8 * time.Minute},
+	}
+	branch := []github.RunTiming{
+		{RunID: 3, Workflow: "ci.yml", Branch: "feature", Conclusion: "success", CreatedAt: created, Duration: 10 * time.Minute},
+	}
+
+	var regressions []string
+	output := captureStdout(t, func() {
+		regressions = printComparison(branch, base, "feature", "main", 20)
+	})
+
+	if !strings.Contains(output, "⚠️ REGRESSION") {
+		t.Errorf("Expected regression indicator, got:\n%s", output)
+	}
+	if len(regressions) != 1 || regressions[0] != "ci.yml" {
+		t.Errorf("Expected ci.yml to regress, got %v", regressions)
+	}
+	if code := regressionExitCode(regressions, true); code != 2 {
+		t.Errorf("Expected exit code 2 with --fail-on-regression, got %d", code)
+	}
+	if code := regressionExitCode(regressions, false); code != 0 {
+		t.Errorf("Expected exit code 0 without --fail-on-regression, got %d", code)
+	}
+
+	output = captureStdout(t, func() {
+		regressions = printComparison(branch, base, "feature", "main", 30)
+	})
+	if strings.Contains(output, "REGRESSION") || regressionExitCode(regressions, true) != 0 {
+		t.Errorf("Expected 25%% slower to pass a 30%% threshold, got:\n%s", output)
+	}
+}
+
+// TestParseStepTiming tests splitting job:step arguments
+func TestParseStepTiming(t *testing.T) {
+	job, step, ok := parseStepTiming("build:Run tests: unit")
+	if !ok || job != "build" || step != "Run tests: unit" {
+		t.Errorf("Expected build / Run tests: unit, got %q / %q (ok=%v)", job, step, ok)
+	}
+
+	for _, value := range []string{"build", ":step", "build:"} {
+		if _, _, ok := parseStepTiming(value); ok {
+			t.Errorf("Expected %q to be rejected", value)
+		}
+	}
+}
+
+// TestFormatBytes tests unit selection for artifact sizes
+func TestFormatBytes(t *testing.T) {
+	tests := map[int64]string{
+		512:             "512 B",
+		1536:            "1.5 KiB",
+		5 * 1024 * 1024: "5.0 MiB",
+	}
+	for n, expected := range tests {
+		if got := formatBytes(n); got != expected {
+			t.Errorf("formatBytes(%d): expected %q, got %q", n, expected, got)
+		}
+	}
+}
+
+func TestExportPrometheusWritesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gh_sweep.prom")
+	if err := exportPrometheus(testCSVRuns(), "owner/repo", path); err != nil {
+		t.Fatalf("exportPrometheus failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read Prometheus file: %v", err)
+	}
+	for _, want := range []string{
+		`gh_sweep_workflow_runs{repo="owner/repo",workflow="ci.yml"} 2`,
+		`gh_sweep_job_runs{repo="owner/repo",workflow="ci.yml",job="build"} 2`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Expected %q in output, got:\n%s", want, data)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var healthCmd = &cobra.Command{
+	Use:   "health",
+	Short: "Run repository health checks",
+	Long: `Check repositories for common best-practice gaps.
+
+Checks:
+  - Security policy (SECURITY.md or .github/SECURITY.md) exists and is not a placeholder
+
+Repositories default to the 'repositories' list in .gh-sweep.yaml.
+
+Examples:
+  # Check repositories from the config file
+  gh-sweep health
+
+  # Check specific repositories
+  gh-sweep health --repos owner/repo1,owner/repo2
+
+  # Require longer security policies
+  gh-sweep health --min-policy-length 500`,
+	Run: runHealth,
+}
+
+func init() {
+	rootCmd.AddCommand(healthCmd)
+	supportsGroup(healthCmd)
+
+	healthCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	healthCmd.Flags().Int("min-policy-length", 0, "Minimum security policy length in characters (default from config)")
+}
+
+func runHealth(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	minPolicyLength, _ := cmd.Flags().GetInt("min-policy-length")
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error: failed to load config: %v\n", err)
+		return
+	}
+
+	if len(repos) == 0 {
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+	if minPolicyLength <= 0 {
+		minPolicyLength = cfg.Security.MinPolicyLength
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	policies := make(map[string]string)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		policy, err := client.GetSecurityPolicy(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+		policies[repoStr] = policy
+	}
+
+	report := github.AuditSecurityPolicyWithMinLength(policies, minPolicyLength)
+	printSecurityPolicyReport(report)
+	exitOnFindings(cmd, "security findings", len(report.Missing)+len(report.TooShort), cfg.CI.MaxSecurityFindings)
+}
+
+func printSecurityPolicyReport(report github.SecurityPolicyReport) {
+	fmt.Println("Security Policy")
+	fmt.Println(strings.Repeat("-", 40))
+
+	for _, repo := range report.Missing {
+		fmt.Printf("  [MISSING]   %s\n", repo)
+	}
+	for _, repo := range report.TooShort {
+		fmt.Printf("  [TOO SHORT] %s (< %d chars)\n", repo, report.MinLength)
+	}
+	for _, repo := range report.Compliant {
+		fmt.Printf("  [OK]        %s\n", repo)
+	}
+
+	fmt.Printf("\n%d missing, %d too short, %d ok\n",
+		len(report.Missing), len(report.TooShort), len(report.Compliant))
+}
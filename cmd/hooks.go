@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/KyleKing/gh-sweep/internal/git"
+	"github.com/spf13/cobra"
+)
+
+var hooksCmd = &cobra.Command{
+	Use:   "hooks",
+	Short: "Manage Git hooks that run gh-sweep checks",
+	Long: `Install or remove a pre-push hook that runs the orphan branch check
+for the current repository before each push.
+
+Examples:
+  # Install the pre-push hook in the current repository
+  gh-sweep hooks install
+
+  # Allow up to 3 orphans and ignore wip branches
+  gh-sweep hooks install --max-orphans 3 --exclude 'wip/*'
+
+  # Remove the hook
+  gh-sweep hooks uninstall`,
+}
+
+var hooksInstallCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install the pre-push orphan check hook",
+	Run: func(cmd *cobra.Command, args []string) {
+		path, _ := cmd.Flags().GetString("path")
+		maxOrphans, _ := cmd.Flags().GetInt("max-orphans")
+		exclude, _ := cmd.Flags().GetStringSlice("exclude")
+
+		if !git.NewLocalRepo(path).IsInsideWorkTree() {
+			fmt.Printf("Error: %s is not inside a Git repository\n", path)
+			return
+		}
+
+		config := git.HookConfig{
+			MaxOrphans:      maxOrphans,
+			ExcludePatterns: exclude,
+		}
+		if err := git.InstallPrePushHook(path, config); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		fmt.Println("✓ Installed pre-push hook")
+	},
+}
+
+var hooksUninstallCmd = &cobra.Command{
+	Use:   "uninstall",
+	Short: "Remove the pre-push orphan check hook",
+	Run: func(cmd *cobra.Command, args []string) {
+		path, _ := cmd.Flags().GetString("path")
+
+		if err := git.UninstallHook(path); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		fmt.Println("✓ Removed pre-push hook")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(hooksCmd)
+	hooksCmd.AddCommand(hooksInstallCmd)
+	hooksCmd.AddCommand(hooksUninstallCmd)
+
+	hooksCmd.PersistentFlags().String("path", ".", "Path to the local repository")
+	hooksInstallCmd.Flags().Int("max-orphans", 0, "Orphans tolerated before the push is blocked")
+	hooksInstallCmd.Flags().StringSlice("exclude", nil, "Branch patterns to exclude from the check")
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var ipAllowlistCmd = &cobra.Command{
+	Use:   "ip-allowlist",
+	Short: "Audit an organization's IP allow list",
+	Long: `List IP allow list entries for an organization and flag stale ones.
+
+Entries created more than --stale-days ago are marked for review. Reading
+the allow list requires organization owner access.
+
+Examples:
+  # Audit the default org from .gh-sweep.yaml
+  gh-sweep ip-allowlist
+
+  # Flag entries older than 180 days
+  gh-sweep ip-allowlist --org myorg --stale-days 180`,
+	Run: runIPAllowlist,
+}
+
+func init() {
+	rootCmd.AddCommand(ipAllowlistCmd)
+
+	ipAllowlistCmd.Flags().String("org", "", "Organization to audit (default: default_org from config)")
+	ipAllowlistCmd.Flags().Int("stale-days", 90, "Flag entries created more than N days ago")
+}
+
+func runIPAllowlist(cmd *cobra.Command, _ []string) {
+	org, _ := cmd.Flags().GetString("org")
+	staleDays, _ := cmd.Flags().GetInt("stale-days")
+
+	if org == "" {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		org = cfg.DefaultOrg
+	}
+
+	if org == "" {
+		fmt.Println("Error: no org configured (use --org or default_org in .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewGraphQLClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	entries, err := client.GetOrgIPAllowlist(org)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No IP allow list entries for %s.\n", org)
+		return
+	}
+
+	stale := make(map[string]bool)
+	for _, entry := range github.DetectStaleIPEntries(entries, staleDays) {
+		stale[entry.ID] = true
+	}
+
+	fmt.Printf("%-20s %-25s %-8s %-12s %s\n", "CIDR", "Name", "Active", "Created", "Status")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, entry := range entries {
+		status := "ok"
+		if stale[entry.ID] {
+			status = "STALE"
+		}
+		fmt.Printf("%-20s %-25s %-8v %-12s %s\n",
+			truncate(entry.CIDR, 20),
+			truncate(entry.Name, 25),
+			entry.IsActive,
+			entry.CreatedAt.Format("2006-01-02"),
+			status)
+	}
+
+	fmt.Printf("\n%d of %d entries older than %d days\n", len(stale), len(entries), staleDays)
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var issuesCmd = &cobra.Command{
+	Use:   "issues",
+	Short: "Track issue milestones across repositories",
+	Long: `List open milestones with completion progress across repositories.
+
+Use --milestone-alerts to only show milestones due within --warning-days,
+along with an estimated completion date based on the current burn rate
+(closed issues per day since the milestone was created).
+
+Examples:
+  # Milestone progress for configured repos
+  gh-sweep issues
+
+  # Milestones due within the next two weeks
+  gh-sweep issues --milestone-alerts --warning-days 14`,
+	Run: runIssues,
+}
+
+func init() {
+	rootCmd.AddCommand(issuesCmd)
+	supportsGroup(issuesCmd)
+
+	issuesCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	issuesCmd.Flags().Bool("milestone-alerts", false, "Only show milestones approaching their due date")
+	issuesCmd.Flags().Int("warning-days", 7, "Days before the due date to start alerting")
+}
+
+func runIssues(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	milestoneAlerts, _ := cmd.Flags().GetBool("milestone-alerts")
+	warningDays, _ := cmd.Flags().GetInt("warning-days")
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	var milestones []github.Milestone
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		repoMilestones, err := client.ListMilestones(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+		milestones = append(milestones, repoMilestones...)
+	}
+
+	if milestoneAlerts {
+		printMilestoneAlerts(github.AlertApproachingMilestones(milestones, warningDays), warningDays)
+		return
+	}
+
+	printMilestones(milestones)
+}
+
+func printMilestones(milestones []github.Milestone) {
+	now := time.Now()
+
+	fmt.Printf("%-30s %-25s %-14s %s\n", "Repository", "Milestone", "Progress", "Due")
+	fmt.Println(strings.Repeat("-", 80))
+
+	count := 0
+	for _, m := range milestones {
+		if m.State != "open" {
+			continue
+		}
+		count++
+
+		due := "-"
+		if m.DueOn != nil {
+			due = m.DueOn.Format("2006-01-02")
+			if github.IsMilestonePastDue(m, now) {
+				due += " (PAST DUE)"
+			}
+		}
+
+		fmt.Printf("%-30s %-25s %-14s %s\n",
+			truncate(m.Repository, 30), truncate(m.Title, 25), github.GetMilestoneProgress(m), due)
+	}
+
+	fmt.Printf("\n%d open milestones\n", count)
+}
+
+func printMilestoneAlerts(alerts []github.MilestoneAlert, warningDays int) {
+	if len(alerts) == 0 {
+		fmt.Printf("No open milestones due within %d days\n", warningDays)
+		return
+	}
+
+	fmt.Printf("%-7s %-30s %-25s %-5s %-6s %s\n", "Level", "Repository", "Milestone", "Days", "Done", "Est. Completion")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, a := range alerts {
+		estimate := "unknown"
+		if a.EstimatedCompletionDate != nil {
+			estimate = a.EstimatedCompletionDate.Format("2006-01-02")
+			if a.Milestone.DueOn != nil && a.EstimatedCompletionDate.After(*a.Milestone.DueOn) {
+				estimate += " (late)"
+			}
+		}
+
+		fmt.Printf("%-7s %-30s %-25s %-5d %5.0f%% %s\n",
+			strings.ToUpper(a.Level), truncate(a.Milestone.Repository, 30), truncate(a.Milestone.Title, 25),
+			a.DaysUntilDue, a.CompletionPct, estimate)
+	}
+
+	fmt.Printf("\n%d milestones due within %d days\n", len(alerts), warningDays)
+}
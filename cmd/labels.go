@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	labelstui "github.com/KyleKing/gh-sweep/internal/tui/components/labels"
+	"github.com/spf13/cobra"
+)
+
+var labelsCmd = &cobra.Command{
+	Use:   "labels",
+	Short: "Compare issue labels across repositories",
+	Long: `Find labels that exist in some repositories but not others, and
+same-named labels that use different colors.
+
+Repositories default to the 'repositories' list in .gh-sweep.yaml.
+Label names are compared case-insensitively, as GitHub does.
+
+Examples:
+  # Label consistency for configured repos
+  gh-sweep labels
+
+  # Compare specific repos
+  gh-sweep labels --repos owner/repo1,owner/repo2,owner/repo3
+
+  # Create labels from a template repo wherever they are missing
+  gh-sweep labels --repos owner/repo1,owner/repo2 --sync-from owner/template`,
+	Run: runLabels,
+}
+
+func init() {
+	rootCmd.AddCommand(labelsCmd)
+	supportsGroup(labelsCmd)
+
+	labelsCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	labelsCmd.Flags().String("sync-from", "", "Create this repo's labels in the other repos where they are missing")
+}
+
+func runLabels(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	syncFrom, _ := cmd.Flags().GetString("sync-from")
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if syncFrom != "" && !containsString(repos, syncFrom) {
+		repos = append([]string{syncFrom}, repos...)
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	if syncFrom == "" && interactive(cmd) {
+		if err := runProgram(labelstui.NewModel(repos)); err != nil {
+			fmt.Printf("Error running TUI: %v\n", err)
+		}
+		return
+	}
+
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	labelSets := make(map[string][]github.Label)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		labels, err := client.ListLabels(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+		labelSets[repoStr] = labels
+	}
+
+	if syncFrom != "" {
+		syncLabelsFrom(client, syncFrom, labelSets)
+		return
+	}
+
+	printLabelReport(github.CompareLabelSets(labelSets))
+}
+
+// syncLabelsFrom creates the template repo's labels in every other loaded repo that lacks them
+func syncLabelsFrom(client *github.Client, template string, labelSets map[string][]github.Label) {
+	templateLabels, ok := labelSets[template]
+	if !ok {
+		fmt.Printf("Error: no labels loaded for %s\n", template)
+		return
+	}
+
+	names := make([]string, 0, len(labelSets))
+	for name := range labelSets {
+		if name != template {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, repoStr := range names {
+		missing := github.MissingLabels(labelSets[repoStr], templateLabels)
+		if len(missing) == 0 {
+			fmt.Printf("%s: up to date\n", repoStr)
+			continue
+		}
+
+		parts := strings.Split(repoStr, "/")
+		if err := github.SyncLabels(client, parts[0], parts[1], templateLabels); err != nil {
+			fmt.Printf("%s: %v\n", repoStr, err)
+			continue
+		}
+		fmt.Printf("%s: created %d label(s)\n", repoStr, len(missing))
+	}
+}
+
+func printLabelReport(report github.LabelDiffReport) {
+	fmt.Printf("Compared labels across %d repositories\n", report.TotalRepos)
+
+	if !report.HasDifferences() {
+		fmt.Println("✓ All repositories have the same labels")
+		return
+	}
+
+	if len(report.Partial) > 0 {
+		fmt.Printf("\nLabels missing from some repos (%d):\n", len(report.Partial))
+		for _, presence := range report.Partial {
+			fmt.Printf("  %-30s %d/%d  missing from: %s\n",
+				truncate(presence.Name, 30), len(presence.PresentIn), report.TotalRepos, strings.Join(presence.MissingFrom, ", "))
+		}
+	}
+
+	if len(report.ColorConflicts) > 0 {
+		fmt.Printf("\nLabels with inconsistent colors (%d):\n", len(report.ColorConflicts))
+		for _, conflict := range report.ColorConflicts {
+			fmt.Printf("  %s:\n", conflict.Name)
+
+			colors := make([]string, 0, len(conflict.Colors))
+			for color := range conflict.Colors {
+				colors = append(colors, color)
+			}
+			sort.Strings(colors)
+
+			for _, color := range colors {
+				fmt.Printf("    #%s  %s\n", color, strings.Join(conflict.Colors[color], ", "))
+			}
+		}
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"os"
 	"strings"
 
+	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/export"
 	"github.com/KyleKing/gh-sweep/internal/github"
 	"github.com/KyleKing/gh-sweep/internal/orphans"
 	orphanstui "github.com/KyleKing/gh-sweep/internal/tui/components/orphans"
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 )
 
@@ -23,6 +25,7 @@ Orphan types detected:
   - merged_pr:   Branch from a merged PR that wasn't auto-deleted
   - closed_pr:   Branch from a closed (unmerged) PR
   - stale:       No associated PR, inactive > threshold (default 7 days)
+  - stale_tag:   Tag with no release, older than --tag-max-age (with --include-tags)
 
 Examples:
   # Launch interactive TUI for current user
@@ -34,16 +37,44 @@ Examples:
   # List orphaned branches (no TUI)
   gh-sweep orphans --org mycompany --list
 
+  # List the safest cleanups first (merged PR, closed PR, long-stale)
+  gh-sweep orphans --org mycompany --list --sort priority
+
   # Preview cleanup without executing
   gh-sweep orphans --cleanup --dry-run
 
   # Export to JSON
-  gh-sweep orphans --format json -o orphans.json`,
+  gh-sweep orphans --format json -o orphans.json
+
+  # Print a GitHub issue body with a checklist of branches to delete
+  gh-sweep orphans --org mycompany --format github-issue
+
+  # Open that checklist as an issue in a tracking repository
+  gh-sweep orphans --org mycompany --create-issue --issue-repo mycompany/housekeeping
+
+  # Export one JSON file per repository
+  gh-sweep orphans --format json --output-dir reports/
+
+  # Only scan feature branches
+  gh-sweep orphans --repos owner/repo --list --include 'feature/*'
+
+  # Also report old tags that were never released
+  gh-sweep orphans --repos owner/repo --list --include-tags --tag-pattern 'deploy-*'
+
+  # Keep branches whose PR was labeled do-not-delete or long-lived
+  gh-sweep orphans --org mycompany --list --exclude-pr-labels do-not-delete,long-lived
+
+  # Include archived repositories in a namespace scan
+  gh-sweep orphans --org mycompany --list --include-archived
+
+  # Check specific repos and exit non-zero if any orphans are found
+  gh-sweep orphans --repos owner/repo --list --dry-run --exit-code-on-findings`,
 	Run: runOrphans,
 }
 
 func init() {
 	rootCmd.AddCommand(orphansCmd)
+	supportsGroup(orphansCmd)
 
 	orphansCmd.Flags().String("org", "", "Organization to scan")
 	orphansCmd.Flags().String("namespace", "", "Namespace (org or user) to scan")
@@ -54,8 +85,21 @@ func init() {
 	orphansCmd.Flags().Int("stale-days", 7, "Days of inactivity before a branch is considered stale")
 	orphansCmd.Flags().Bool("include-recent", false, "Include recent branches without PRs")
 	orphansCmd.Flags().StringSlice("exclude", nil, "Branch patterns to exclude")
+	orphansCmd.Flags().StringSlice("include", nil, "Only evaluate branches matching these patterns (applied after --exclude)")
 	orphansCmd.Flags().StringP("output", "o", "", "Output file path")
-	orphansCmd.Flags().String("format", "table", "Output format: table, json, markdown")
+	orphansCmd.Flags().String("output-dir", "", "Write one output file per repository to this directory")
+	orphansCmd.Flags().String("format", "table", "Output format: table, json, markdown, github-issue")
+	orphansCmd.Flags().Bool("create-issue", false, "Open a GitHub issue with a checklist of orphaned branches")
+	orphansCmd.Flags().String("issue-repo", "", "Repository (owner/repo) for --create-issue (default: detected from git remote)")
+	orphansCmd.Flags().Bool("fail-if-found", false, "Alias for --exit-code-on-findings")
+	_ = orphansCmd.Flags().MarkDeprecated("fail-if-found", "use --exit-code-on-findings instead")
+	orphansCmd.Flags().Int("max-orphans", 0, "Orphans tolerated before --exit-code-on-findings fails (default: ci.max_orphans)")
+	orphansCmd.Flags().Bool("include-tags", false, "Also detect stale tags not backed by a release")
+	orphansCmd.Flags().Int("tag-max-age", 180, "Days before an unreleased tag is considered stale")
+	orphansCmd.Flags().StringSlice("tag-pattern", nil, "Only check tags matching these patterns")
+	orphansCmd.Flags().Bool("include-archived", false, "Also scan archived repositories")
+	orphansCmd.Flags().StringSlice("exclude-pr-labels", nil, "Keep branches whose closed or merged PR has one of these labels")
+	orphansCmd.Flags().String("sort", "repo", "List order: repo, priority")
 }
 
 func runOrphans(cmd *cobra.Command, args []string) {
@@ -69,15 +113,57 @@ func runOrphans(cmd *cobra.Command, args []string) {
 
 	org, _ := cmd.Flags().GetString("org")
 	namespace, _ := cmd.Flags().GetString("namespace")
+	repos, _ := cmd.Flags().GetStringSlice("repos")
 	listMode, _ := cmd.Flags().GetBool("list")
 	cleanup, _ := cmd.Flags().GetBool("cleanup")
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
 	staleDays, _ := cmd.Flags().GetInt("stale-days")
 	includeRecent, _ := cmd.Flags().GetBool("include-recent")
 	excludePatterns, _ := cmd.Flags().GetStringSlice("exclude")
+	includePatterns, _ := cmd.Flags().GetStringSlice("include")
 	outputPath, _ := cmd.Flags().GetString("output")
+	outputDir, _ := cmd.Flags().GetString("output-dir")
 	format, _ := cmd.Flags().GetString("format")
+	maxOrphans, _ := cmd.Flags().GetInt("max-orphans")
+	group, _ := cmd.Flags().GetString("group")
+	includeTags, _ := cmd.Flags().GetBool("include-tags")
+	tagMaxAge, _ := cmd.Flags().GetInt("tag-max-age")
+	tagPatterns, _ := cmd.Flags().GetStringSlice("tag-pattern")
+	includeArchived, _ := cmd.Flags().GetBool("include-archived")
+	excludePRLabels, _ := cmd.Flags().GetStringSlice("exclude-pr-labels")
+	sortBy, _ := cmd.Flags().GetString("sort")
+	createIssue, _ := cmd.Flags().GetBool("create-issue")
+	issueRepo, _ := cmd.Flags().GetString("issue-repo")
+
+	if sortBy != "repo" && sortBy != "priority" {
+		fmt.Fprintf(os.Stderr, "Error: --sort must be repo or priority, got %q\n", sortBy)
+		os.Exit(1)
+	}
+
+	if createIssue {
+		issueRepo = resolveRepo(cmd, issueRepo)
+		if len(strings.Split(issueRepo, "/")) != 2 {
+			fmt.Fprintf(os.Stderr, "Error: --create-issue requires --issue-repo owner/repo\n")
+			os.Exit(1)
+		}
+	}
+
+	if group != "" && len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to load config: %v\n", err)
+			os.Exit(1)
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
 
+	if namespace == "" && len(repos) > 0 {
+		namespace = strings.SplitN(repos[0], "/", 2)[0]
+	}
 	if namespace == "" {
 		namespace = org
 	}
@@ -96,37 +182,125 @@ func runOrphans(cmd *cobra.Command, args []string) {
 	if len(excludePatterns) > 0 {
 		options.ExcludePatterns = append(options.ExcludePatterns, excludePatterns...)
 	}
+	options.IncludePatterns = includePatterns
+	options.IncludeTags = includeTags
+	options.Tags.MaxAgeDays = tagMaxAge
+	options.Tags.IncludePatterns = tagPatterns
+	options.IncludeArchived = includeArchived
+	options.ExcludePRLabels = excludePRLabels
+
+	if interactive(cmd) && !listMode && !cleanup && !createIssue && outputPath == "" && outputDir == "" {
+		var tuiOpts []orphanstui.Option
+		if cfg, err := config.Load(); err == nil {
+			tuiOpts = append(tuiOpts, orphanstui.WithCacheTTL(cfg.Cache.OrphansTTLDuration()))
+		}
+		m := orphanstui.NewModel(namespace, options, tuiOpts...)
 
-	if !listMode && !cleanup && outputPath == "" {
-		m := orphanstui.NewModel(namespace, options)
-		p := tea.NewProgram(m, tea.WithAltScreen())
-
-		if _, err := p.Run(); err != nil {
+		if err := runProgram(m); err != nil {
 			fmt.Fprintf(os.Stderr, "Error running TUI: %v\n", err)
 			os.Exit(1)
 		}
 		return
 	}
 
-	fmt.Printf("Scanning namespace: %s\n", namespace)
 	scanner := orphans.NewNamespaceScanner(client, options)
-	result, err := scanner.ScanNamespace(ctx, namespace)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: failed to scan namespace: %v\n", err)
-		os.Exit(1)
+
+	var result *orphans.NamespaceScanResult
+	if len(repos) > 0 {
+		fmt.Printf("Scanning repositories: %s\n", strings.Join(repos, ", "))
+		result = scanner.ScanRepos(ctx, namespace, resolveRepositories(client, repos))
+	} else {
+		fmt.Printf("Scanning namespace: %s\n", namespace)
+		result, err = scanner.ScanNamespace(ctx, namespace)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to scan namespace: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Scanning %d repos (%d archived skipped)\n", result.ScannedRepos, result.ArchivedSkipped)
+	}
+
+	// Dry runs, such as the pre-push hook, must not replace the baseline that diff compares against
+	if !dryRun {
+		scope := cache.SnapshotScope(namespace, nil)
+		if len(repos) > 0 {
+			scope = cache.SnapshotScope("", repos)
+		}
+		saveSnapshot(snapshotOrphans, scope, orphans.SnapshotEntries(result), failedOrphanRepos(result))
 	}
 
 	if cleanup {
 		runCleanup(ctx, client, result, dryRun)
-		return
+	} else if createIssue {
+		createOrphansIssue(client, result, issueRepo, dryRun)
+	} else if outputPath != "" || outputDir != "" || format == "json" || format == "markdown" || format == "github-issue" {
+		outputResult(result, outputPath, outputDir, format)
+	} else {
+		printTable(result, sortBy)
 	}
 
-	if outputPath != "" || format == "json" || format == "markdown" {
-		outputResult(result, outputPath, format)
-		return
+	applyFailIfFoundAlias(cmd)
+	if enabled, _ := cmd.Flags().GetBool("exit-code-on-findings"); enabled && !cmd.Flags().Changed("max-orphans") {
+		if cfg, err := config.Load(); err == nil {
+			maxOrphans = cfg.CI.MaxOrphans
+		}
+	}
+	exitOnFindings(cmd, "orphaned branches", result.TotalOrphans, maxOrphans)
+
+	if code := scanErrorExitCode(result); code != 0 {
+		for _, failed := range result.FailedResults() {
+			fmt.Fprintf(os.Stderr, "Warning: %s: %v\n", failed.Repository.FullName, failed.Error)
+		}
+		fmt.Fprintf(os.Stderr, "Error: %d of %d repos had scan errors\n", result.ErrorCount, len(result.Results))
+		os.Exit(code)
+	}
+}
+
+// applyFailIfFoundAlias turns on --exit-code-on-findings when the deprecated --fail-if-found is set
+func applyFailIfFoundAlias(cmd *cobra.Command) {
+	if failIfFound, _ := cmd.Flags().GetBool("fail-if-found"); failIfFound {
+		_ = cmd.Flags().Set("exit-code-on-findings", "true")
 	}
+}
+
+// exitCodePartialScan is returned when some repositories failed to scan but others succeeded
+const exitCodePartialScan = 2
 
-	printTable(result)
+// scanErrorExitCode returns 0 without scan errors, exitCodePartialScan when only some repos failed, and 1 when all failed
+func scanErrorExitCode(result *orphans.NamespaceScanResult) int {
+	switch {
+	case result.ErrorCount == 0:
+		return 0
+	case result.ErrorCount < len(result.Results):
+		return exitCodePartialScan
+	default:
+		return 1
+	}
+}
+
+// resolveRepositories looks up the default branch for each owner/repo
+func resolveRepositories(client *github.Client, repos []string) []github.Repository {
+	var resolved []github.Repository
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		defaultBranch, err := client.GetDefaultBranch(parts[0], parts[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+
+		resolved = append(resolved, github.Repository{
+			Name:          parts[1],
+			FullName:      repoStr,
+			Owner:         parts[0],
+			DefaultBranch: defaultBranch,
+		})
+	}
+	return resolved
 }
 
 func runCleanup(ctx context.Context, client *github.Client, result *orphans.NamespaceScanResult, dryRun bool) {
@@ -173,25 +347,44 @@ func runCleanup(ctx context.Context, client *github.Client, result *orphans.Name
 	fmt.Printf("\nTotal: %d deleted, %d failed\n", deleted, failed)
 }
 
-func outputResult(result *orphans.NamespaceScanResult, outputPath, format string) {
-	var output string
+// createOrphansIssue opens an issue in issueRepo listing the orphaned branches as a task list
+func createOrphansIssue(client *github.Client, result *orphans.NamespaceScanResult, issueRepo string, dryRun bool) {
+	if result.TotalOrphans == 0 {
+		fmt.Println("No orphaned branches found; not creating an issue.")
+		return
+	}
 
-	switch format {
-	case "json":
-		data, err := json.MarshalIndent(result, "", "  ")
+	title := fmt.Sprintf("Clean up %d orphaned branches in %s", result.TotalOrphans, result.Namespace)
+	if dryRun {
+		fmt.Printf("[DRY RUN] Would create issue in %s: %s\n\n", issueRepo, title)
+		fmt.Print(export.FormatAsGitHubIssue(result))
+		return
+	}
+
+	parts := strings.SplitN(issueRepo, "/", 2)
+	issue, err := client.CreateIssue(parts[0], parts[1], title, export.FormatAsGitHubIssue(result))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Created issue #%d: %s\n", issue.Number, issue.HTMLURL)
+}
+
+func outputResult(result *orphans.NamespaceScanResult, outputPath, outputDir, format string) {
+	if outputDir != "" {
+		paths, err := writeOrphansToDir(result, outputDir, format)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: failed to marshal JSON: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
-		output = string(data)
-
-	case "markdown":
-		output = formatMarkdown(result)
+		fmt.Printf("Output written to %s (%d files)\n", outputDir, len(paths))
+		return
+	}
 
-	default:
-		var b strings.Builder
-		printTableTo(&b, result)
-		output = b.String()
+	output, err := renderOrphans(result, format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
 	}
 
 	if outputPath != "" {
@@ -205,6 +398,67 @@ func outputResult(result *orphans.NamespaceScanResult, outputPath, format string
 	}
 }
 
+func renderOrphans(result *orphans.NamespaceScanResult, format string) (string, error) {
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		return string(data), nil
+
+	case "markdown":
+		return formatMarkdown(result), nil
+
+	case "github-issue":
+		return export.FormatAsGitHubIssue(result), nil
+
+	default:
+		var b strings.Builder
+		printTableTo(&b, result)
+		return b.String(), nil
+	}
+}
+
+// writeOrphansToDir writes one report per repository and returns the written paths
+func writeOrphansToDir(result *orphans.NamespaceScanResult, dir, format string) ([]string, error) {
+	ext := "txt"
+	switch format {
+	case "json":
+		ext = "json"
+	case "markdown", "github-issue":
+		ext = "md"
+	}
+
+	var paths []string
+	for _, scanResult := range result.Results {
+		repoResult := &orphans.NamespaceScanResult{
+			Namespace:    result.Namespace,
+			IsOrg:        result.IsOrg,
+			Results:      []orphans.ScanResult{scanResult},
+			TotalRepos:   1,
+			ScannedRepos: 1,
+			TotalOrphans: len(scanResult.Orphans),
+
+			TotalOrphanedTags: len(scanResult.OrphanedTags),
+		}
+
+		output, err := renderOrphans(repoResult, format)
+		if err != nil {
+			return paths, err
+		}
+
+		repo := scanResult.Repository
+		path, err := writeBulkFile(dir, repo.Owner, repo.Name, "orphans", ext, []byte(output))
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
 func formatMarkdown(result *orphans.NamespaceScanResult) string {
 	var b strings.Builder
 
@@ -237,12 +491,46 @@ func formatMarkdown(result *orphans.NamespaceScanResult) string {
 	return b.String()
 }
 
-func printTable(result *orphans.NamespaceScanResult) {
+func printTable(result *orphans.NamespaceScanResult, sortBy string) {
 	var b strings.Builder
-	printTableTo(&b, result)
+	if sortBy == "priority" {
+		printPriorityTableTo(&b, result)
+	} else {
+		printTableTo(&b, result)
+	}
 	fmt.Print(b.String())
 }
 
+// printPriorityTableTo lists every orphan in one list, highest cleanup priority first
+func printPriorityTableTo(b *strings.Builder, result *orphans.NamespaceScanResult) {
+	b.WriteString(fmt.Sprintf("Orphaned Branches Report: %s\n\n", result.Namespace))
+	b.WriteString(fmt.Sprintf("Total Repositories: %d\n", result.TotalRepos))
+	b.WriteString(fmt.Sprintf("Total Orphaned Branches: %d\n\n", result.TotalOrphans))
+
+	all := result.AllOrphans()
+	if len(all) == 0 {
+		b.WriteString("No orphaned branches found.\n")
+		printTagsTo(b, result)
+		return
+	}
+
+	orphans.SortByPriority(all)
+
+	b.WriteString("Orphaned Branches by Priority:\n\n")
+	for _, orphan := range all {
+		prInfo := ""
+		if orphan.PRNumber != nil {
+			prInfo = fmt.Sprintf(" (PR #%d)", *orphan.PRNumber)
+		}
+		b.WriteString(fmt.Sprintf("  [P%d] %s/%s [%s, %d days]%s\n",
+			orphans.PriorityScore(orphan), orphan.Repository, orphan.BranchName,
+			orphan.Type.Label(), orphan.DaysSinceActivity, prInfo))
+	}
+	b.WriteString("\n")
+
+	printTagsTo(b, result)
+}
+
 func printTableTo(b *strings.Builder, result *orphans.NamespaceScanResult) {
 	b.WriteString(fmt.Sprintf("Orphaned Branches Report: %s\n\n", result.Namespace))
 	b.WriteString(fmt.Sprintf("Total Repositories: %d\n", result.TotalRepos))
@@ -250,6 +538,7 @@ func printTableTo(b *strings.Builder, result *orphans.NamespaceScanResult) {
 
 	if result.TotalOrphans == 0 {
 		b.WriteString("No orphaned branches found.\n")
+		printTagsTo(b, result)
 		return
 	}
 
@@ -278,4 +567,17 @@ func printTableTo(b *strings.Builder, result *orphans.NamespaceScanResult) {
 		}
 		b.WriteString("\n")
 	}
+
+	printTagsTo(b, result)
+}
+
+func printTagsTo(b *strings.Builder, result *orphans.NamespaceScanResult) {
+	if result.TotalOrphanedTags == 0 {
+		return
+	}
+
+	b.WriteString(fmt.Sprintf("\nStale Tags (%d):\n\n", result.TotalOrphanedTags))
+	for _, tag := range result.AllOrphanedTags() {
+		b.WriteString(fmt.Sprintf("  - %s@%s [%d days]\n", tag.Repository, tag.TagName, tag.DaysSinceCreated))
+	}
 }
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+	"github.com/spf13/cobra"
+)
+
+// TestScanErrorExitCode tests exit codes for clean, partial, and fully failed scans
+func TestScanErrorExitCode(t *testing.T) {
+	failed := orphans.ScanResult{Error: errors.New("403 Forbidden")}
+	ok := orphans.ScanResult{}
+
+	tests := []struct {
+		name     string
+		result   *orphans.NamespaceScanResult
+		expected int
+	}{
+		{"no errors", &orphans.NamespaceScanResult{Results: []orphans.ScanResult{ok, ok, ok}}, 0},
+		{"partial", &orphans.NamespaceScanResult{Results: []orphans.ScanResult{ok, failed, ok}, ErrorCount: 1}, exitCodePartialScan},
+		{"all failed", &orphans.NamespaceScanResult{Results: []orphans.ScanResult{failed}, ErrorCount: 1}, 1},
+	}
+
+	for _, tt := range tests {
+		if got := scanErrorExitCode(tt.result); got != tt.expected {
+			t.Errorf("%s: expected %d, got %d", tt.name, tt.expected, got)
+		}
+	}
+}
+
+// TestApplyFailIfFoundAlias tests that --fail-if-found enables --exit-code-on-findings
+func TestApplyFailIfFoundAlias(t *testing.T) {
+	for _, args := range [][]string{{"--fail-if-found"}, {"--exit-code-on-findings"}, {}} {
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("exit-code-on-findings", false, "")
+		cmd.Flags().Bool("fail-if-found", false, "")
+		if err := cmd.ParseFlags(args); err != nil {
+			t.Fatalf("ParseFlags(%v) failed: %v", args, err)
+		}
+
+		applyFailIfFoundAlias(cmd)
+
+		enabled, _ := cmd.Flags().GetBool("exit-code-on-findings")
+		if want := len(args) > 0; enabled != want {
+			t.Errorf("%v: expected exit-code-on-findings=%v, got %v", args, want, enabled)
+		}
+	}
+}
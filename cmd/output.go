@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FormatBulkFilename builds the per-repo filename used by --output-dir
+// e.g. FormatBulkFilename("owner", "repo", "orphans", "json") -> "owner_repo_orphans.json"
+func FormatBulkFilename(owner, repo, view, ext string) string {
+	return fmt.Sprintf("%s_%s_%s.%s", owner, repo, view, strings.TrimPrefix(ext, "."))
+}
+
+// writeBulkFile writes data to FormatBulkFilename inside dir, creating dir if needed
+func writeBulkFile(dir, owner, repo, view, ext string, data []byte) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	path := filepath.Join(dir, FormatBulkFilename(owner, repo, view, ext))
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return path, nil
+}
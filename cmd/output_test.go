@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+)
+
+// TestFormatBulkFilename tests per-repo filename generation
+func TestFormatBulkFilename(t *testing.T) {
+	tests := []struct {
+		owner    string
+		repo     string
+		view     string
+		ext      string
+		expected string
+	}{
+		{"owner", "repo", "orphans", "json", "owner_repo_orphans.json"},
+		{"my-org", "api", "gha-perf", ".csv", "my-org_api_gha-perf.csv"},
+		{"user", "dotfiles", "settings", "md", "user_dotfiles_settings.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got := FormatBulkFilename(tt.owner, tt.repo, tt.view, tt.ext)
+			if got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestWriteOrphansToDir tests that one file per repo is written into a new directory
+func TestWriteOrphansToDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "nested", "reports")
+
+	result := &orphans.NamespaceScanResult{
+		Namespace: "org",
+		IsOrg:     true,
+		Results: []orphans.ScanResult{
+			{
+				Repository: github.Repository{Owner: "org", Name: "api", FullName: "org/api"},
+				Orphans: []orphans.OrphanedBranch{
+					{Repository: "org/api", BranchName: "feature/a", Type: orphans.OrphanTypeStale},
+					{Repository: "org/api", BranchName: "feature/b", Type: orphans.OrphanTypeMergedPR},
+				},
+			},
+			{
+				Repository: github.Repository{Owner: "org", Name: "web", FullName: "org/web"},
+			},
+		},
+		TotalRepos:   2,
+		TotalOrphans: 2,
+	}
+
+	paths, err := writeOrphansToDir(result, dir, "json")
+	if err != nil {
+		t.Fatalf("writeOrphansToDir failed: %v", err)
+	}
+
+	if len(paths) != 2 {
+		t.Fatalf("Expected 2 files, got %d", len(paths))
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Expected output directory to be created: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Errorf("Expected 2 entries in output directory, got %d", len(entries))
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "org_api_orphans.json"))
+	if err != nil {
+		t.Fatalf("Expected org_api_orphans.json to exist: %v", err)
+	}
+
+	var repoResult orphans.NamespaceScanResult
+	if err := json.Unmarshal(data, &repoResult); err != nil {
+		t.Fatalf("Failed to parse output: %v", err)
+	}
+
+	if repoResult.TotalOrphans != 2 {
+		t.Errorf("Expected 2 orphans for org/api, got %d", repoResult.TotalOrphans)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "org_web_orphans.json")); err != nil {
+		t.Errorf("Expected org_web_orphans.json to exist: %v", err)
+	}
+}
+
+// TestPrintPriorityTable tests that --sort priority lists merged PR branches before stale ones
+func TestPrintPriorityTable(t *testing.T) {
+	result := &orphans.NamespaceScanResult{
+		Namespace: "org",
+		Results: []orphans.ScanResult{{
+			Repository: github.Repository{Owner: "org", Name: "api", FullName: "org/api"},
+			Orphans: []orphans.OrphanedBranch{
+				{Repository: "org/api", BranchName: "feature/a", Type: orphans.OrphanTypeStale, DaysSinceActivity: 45},
+				{Repository: "org/api", BranchName: "feature/b", Type: orphans.OrphanTypeMergedPR, DaysSinceActivity: 3},
+			},
+		}},
+		TotalRepos:   1,
+		TotalOrphans: 2,
+	}
+
+	var b strings.Builder
+	printPriorityTableTo(&b, result)
+	output := b.String()
+
+	merged := strings.Index(output, "[P10] org/api/feature/b")
+	stale := strings.Index(output, "[P5] org/api/feature/a")
+	if merged < 0 || stale < 0 || merged > stale {
+		t.Errorf("Expected merged branch listed before stale branch, got:\n%s", output)
+	}
+}
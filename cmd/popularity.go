@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var popularityCmd = &cobra.Command{
+	Use:   "popularity",
+	Short: "Rank repositories by stars and forks",
+	Long: `Rank repositories by stars, then forks, and report watchers, open
+issues, and network size.
+
+Each run saves a snapshot per repository so the next run can show how
+many stars were gained or lost in between (the Δ column).
+
+Repositories default to the 'repositories' list in .gh-sweep.yaml.
+
+Examples:
+  # Popularity of configured repos
+  gh-sweep popularity
+
+  # Popularity of specific repos
+  gh-sweep popularity --repos owner/repo1,owner/repo2`,
+	Run: runPopularity,
+}
+
+func init() {
+	rootCmd.AddCommand(popularityCmd)
+	supportsGroup(popularityCmd)
+
+	popularityCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+}
+
+func runPopularity(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	popularityCache, err := cache.NewPopularityCache("")
+	if err != nil {
+		fmt.Printf("Warning: star deltas unavailable: %v\n", err)
+	}
+
+	stats := make(map[string]*github.RepoStats)
+	hasPrevious := make(map[string]bool)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		repoStats, err := client.GetRepoStats(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+
+		if popularityCache != nil {
+			ok, err := popularityCache.UpdateStarDelta(repoStats)
+			if err != nil {
+				fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			}
+			hasPrevious[repoStr] = ok
+		}
+		stats[repoStr] = repoStats
+	}
+
+	if len(stats) == 0 {
+		fmt.Println("No repository stats found.")
+		return
+	}
+
+	fmt.Printf("%-35s %8s %6s %7s %8s %7s %8s\n", "Repository", "Stars", "Δ", "Forks", "Watchers", "Issues", "Network")
+	fmt.Println(strings.Repeat("-", 85))
+	for _, name := range github.RankReposByPopularity(stats) {
+		s := stats[name]
+		delta := "-"
+		if hasPrevious[name] {
+			delta = fmt.Sprintf("%+d", s.StarDelta)
+		}
+		fmt.Printf("%-35s %8d %6s %7d %8d %7d %8d\n",
+			truncate(name, 35), s.Stars, delta, s.Forks, s.Watchers, s.OpenIssues, s.Network)
+	}
+}
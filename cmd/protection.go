@@ -1,8 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/export"
+	"github.com/KyleKing/gh-sweep/internal/github"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +24,9 @@ Features:
   - Detect drift from baseline
   - Export/import rule configurations
 
+Use --graphql to fetch every repository in a single batched GraphQL
+request, which is much faster for large org scans.
+
 Examples:
   # Compare protection rules across repos
   gh-sweep protection --repos owner/repo1,owner/repo2
@@ -25,24 +35,534 @@ Examples:
   gh-sweep protection --template templates/default.yaml --apply
 
   # Show drift from baseline
-  gh-sweep protection --baseline owner/baseline-repo`,
-	Run: func(cmd *cobra.Command, args []string) {
-		repos, _ := cmd.Flags().GetString("repos")
-		template, _ := cmd.Flags().GetString("template")
-		baseline, _ := cmd.Flags().GetString("baseline")
+  gh-sweep protection --baseline owner/baseline-repo
+
+  # Preview, then apply the baseline's rule to every repo that drifts from it
+  gh-sweep protection --baseline owner/baseline-repo --apply
+  gh-sweep protection --baseline owner/baseline-repo --apply --confirm
+
+  # Show drift with prioritized remediation suggestions
+  gh-sweep protection --baseline owner/baseline-repo --suggest
+
+  # Batch fetch via GraphQL
+  gh-sweep protection --baseline owner/baseline-repo --graphql
+
+  # Find repos whose rules are weaker than the org's default branch ruleset
+  gh-sweep protection --repos acme/api,acme/web --org-policy-audit
+
+  # Generate Terraform github_branch_protection resources
+  gh-sweep protection --repos owner/repo1,owner/repo2 --format terraform > protection.tf
+
+  # Export a baseline rule to JSON, edit it, then apply it to other repos
+  gh-sweep protection export --repo owner/baseline-repo -o rules.json
+  gh-sweep protection apply --from-file rules.json --repos owner/repo1,owner/repo2`,
+	Run: runProtection,
+}
+
+var protectionExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a repository's default branch protection as JSON",
+	Run:   runProtectionExport,
+}
+
+var protectionApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply a JSON protection rule to repositories",
+	Long: `Apply a protection rule file written by 'protection export' to each repository.
 
-		fmt.Printf("Protection rule management\n")
-		fmt.Printf("Repos: %s\n", repos)
-		fmt.Printf("Template: %s, Baseline: %s\n", template, baseline)
-		fmt.Println("\n🚧 Coming in Phase 1!")
-	},
+The rule replaces the existing protection of the branch named in the file,
+or of each repository's default branch when the file has no branch.
+Each branch keeps its existing push restrictions. --dry-run lists every
+setting that would change.`,
+	Run: runProtectionApply,
 }
 
 func init() {
 	rootCmd.AddCommand(protectionCmd)
+	supportsGroup(protectionCmd)
 
-	protectionCmd.Flags().String("repos", "", "Comma-separated list of repos (owner/repo1,owner/repo2)")
+	protectionCmd.Flags().StringSlice("repos", nil, "Comma-separated list of repos (owner/repo1,owner/repo2)")
 	protectionCmd.Flags().String("template", "", "Path to protection rule template (YAML)")
 	protectionCmd.Flags().String("baseline", "", "Baseline repository to compare against")
-	protectionCmd.Flags().Bool("apply", false, "Apply changes (default: dry-run)")
+	protectionCmd.Flags().Bool("apply", false, "Apply the --baseline rule to drifted repos (dry-run unless --confirm)")
+	protectionCmd.Flags().Bool("confirm", false, "Actually apply changes with --apply")
+	protectionCmd.Flags().Bool("graphql", false, "Batch fetch rules with a single GraphQL query")
+	protectionCmd.Flags().String("format", "table", "Output format: table or terraform")
+	protectionCmd.Flags().Bool("suggest", false, "Suggest remediations for drift from --baseline")
+	protectionCmd.Flags().Bool("org-policy-audit", false, "Flag repos whose rules are weaker than the org default branch policy")
+	protectionCmd.Flags().String("org", "", "Organization for --org-policy-audit (default: owner of the first repo)")
+
+	protectionCmd.AddCommand(protectionExportCmd)
+	protectionExportCmd.Flags().String("repo", "", "Repository to export (owner/repo)")
+	protectionExportCmd.Flags().String("branch", "", "Branch to export (default: the repository's default branch)")
+	protectionExportCmd.Flags().StringP("output", "o", "", "Output file path (default: stdout)")
+
+	protectionCmd.AddCommand(protectionApplyCmd)
+	supportsGroup(protectionApplyCmd)
+	protectionApplyCmd.Flags().String("from-file", "", "Protection rule JSON file")
+	protectionApplyCmd.Flags().StringSlice("repos", nil, "Comma-separated list of repos (owner/repo1,owner/repo2)")
+	protectionApplyCmd.Flags().Bool("dry-run", false, "Preview changes without applying them")
+}
+
+func runProtection(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	template, _ := cmd.Flags().GetString("template")
+	baseline, _ := cmd.Flags().GetString("baseline")
+	useGraphQL, _ := cmd.Flags().GetBool("graphql")
+	format, _ := cmd.Flags().GetString("format")
+	suggest, _ := cmd.Flags().GetBool("suggest")
+	orgPolicyAudit, _ := cmd.Flags().GetBool("org-policy-audit")
+	org, _ := cmd.Flags().GetString("org")
+	apply, _ := cmd.Flags().GetBool("apply")
+	confirm, _ := cmd.Flags().GetBool("confirm")
+
+	if format != "table" && format != string(export.FormatTerraform) {
+		fmt.Printf("Error: unsupported format %q (use table or terraform)\n", format)
+		return
+	}
+
+	if template != "" {
+		fmt.Printf("Template: %s\n", template)
+		fmt.Println("🚧 Template apply is not implemented yet")
+		return
+	}
+
+	if suggest && baseline == "" {
+		fmt.Println("Error: --suggest requires --baseline")
+		return
+	}
+
+	if apply && baseline == "" {
+		fmt.Println("Error: --apply requires --baseline")
+		return
+	}
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if baseline != "" && !containsString(repos, baseline) {
+		repos = append([]string{baseline}, repos...)
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	var rules map[string]*github.ProtectionRule
+	var err error
+	if useGraphQL {
+		rules, err = fetchProtectionGraphQL(repos)
+	} else {
+		rules, err = fetchProtectionREST(repos)
+	}
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	if len(rules) == 0 {
+		fmt.Println("No protection rules found.")
+		return
+	}
+
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if format == string(export.FormatTerraform) {
+		ordered := make([]*github.ProtectionRule, len(names))
+		for i, name := range names {
+			ordered[i] = rules[name]
+		}
+		fmt.Print(export.ExportProtectionRulesToTerraform(ordered))
+		return
+	}
+
+	fmt.Printf("%-35s %-15s %8s %11s %7s\n", "Repository", "Branch", "Reviews", "Code Owners", "Admins")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, name := range names {
+		rule := rules[name]
+		fmt.Printf("%-35s %-15s %8d %11v %7v\n",
+			truncate(name, 35), truncate(rule.Branch, 15), rule.RequiredReviews, rule.RequireCodeOwnerReviews, rule.EnforceAdmins)
+	}
+
+	if orgPolicyAudit {
+		if org == "" {
+			org = strings.SplitN(repos[0], "/", 2)[0]
+		}
+		printOrgPolicyAudit(org, rules)
+	}
+
+	if baseline == "" {
+		return
+	}
+
+	baselineRule := rules[baseline]
+	if baselineRule == nil {
+		fmt.Printf("\nWarning: no protection rule found for baseline %s\n", baseline)
+		return
+	}
+
+	// CompareProtectionRules treats the first rule as the baseline
+	ordered := []*github.ProtectionRule{baselineRule}
+	for _, name := range names {
+		if name != baseline {
+			ordered = append(ordered, rules[name])
+		}
+	}
+
+	diffs := github.CompareProtectionRules(ordered)
+	targets := github.DriftedRepos(baseline, repos, rules)
+	var unprotected []string
+	for _, repo := range targets {
+		if rules[repo] == nil {
+			unprotected = append(unprotected, repo)
+		}
+	}
+
+	fmt.Printf("\nDrift from %s:\n", baseline)
+	if len(diffs) == 0 && len(unprotected) == 0 {
+		fmt.Println("  ✓ No differences")
+		return
+	}
+
+	fields := make([]string, 0, len(diffs))
+	for field := range diffs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		fmt.Printf("  %s:\n", field)
+		for _, diff := range diffs[field] {
+			fmt.Printf("    - %s\n", diff)
+		}
+	}
+	if len(unprotected) > 0 {
+		fmt.Println("  Unprotected:")
+		for _, repo := range unprotected {
+			fmt.Printf("    - %s\n", repo)
+		}
+	}
+
+	if suggest {
+		fmt.Println("\nSuggested remediations:")
+		for _, r := range github.SuggestProtectionRemediations(diffs, baselineRule) {
+			fmt.Printf("  [%-6s] %-35s %s\n", r.Priority, truncate(r.Repository, 35), r.Action)
+		}
+	}
+
+	if !apply {
+		return
+	}
+
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nApplying %s protection:\n", baseline)
+	applied, failed := applyBaselineProtection(client, baselineRule, rules, targets, !confirm)
+	if !confirm {
+		fmt.Println("\nRe-run with --confirm to apply these changes")
+		return
+	}
+	fmt.Printf("\nApplied %s to %d/%d repositories\n", baseline, applied, len(targets))
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// protectionApplier applies a protection rule; implemented by *github.Client
+type protectionApplier interface {
+	ApplyProtectionRule(owner, repo, branch string, rule *github.ProtectionRule) error
+	GetRepoSettings(owner, repo string) (*github.RepoSettings, error)
+}
+
+// applyBaselineProtection applies baselineRule to each target repo's protected branch,
+// or to its default branch when it has no protection yet,
+// only printing what would change when dryRun is set
+func applyBaselineProtection(applier protectionApplier, baselineRule *github.ProtectionRule, rules map[string]*github.ProtectionRule, targets []string, dryRun bool) (applied, failed int) {
+	for _, repoStr := range targets {
+		owner, name, ok := strings.Cut(repoStr, "/")
+		if !ok {
+			fmt.Printf("  ✗ %s: expected owner/repo\n", repoStr)
+			failed++
+			continue
+		}
+
+		current := rules[repoStr]
+		branch := "main"
+		if current != nil && current.Branch != "" {
+			branch = current.Branch
+		} else if current == nil {
+			settings, err := applier.GetRepoSettings(owner, name)
+			if err != nil {
+				fmt.Printf("  ✗ %s: %v\n", repoStr, err)
+				failed++
+				continue
+			}
+			branch = settings.DefaultBranch
+		}
+
+		if dryRun {
+			fmt.Printf("  [DRY RUN] Would apply baseline protection to %s (%s)\n", repoStr, branch)
+			printProtectionChanges(current, baselineRule.WithRestrictionsFrom(current))
+			continue
+		}
+
+		if err := applier.ApplyProtectionRule(owner, name, branch, baselineRule); err != nil {
+			fmt.Printf("  ✗ %s: %v\n", repoStr, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✓ %s (%s)\n", repoStr, branch)
+		applied++
+	}
+
+	return applied, failed
+}
+
+// printProtectionChanges lists each setting that applying desired over current will change
+func printProtectionChanges(current, desired *github.ProtectionRule) {
+	for _, change := range github.ProtectionRuleChanges(current, desired) {
+		fmt.Printf("      %s\n", change)
+	}
+}
+
+func printOrgPolicyAudit(org string, rules map[string]*github.ProtectionRule) {
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	policy, err := client.GetOrgDefaultBranchProtection(org)
+	if err != nil {
+		fmt.Printf("\nError: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nOrg policy audit (%s):\n", org)
+	if policy == nil {
+		fmt.Println("  No active org ruleset covers default branches")
+		return
+	}
+	fmt.Printf("  Policy: %s | approvals: %d | status checks: %v\n",
+		policy.Pattern, policy.RequiredApprovals, policy.RequireStatusChecks)
+
+	overrides := github.FindWeakerOverrides(policy, rules)
+	if len(overrides) == 0 {
+		fmt.Println("  ✓ No repos weaker than the org policy")
+		return
+	}
+
+	for _, override := range overrides {
+		fmt.Printf("  [WEAKER] %s: %s\n", override.Repository, strings.Join(override.Reasons, "; "))
+	}
+}
+
+func fetchProtectionGraphQL(repos []string) (map[string]*github.ProtectionRule, error) {
+	client, err := github.NewGraphQLClient(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetBranchProtectionBatch(repos)
+}
+
+func fetchProtectionREST(repos []string) (map[string]*github.ProtectionRule, error) {
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
+	rules := make(map[string]*github.ProtectionRule)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		settings, err := client.GetRepoSettings(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+
+		rule, err := client.GetBranchProtection(parts[0], parts[1], settings.DefaultBranch)
+		if err != nil {
+			// Unprotected default branches return 404 and are left out of rules
+			if !strings.Contains(err.Error(), "404") {
+				fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			}
+			continue
+		}
+		rules[repoStr] = rule
+	}
+
+	return rules, nil
+}
+
+func runProtectionExport(cmd *cobra.Command, _ []string) {
+	repo, _ := cmd.Flags().GetString("repo")
+	branch, _ := cmd.Flags().GetString("branch")
+	outputPath, _ := cmd.Flags().GetString("output")
+
+	repo = resolveRepo(cmd, repo)
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || owner == "" || name == "" {
+		fmt.Println("Error: --repo must be in format owner/repo")
+		return
+	}
+
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	if branch == "" {
+		settings, err := client.GetRepoSettings(owner, name)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		branch = settings.DefaultBranch
+	}
+
+	rule, err := client.GetBranchProtection(owner, name, branch)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	data, err := github.ExportProtectionRuleToJSON(rule)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	if outputPath == "" {
+		fmt.Print(string(data))
+		return
+	}
+	if err := os.WriteFile(outputPath, data, 0644); err != nil {
+		fmt.Printf("Error: failed to write %s: %v\n", outputPath, err)
+		return
+	}
+	fmt.Printf("Exported %s (%s) to %s\n", repo, branch, outputPath)
+}
+
+func runProtectionApply(cmd *cobra.Command, _ []string) {
+	fromFile, _ := cmd.Flags().GetString("from-file")
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	if fromFile == "" {
+		fmt.Println("Error: --from-file is required")
+		return
+	}
+
+	data, err := os.ReadFile(fromFile)
+	if err != nil {
+		fmt.Printf("Error: failed to read %s: %v\n", fromFile, err)
+		return
+	}
+	rule, err := github.LoadProtectionRuleFromJSON(data)
+	if err != nil {
+		fmt.Printf("Error: %s: %v\n", fromFile, err)
+		return
+	}
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	applied := 0
+	for _, repoStr := range repos {
+		owner, name, ok := strings.Cut(repoStr, "/")
+		if !ok {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		branch := rule.Branch
+		if branch == "" {
+			settings, err := client.GetRepoSettings(owner, name)
+			if err != nil {
+				fmt.Printf("  ✗ %s: %v\n", repoStr, err)
+				continue
+			}
+			branch = settings.DefaultBranch
+		}
+
+		if dryRun {
+			current, err := client.GetBranchProtection(owner, name, branch)
+			if err != nil && !strings.Contains(err.Error(), "404") {
+				fmt.Printf("  ✗ %s: %v\n", repoStr, err)
+				continue
+			}
+			fmt.Printf("  [DRY RUN] Would protect %s (%s)\n", repoStr, branch)
+			printProtectionChanges(current, rule.WithRestrictionsFrom(current))
+			continue
+		}
+
+		if err := client.ApplyProtectionRule(owner, name, branch, rule); err != nil {
+			fmt.Printf("  ✗ %s: %v\n", repoStr, err)
+			continue
+		}
+		fmt.Printf("  ✓ %s (%s)\n", repoStr, branch)
+		applied++
+	}
+
+	if !dryRun {
+		fmt.Printf("\nApplied %s to %d/%d repositories\n", fromFile, applied, len(repos))
+	}
+}
+
+func containsString(items []string, target string) bool {
+	for _, item := range items {
+		if item == target {
+			return true
+		}
+	}
+	return false
 }
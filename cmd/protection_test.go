@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// fakeApplier records the PUT requests the GitHub client would make
+type fakeApplier struct {
+	calls []string
+	fail  map[string]bool // repo names whose update fails
+}
+
+func (f *fakeApplier) ApplyProtectionRule(owner, repo, branch string, rule *github.ProtectionRule) error {
+	f.calls = append(f.calls, fmt.Sprintf("PUT repos/%s/%s/branches/%s/protection", owner, repo, branch))
+	if f.fail[repo] {
+		return errors.New("403 Forbidden")
+	}
+	return nil
+}
+
+func (f *fakeApplier) GetRepoSettings(owner, repo string) (*github.RepoSettings, error) {
+	return &github.RepoSettings{DefaultBranch: "develop"}, nil
+}
+
+func protectionFixture() (*github.ProtectionRule, map[string]*github.ProtectionRule) {
+	baseline := &github.ProtectionRule{Repository: "acme/base", Branch: "main", RequiredReviews: 2}
+	rules := map[string]*github.ProtectionRule{
+		"acme/base": baseline,
+		"acme/api":  {Repository: "acme/api", Branch: "main", RequiredReviews: 1},
+		"acme/web":  {Repository: "acme/web", Branch: "trunk", RequiredReviews: 0},
+	}
+	return baseline, rules
+}
+
+// TestApplyBaselineProtectionDryRun tests that dry-run makes no API calls and lists each change
+func TestApplyBaselineProtectionDryRun(t *testing.T) {
+	baseline, rules := protectionFixture()
+	applier := &fakeApplier{}
+
+	var applied, failed int
+	output := captureStdout(t, func() {
+		applied, failed = applyBaselineProtection(applier, baseline, rules, []string{"acme/api", "acme/web"}, true)
+	})
+	if applied != 0 || failed != 0 {
+		t.Errorf("applied, failed = %d, %d; want 0, 0", applied, failed)
+	}
+	if len(applier.calls) != 0 {
+		t.Errorf("Expected no API calls in dry-run, got %v", applier.calls)
+	}
+	for _, want := range []string{"RequiredReviews: 1 → 2", "RequiredReviews: 0 → 2"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in dry-run output:\n%s", want, output)
+		}
+	}
+}
+
+// TestApplyBaselineProtectionUnprotected tests that unprotected repos get the baseline on their default branch
+func TestApplyBaselineProtectionUnprotected(t *testing.T) {
+	baseline, rules := protectionFixture()
+	applier := &fakeApplier{}
+
+	applied, failed := applyBaselineProtection(applier, baseline, rules, []string{"acme/new"}, false)
+	if applied != 1 || failed != 0 {
+		t.Errorf("applied, failed = %d, %d; want 1, 0", applied, failed)
+	}
+
+	want := []string{"PUT repos/acme/new/branches/develop/protection"}
+	if !reflect.DeepEqual(applier.calls, want) {
+		t.Errorf("calls = %v, want %v", applier.calls, want)
+	}
+}
+
+// TestApplyBaselineProtection tests that each target's own branch is updated
+func TestApplyBaselineProtection(t *testing.T) {
+	baseline, rules := protectionFixture()
+	applier := &fakeApplier{fail: map[string]bool{"web": true}}
+
+	applied, failed := applyBaselineProtection(applier, baseline, rules, []string{"acme/api", "acme/web"}, false)
+	if applied != 1 || failed != 1 {
+		t.Errorf("applied, failed = %d, %d; want 1, 1", applied, failed)
+	}
+
+	want := []string{
+		"PUT repos/acme/api/branches/main/protection",
+		"PUT repos/acme/web/branches/trunk/protection",
+	}
+	if !reflect.DeepEqual(applier.calls, want) {
+		t.Errorf("calls = %v, want %v", applier.calls, want)
+	}
+}
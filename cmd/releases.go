@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	releasestui "github.com/KyleKing/gh-sweep/internal/tui/components/releases"
+	"github.com/spf13/cobra"
+)
+
+var releasesCmd = &cobra.Command{
+	Use:   "releases",
+	Short: "Review releases and changelogs across repositories",
+	Long: `Show the latest release for each repository, or the changes between two tags.
+
+Repositories default to the 'repositories' list in .gh-sweep.yaml.
+In the TUI, press Enter on a repository to compare its latest release
+with the previous one.
+
+Release age is classified as current, aging, stale, or critical using
+per-bump limits from 'releases' in .gh-sweep.yaml (major_max_age_days,
+minor_max_age_days, patch_max_age_days).
+
+Examples:
+  # Release overview for configured repos
+  gh-sweep releases
+
+  # Commits, merged PRs, and contributors between two tags
+  gh-sweep releases --repos owner/repo --compare-tags v1.0.0..v1.1.0`,
+	Run: runReleases,
+}
+
+func init() {
+	rootCmd.AddCommand(releasesCmd)
+	supportsGroup(releasesCmd)
+
+	releasesCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	releasesCmd.Flags().String("compare-tags", "", "Compare two tags as from..to (requires a single repo)")
+}
+
+func runReleases(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	compareTags, _ := cmd.Flags().GetString("compare-tags")
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error: failed to load config: %v\n", err)
+		return
+	}
+
+	if len(repos) == 0 {
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	if compareTags == "" && interactive(cmd) {
+		if err := runProgram(releasestui.NewModel(repos, releasestui.WithReleasePolicy(cfg.Releases))); err != nil {
+			fmt.Printf("Error running TUI: %v\n", err)
+		}
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	if compareTags != "" {
+		fromTag, toTag, err := parseTagRange(compareTags)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		if len(repos) != 1 {
+			fmt.Println("Error: --compare-tags requires exactly one repository")
+			return
+		}
+		parts := strings.Split(repos[0], "/")
+		if len(parts) != 2 {
+			fmt.Println("Error: --repos must be in format owner/repo")
+			return
+		}
+
+		comparison, err := client.CompareReleases(parts[0], parts[1], fromTag, toTag)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		printReleaseComparison(repos[0], comparison)
+		return
+	}
+
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		release, err := client.GetLatestRelease(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("%-40s no releases\n", repoStr)
+			continue
+		}
+		fmt.Printf("%-40s %-15s %s  %s\n", repoStr, release.TagName, release.PublishedAt.Format("2006-01-02"),
+			github.ClassifyReleaseAge(*release, cfg.Releases))
+	}
+}
+
+// parseTagRange splits a from..to tag range
+func parseTagRange(value string) (string, string, error) {
+	from, to, ok := strings.Cut(value, "..")
+	to = strings.TrimPrefix(to, ".")
+	if !ok || from == "" || to == "" {
+		return "", "", fmt.Errorf("--compare-tags must be in format from..to, got %q", value)
+	}
+	return from, to, nil
+}
+
+func printReleaseComparison(repo string, comparison *github.ReleaseComparison) {
+	fmt.Printf("%s: %s..%s\n", repo, comparison.FromTag, comparison.ToTag)
+	fmt.Printf("  Commits:      %d\n", comparison.CommitCount)
+	fmt.Printf("  Merged PRs:   %d\n", comparison.PRCount)
+	for _, title := range comparison.PRTitles {
+		fmt.Printf("    - %s\n", title)
+	}
+	fmt.Printf("  Contributors: %s\n", strings.Join(comparison.Contributors, ", "))
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import "testing"
+
+func TestParseTagRange(t *testing.T) {
+	tests := []struct {
+		value    string
+		from, to string
+		wantErr  bool
+	}{
+		{value: "v1.0.0..v1.1.0", from: "v1.0.0", to: "v1.1.0"},
+		{value: "v1.0.0...v1.1.0", from: "v1.0.0", to: "v1.1.0"},
+		{value: "v1.0.0", wantErr: true},
+		{value: "..v1.1.0", wantErr: true},
+		{value: "v1.0.0..", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		from, to, err := parseTagRange(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseTagRange(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if from != tt.from || to != tt.to {
+			t.Errorf("parseTagRange(%q) = %q, %q; want %q, %q", tt.value, from, to, tt.from, tt.to)
+		}
+	}
+}
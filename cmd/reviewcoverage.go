@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var reviewCoverageCmd = &cobra.Command{
+	Use:   "review-coverage",
+	Short: "Report pull requests without reviews",
+	Long: `Report how many pull requests were reviewed and list the ones that were not.
+
+A PR counts as reviewed when at least --min-reviewers distinct users have
+submitted a review. Use --state merged to find PRs merged without review.
+
+Examples:
+  # Unreviewed open PRs in configured repos
+  gh-sweep review-coverage
+
+  # Recently merged PRs that had fewer than two reviewers
+  gh-sweep review-coverage --repos owner/repo --state merged --min-reviewers 2`,
+	Run: runReviewCoverage,
+}
+
+func init() {
+	rootCmd.AddCommand(reviewCoverageCmd)
+	supportsGroup(reviewCoverageCmd)
+
+	reviewCoverageCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	reviewCoverageCmd.Flags().Int("min-reviewers", 1, "Distinct reviewers required for a PR to count as reviewed")
+	reviewCoverageCmd.Flags().String("state", "open", "Pull requests to check: open, merged, all")
+	reviewCoverageCmd.Flags().Int("limit", 50, "Most recent PRs to check per repository")
+}
+
+func runReviewCoverage(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	minReviewers, _ := cmd.Flags().GetInt("min-reviewers")
+	state, _ := cmd.Flags().GetString("state")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	apiState := state
+	switch state {
+	case "open", "all":
+	case "merged":
+		apiState = "closed"
+	default:
+		fmt.Printf("Error: invalid --state %q (expected open, merged, or all)\n", state)
+		return
+	}
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	fmt.Printf("%-35s %6s %9s %11s %9s\n", "Repository", "PRs", "Reviewed", "Unreviewed", "Coverage")
+	fmt.Println(strings.Repeat("-", 80))
+
+	var unreviewed []string
+	total, reviewed := 0, 0
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		prs, err := client.ListPullRequests(parts[0], parts[1], apiState)
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+
+		if state == "merged" {
+			var merged []github.PullRequest
+			for _, pr := range prs {
+				if pr.MergedAt != nil {
+					merged = append(merged, pr)
+				}
+			}
+			prs = merged
+		}
+		if limit > 0 && len(prs) > limit {
+			prs = prs[:limit]
+		}
+
+		reviews := make(map[int][]github.PRReview)
+		for _, pr := range prs {
+			prReviews, err := client.ListPRReviews(parts[0], parts[1], pr.Number)
+			if err != nil {
+				fmt.Printf("Warning: %s#%d: %v\n", repoStr, pr.Number, err)
+				continue
+			}
+			reviews[pr.Number] = prReviews
+		}
+
+		report := github.ComputeReviewCoverageWithMin(prs, reviews, minReviewers)
+		total += report.TotalPRs
+		reviewed += report.ReviewedPRs
+		for _, number := range report.UnreviewedPRNumbers {
+			unreviewed = append(unreviewed, fmt.Sprintf("%s#%d", repoStr, number))
+		}
+
+		fmt.Printf("%-35s %6d %9d %11d %8.1f%%\n",
+			truncate(repoStr, 35), report.TotalPRs, report.ReviewedPRs, report.UnreviewedPRs, report.CoverageRate)
+	}
+
+	if len(unreviewed) > 0 {
+		fmt.Printf("\nPRs with fewer than %d reviewer(s):\n", minReviewers)
+		for _, pr := range unreviewed {
+			fmt.Printf("  %s\n", pr)
+		}
+	}
+
+	coverage := 0.0
+	if total > 0 {
+		coverage = float64(reviewed) / float64(total) * 100
+	}
+	fmt.Printf("\n%d of %d PRs reviewed (%.1f%% coverage)\n", reviewed, total, coverage)
+}
@@ -2,10 +2,13 @@ package cmd
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/git"
+	"github.com/KyleKing/gh-sweep/internal/github"
 	"github.com/KyleKing/gh-sweep/internal/tui"
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 )
 
@@ -28,15 +31,71 @@ It provides interactive tools for:
   - GitHub Actions analytics
   - And much more...
 
+Repositories can be organized into named groups in .gh-sweep.yaml:
+
+  groups:
+    frontend: [owner/ui, owner/web]
+    backend: [owner/api, owner/db]
+
+The TUI opens with a group selector when groups are defined, and commands
+that scan multiple repositories accept --group to restrict them to one
+group's repositories; other commands reject --group.
+
+When stdout is not a terminal (or with --ci), a tab-separated health summary
+is printed instead of the TUI. Add --exit-code-on-findings to fail when
+findings exceed the 'ci' thresholds in .gh-sweep.yaml.
+
+Commands that take --repo default to the GitHub remote 'origin' of the
+current directory's Git repository; pass --no-autodetect to disable this.
+
+Authentication uses the first token found in: --token, 'github.token' in
+.gh-sweep.yaml, GH_TOKEN, GITHUB_TOKEN, then the gh CLI login.
+
 Use 'gh-sweep <command> --help' for more information about a command.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := checkGroupSupported(cmd); err != nil {
+			return err
+		}
+		configureAuth(cmd, args)
+		return nil
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		repo, _ := cmd.Flags().GetString("repo")
+		group, _ := cmd.Flags().GetString("group")
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to load config: %v\n", err)
+			os.Exit(1)
+		}
+
+		opts := []tui.MainOption{tui.WithConfig(cfg)}
+		if group != "" {
+			repos, err := configuredRepos(cfg, group)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			opts = append(opts, tui.WithGroup(group, repos))
+		}
+
+		if !interactive(cmd) {
+			repos, err := configuredRepos(cfg, group)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			if repo != "" {
+				repos = []string{repo}
+			}
+			runHealthSummary(cmd, cfg, repos)
+			return
+		}
 
 		// Launch full interactive TUI
-		m := tui.NewMainModel(repo)
-		p := tea.NewProgram(m, tea.WithAltScreen())
+		m := tui.NewMainModel(resolveRepo(cmd, repo), opts...)
 
-		if _, err := p.Run(); err != nil {
+		if err := runProgram(m); err != nil {
 			fmt.Fprintf(os.Stderr, "Error running TUI: %v\n", err)
 			os.Exit(1)
 		}
@@ -53,4 +112,76 @@ func Execute() {
 func init() {
 	rootCmd.Version = fmt.Sprintf("%s (commit: %s, built: %s)", version, commit, date)
 	rootCmd.Flags().String("repo", "", "Repository (owner/repo)")
+	rootCmd.PersistentFlags().String("group", "", "Restrict to a repository group from .gh-sweep.yaml")
+	supportsGroup(rootCmd)
+	rootCmd.PersistentFlags().Bool("ci", false, "Non-interactive mode: print text output instead of launching a TUI")
+	rootCmd.PersistentFlags().Bool("exit-code-on-findings", false, "Exit with status 1 when findings exceed the configured CI thresholds")
+	rootCmd.PersistentFlags().String("token", "", "GitHub token (overrides config, GH_TOKEN, and GITHUB_TOKEN)")
+	rootCmd.PersistentFlags().Bool("no-autodetect", false, "Do not default --repo to the origin remote of the current directory")
+	rootCmd.PersistentFlags().Bool("debug", false, "Log debug details, such as which auth source was used, to stderr")
+}
+
+// groupAnnotation marks commands that scope their repositories by --group
+const groupAnnotation = "gh-sweep/supports-group"
+
+// supportsGroup marks cmd as resolving its repositories through --group
+func supportsGroup(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	cmd.Annotations[groupAnnotation] = "true"
+}
+
+// checkGroupSupported rejects --group on commands that would otherwise silently ignore it
+func checkGroupSupported(cmd *cobra.Command) error {
+	if !cmd.Flags().Changed("group") || cmd.Annotations[groupAnnotation] == "true" {
+		return nil
+	}
+	return fmt.Errorf("--group is not supported by %s", cmd.CommandPath())
+}
+
+// configureAuth registers the --token flag, config token, and API URL before any GitHub client is created
+func configureAuth(cmd *cobra.Command, _ []string) {
+	if debug, _ := cmd.Flags().GetBool("debug"); debug {
+		slog.SetLogLoggerLevel(slog.LevelDebug)
+	}
+
+	opts := github.TokenOptions{}
+	opts.FlagToken, _ = cmd.Flags().GetString("token")
+
+	// Config errors are reported by the command itself when it loads config
+	if cfg, err := config.Load(); err == nil {
+		opts.ConfigToken = cfg.GitHub.Token
+		github.SetAPIURL(cfg.GitHub.APIURL)
+	}
+
+	github.SetTokenOptions(opts)
+}
+
+// resolveRepo returns repo, or when it is empty the origin remote of the current directory
+// Returns an empty string when --no-autodetect is set or no remote can be parsed
+func resolveRepo(cmd *cobra.Command, repo string) string {
+	if repo != "" {
+		return repo
+	}
+	if noAutodetect, _ := cmd.Flags().GetBool("no-autodetect"); noAutodetect {
+		return ""
+	}
+
+	detected, err := git.DetectRepoFromCWD()
+	if err != nil {
+		slog.Debug("repository autodetection failed", "error", err)
+		return ""
+	}
+
+	fmt.Fprintf(os.Stderr, "Using %s (detected from git remote origin)\n", detected)
+	return detected
+}
+
+// configuredRepos returns the repos in the named group, or all configured repos when group is empty
+func configuredRepos(cfg *config.Config, group string) ([]string, error) {
+	if group == "" {
+		return cfg.Repositories, nil
+	}
+	return cfg.GroupRepos(group)
 }
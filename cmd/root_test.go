@@ -1,7 +1,13 @@
 package cmd
 
 import (
+	"os"
+	"os/exec"
+	"strings"
 	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/spf13/cobra"
 )
 
 func TestRootCmd(t *testing.T) {
@@ -21,3 +27,130 @@ func TestVersionInfo(t *testing.T) {
 		t.Error("version is empty")
 	}
 }
+
+func TestGroupFlag(t *testing.T) {
+	if rootCmd.PersistentFlags().Lookup("group") == nil {
+		t.Fatal("Expected --group to be a persistent flag")
+	}
+
+	// Persistent flags are inherited by every subcommand
+	if protectionCmd.InheritedFlags().Lookup("group") == nil {
+		t.Error("Expected protection subcommand to inherit --group")
+	}
+}
+
+func TestConfiguredRepos(t *testing.T) {
+	cfg := &config.Config{
+		Repositories: []string{"owner/ui", "owner/api"},
+		Groups: map[string][]string{
+			"frontend": {"owner/ui", "owner/web"},
+			"backend":  {"owner/api"},
+		},
+	}
+
+	tests := []struct {
+		group    string
+		expected []string
+		wantErr  bool
+	}{
+		{"", []string{"owner/ui", "owner/api"}, false},
+		{"frontend", []string{"owner/ui", "owner/web"}, false},
+		{"mobile", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.group, func(t *testing.T) {
+			repos, err := configuredRepos(cfg, tt.group)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if strings.Join(repos, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("Expected repos %v, got %v", tt.expected, repos)
+			}
+		})
+	}
+}
+
+func TestResolveRepo(t *testing.T) {
+	dir := t.TempDir()
+	for _, args := range [][]string{{"init"}, {"remote", "add", "origin", "git@github.com:owner/detected.git"}} {
+		gitCmd := exec.Command("git", args...)
+		gitCmd.Dir = dir
+		if err := gitCmd.Run(); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	os.Chdir(dir)
+
+	newCmd := func(noAutodetect bool) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("no-autodetect", noAutodetect, "")
+		return cmd
+	}
+
+	if repo := resolveRepo(newCmd(false), "owner/explicit"); repo != "owner/explicit" {
+		t.Errorf("Expected --repo to win, got %q", repo)
+	}
+	if repo := resolveRepo(newCmd(false), ""); repo != "owner/detected" {
+		t.Errorf("Expected repo from origin remote, got %q", repo)
+	}
+	if repo := resolveRepo(newCmd(true), ""); repo != "" {
+		t.Errorf("Expected no repo with --no-autodetect, got %q", repo)
+	}
+}
+
+// TestCheckGroupSupported tests that --group is rejected by commands that do not resolve groups
+func TestCheckGroupSupported(t *testing.T) {
+	tests := []struct {
+		name      string
+		supported bool
+		args      []string
+		wantErr   bool
+	}{
+		{"supported with group", true, []string{"--group", "frontend"}, false},
+		{"unsupported without group", false, nil, false},
+		{"unsupported with group", false, []string{"--group", "frontend"}, true},
+	}
+
+	for _, tt := range tests {
+		cmd := &cobra.Command{Use: "example"}
+		cmd.Flags().String("group", "", "")
+		if tt.supported {
+			supportsGroup(cmd)
+		}
+		if err := cmd.ParseFlags(tt.args); err != nil {
+			t.Fatalf("%s: ParseFlags failed: %v", tt.name, err)
+		}
+
+		err := checkGroupSupported(cmd)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.wantErr, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "--group is not supported by example") {
+			t.Errorf("%s: unexpected error message %q", tt.name, err)
+		}
+	}
+}
+
+// TestGroupSupportedCommands tests that multi-repo commands accept --group and single-repo ones do not
+func TestGroupSupportedCommands(t *testing.T) {
+	for _, path := range [][]string{{"traffic"}, {"orphans"}, {"protection", "apply"}, {"cache", "warm"}} {
+		cmd, _, err := rootCmd.Find(path)
+		if err != nil || cmd.Annotations[groupAnnotation] != "true" {
+			t.Errorf("Expected %v to support --group", path)
+		}
+	}
+	for _, path := range [][]string{{"gha-perf"}, {"gha-errors"}, {"branches"}, {"comments"}, {"analytics"}, {"hooks", "install"}, {"ip-allowlist"}, {"linear"}, {"watching"}} {
+		cmd, _, err := rootCmd.Find(path)
+		if err != nil {
+			t.Fatalf("Find(%v) failed: %v", path, err)
+		}
+		if cmd.Annotations[groupAnnotation] == "true" {
+			t.Errorf("Expected %v to reject --group", path)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/export"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var secretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "Audit Actions secrets across repositories",
+	Long: `List Actions secrets and the number of repositories each one reaches.
+
+Organization secrets are counted against every scanned repository, so the
+repo count approximates the blast radius if a secret is compromised.
+
+Examples:
+  # Secrets for the default org and configured repos
+  gh-sweep secrets
+
+  # Only secrets that reach more than 10 repos
+  gh-sweep secrets --org myorg --blast-radius 10
+
+  # Export a secret x repo CSV of how many workflow files reference each secret
+  # (empty cells: the repo cannot use the secret)
+  gh-sweep secrets --org myorg --matrix-export secrets.csv`,
+	Run: runSecrets,
+}
+
+func init() {
+	rootCmd.AddCommand(secretsCmd)
+	supportsGroup(secretsCmd)
+
+	secretsCmd.Flags().String("org", "", "Organization to audit (default: default_org from config)")
+	secretsCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	secretsCmd.Flags().Int("blast-radius", 0, "Only list secrets affecting more than N repos")
+	secretsCmd.Flags().String("matrix-export", "", "Export secret usage per repo to this CSV file")
+}
+
+func runSecrets(cmd *cobra.Command, _ []string) {
+	org, _ := cmd.Flags().GetString("org")
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	blastRadius, _ := cmd.Flags().GetInt("blast-radius")
+	matrixExport, _ := cmd.Flags().GetString("matrix-export")
+
+	if org == "" || len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		if org == "" {
+			org = cfg.DefaultOrg
+		}
+		if len(repos) == 0 {
+			repos, err = configuredRepos(cfg, group)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+		}
+	}
+
+	if org == "" && len(repos) == 0 {
+		fmt.Println("Error: no org or repositories configured (use --org, --repos, or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	var orgSecrets []github.Secret
+	if org != "" {
+		orgSecrets, err = client.ListOrgSecrets(org)
+		if err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+
+	repoSecrets := make(map[string][]github.Secret)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		secrets, err := client.ListRepoSecrets(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+		repoSecrets[repoStr] = secrets
+	}
+
+	if matrixExport != "" {
+		exportSecretMatrix(client, orgSecrets, repoSecrets, matrixExport)
+		return
+	}
+
+	graph := github.BuildSecretDependencyGraph(orgSecrets, repoSecrets)
+
+	var nodes []github.SecretNode
+	for _, node := range github.TopSecretsByRepoCount(graph, 0) {
+		if node.RepoCount > blastRadius {
+			nodes = append(nodes, node)
+		}
+	}
+
+	if len(nodes) == 0 {
+		fmt.Println("No secrets found.")
+		return
+	}
+
+	fmt.Printf("%-40s %6s  %s\n", "Secret", "Repos", "Repositories")
+	fmt.Println(strings.Repeat("-", 100))
+	for _, node := range nodes {
+		fmt.Printf("%-40s %6d  %s\n",
+			truncate(node.SecretName, 40),
+			node.RepoCount,
+			truncate(strings.Join(graph[node.SecretName], ", "), 50))
+	}
+}
+
+// exportSecretMatrix scans each repo's workflows for secret references and writes the usage matrix
+func exportSecretMatrix(client *github.Client, orgSecrets []github.Secret, repoSecrets map[string][]github.Secret, path string) {
+	namingRegex := ""
+	if cfg, err := config.Load(); err == nil {
+		namingRegex = cfg.Secrets.NamingRegex
+	}
+	pattern, err := github.SecretReferencePattern(namingRegex)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	workflowRefs := make(map[string]map[string][]string)
+	for repoStr := range repoSecrets {
+		refs, err := scanWorkflowSecretRefs(client, repoStr, pattern)
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+		workflowRefs[repoStr] = refs
+	}
+
+	matrix := github.BuildOrgSecretMatrix(orgSecrets, repoSecrets, workflowRefs)
+	if err := export.ExportSecretMatrix(matrix, path); err != nil {
+		fmt.Printf("Error: failed to export secret matrix: %v\n", err)
+		return
+	}
+	fmt.Printf("Exported %d secret(s) across %d repo(s) to %s\n", len(matrix), len(repoSecrets), path)
+}
+
+// scanWorkflowSecretRefs maps each secret referenced in a repo's workflows to the referencing workflow paths
+func scanWorkflowSecretRefs(client *github.Client, repoStr string, pattern *regexp.Regexp) (map[string][]string, error) {
+	owner, repo, _ := strings.Cut(repoStr, "/")
+
+	workflows, err := client.ListWorkflows(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make(map[string][]string)
+	for _, workflow := range workflows {
+		if !strings.HasPrefix(workflow.Path, ".github/workflows/") {
+			continue
+		}
+
+		content, err := client.GetWorkflowContent(owner, repo, workflow.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range github.ScanWorkflowForSecretsWithOptions(content, pattern) {
+			refs[name] = append(refs[name], workflow.Path)
+		}
+	}
+	return refs, nil
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var securityCmd = &cobra.Command{
+	Use:   "security",
+	Short: "Audit repository security posture",
+	Long: `Audit security policies and Dependabot alerts across repositories.
+
+By default, checks that each repository has a security policy. Use
+--dismissed to report Dependabot alerts that were dismissed, grouped by
+dismissal reason, so false-positive triage does not get lost over time.
+Use --alert-trend to compare open alerts with the previous run, which is
+saved per repository in ~/.cache/gh-sweep/alerts.
+
+Examples:
+  # Check security policies for configured repos
+  gh-sweep security
+
+  # Report dismissed Dependabot alerts
+  gh-sweep security --repos owner/repo1,owner/repo2 --dismissed
+
+  # Show whether open alerts are going up or down since the last run
+  gh-sweep security --alert-trend`,
+	Run: runSecurity,
+}
+
+func init() {
+	rootCmd.AddCommand(securityCmd)
+	supportsGroup(securityCmd)
+
+	securityCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	securityCmd.Flags().Int("min-policy-length", 0, "Minimum security policy length in characters (default from config)")
+	securityCmd.Flags().Bool("dismissed", false, "Report dismissed Dependabot alerts grouped by reason")
+	securityCmd.Flags().Bool("alert-trend", false, "Compare open Dependabot alerts with the previous run")
+}
+
+func runSecurity(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	minPolicyLength, _ := cmd.Flags().GetInt("min-policy-length")
+	dismissed, _ := cmd.Flags().GetBool("dismissed")
+	alertTrend, _ := cmd.Flags().GetBool("alert-trend")
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error: failed to load config: %v\n", err)
+		return
+	}
+
+	if len(repos) == 0 {
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+	if minPolicyLength <= 0 {
+		minPolicyLength = cfg.Security.MinPolicyLength
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	if dismissed {
+		var alerts []github.DependabotAlert
+		for _, repoStr := range repos {
+			parts := strings.Split(repoStr, "/")
+			if len(parts) != 2 {
+				fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+				continue
+			}
+
+			repoAlerts, err := client.ListDismissedAlerts(parts[0], parts[1])
+			if err != nil {
+				fmt.Printf("Warning: %s: %v\n", repoStr, err)
+				continue
+			}
+			alerts = append(alerts, repoAlerts...)
+		}
+
+		printDismissalReport(alerts)
+		return
+	}
+
+	if alertTrend {
+		printAlertTrends(client, repos)
+		return
+	}
+
+	var failedRepos []string
+	policies := make(map[string]string)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		policy, err := client.GetSecurityPolicy(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			failedRepos = append(failedRepos, repoStr)
+			continue
+		}
+		policies[repoStr] = policy
+	}
+
+	report := github.AuditSecurityPolicyWithMinLength(policies, minPolicyLength)
+	printSecurityPolicyReport(report)
+	saveSnapshot(snapshotSecurity, cache.SnapshotScope("", repos), securitySnapshotEntries(report), failedRepos)
+	exitOnFindings(cmd, "security findings", len(report.Missing)+len(report.TooShort), cfg.CI.MaxSecurityFindings)
+}
+
+func printDismissalReport(alerts []github.DependabotAlert) {
+	report := github.BuildDismissalReport(alerts)
+
+	fmt.Println("Dismissed Dependabot Alerts")
+	fmt.Println(strings.Repeat("-", 80))
+
+	if report.TotalDismissed == 0 {
+		fmt.Println("No dismissed alerts found")
+		return
+	}
+
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].DismissedAt.Before(alerts[j].DismissedAt)
+	})
+
+	fmt.Printf("%-30s %-6s %-10s %-16s %-12s %s\n", "Repository", "#", "Severity", "Reason", "Dismissed", "Package")
+	for _, alert := range alerts {
+		fmt.Printf("%-30s %-6d %-10s %-16s %-12s %s\n",
+			truncate(alert.Repository, 30), alert.Number, alert.Severity, alert.DismissedReason,
+			alert.DismissedAt.Format("2006-01-02"), alert.Package)
+	}
+
+	reasons := make([]string, 0, len(report.ByReason))
+	for reason := range report.ByReason {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+
+	fmt.Printf("\nTotal dismissed: %d (oldest: %s)\n", report.TotalDismissed, report.OldestDismissal.Format("2006-01-02"))
+	for _, reason := range reasons {
+		fmt.Printf("  %-16s %d\n", reason, report.ByReason[reason])
+	}
+}
+
+func printAlertTrends(client *github.Client, repos []string) {
+	alertCache, err := cache.NewAlertStateCache("")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Println("Dependabot Alert Trend")
+	fmt.Println(strings.Repeat("-", 80))
+	fmt.Printf("%-35s %-8s %-6s %-6s %s\n", "Repository", "Open", "New", "Fixed", "New by severity")
+
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		alerts, err := client.ListOpenAlerts(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+
+		trend, hasPrevious, err := alertCache.UpdateTrend(parts[0], parts[1], alerts)
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+
+		if !hasPrevious {
+			fmt.Printf("%-35s %-8d %s\n", truncate(repoStr, 35), len(alerts), "(baseline saved)")
+			continue
+		}
+
+		fmt.Printf("%-35s %-8s %-6s %-6s %s\n", truncate(repoStr, 35),
+			fmt.Sprintf("%d %s", len(alerts), trend.Arrow()),
+			fmt.Sprintf("+%d", len(trend.NewAlerts)), fmt.Sprintf("-%d", len(trend.ResolvedAlerts)),
+			formatSeverityCounts(trend.NewBySeverity))
+	}
+}
+
+// formatSeverityCounts lists counts from most to least severe, e.g. "critical: 1, medium: 2"
+func formatSeverityCounts(counts map[string]int) string {
+	var parts []string
+	for _, severity := range []string{"critical", "high", "medium", "low"} {
+		if counts[severity] > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %d", severity, counts[severity]))
+		}
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
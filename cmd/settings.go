@@ -0,0 +1,344 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var settingsCmd = &cobra.Command{
+	Use:   "settings",
+	Short: "Compare repository settings",
+	Long: `Compare repository settings (merge strategies, default branch, features)
+across repositories and report drift from a baseline.
+
+Use --graphql to fetch every repository in a single batched GraphQL
+request, which is much faster for large org scans.
+
+Use --enforce-merge-strategy to report repositories that enable merge
+methods outside the allowed list (default: settings.allowed_merge_strategies).
+
+Use --settings-policy to override drift severities with a YAML file that
+maps fields (DefaultBranch, DeleteBranchOnMerge, MergeStrategies) to
+critical, warning, or info.
+
+Use --apply with --baseline to preview patching drifted repositories (or only
+--targets) so their merge strategies and delete-on-merge setting match the
+baseline, then add --confirm to write them. Only fields that differ are sent.
+The default branch is only changed with --include-default-branch, since the
+branch must already exist on every target.
+
+Examples:
+  # Show settings for configured repos
+  gh-sweep settings
+
+  # Show drift from the majority-aligned repo (auto-selected baseline)
+  gh-sweep settings --repos owner/repo1,owner/repo2,owner/repo3
+
+  # Show drift from a baseline repo
+  gh-sweep settings --repos owner/repo1,owner/repo2 --baseline owner/template
+
+  # Batch fetch via GraphQL
+  gh-sweep settings --baseline owner/template --graphql
+
+  # Flag repos that allow anything other than squash merges
+  gh-sweep settings --enforce-merge-strategy squash
+
+  # Treat a mismatched default branch as critical
+  echo 'DefaultBranch: critical' > policy.yaml
+  gh-sweep settings --settings-policy policy.yaml
+
+  # Preview, then copy the baseline's settings to two repos
+  gh-sweep settings --baseline owner/template --targets owner/repo1,owner/repo2 --apply
+  gh-sweep settings --baseline owner/template --targets owner/repo1,owner/repo2 --apply --confirm`,
+	Run: runSettings,
+}
+
+func init() {
+	rootCmd.AddCommand(settingsCmd)
+	supportsGroup(settingsCmd)
+
+	settingsCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	settingsCmd.Flags().String("baseline", "", "Baseline repository to compare against (default: auto-select by majority)")
+	settingsCmd.Flags().Bool("graphql", false, "Batch fetch settings with a single GraphQL query")
+	settingsCmd.Flags().StringSlice("enforce-merge-strategy", nil, "Allowed merge strategies: merge, squash, rebase (default from config)")
+	settingsCmd.Flags().String("settings-policy", "", "YAML file mapping settings fields to drift severities")
+	settingsCmd.Flags().StringSlice("targets", nil, "Repos to update with --apply (default: every repo that drifts from the baseline)")
+	settingsCmd.Flags().Bool("apply", false, "Patch target repos to match the baseline's settings (dry-run unless --confirm)")
+	settingsCmd.Flags().Bool("confirm", false, "Actually apply changes with --apply")
+	settingsCmd.Flags().Bool("include-default-branch", false, "Also copy the baseline's default branch with --apply")
+}
+
+func runSettings(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	baseline, _ := cmd.Flags().GetString("baseline")
+	useGraphQL, _ := cmd.Flags().GetBool("graphql")
+	allowedStrategies, _ := cmd.Flags().GetStringSlice("enforce-merge-strategy")
+	policyPath, _ := cmd.Flags().GetString("settings-policy")
+	targets, _ := cmd.Flags().GetStringSlice("targets")
+	apply, _ := cmd.Flags().GetBool("apply")
+	confirm, _ := cmd.Flags().GetBool("confirm")
+	includeDefaultBranch, _ := cmd.Flags().GetBool("include-default-branch")
+
+	if apply && baseline == "" {
+		fmt.Println("Error: --apply requires --baseline")
+		return
+	}
+
+	policy := github.DefaultSettingsPolicy()
+	if policyPath != "" {
+		loaded, err := github.LoadSettingsPolicy(policyPath)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		policy = loaded
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error: failed to load config: %v\n", err)
+		return
+	}
+
+	if len(allowedStrategies) == 0 {
+		allowedStrategies = cfg.Settings.AllowedMergeStrategies
+	}
+	for _, strategy := range allowedStrategies {
+		if !containsString(github.MergeStrategies, strategy) {
+			fmt.Printf("Error: unknown merge strategy %q (use merge, squash, or rebase)\n", strategy)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if baseline != "" && !containsString(repos, baseline) {
+		repos = append([]string{baseline}, repos...)
+	}
+	for _, target := range targets {
+		if !containsString(repos, target) {
+			repos = append(repos, target)
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	var settings map[string]*github.RepoSettings
+	if useGraphQL {
+		settings, err = fetchSettingsGraphQL(repos)
+	} else {
+		settings, err = fetchSettingsREST(repos)
+	}
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Printf("%-35s %-12s %6s %6s %6s %10s\n", "Repository", "Default", "Merge", "Squash", "Rebase", "DeleteHead")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, name := range names {
+		s := settings[name]
+		fmt.Printf("%-35s %-12s %6v %6v %6v %10v\n",
+			truncate(name, 35), truncate(s.DefaultBranch, 12), s.AllowMergeCommit, s.AllowSquashMerge, s.AllowRebaseMerge, s.DeleteBranchOnMerge)
+	}
+
+	if len(allowedStrategies) > 0 {
+		printMergeStrategyViolations(github.EnforceMergeStrategy(settings, allowedStrategies), allowedStrategies)
+	}
+
+	if baseline == "" {
+		selected, err := github.SelectBaselineRepo(settings)
+		if err != nil {
+			return
+		}
+		baseline = selected
+		fmt.Printf("\nAuto-selected baseline: %s\n", baseline)
+	}
+
+	baselineSettings := settings[baseline]
+	if baselineSettings == nil {
+		fmt.Printf("\nWarning: no settings found for baseline %s\n", baseline)
+		return
+	}
+
+	fmt.Printf("\nDrift from %s:\n", baseline)
+	drifted := 0
+	for _, name := range names {
+		if name == baseline {
+			continue
+		}
+
+		diffs := github.CompareSettingsWithPolicy(baselineSettings, settings[name], policy)
+		if len(diffs) == 0 {
+			continue
+		}
+
+		drifted++
+		fmt.Printf("  %s:\n", name)
+		for _, diff := range diffs {
+			fmt.Printf("    - [%s] %s: %v (baseline: %v)\n", diff.Severity, diff.Field, diff.Current, diff.Baseline)
+		}
+	}
+
+	if drifted == 0 {
+		fmt.Println("  ✓ No differences")
+	}
+
+	if !apply {
+		return
+	}
+
+	if len(targets) == 0 {
+		for _, name := range names {
+			if name != baseline {
+				targets = append(targets, name)
+			}
+		}
+	}
+
+	var applier settingsApplier
+	if confirm {
+		client, err := github.NewClient(context.Background())
+		if err != nil {
+			fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+			return
+		}
+		applier = client
+	}
+
+	opts := github.SettingsPatchOptions{IncludeDefaultBranch: includeDefaultBranch}
+	fmt.Printf("\nApplying %s settings:\n", baseline)
+	applied, failed := applyBaselineSettings(applier, baselineSettings, settings, targets, opts, !confirm)
+	if !confirm {
+		fmt.Println("\nRe-run with --confirm to apply these changes")
+		return
+	}
+	fmt.Printf("\nUpdated %d/%d repositories\n", applied, len(targets))
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// settingsApplier patches repository settings; implemented by *github.Client
+type settingsApplier interface {
+	ApplyRepoSettings(owner, repo string, current, desired *github.RepoSettings, opts github.SettingsPatchOptions) (map[string]interface{}, error)
+}
+
+// applyBaselineSettings patches each target so its compared settings match baseline,
+// only printing the fields that would change when dryRun is set
+func applyBaselineSettings(applier settingsApplier, baseline *github.RepoSettings, settings map[string]*github.RepoSettings, targets []string, opts github.SettingsPatchOptions, dryRun bool) (applied, failed int) {
+	for _, repoStr := range targets {
+		current := settings[repoStr]
+		if current == nil {
+			fmt.Printf("  ✗ %s: settings not loaded\n", repoStr)
+			failed++
+			continue
+		}
+
+		patch := github.SettingsPatch(current, baseline, opts)
+		if len(patch) == 0 {
+			fmt.Printf("  ✓ %s already matches\n", repoStr)
+			continue
+		}
+
+		if dryRun {
+			fmt.Printf("  [DRY RUN] Would set %s on %s\n", formatSettingsPatch(patch), repoStr)
+			continue
+		}
+
+		owner, name, ok := strings.Cut(repoStr, "/")
+		if !ok {
+			fmt.Printf("  ✗ %s: expected owner/repo\n", repoStr)
+			failed++
+			continue
+		}
+		if _, err := applier.ApplyRepoSettings(owner, name, current, baseline, opts); err != nil {
+			fmt.Printf("  ✗ %s: %v\n", repoStr, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✓ %s: set %s\n", repoStr, formatSettingsPatch(patch))
+		applied++
+	}
+
+	return applied, failed
+}
+
+// formatSettingsPatch renders patch fields in name order, e.g. "allow_merge_commit=false, default_branch=main"
+func formatSettingsPatch(patch map[string]interface{}) string {
+	fields := make([]string, 0, len(patch))
+	for field, value := range patch {
+		fields = append(fields, fmt.Sprintf("%s=%v", field, value))
+	}
+	sort.Strings(fields)
+	return strings.Join(fields, ", ")
+}
+
+func printMergeStrategyViolations(violations []github.MergeStrategyViolation, allowed []string) {
+	fmt.Printf("\nMerge strategy policy (allowed: %s):\n", strings.Join(allowed, ", "))
+	if len(violations) == 0 {
+		fmt.Println("  ✓ All repositories comply")
+		return
+	}
+
+	for _, violation := range violations {
+		fmt.Printf("  ✗ %s enables %s\n", violation.Repository, strings.Join(violation.Disallowed, ", "))
+	}
+}
+
+func fetchSettingsGraphQL(repos []string) (map[string]*github.RepoSettings, error) {
+	client, err := github.NewGraphQLClient(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetRepoSettingsBatch(repos)
+}
+
+func fetchSettingsREST(repos []string) (map[string]*github.RepoSettings, error) {
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
+	settings := make(map[string]*github.RepoSettings)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		repoSettings, err := client.GetRepoSettings(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+		settings[repoStr] = repoSettings
+	}
+
+	return settings, nil
+}
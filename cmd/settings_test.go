@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// fakeSettingsApplier records the PATCH requests the GitHub client would make
+type fakeSettingsApplier struct {
+	calls []string
+	fail  map[string]bool // repo names whose update fails
+}
+
+func (f *fakeSettingsApplier) ApplyRepoSettings(owner, repo string, current, desired *github.RepoSettings, opts github.SettingsPatchOptions) (map[string]interface{}, error) {
+	patch := github.SettingsPatch(current, desired, opts)
+	f.calls = append(f.calls, fmt.Sprintf("PATCH repos/%s/%s %s", owner, repo, formatSettingsPatch(patch)))
+	if f.fail[repo] {
+		return nil, errors.New("403 Forbidden")
+	}
+	return patch, nil
+}
+
+func settingsFixture() (*github.RepoSettings, map[string]*github.RepoSettings) {
+	baseline := &github.RepoSettings{Repository: "acme/base", DefaultBranch: "main", AllowSquashMerge: true, DeleteBranchOnMerge: true}
+	settings := map[string]*github.RepoSettings{
+		"acme/base": baseline,
+		"acme/api":  {Repository: "acme/api", DefaultBranch: "main", AllowSquashMerge: true, DeleteBranchOnMerge: true},
+		"acme/web":  {Repository: "acme/web", DefaultBranch: "main", AllowMergeCommit: true, AllowSquashMerge: true},
+		"acme/docs": {Repository: "acme/docs", DefaultBranch: "master", AllowSquashMerge: true, DeleteBranchOnMerge: true},
+	}
+	return baseline, settings
+}
+
+// TestApplyBaselineSettingsDryRun tests that dry-run makes no API calls
+func TestApplyBaselineSettingsDryRun(t *testing.T) {
+	baseline, settings := settingsFixture()
+
+	applied, failed := applyBaselineSettings(nil, baseline, settings, []string{"acme/web", "acme/docs"}, github.SettingsPatchOptions{}, true)
+	if applied != 0 || failed != 0 {
+		t.Errorf("applied, failed = %d, %d; want 0, 0", applied, failed)
+	}
+}
+
+// TestApplyBaselineSettings tests that only drifted targets are patched with their differing fields
+func TestApplyBaselineSettings(t *testing.T) {
+	baseline, settings := settingsFixture()
+	applier := &fakeSettingsApplier{}
+
+	applied, failed := applyBaselineSettings(applier, baseline, settings, []string{"acme/api", "acme/web", "acme/docs"}, github.SettingsPatchOptions{}, false)
+	if applied != 1 || failed != 0 {
+		t.Errorf("applied, failed = %d, %d; want 1, 0", applied, failed)
+	}
+
+	want := []string{"PATCH repos/acme/web allow_merge_commit=false, delete_branch_on_merge=true"}
+	if !reflect.DeepEqual(applier.calls, want) {
+		t.Errorf("calls = %v, want %v", applier.calls, want)
+	}
+}
+
+// TestApplyBaselineSettingsIncludeDefaultBranch tests the opt-in default branch change and failures
+func TestApplyBaselineSettingsIncludeDefaultBranch(t *testing.T) {
+	baseline, settings := settingsFixture()
+	applier := &fakeSettingsApplier{fail: map[string]bool{"docs": true}}
+
+	opts := github.SettingsPatchOptions{IncludeDefaultBranch: true}
+	applied, failed := applyBaselineSettings(applier, baseline, settings, []string{"acme/api", "acme/web", "acme/docs", "acme/missing"}, opts, false)
+	if applied != 1 || failed != 2 {
+		t.Errorf("applied, failed = %d, %d; want 1, 2", applied, failed)
+	}
+
+	want := []string{
+		"PATCH repos/acme/web allow_merge_commit=false, delete_branch_on_merge=true",
+		"PATCH repos/acme/docs default_branch=main",
+	}
+	if !reflect.DeepEqual(applier.calls, want) {
+		t.Errorf("calls = %v, want %v", applier.calls, want)
+	}
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var trafficCmd = &cobra.Command{
+	Use:   "traffic",
+	Short: "Show repository traffic statistics",
+	Long: `Rank repositories by unique visitors over the last 14 days.
+
+Reports views, clones, top referrers, and popular paths. GitHub only
+exposes traffic data to users with push access to the repository.
+
+Repositories default to the 'repositories' list in .gh-sweep.yaml.
+
+Examples:
+  # Traffic for configured repos
+  gh-sweep traffic
+
+  # Traffic for specific repos
+  gh-sweep traffic --repos owner/repo1,owner/repo2`,
+	Run: runTraffic,
+}
+
+func init() {
+	rootCmd.AddCommand(trafficCmd)
+	supportsGroup(trafficCmd)
+
+	trafficCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+}
+
+func runTraffic(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	var traffic []github.RepoTraffic
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		repoTraffic, err := client.GetRepoTraffic(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v (traffic requires push access)\n", repoStr, err)
+			continue
+		}
+		traffic = append(traffic, *repoTraffic)
+	}
+
+	if len(traffic) == 0 {
+		fmt.Println("No traffic data found.")
+		return
+	}
+
+	fmt.Printf("%-35s %8s %8s %8s %8s  %s\n", "Repository", "Views", "Unique", "Clones", "Unique", "Top Referrer")
+	fmt.Println(strings.Repeat("-", 100))
+	for _, t := range github.RankReposByUniqueViews(traffic) {
+		referrer := "-"
+		if len(t.TopReferrers) > 0 {
+			referrer = t.TopReferrers[0]
+		}
+		fmt.Printf("%-35s %8d %8d %8d %8d  %s\n",
+			truncate(t.Repository, 35),
+			t.ViewsTotal,
+			t.ViewsUnique,
+			t.ClonesTotal,
+			t.ClonesUnique,
+			referrer)
+	}
+}
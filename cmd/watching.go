@@ -3,8 +3,11 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/watching"
 	"github.com/spf13/cobra"
 )
 
@@ -21,10 +24,22 @@ Examples:
   gh-sweep watching --unwatched
 
   # Watch all repos in namespace
-  gh-sweep watching --watch-all`,
+  gh-sweep watching --watch-all
+
+  # Stop watching every watched repo
+  gh-sweep watching --unwatch-all
+
+  # List repos by subscription reason (e.g. subscribed, ignored)
+  gh-sweep watching --reason ignored
+
+  # Include org repos you can access but don't own
+  gh-sweep watching --org myorg --unwatched`,
 	Run: func(cmd *cobra.Command, args []string) {
 		unwatched, _ := cmd.Flags().GetBool("unwatched")
 		watchAll, _ := cmd.Flags().GetBool("watch-all")
+		unwatchAll, _ := cmd.Flags().GetBool("unwatch-all")
+		reason, _ := cmd.Flags().GetString("reason")
+		org, _ := cmd.Flags().GetString("org")
 
 		ctx := context.Background()
 		client, err := github.NewClient(ctx)
@@ -45,17 +60,47 @@ Examples:
 			return
 		}
 
+		if org != "" {
+			orgRepos, err := client.ListOrgRepositories(org)
+			if err != nil {
+				fmt.Printf("Error: failed to list org repos: %v\n", err)
+				return
+			}
+			repos = append(repos, watching.OrgOnlyRepos(repos, orgRepos, username)...)
+		}
+
 		var unwatchedRepos []github.RepoBasic
+		var watchedRepos []github.RepoBasic
+		var reasonRepos []github.RepoBasic
 		for _, repo := range repos {
 			sub, err := client.GetRepoSubscription(repo.Owner, repo.Name)
 			if err != nil {
 				continue
 			}
-			if sub.State == github.WatchStateNotWatching {
+			switch sub.State {
+			case github.WatchStateNotWatching:
 				unwatchedRepos = append(unwatchedRepos, repo)
+			case github.WatchStateSubscribed:
+				watchedRepos = append(watchedRepos, repo)
+			}
+			if reason != "" && github.SubscriptionReason(sub) == reason {
+				reasonRepos = append(reasonRepos, repo)
 			}
 		}
 
+		if reason != "" {
+			fmt.Printf("Repositories with reason %q for %s:\n\n", reason, username)
+			if len(reasonRepos) == 0 {
+				fmt.Println("No matching repositories.")
+				return
+			}
+			for _, repo := range reasonRepos {
+				fmt.Printf("  - %s\n", repo.FullName)
+			}
+			fmt.Printf("\nTotal: %d repositories\n", len(reasonRepos))
+			return
+		}
+
 		if unwatched {
 			fmt.Printf("Unwatched repositories for %s:\n\n", username)
 			if len(unwatchedRepos) == 0 {
@@ -75,15 +120,17 @@ Examples:
 				return
 			}
 			fmt.Printf("Watching %d repositories...\n\n", len(unwatchedRepos))
-			for _, repo := range unwatchedRepos {
-				_, err := client.SetRepoSubscription(repo.Owner, repo.Name, true, false)
-				if err != nil {
-					fmt.Printf("  Failed to watch %s: %v\n", repo.FullName, err)
-					continue
-				}
-				fmt.Printf("  Watching %s\n", repo.FullName)
+			printBulkWatchResult("Watching", watching.BulkWatch(unwatchedRepos, true, false))
+			return
+		}
+
+		if unwatchAll {
+			if len(watchedRepos) == 0 {
+				fmt.Println("No repositories are being watched.")
+				return
 			}
-			fmt.Println("\nDone.")
+			fmt.Printf("Unwatching %d repositories...\n\n", len(watchedRepos))
+			printBulkWatchResult("Unwatched", watching.BulkWatch(watchedRepos, false, false))
 			return
 		}
 
@@ -101,4 +148,25 @@ func init() {
 
 	watchingCmd.Flags().Bool("unwatched", false, "List unwatched repositories")
 	watchingCmd.Flags().Bool("watch-all", false, "Watch all unwatched repositories")
+	watchingCmd.Flags().Bool("unwatch-all", false, "Unwatch all watched repositories")
+	watchingCmd.Flags().String("org", "", "Also include repositories in this organization that you don't own")
+	watchingCmd.Flags().String("reason", "", "List repositories with this subscription reason (e.g. subscribed, ignored)")
+}
+
+func printBulkWatchResult(action string, result watching.BulkWatchResult) {
+	for _, repo := range result.Succeeded {
+		fmt.Printf("  %s %s\n", action, repo)
+	}
+
+	failed := make([]string, 0, len(result.Failed))
+	for repo := range result.Failed {
+		failed = append(failed, repo)
+	}
+	sort.Strings(failed)
+	for _, repo := range failed {
+		fmt.Printf("  Failed %s: %v\n", repo, result.Failed[repo])
+	}
+
+	fmt.Printf("\nDone: %d succeeded, %d failed in %s\n",
+		len(result.Succeeded), len(result.Failed), result.Duration.Round(time.Millisecond))
 }
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	webhookstui "github.com/KyleKing/gh-sweep/internal/tui/components/webhooks"
+	"github.com/spf13/cobra"
+)
+
+var webhooksCmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Audit repository webhooks",
+	Long: `List repository webhooks and check that required events are covered.
+
+Repositories default to the 'repositories' list in .gh-sweep.yaml.
+Required events default to 'webhooks.required_webhook_events' in the config.
+
+Examples:
+  # Audit webhooks for configured repos
+  gh-sweep webhooks
+
+  # Check specific repos for push and release listeners
+  gh-sweep webhooks --repos owner/repo1,owner/repo2 --required-events push,release
+
+  # Only list webhooks with SSL verification disabled
+  gh-sweep webhooks --insecure-only
+
+  # Tail new deliveries live in the TUI
+  gh-sweep webhooks --repos owner/repo --follow`,
+	Run: runWebhooks,
+}
+
+func init() {
+	rootCmd.AddCommand(webhooksCmd)
+	supportsGroup(webhooksCmd)
+
+	webhooksCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	webhooksCmd.Flags().StringSlice("required-events", nil, "Events every repo should have a webhook for (comma-separated)")
+	webhooksCmd.Flags().Bool("follow", false, "Tail new webhook deliveries in the TUI, polling every 5 seconds")
+	webhooksCmd.Flags().Bool("insecure-only", false, "Only show webhooks with SSL verification disabled")
+}
+
+func runWebhooks(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	requiredEvents, _ := cmd.Flags().GetStringSlice("required-events")
+	follow, _ := cmd.Flags().GetBool("follow")
+	insecureOnly, _ := cmd.Flags().GetBool("insecure-only")
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error: failed to load config: %v\n", err)
+		return
+	}
+
+	if len(repos) == 0 {
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+	if len(requiredEvents) == 0 {
+		requiredEvents = cfg.Webhooks.RequiredWebhookEvents
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	if follow && !interactive(cmd) {
+		fmt.Println("Warning: --follow requires an interactive terminal; printing a snapshot instead")
+		follow = false
+	}
+
+	if follow {
+		m := webhookstui.NewModel(repos, webhookstui.WithRequiredEvents(requiredEvents), webhookstui.WithFollow(true))
+		if err := runProgram(m); err != nil {
+			fmt.Printf("Error running TUI: %v\n", err)
+		}
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	webhooksByRepo := make(map[string][]github.Webhook)
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		webhooks, err := client.ListWebhooks(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+		webhooksByRepo[repoStr] = webhooks
+	}
+
+	insecureCount := github.CountInsecureWebhooks(webhooksByRepo)
+	if insecureOnly {
+		webhooksByRepo = github.FilterInsecureWebhooks(webhooksByRepo)
+		// Event coverage is meaningless for a filtered subset of webhooks
+		requiredEvents = nil
+	}
+
+	reposWithGaps := 0
+	for _, repoStr := range repos {
+		webhooks, ok := webhooksByRepo[repoStr]
+		if !ok {
+			continue
+		}
+
+		fmt.Printf("%s (%d webhooks)\n", repoStr, len(webhooks))
+		for _, webhook := range webhooks {
+			state := ""
+			if !webhook.Active {
+				state = " (inactive)"
+			}
+			if webhook.InsecureSSL {
+				state += " [INSECURE SSL]"
+			}
+			fmt.Printf("  %d %s%s\n", webhook.ID, webhook.URL, state)
+			fmt.Printf("     Events: %s\n", strings.Join(webhook.Events, ", "))
+		}
+
+		if len(requiredEvents) > 0 {
+			report := github.DetectWebhookCoverage(webhooks, requiredEvents)
+			if len(report.MissingEvents) > 0 {
+				reposWithGaps++
+				fmt.Printf("  [MISSING] %s\n", strings.Join(report.MissingEvents, ", "))
+			}
+		}
+		fmt.Println()
+	}
+
+	if len(requiredEvents) > 0 {
+		fmt.Printf("Required events: %s\n", strings.Join(requiredEvents, ", "))
+		fmt.Printf("%d of %d repos missing required events\n", reposWithGaps, len(repos))
+	}
+	fmt.Printf("%d webhooks with SSL verification disabled\n", insecureCount)
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/spf13/cobra"
+)
+
+var workflowPinningCmd = &cobra.Command{
+	Use:   "workflow-pinning",
+	Short: "Audit how GitHub Actions dependencies are pinned",
+	Long: `Check every workflow's 'uses:' references and classify them as
+SHA-pinned, tag-pinned, or branch-pinned.
+
+Tags and branches can be moved to point at different code, so only
+references pinned to a full commit SHA are immutable.
+
+Examples:
+  # Audit workflows in configured repos
+  gh-sweep workflow-pinning
+
+  # Also list references that are already SHA-pinned
+  gh-sweep workflow-pinning --repos owner/repo --show-pinned`,
+	Run: runWorkflowPinning,
+}
+
+func init() {
+	rootCmd.AddCommand(workflowPinningCmd)
+	supportsGroup(workflowPinningCmd)
+
+	workflowPinningCmd.Flags().StringSlice("repos", nil, "Specific repos to check (comma-separated)")
+	workflowPinningCmd.Flags().Bool("show-pinned", false, "Also list SHA-pinned references")
+}
+
+func runWorkflowPinning(cmd *cobra.Command, _ []string) {
+	repos, _ := cmd.Flags().GetStringSlice("repos")
+	group, _ := cmd.Flags().GetString("group")
+	showPinned, _ := cmd.Flags().GetBool("show-pinned")
+
+	if len(repos) == 0 {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error: failed to load config: %v\n", err)
+			return
+		}
+		repos, err = configuredRepos(cfg, group)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if len(repos) == 0 {
+		fmt.Println("Error: no repositories configured (use --repos or .gh-sweep.yaml)")
+		return
+	}
+
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		fmt.Printf("Error: failed to create GitHub client: %v\n", err)
+		return
+	}
+
+	totalSHA, totalUnpinned := 0, 0
+	for _, repoStr := range repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			fmt.Printf("Warning: skipping %s (expected owner/repo)\n", repoStr)
+			continue
+		}
+
+		workflows, err := client.ListWorkflows(parts[0], parts[1])
+		if err != nil {
+			fmt.Printf("Warning: %s: %v\n", repoStr, err)
+			continue
+		}
+
+		for _, workflow := range workflows {
+			if !strings.HasPrefix(workflow.Path, ".github/workflows/") {
+				continue
+			}
+
+			content, err := client.GetWorkflowContent(parts[0], parts[1], workflow.Path)
+			if err != nil {
+				fmt.Printf("Warning: %s: %v\n", repoStr, err)
+				continue
+			}
+
+			audit := github.AuditActionPinning(content)
+			totalSHA += len(audit.SHAPinned)
+			totalUnpinned += audit.UnpinnedCount
+
+			if audit.UnpinnedCount == 0 && !showPinned {
+				continue
+			}
+
+			fmt.Printf("\n%s %s (%d SHA, %d tag, %d branch)\n", repoStr, workflow.Path,
+				len(audit.SHAPinned), len(audit.TagPinned), len(audit.BranchPinned))
+			for _, uses := range audit.BranchPinned {
+				fmt.Printf("  [BRANCH] %s\n", uses)
+			}
+			for _, uses := range audit.TagPinned {
+				fmt.Printf("  [TAG]    %s\n", uses)
+			}
+			if showPinned {
+				for _, uses := range audit.SHAPinned {
+					fmt.Printf("  [SHA]    %s\n", uses)
+				}
+			}
+		}
+	}
+
+	fmt.Printf("\n%d SHA-pinned, %d not pinned to a SHA\n", totalSHA, totalUnpinned)
+}
@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Entry is one audited change, written as a JSON line
+type Entry struct {
+	Time       time.Time `json:"time"`
+	Action     string    `json:"action"`
+	Repository string    `json:"repository"`
+	Target     string    `json:"target"`
+	SHA        string    `json:"sha,omitempty"`
+	DryRun     bool      `json:"dry_run"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// Logger appends entries to a JSON lines file
+type Logger struct {
+	path string
+	now  func() time.Time
+	mu   sync.Mutex
+}
+
+// NewLogger creates a logger writing to path (default: ~/.cache/gh-sweep/audit.log)
+func NewLogger(path string) (*Logger, error) {
+	if path == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get home directory: %w", err)
+		}
+		path = filepath.Join(homeDir, ".cache", "gh-sweep", "audit.log")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+
+	return &Logger{path: path, now: time.Now}, nil
+}
+
+// Path returns the file entries are written to
+func (l *Logger) Path() string {
+	return l.path
+}
+
+// Log appends entry, stamping the current time when entry.Time is zero
+func (l *Logger) Log(entry Entry) error {
+	if entry.Time.IsZero() {
+		entry.Time = l.now()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	return nil
+}
+
+// ReadEntries reads every entry in the log at path, oldest first
+func ReadEntries(path string) ([]Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	var entries []Entry
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for decoder.More() {
+		var entry Entry
+		if err := decoder.Decode(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse audit log: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestLoggerAppendsEntries tests that entries accumulate as JSON lines with timestamps
+func TestLoggerAppendsEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "audit.log")
+	logger, err := NewLogger(path)
+	if err != nil {
+		t.Fatalf("NewLogger failed: %v", err)
+	}
+	stamp := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	logger.now = func() time.Time { return stamp }
+
+	if err := logger.Log(Entry{Action: "delete_branch", Repository: "owner/repo", Target: "feature/a", SHA: "abc123"}); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+	if err := logger.Log(Entry{Action: "delete_branch", Repository: "owner/repo", Target: "feature/b", DryRun: true}); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+
+	entries, err := ReadEntries(logger.Path())
+	if err != nil {
+		t.Fatalf("ReadEntries failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Target != "feature/a" || entries[0].SHA != "abc123" || !entries[0].Time.Equal(stamp) {
+		t.Errorf("Unexpected first entry: %+v", entries[0])
+	}
+	if entries[1].Target != "feature/b" || !entries[1].DryRun {
+		t.Errorf("Unexpected second entry: %+v", entries[1])
+	}
+}
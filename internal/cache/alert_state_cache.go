@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// AlertStateCache stores the last seen open Dependabot alerts per repository for trend tracking
+type AlertStateCache struct {
+	cacheDir string
+	now      func() time.Time
+}
+
+type alertStateEntry struct {
+	SavedAt time.Time                `json:"saved_at"`
+	Alerts  []github.DependabotAlert `json:"alerts"`
+}
+
+// NewAlertStateCache creates a cache in cacheDir (default ~/.cache/gh-sweep/alerts)
+func NewAlertStateCache(cacheDir string) (*AlertStateCache, error) {
+	if cacheDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get home directory: %w", err)
+		}
+		cacheDir = filepath.Join(homeDir, ".cache", "gh-sweep", "alerts")
+	}
+
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &AlertStateCache{cacheDir: cacheDir, now: time.Now}, nil
+}
+
+func (c *AlertStateCache) cacheFilePath(owner, repo string) string {
+	return filepath.Join(c.cacheDir, fmt.Sprintf("%s_%s.json", owner, repo))
+}
+
+// Load returns the previously saved alerts and when they were saved
+// Returns ok=false when no state has been saved for the repository
+func (c *AlertStateCache) Load(owner, repo string) (alerts []github.DependabotAlert, savedAt time.Time, ok bool, err error) {
+	data, err := os.ReadFile(c.cacheFilePath(owner, repo))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, time.Time{}, false, nil
+		}
+		return nil, time.Time{}, false, fmt.Errorf("failed to read cache file: %w", err)
+	}
+
+	var entry alertStateEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, time.Time{}, false, fmt.Errorf("failed to parse cache file: %w", err)
+	}
+
+	return entry.Alerts, entry.SavedAt, true, nil
+}
+
+// Save replaces the stored alerts for the repository
+func (c *AlertStateCache) Save(owner, repo string, alerts []github.DependabotAlert) error {
+	data, err := json.MarshalIndent(alertStateEntry{SavedAt: c.now(), Alerts: alerts}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache: %w", err)
+	}
+
+	if err := os.WriteFile(c.cacheFilePath(owner, repo), data, 0644); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateTrend compares alerts with the saved state, then saves alerts as the new state
+// Returns hasPrevious=false on the first run, when there is nothing to compare against
+func (c *AlertStateCache) UpdateTrend(owner, repo string, alerts []github.DependabotAlert) (trend github.AlertTrend, hasPrevious bool, err error) {
+	previous, _, ok, err := c.Load(owner, repo)
+	if err != nil {
+		return github.AlertTrend{}, false, err
+	}
+
+	trend = github.TrackAlertTrend(alerts, previous)
+	if err := c.Save(owner, repo, alerts); err != nil {
+		return trend, ok, err
+	}
+
+	return trend, ok, nil
+}
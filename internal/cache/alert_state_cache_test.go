@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+func TestAlertStateCacheUpdateTrend(t *testing.T) {
+	alertCache, err := NewAlertStateCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+
+	first := []github.DependabotAlert{
+		{Repository: "acme/api", Number: 1, Severity: "critical"},
+	}
+	if _, hasPrevious, err := alertCache.UpdateTrend("acme", "api", first); err != nil || hasPrevious {
+		t.Fatalf("Expected no previous state on first run, got hasPrevious=%v err=%v", hasPrevious, err)
+	}
+
+	second := []github.DependabotAlert{
+		{Repository: "acme/api", Number: 2, Severity: "medium"},
+		{Repository: "acme/api", Number: 3, Severity: "medium"},
+	}
+	trend, hasPrevious, err := alertCache.UpdateTrend("acme", "api", second)
+	if err != nil || !hasPrevious {
+		t.Fatalf("Expected previous state, got hasPrevious=%v err=%v", hasPrevious, err)
+	}
+	if trend.TrendDirection != github.AlertTrendWorsening || len(trend.ResolvedAlerts) != 1 {
+		t.Errorf("Expected worsening with 1 resolved, got %+v", trend)
+	}
+
+	saved, _, ok, err := alertCache.Load("acme", "api")
+	if err != nil || !ok || len(saved) != 2 {
+		t.Errorf("Expected the latest alerts to be saved, got %+v (ok=%v, err=%v)", saved, ok, err)
+	}
+
+	if _, _, ok, _ := alertCache.Load("acme", "web"); ok {
+		t.Error("Expected no state for an unseen repo")
+	}
+}
@@ -3,25 +3,88 @@ package cache
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
 )
 
 type GHAPerfCache struct {
-	UpdatedAt time.Time            `json:"updated_at"`
-	Repo      string               `json:"repo"`
-	Runs      []github.RunTiming   `json:"runs"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	Repo      string             `json:"repo"`
+	MaxRunID  int                `json:"max_run_id"`
+	Runs      []github.RunTiming `json:"runs"`
+
+	// PendingRunID is the oldest run still in progress at the last fetch, or 0 when none were.
+	// It is lower than runs cached after it, so incremental fetches resume below it
+	PendingRunID int `json:"pending_run_id,omitempty"`
+
+	// Expired is set by Load when a cache older than MaxAge was discarded
+	Expired bool `json:"-"`
+}
+
+// ResumeRunID returns the run ID incremental fetches stop at: MaxRunID, or just below
+// PendingRunID so runs that were in progress at the last fetch are picked up once finished
+func (c *GHAPerfCache) ResumeRunID() int {
+	if c.PendingRunID > 0 && c.PendingRunID-1 < c.MaxRunID {
+		return c.PendingRunID - 1
+	}
+	return c.MaxRunID
+}
+
+// UpdatePending records the oldest in-progress run seen by a fetch; call it before Runs are merged
+// Filtered fetches (one workflow or branch) cannot see other runs that are still in progress,
+// so they keep the resume point at or below the previous MaxRunID instead of moving it forward
+func (c *GHAPerfCache) UpdatePending(pendingRunID int, filtered bool) {
+	if !filtered {
+		c.PendingRunID = pendingRunID
+		return
+	}
+
+	lowest := c.MaxRunID + 1
+	for _, id := range []int{c.PendingRunID, pendingRunID} {
+		if id > 0 && id < lowest {
+			lowest = id
+		}
+	}
+	c.PendingRunID = lowest
 }
 
 type GHAPerfCacheManager struct {
 	cacheDir string
+	remote   RemoteCacheBackend
+
+	// MaxAge discards caches last updated longer ago than this; zero never expires
+	MaxAge time.Duration
+
+	// MaxSizeBytes caps the total size of cache files; Save evicts the least recently
+	// updated repos to stay under it. Zero means unlimited
+	MaxSizeBytes int64
+}
+
+// GHAPerfCacheEntry describes one repo's cache file
+type GHAPerfCacheEntry struct {
+	Owner     string
+	Repo      string
+	Size      int64
+	UpdatedAt time.Time // Zero when the file cannot be parsed, so it is evicted first
 }
 
-func NewGHAPerfCacheManager(cacheDir string) (*GHAPerfCacheManager, error) {
+// GHAPerfCacheOption configures a GHAPerfCacheManager
+type GHAPerfCacheOption func(*GHAPerfCacheManager)
+
+// WithMaxSize caps the total cache size in bytes; see MaxSizeBytes
+func WithMaxSize(bytes int64) GHAPerfCacheOption {
+	return func(m *GHAPerfCacheManager) {
+		m.MaxSizeBytes = bytes
+	}
+}
+
+func NewGHAPerfCacheManager(cacheDir string, opts ...GHAPerfCacheOption) (*GHAPerfCacheManager, error) {
 	if cacheDir == "" {
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
@@ -34,7 +97,20 @@ func NewGHAPerfCacheManager(cacheDir string) (*GHAPerfCacheManager, error) {
 		return nil, fmt.Errorf("failed to create cache directory: %w", err)
 	}
 
-	return &GHAPerfCacheManager{cacheDir: cacheDir}, nil
+	m := &GHAPerfCacheManager{cacheDir: cacheDir}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, nil
+}
+
+// SetRemote shares caches through a remote backend; the local cache is used when it is unavailable
+func (m *GHAPerfCacheManager) SetRemote(remote RemoteCacheBackend) {
+	m.remote = remote
+}
+
+func (m *GHAPerfCacheManager) remoteKey(owner, repo string) string {
+	return fmt.Sprintf("gha-perf/%s_%s.json", owner, repo)
 }
 
 func (m *GHAPerfCacheManager) cacheFilePath(owner, repo string) string {
@@ -42,20 +118,54 @@ func (m *GHAPerfCacheManager) cacheFilePath(owner, repo string) string {
 	return filepath.Join(m.cacheDir, safeRepo)
 }
 
+// Load returns the cached runs for a repo, or an empty cache when there is none
+// or it is older than MaxAge
 func (m *GHAPerfCacheManager) Load(owner, repo string) (*GHAPerfCache, error) {
+	if m.remote != nil {
+		if data, err := m.remote.Load(m.remoteKey(owner, repo)); err == nil {
+			if cache, err := parseGHAPerfCache(data); err == nil {
+				return m.expire(owner, repo, cache), nil
+			}
+		}
+	}
+
 	path := m.cacheFilePath(owner, repo)
 
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return &GHAPerfCache{
-				Repo: fmt.Sprintf("%s/%s", owner, repo),
-				Runs: []github.RunTiming{},
-			}, nil
+			return emptyGHAPerfCache(owner, repo), nil
 		}
 		return nil, fmt.Errorf("failed to read cache file: %w", err)
 	}
 
+	cache, err := parseGHAPerfCache(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.expire(owner, repo, cache), nil
+}
+
+func emptyGHAPerfCache(owner, repo string) *GHAPerfCache {
+	return &GHAPerfCache{
+		Repo: fmt.Sprintf("%s/%s", owner, repo),
+		Runs: []github.RunTiming{},
+	}
+}
+
+// expire replaces cache with an empty one when it was updated before MaxAge ago
+func (m *GHAPerfCacheManager) expire(owner, repo string, cache *GHAPerfCache) *GHAPerfCache {
+	if m.MaxAge <= 0 || !cache.UpdatedAt.Before(time.Now().Add(-m.MaxAge)) {
+		return cache
+	}
+
+	expired := emptyGHAPerfCache(owner, repo)
+	expired.Expired = true
+	return expired
+}
+
+func parseGHAPerfCache(data []byte) (*GHAPerfCache, error) {
 	var cache GHAPerfCache
 	if err := json.Unmarshal(data, &cache); err != nil {
 		return nil, fmt.Errorf("failed to parse cache file: %w", err)
@@ -63,6 +173,8 @@ func (m *GHAPerfCacheManager) Load(owner, repo string) (*GHAPerfCache, error) {
 
 	for i := range cache.Runs {
 		cache.Runs[i].Duration = time.Duration(cache.Runs[i].DurationSeconds * float64(time.Second))
+		cache.Runs[i].QueueDuration, cache.Runs[i].ExecutionDuration = github.SplitRunDuration(
+			cache.Runs[i].CreatedAt, cache.Runs[i].StartedAt, cache.Runs[i].UpdatedAt)
 		for j := range cache.Runs[i].Jobs {
 			cache.Runs[i].Jobs[j].Duration = time.Duration(
 				cache.Runs[i].Jobs[j].DurationSeconds * float64(time.Second))
@@ -73,23 +185,81 @@ func (m *GHAPerfCacheManager) Load(owner, repo string) (*GHAPerfCache, error) {
 		}
 	}
 
+	// Caches written before MaxRunID was tracked
+	if cache.MaxRunID == 0 {
+		cache.MaxRunID = MaxRunID(cache.Runs)
+	}
+
 	return &cache, nil
 }
 
 func (m *GHAPerfCacheManager) Save(owner, repo string, cache *GHAPerfCache) error {
 	cache.UpdatedAt = time.Now()
 	cache.Repo = fmt.Sprintf("%s/%s", owner, repo)
+	cache.MaxRunID = MaxRunID(cache.Runs)
 
 	data, err := json.MarshalIndent(cache, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal cache: %w", err)
 	}
 
+	if err := m.evict(owner, repo, int64(len(data))); err != nil {
+		return err
+	}
+
 	path := m.cacheFilePath(owner, repo)
 	if err := os.WriteFile(path, data, 0644); err != nil {
 		return fmt.Errorf("failed to write cache file: %w", err)
 	}
 
+	// Best effort: the local copy is authoritative if the upload fails
+	if m.remote != nil {
+		_ = m.remote.Save(m.remoteKey(owner, repo), data)
+	}
+
+	return nil
+}
+
+// evict removes the least recently written caches until writing size bytes for owner/repo
+// keeps the total within MaxSizeBytes. The repo being saved is never evicted
+func (m *GHAPerfCacheManager) evict(owner, repo string, size int64) error {
+	if m.MaxSizeBytes <= 0 {
+		return nil
+	}
+
+	entries, err := m.listCacheFiles()
+	if err != nil {
+		return err
+	}
+
+	total := size
+	var candidates []GHAPerfCacheEntry
+	for _, entry := range entries {
+		if entry.Owner == owner && entry.Repo == repo {
+			continue
+		}
+		total += entry.Size
+		candidates = append(candidates, entry)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].UpdatedAt.Before(candidates[j].UpdatedAt)
+	})
+
+	for _, entry := range candidates {
+		if total <= m.MaxSizeBytes {
+			break
+		}
+		if err := m.Clear(entry.Owner, entry.Repo); err != nil {
+			return err
+		}
+		slog.Debug("evicted gha-perf cache",
+			"repo", fmt.Sprintf("%s/%s", entry.Owner, entry.Repo),
+			"size", entry.Size,
+			"max_size", m.MaxSizeBytes)
+		total -= entry.Size
+	}
+
 	return nil
 }
 
@@ -130,13 +300,41 @@ func (m *GHAPerfCacheManager) GetCachedRunIDs(owner, repo string) (map[int]bool,
 	return ids, nil
 }
 
-func (m *GHAPerfCacheManager) Stats(owner, repo string) (int, time.Time, error) {
+func (m *GHAPerfCacheManager) GetMaxRunID(owner, repo string) (int, error) {
+	cache, err := m.Load(owner, repo)
+	if err != nil {
+		return 0, err
+	}
+
+	return cache.MaxRunID, nil
+}
+
+func MaxRunID(runs []github.RunTiming) int {
+	maxID := 0
+	for _, r := range runs {
+		if r.RunID > maxID {
+			maxID = r.RunID
+		}
+	}
+	return maxID
+}
+
+// Stats returns the cached run count and update time for a repo, and the total size of all cache files
+func (m *GHAPerfCacheManager) Stats(owner, repo string) (runs int, updatedAt time.Time, totalSize int64, err error) {
 	cache, err := m.Load(owner, repo)
 	if err != nil {
-		return 0, time.Time{}, err
+		return 0, time.Time{}, 0, err
 	}
 
-	return len(cache.Runs), cache.UpdatedAt, nil
+	entries, err := m.listCacheFiles()
+	if err != nil {
+		return 0, time.Time{}, 0, err
+	}
+	for _, entry := range entries {
+		totalSize += entry.Size
+	}
+
+	return len(cache.Runs), cache.UpdatedAt, totalSize, nil
 }
 
 func (m *GHAPerfCacheManager) Clear(owner, repo string) error {
@@ -168,8 +366,29 @@ func (m *GHAPerfCacheManager) ClearAll() error {
 	return nil
 }
 
-func (m *GHAPerfCacheManager) ListCaches() ([]string, error) {
-	entries, err := os.ReadDir(m.cacheDir)
+// ListCaches describes every local cache file, sorted by file name
+func (m *GHAPerfCacheManager) ListCaches() ([]GHAPerfCacheEntry, error) {
+	entries, err := m.listCacheFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, entry := range entries {
+		entries[i].UpdatedAt = time.Time{}
+		if data, err := os.ReadFile(m.cacheFilePath(entry.Owner, entry.Repo)); err == nil {
+			if cache, err := parseGHAPerfCache(data); err == nil {
+				entries[i].UpdatedAt = cache.UpdatedAt
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// listCacheFiles describes every local cache file from directory metadata alone,
+// using the file modification time as UpdatedAt so no cache needs to be parsed
+func (m *GHAPerfCacheManager) listCacheFiles() ([]GHAPerfCacheEntry, error) {
+	files, err := os.ReadDir(m.cacheDir)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -177,30 +396,30 @@ func (m *GHAPerfCacheManager) ListCaches() ([]string, error) {
 		return nil, fmt.Errorf("failed to read cache directory: %w", err)
 	}
 
-	var repos []string
-	for _, entry := range entries {
-		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".json" {
-			name := entry.Name()
-			name = name[:len(name)-5]
-			repos = append(repos, name)
+	var entries []GHAPerfCacheEntry
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+			continue
 		}
+
+		owner, repo, ok := strings.Cut(strings.TrimSuffix(file.Name(), ".json"), "_")
+		if !ok {
+			continue
+		}
+
+		info, err := file.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat cache file %s: %w", file.Name(), err)
+		}
+
+		entries = append(entries, GHAPerfCacheEntry{Owner: owner, Repo: repo, Size: info.Size(), UpdatedAt: info.ModTime()})
 	}
 
-	return repos, nil
+	return entries, nil
 }
 
 func FilterRunsByCommit(runs []github.RunTiming, commitSHA string) []github.RunTiming {
-	if commitSHA == "" {
-		return runs
-	}
-	var filtered []github.RunTiming
-	for _, r := range runs {
-		if r.HeadSHA == commitSHA || (len(commitSHA) >= 7 && len(r.HeadSHA) >= 7 &&
-			r.HeadSHA[:7] == commitSHA[:7]) {
-			filtered = append(filtered, r)
-		}
-	}
-	return filtered
+	return github.FilterRunsByCommit(runs, commitSHA)
 }
 
 func FilterRunsByConclusion(runs []github.RunTiming, conclusion string) []github.RunTiming {
@@ -0,0 +1,214 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+func TestGHAPerfCacheMaxRunID(t *testing.T) {
+	manager, err := NewGHAPerfCacheManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+
+	maxID, err := manager.GetMaxRunID("owner", "repo")
+	if err != nil {
+		t.Fatalf("Failed to get max run ID: %v", err)
+	}
+	if maxID != 0 {
+		t.Errorf("Expected 0 for empty cache, got %d", maxID)
+	}
+
+	cached := &GHAPerfCache{Runs: []github.RunTiming{{RunID: 42}, {RunID: 108}, {RunID: 7}}}
+	if err := manager.Save("owner", "repo", cached); err != nil {
+		t.Fatalf("Failed to save cache: %v", err)
+	}
+
+	maxID, err = manager.GetMaxRunID("owner", "repo")
+	if err != nil {
+		t.Fatalf("Failed to get max run ID: %v", err)
+	}
+	if maxID != 108 {
+		t.Errorf("Expected max run ID 108, got %d", maxID)
+	}
+}
+
+func TestGHAPerfCacheResumeRunID(t *testing.T) {
+	tests := []struct {
+		name     string
+		cache    GHAPerfCache
+		pending  int
+		filtered bool
+		want     int
+	}{
+		{name: "no pending runs", cache: GHAPerfCache{MaxRunID: 108}, want: 108},
+		{name: "resumes below a pending run", cache: GHAPerfCache{MaxRunID: 108}, pending: 100, want: 99},
+		{name: "pending run newer than the cache", cache: GHAPerfCache{MaxRunID: 108}, pending: 120, want: 108},
+		{name: "filtered fetch keeps the previous max", cache: GHAPerfCache{MaxRunID: 108}, filtered: true, want: 108},
+		{name: "filtered fetch keeps an older pending run", cache: GHAPerfCache{MaxRunID: 108, PendingRunID: 90}, pending: 100, filtered: true, want: 89},
+		{name: "filtered fetch of an empty cache", cache: GHAPerfCache{}, filtered: true, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.cache
+			c.UpdatePending(tt.pending, tt.filtered)
+			if got := c.ResumeRunID(); got != tt.want {
+				t.Errorf("ResumeRunID() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGHAPerfCachePendingRunIDRoundTrip(t *testing.T) {
+	manager, err := NewGHAPerfCacheManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+
+	if err := manager.Save("owner", "repo", &GHAPerfCache{Runs: []github.RunTiming{{RunID: 108}}, PendingRunID: 100}); err != nil {
+		t.Fatalf("Failed to save cache: %v", err)
+	}
+
+	loaded, err := manager.Load("owner", "repo")
+	if err != nil {
+		t.Fatalf("Failed to load cache: %v", err)
+	}
+	if loaded.ResumeRunID() != 99 {
+		t.Errorf("Expected to resume below the pending run, got %d", loaded.ResumeRunID())
+	}
+}
+
+func writeGHAPerfCache(t *testing.T, manager *GHAPerfCacheManager, updatedAt time.Time) {
+	t.Helper()
+
+	data, err := json.Marshal(GHAPerfCache{
+		UpdatedAt: updatedAt,
+		Repo:      "owner/repo",
+		MaxRunID:  42,
+		Runs:      []github.RunTiming{{RunID: 42}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal cache: %v", err)
+	}
+	if err := os.WriteFile(manager.cacheFilePath("owner", "repo"), data, 0644); err != nil {
+		t.Fatalf("Failed to write cache: %v", err)
+	}
+}
+
+func TestGHAPerfCacheMaxAge(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxAge       time.Duration
+		age          time.Duration
+		expectedRuns int
+	}{
+		{"fresh cache is kept", 7 * 24 * time.Hour, 24 * time.Hour, 1},
+		{"stale cache is discarded", 7 * 24 * time.Hour, 14 * 24 * time.Hour, 0},
+		{"zero max age never expires", 0, 365 * 24 * time.Hour, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, err := NewGHAPerfCacheManager(t.TempDir())
+			if err != nil {
+				t.Fatalf("Failed to create cache manager: %v", err)
+			}
+			manager.MaxAge = tt.maxAge
+			writeGHAPerfCache(t, manager, time.Now().Add(-tt.age))
+
+			cached, err := manager.Load("owner", "repo")
+			if err != nil {
+				t.Fatalf("Failed to load cache: %v", err)
+			}
+			if len(cached.Runs) != tt.expectedRuns {
+				t.Errorf("Expected %d runs, got %d", tt.expectedRuns, len(cached.Runs))
+			}
+			if expired := tt.expectedRuns == 0; cached.Expired != expired {
+				t.Errorf("Expected Expired=%v, got %v", expired, cached.Expired)
+			}
+			if tt.expectedRuns == 0 && cached.MaxRunID != 0 {
+				t.Errorf("Expected MaxRunID reset for a stale cache, got %d", cached.MaxRunID)
+			}
+		})
+	}
+}
+
+func TestGHAPerfCacheListCaches(t *testing.T) {
+	manager, err := NewGHAPerfCacheManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+	updatedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	writeGHAPerfCache(t, manager, updatedAt)
+
+	entries, err := manager.ListCaches()
+	if err != nil {
+		t.Fatalf("ListCaches failed: %v", err)
+	}
+	info, err := os.Stat(manager.cacheFilePath("owner", "repo"))
+	if err != nil {
+		t.Fatalf("Failed to stat cache: %v", err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry.Owner != "owner" || entry.Repo != "repo" || entry.Size != info.Size() || !entry.UpdatedAt.Equal(updatedAt) {
+		t.Errorf("Unexpected entry %+v (size %d, updated %v)", entry, info.Size(), updatedAt)
+	}
+
+	_, _, totalSize, err := manager.Stats("owner", "repo")
+	if err != nil || totalSize != info.Size() {
+		t.Errorf("Expected total size %d from Stats, got %d (err: %v)", info.Size(), totalSize, err)
+	}
+}
+
+func TestGHAPerfCacheEvictsLeastRecentlyUpdated(t *testing.T) {
+	dir := t.TempDir()
+	unlimited, err := NewGHAPerfCacheManager(dir)
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+
+	runs := []github.RunTiming{{RunID: 1, Workflow: "ci.yml"}}
+	for _, repo := range []string{"oldest", "newer"} {
+		if err := unlimited.Save("owner", repo, &GHAPerfCache{Runs: runs}); err != nil {
+			t.Fatalf("Failed to save %s: %v", repo, err)
+		}
+	}
+	// Eviction orders by file modification time without parsing the caches
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "owner_oldest.json"), old, old); err != nil {
+		t.Fatalf("Failed to age oldest: %v", err)
+	}
+	entries, _ := unlimited.ListCaches()
+	entrySize := entries[0].Size
+
+	// Room for two entries: saving a third must evict exactly the oldest
+	manager, err := NewGHAPerfCacheManager(dir, WithMaxSize(2*entrySize+entrySize/2))
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+	if err := manager.Save("owner", "newest", &GHAPerfCache{Runs: runs}); err != nil {
+		t.Fatalf("Failed to save newest: %v", err)
+	}
+
+	entries, err = manager.ListCaches()
+	if err != nil {
+		t.Fatalf("ListCaches failed: %v", err)
+	}
+	var repos []string
+	for _, entry := range entries {
+		repos = append(repos, entry.Repo)
+	}
+	if len(repos) != 2 || repos[0] != "newer" || repos[1] != "newest" {
+		t.Errorf("Expected oldest to be evicted leaving [newer newest], got %v", repos)
+	}
+}
@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+)
+
+// DefaultOrphanScanTTL is how long a namespace scan is reused when no TTL is configured
+const DefaultOrphanScanTTL = time.Hour
+
+// OrphanScanCache stores namespace scan results on disk so reopening the TUI skips a full rescan
+type OrphanScanCache struct {
+	cacheDir string
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+type orphanScanCacheEntry struct {
+	SavedAt time.Time                    `json:"saved_at"`
+	Result  *orphans.NamespaceScanResult `json:"result"`
+	Errors  map[string]string            `json:"errors,omitempty"` // Repo full name to scan error
+}
+
+// NewOrphanScanCache creates a cache in cacheDir (default ~/.cache/gh-sweep/orphans)
+// A non-positive ttl uses DefaultOrphanScanTTL
+func NewOrphanScanCache(cacheDir string, ttl time.Duration) (*OrphanScanCache, error) {
+	if cacheDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get home directory: %w", err)
+		}
+		cacheDir = filepath.Join(homeDir, ".cache", "gh-sweep", "orphans")
+	}
+
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultOrphanScanTTL
+	}
+
+	return &OrphanScanCache{cacheDir: cacheDir, ttl: ttl, now: time.Now}, nil
+}
+
+// cacheFilePath keys entries by namespace and scan options, since options change the results
+func (c *OrphanScanCache) cacheFilePath(namespace string, options orphans.ScanOptions) string {
+	optionData, _ := json.Marshal(options)
+	sum := sha256.Sum256(optionData)
+	return filepath.Join(c.cacheDir, fmt.Sprintf("%s_%s.json", namespace, hex.EncodeToString(sum[:])[:12]))
+}
+
+// Load returns the cached scan and when it was saved
+// Returns ok=false when there is no entry or it is older than the TTL
+func (c *OrphanScanCache) Load(namespace string, options orphans.ScanOptions) (result *orphans.NamespaceScanResult, savedAt time.Time, ok bool, err error) {
+	data, err := os.ReadFile(c.cacheFilePath(namespace, options))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, time.Time{}, false, nil
+		}
+		return nil, time.Time{}, false, fmt.Errorf("failed to read cache file: %w", err)
+	}
+
+	var entry orphanScanCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, time.Time{}, false, fmt.Errorf("failed to parse cache file: %w", err)
+	}
+
+	if entry.Result == nil || c.now().Sub(entry.SavedAt) > c.ttl {
+		return nil, time.Time{}, false, nil
+	}
+
+	for i := range entry.Result.Results {
+		if msg, failed := entry.Errors[entry.Result.Results[i].Repository.FullName]; failed {
+			entry.Result.Results[i].Error = errors.New(msg)
+		}
+	}
+
+	return entry.Result, entry.SavedAt, true, nil
+}
+
+// Save stores a scan result; per-repo errors are kept as messages since errors do not round-trip through JSON
+func (c *OrphanScanCache) Save(namespace string, options orphans.ScanOptions, result *orphans.NamespaceScanResult) error {
+	copied := *result
+	copied.Results = make([]orphans.ScanResult, len(result.Results))
+	scanErrors := make(map[string]string)
+	for i, scanResult := range result.Results {
+		if scanResult.Error != nil {
+			scanErrors[scanResult.Repository.FullName] = scanResult.Error.Error()
+			scanResult.Error = nil
+		}
+		copied.Results[i] = scanResult
+	}
+
+	data, err := json.MarshalIndent(orphanScanCacheEntry{SavedAt: c.now(), Result: &copied, Errors: scanErrors}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache: %w", err)
+	}
+
+	if err := os.WriteFile(c.cacheFilePath(namespace, options), data, 0644); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+)
+
+func TestOrphanScanCacheTTL(t *testing.T) {
+	scanCache, err := NewOrphanScanCache(t.TempDir(), 30*time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	scanCache.now = func() time.Time { return now }
+
+	options := orphans.DefaultScanOptions()
+	result := &orphans.NamespaceScanResult{
+		Namespace:    "acme",
+		TotalOrphans: 1,
+		Results: []orphans.ScanResult{
+			{
+				Repository: github.Repository{FullName: "acme/api"},
+				Orphans:    []orphans.OrphanedBranch{{Repository: "acme/api", BranchName: "feature-x", Type: orphans.OrphanTypeMergedPR}},
+			},
+			{Repository: github.Repository{FullName: "acme/web"}, Error: fmt.Errorf("rate limited")},
+		},
+	}
+	if err := scanCache.Save("acme", options, result); err != nil {
+		t.Fatalf("Failed to save: %v", err)
+	}
+
+	now = now.Add(10 * time.Minute)
+	cached, savedAt, ok, err := scanCache.Load("acme", options)
+	if err != nil || !ok {
+		t.Fatalf("Expected cache hit within TTL, got ok=%v err=%v", ok, err)
+	}
+	if !savedAt.Equal(now.Add(-10 * time.Minute)) {
+		t.Errorf("Expected saved time to round-trip, got %v", savedAt)
+	}
+	if cached.TotalOrphans != 1 || cached.Results[0].Orphans[0].Key() != "acme/api/feature-x" {
+		t.Errorf("Expected cached orphans, got %+v", cached)
+	}
+	if cached.Results[1].Error == nil || cached.Results[1].Error.Error() != "rate limited" {
+		t.Errorf("Expected per-repo error to round-trip, got %v", cached.Results[1].Error)
+	}
+	if result.Results[1].Error == nil {
+		t.Error("Expected Save to leave the caller's result unchanged")
+	}
+
+	other := options
+	other.IncludeTags = true
+	if _, _, ok, _ := scanCache.Load("acme", other); ok {
+		t.Error("Expected a miss for different scan options")
+	}
+
+	now = now.Add(time.Hour)
+	if _, _, ok, err := scanCache.Load("acme", options); ok || err != nil {
+		t.Errorf("Expected cache miss after TTL, got ok=%v err=%v", ok, err)
+	}
+}
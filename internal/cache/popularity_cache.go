@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// PopularityCache stores the last popularity snapshot per repository for star delta tracking
+type PopularityCache struct {
+	cacheDir string
+	now      func() time.Time
+}
+
+type popularityEntry struct {
+	SavedAt time.Time        `json:"saved_at"`
+	Stats   github.RepoStats `json:"stats"`
+}
+
+// NewPopularityCache creates a cache in cacheDir (default ~/.cache/gh-sweep/popularity)
+func NewPopularityCache(cacheDir string) (*PopularityCache, error) {
+	if cacheDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get home directory: %w", err)
+		}
+		cacheDir = filepath.Join(homeDir, ".cache", "gh-sweep", "popularity")
+	}
+
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &PopularityCache{cacheDir: cacheDir, now: time.Now}, nil
+}
+
+func (c *PopularityCache) cacheFilePath(repository string) string {
+	return filepath.Join(c.cacheDir, strings.ReplaceAll(repository, "/", "_")+".json")
+}
+
+// Load returns the previously saved stats for an owner/repo and when they were saved
+// Returns ok=false when no snapshot has been saved for the repository
+func (c *PopularityCache) Load(repository string) (stats *github.RepoStats, savedAt time.Time, ok bool, err error) {
+	data, err := os.ReadFile(c.cacheFilePath(repository))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, time.Time{}, false, nil
+		}
+		return nil, time.Time{}, false, fmt.Errorf("failed to read cache file: %w", err)
+	}
+
+	var entry popularityEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, time.Time{}, false, fmt.Errorf("failed to parse cache file: %w", err)
+	}
+
+	return &entry.Stats, entry.SavedAt, true, nil
+}
+
+// Save replaces the stored snapshot for stats.Repository
+func (c *PopularityCache) Save(stats *github.RepoStats) error {
+	data, err := json.MarshalIndent(popularityEntry{SavedAt: c.now(), Stats: *stats}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache: %w", err)
+	}
+
+	if err := os.WriteFile(c.cacheFilePath(stats.Repository), data, 0644); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStarDelta sets stats.StarDelta from the saved snapshot, then saves stats as the new snapshot
+// Returns hasPrevious=false on the first run, when StarDelta is left at zero
+func (c *PopularityCache) UpdateStarDelta(stats *github.RepoStats) (hasPrevious bool, err error) {
+	previous, _, ok, err := c.Load(stats.Repository)
+	if err != nil {
+		return false, err
+	}
+
+	if ok {
+		stats.StarDelta = stats.Stars - previous.Stars
+	}
+
+	if err := c.Save(stats); err != nil {
+		return ok, err
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+func TestPopularityCacheUpdateStarDelta(t *testing.T) {
+	popularityCache, err := NewPopularityCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+
+	first := &github.RepoStats{Repository: "acme/api", Stars: 100, Forks: 4}
+	if hasPrevious, err := popularityCache.UpdateStarDelta(first); err != nil || hasPrevious {
+		t.Fatalf("Expected no previous snapshot on first run, got hasPrevious=%v err=%v", hasPrevious, err)
+	}
+	if first.StarDelta != 0 {
+		t.Errorf("Expected zero delta without a snapshot, got %d", first.StarDelta)
+	}
+
+	second := &github.RepoStats{Repository: "acme/api", Stars: 112, Forks: 5}
+	hasPrevious, err := popularityCache.UpdateStarDelta(second)
+	if err != nil || !hasPrevious {
+		t.Fatalf("Expected previous snapshot, got hasPrevious=%v err=%v", hasPrevious, err)
+	}
+	if second.StarDelta != 12 {
+		t.Errorf("Expected +12 stars since the snapshot, got %d", second.StarDelta)
+	}
+
+	third := &github.RepoStats{Repository: "acme/api", Stars: 109}
+	if _, err := popularityCache.UpdateStarDelta(third); err != nil {
+		t.Fatalf("UpdateStarDelta failed: %v", err)
+	}
+	if third.StarDelta != -3 {
+		t.Errorf("Expected -3 stars after unstars, got %d", third.StarDelta)
+	}
+
+	saved, _, ok, err := popularityCache.Load("acme/api")
+	if err != nil || !ok || saved.Stars != 109 {
+		t.Errorf("Expected the latest snapshot to be saved, got %+v (ok=%v, err=%v)", saved, ok, err)
+	}
+
+	if _, _, ok, _ := popularityCache.Load("acme/web"); ok {
+		t.Error("Expected no snapshot for an unseen repo")
+	}
+}
@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Supported cache backends
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// ErrCacheMiss is returned by a RemoteCacheBackend when the key does not exist
+var ErrCacheMiss = errors.New("cache miss")
+
+// RemoteCacheBackend stores cache blobs somewhere shared, such as an S3 bucket
+type RemoteCacheBackend interface {
+	Load(key string) ([]byte, error)
+	Save(key string, data []byte) error
+}
+
+// NewRemoteCacheBackend creates the backend named by backend for remoteURL
+// Returns nil for the local backend, meaning only on-disk caches are used
+func NewRemoteCacheBackend(backend, remoteURL string) (RemoteCacheBackend, error) {
+	switch backend {
+	case "", BackendLocal:
+		return nil, nil
+	case BackendS3:
+		return NewS3Backend(remoteURL)
+	default:
+		return nil, fmt.Errorf("unsupported cache backend: %s", backend)
+	}
+}
@@ -0,0 +1,238 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Backend stores cache blobs in an S3 bucket using SigV4-signed REST requests
+// Credentials come from AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, and AWS_SESSION_TOKEN
+type S3Backend struct {
+	Bucket   string
+	Prefix   string
+	Region   string
+	Endpoint string // Custom endpoint (e.g. MinIO); uses path-style addressing when set
+
+	endpointURL  *url.URL // Parsed Endpoint; nil for AWS virtual-hosted addressing
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	httpClient   *http.Client
+}
+
+// NewS3Backend creates an S3 backend from a URL like s3://bucket/prefix
+func NewS3Backend(remoteURL string) (*S3Backend, error) {
+	bucket, prefix, err := ParseS3URL(remoteURL)
+	if err != nil {
+		return nil, err
+	}
+
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	endpoint := os.Getenv("AWS_ENDPOINT_URL_S3")
+	if endpoint == "" {
+		endpoint = os.Getenv("AWS_ENDPOINT_URL")
+	}
+
+	backend := &S3Backend{
+		Bucket:       bucket,
+		Prefix:       prefix,
+		Region:       region,
+		Endpoint:     strings.TrimSuffix(endpoint, "/"),
+		accessKey:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+	}
+
+	if backend.accessKey == "" || backend.secretKey == "" {
+		return nil, fmt.Errorf("s3 cache backend requires AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	}
+
+	if backend.Endpoint != "" {
+		u, err := url.Parse(backend.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid S3 endpoint: %w", err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid S3 endpoint %q (expected a URL like http://localhost:9000)", backend.Endpoint)
+		}
+		backend.endpointURL = u
+	}
+
+	return backend, nil
+}
+
+// ParseS3URL splits s3://bucket/prefix into its bucket and prefix
+// Pure function: the prefix has no leading or trailing slash
+func ParseS3URL(remoteURL string) (bucket, prefix string, err error) {
+	u, err := url.Parse(remoteURL)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid remote cache URL: %w", err)
+	}
+	if u.Scheme != "s3" || u.Host == "" {
+		return "", "", fmt.Errorf("invalid remote cache URL %q (expected s3://bucket/prefix)", remoteURL)
+	}
+
+	return u.Host, strings.Trim(u.Path, "/"), nil
+}
+
+// Load fetches an object (GetObject), returning ErrCacheMiss if it does not exist
+func (b *S3Backend) Load(key string) ([]byte, error) {
+	resp, err := b.do(http.MethodGet, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get s3 object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrCacheMiss
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get s3 object: %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read s3 object: %w", err)
+	}
+
+	return data, nil
+}
+
+// Save uploads an object (PutObject)
+func (b *S3Backend) Save(key string, data []byte) error {
+	resp, err := b.do(http.MethodPut, key, data)
+	if err != nil {
+		return fmt.Errorf("failed to put s3 object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to put s3 object: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// objectURL returns the request URL for key
+func (b *S3Backend) objectURL(key string) *url.URL {
+	objectKey := key
+	if b.Prefix != "" {
+		objectKey = path.Join(b.Prefix, key)
+	}
+
+	if b.endpointURL != nil {
+		u := *b.endpointURL
+		u.Path = "/" + b.Bucket + "/" + objectKey
+		return &u
+	}
+
+	return &url.URL{
+		Scheme: "https",
+		Host:   fmt.Sprintf("%s.s3.%s.amazonaws.com", b.Bucket, b.Region),
+		Path:   "/" + objectKey,
+	}
+}
+
+func (b *S3Backend) do(method, key string, body []byte) (*http.Response, error) {
+	u := b.objectURL(key)
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	b.sign(req, body, time.Now().UTC())
+	return b.httpClient.Do(req)
+}
+
+// sign adds AWS Signature Version 4 headers to req
+func (b *S3Backend) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if b.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", b.sessionToken)
+	}
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           amzDate,
+	}
+	if b.sessionToken != "" {
+		headers["x-amz-security-token"] = b.sessionToken
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, b.Region)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+b.secretKey), date)
+	key = hmacSHA256(key, b.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		b.accessKey, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
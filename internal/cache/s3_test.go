@@ -0,0 +1,196 @@
+package cache
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// newMockS3 serves GetObject and PutObject from an in-memory bucket
+func newMockS3(t *testing.T) (*httptest.Server, map[string][]byte) {
+	t.Helper()
+
+	var mu sync.Mutex
+	objects := make(map[string][]byte)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test-key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		case http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = data
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, objects
+}
+
+func newTestS3Backend(t *testing.T, endpoint string) *S3Backend {
+	t.Helper()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret")
+	t.Setenv("AWS_ENDPOINT_URL_S3", endpoint)
+
+	backend, err := NewS3Backend("s3://bucket/team/cache")
+	if err != nil {
+		t.Fatalf("Failed to create S3 backend: %v", err)
+	}
+	return backend
+}
+
+func TestParseS3URL(t *testing.T) {
+	tests := []struct {
+		url            string
+		expectedBucket string
+		expectedPrefix string
+		expectErr      bool
+	}{
+		{"s3://bucket", "bucket", "", false},
+		{"s3://bucket/prefix/", "bucket", "prefix", false},
+		{"s3://bucket/a/b", "bucket", "a/b", false},
+		{"https://bucket/prefix", "", "", true},
+		{"s3:///prefix", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			bucket, prefix, err := ParseS3URL(tt.url)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("Expected error %v, got %v", tt.expectErr, err)
+			}
+			if bucket != tt.expectedBucket || prefix != tt.expectedPrefix {
+				t.Errorf("Expected %s/%s, got %s/%s", tt.expectedBucket, tt.expectedPrefix, bucket, prefix)
+			}
+		})
+	}
+}
+
+func TestNewS3BackendRejectsMalformedEndpoint(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret")
+
+	for _, endpoint := range []string{"http://[::1", "localhost:9000", "minio"} {
+		t.Setenv("AWS_ENDPOINT_URL_S3", endpoint)
+		if _, err := NewS3Backend("s3://bucket"); err == nil || !strings.Contains(err.Error(), "invalid S3 endpoint") {
+			t.Errorf("%q: expected invalid endpoint error, got %v", endpoint, err)
+		}
+	}
+}
+
+func TestNewRemoteCacheBackend(t *testing.T) {
+	remote, err := NewRemoteCacheBackend("", "")
+	if err != nil || remote != nil {
+		t.Errorf("Expected no remote for default backend, got %v, %v", remote, err)
+	}
+
+	if _, err := NewRemoteCacheBackend("gcs", "gs://bucket"); err == nil {
+		t.Error("Expected error for unsupported backend")
+	}
+}
+
+func TestS3BackendRoundTrip(t *testing.T) {
+	server, objects := newMockS3(t)
+	backend := newTestS3Backend(t, server.URL)
+
+	if _, err := backend.Load("missing.json"); !errors.Is(err, ErrCacheMiss) {
+		t.Errorf("Expected ErrCacheMiss, got %v", err)
+	}
+
+	if err := backend.Save("key.json", []byte(`{"ok":true}`)); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if _, ok := objects["/bucket/team/cache/key.json"]; !ok {
+		t.Errorf("Expected object under bucket prefix, got keys %v", objects)
+	}
+
+	data, err := backend.Load("key.json")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if string(data) != `{"ok":true}` {
+		t.Errorf("Unexpected data: %s", data)
+	}
+}
+
+func TestGHAPerfCacheRemoteRoundTrip(t *testing.T) {
+	server, _ := newMockS3(t)
+	backend := newTestS3Backend(t, server.URL)
+
+	writer, err := NewGHAPerfCacheManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+	writer.SetRemote(backend)
+
+	cached := &GHAPerfCache{Runs: []github.RunTiming{{RunID: 1, DurationSeconds: 90}, {RunID: 2}}}
+	if err := writer.Save("owner", "repo", cached); err != nil {
+		t.Fatalf("Failed to save cache: %v", err)
+	}
+
+	// A second machine with an empty local cache reads the shared copy
+	reader, err := NewGHAPerfCacheManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+	reader.SetRemote(backend)
+
+	loaded, err := reader.Load("owner", "repo")
+	if err != nil {
+		t.Fatalf("Failed to load cache: %v", err)
+	}
+	if len(loaded.Runs) != 2 || loaded.MaxRunID != 2 {
+		t.Errorf("Expected 2 runs with max ID 2, got %d runs with max ID %d", len(loaded.Runs), loaded.MaxRunID)
+	}
+	if loaded.Runs[0].Duration.Seconds() != 90 {
+		t.Errorf("Expected duration restored from seconds, got %v", loaded.Runs[0].Duration)
+	}
+}
+
+func TestGHAPerfCacheRemoteFallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	manager, err := NewGHAPerfCacheManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+	manager.SetRemote(newTestS3Backend(t, server.URL))
+
+	if err := manager.Save("owner", "repo", &GHAPerfCache{Runs: []github.RunTiming{{RunID: 5}}}); err != nil {
+		t.Fatalf("Expected save to succeed locally, got %v", err)
+	}
+
+	loaded, err := manager.Load("owner", "repo")
+	if err != nil {
+		t.Fatalf("Failed to load cache: %v", err)
+	}
+	if len(loaded.Runs) != 1 || loaded.Runs[0].RunID != 5 {
+		t.Errorf("Expected local cache fallback, got %+v", loaded.Runs)
+	}
+}
@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Snapshot records the findings of one scan so later runs can report what changed
+type Snapshot struct {
+	Type      string    `json:"type"`
+	Scope     string    `json:"scope"` // SnapshotScope of the scanned namespace and repos
+	CreatedAt time.Time `json:"created_at"`
+	Entries   []string  `json:"entries"`
+}
+
+// SnapshotDiff lists entries that appeared or disappeared between two snapshots
+type SnapshotDiff struct {
+	Added   []string
+	Removed []string
+}
+
+// DefaultSnapshotDir returns ~/.local/share/gh-sweep/snapshots
+func DefaultSnapshotDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".local", "share", "gh-sweep", "snapshots"), nil
+}
+
+// SnapshotScope fingerprints what a scan covered so snapshots of different scopes are never compared
+// Pure function: repo order does not change the scope
+func SnapshotScope(namespace string, repos []string) string {
+	sorted := append([]string(nil), repos...)
+	sort.Strings(sorted)
+	sum := sha256.Sum256([]byte(namespace + "\n" + strings.Join(sorted, "\n")))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// SnapshotPath returns the path for a snapshot of the given type and scope taken on the given day
+// Pure function: later runs of the same scope on the same day overwrite the earlier snapshot
+func SnapshotPath(dir, snapshotType, scope string, at time.Time) string {
+	return filepath.Join(dir, fmt.Sprintf("%s_%s_%s.json", at.Format("2006-01-02"), snapshotType, scope))
+}
+
+// LatestSnapshot returns the most recent snapshot path of the given type and scope, or "" if there is none
+func LatestSnapshot(dir, snapshotType, scope string) (string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*_"+snapshotType+"_"+scope+".json"))
+	if err != nil {
+		return "", fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	if len(matches) == 0 {
+		return "", nil
+	}
+
+	// Date prefixes sort chronologically
+	sort.Strings(matches)
+	return matches[len(matches)-1], nil
+}
+
+// SaveSnapshot writes result as JSON to path, creating parent directories as needed
+func SaveSnapshot(path string, result interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// LoadSnapshot reads the JSON snapshot at path into target
+func LoadSnapshot(path string, target interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("failed to parse snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// CarryForward adds the previous entries of repos that failed to scan to entries,
+// so a transient scan error is not reported as resolved findings
+// Pure function: entries belong to a repo when they start with "owner/repo" followed by '/', '@', or ' '
+func CarryForward(previous, entries, failedRepos []string) []string {
+	merged := append([]string(nil), entries...)
+	for _, entry := range previous {
+		for _, repo := range failedRepos {
+			if rest, ok := strings.CutPrefix(entry, repo); ok && rest != "" && strings.ContainsRune("/@ ", rune(rest[0])) {
+				merged = append(merged, entry)
+				break
+			}
+		}
+	}
+	sort.Strings(merged)
+	return merged
+}
+
+// DiffSnapshots compares the entries of two snapshots
+// Pure function: results are sorted and ignore duplicate entries
+func DiffSnapshots(previous, current Snapshot) SnapshotDiff {
+	before := make(map[string]bool, len(previous.Entries))
+	for _, entry := range previous.Entries {
+		before[entry] = true
+	}
+	after := make(map[string]bool, len(current.Entries))
+	for _, entry := range current.Entries {
+		after[entry] = true
+	}
+
+	var diff SnapshotDiff
+	for entry := range after {
+		if !before[entry] {
+			diff.Added = append(diff.Added, entry)
+		}
+	}
+	for entry := range before {
+		if !after[entry] {
+			diff.Removed = append(diff.Removed, entry)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+
+	return diff
+}
+
+// HasChanges reports whether any entry was added or removed
+func (d SnapshotDiff) HasChanges() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0
+}
+
+// FormatUnifiedDiff renders a diff with ---/+++ headers and -/+ prefixed entries
+// Pure function: removals are listed before additions
+func FormatUnifiedDiff(previous, current Snapshot, diff SnapshotDiff) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("--- %s %s\n", previous.Type, previous.CreatedAt.Format(time.RFC3339)))
+	sb.WriteString(fmt.Sprintf("+++ %s %s\n", current.Type, current.CreatedAt.Format(time.RFC3339)))
+	sb.WriteString(fmt.Sprintf("@@ -%d +%d @@\n", len(previous.Entries), len(current.Entries)))
+
+	for _, entry := range diff.Removed {
+		sb.WriteString("-" + entry + "\n")
+	}
+	for _, entry := range diff.Added {
+		sb.WriteString("+" + entry + "\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRoundTripReportsNewOrphan(t *testing.T) {
+	dir := t.TempDir()
+	day1 := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	scope := SnapshotScope("", []string{"acme/web", "acme/api"})
+
+	first := Snapshot{Type: "orphans", CreatedAt: day1, Entries: []string{
+		"acme/api/feature-x [merged_pr]",
+		"acme/web/old-spike [stale]",
+	}}
+	if err := SaveSnapshot(SnapshotPath(dir, "orphans", scope, day1), first); err != nil {
+		t.Fatalf("Failed to save first snapshot: %v", err)
+	}
+
+	path, err := LatestSnapshot(dir, "orphans", scope)
+	if err != nil {
+		t.Fatalf("Failed to find snapshot: %v", err)
+	}
+	var previous Snapshot
+	if err := LoadSnapshot(path, &previous); err != nil {
+		t.Fatalf("Failed to load snapshot: %v", err)
+	}
+
+	current := Snapshot{Type: "orphans", CreatedAt: day2, Entries: []string{
+		"acme/api/feature-x [merged_pr]",
+		"acme/web/old-spike [stale]",
+		"acme/api/fix-login [closed_pr]",
+	}}
+	if err := SaveSnapshot(SnapshotPath(dir, "orphans", scope, day2), current); err != nil {
+		t.Fatalf("Failed to save second snapshot: %v", err)
+	}
+
+	diff := DiffSnapshots(previous, current)
+	if !reflect.DeepEqual(diff.Added, []string{"acme/api/fix-login [closed_pr]"}) {
+		t.Errorf("Expected new orphan to be added, got %v", diff.Added)
+	}
+	if len(diff.Removed) != 0 {
+		t.Errorf("Expected no removals, got %v", diff.Removed)
+	}
+
+	latest, _ := LatestSnapshot(dir, "orphans", scope)
+	if !strings.HasSuffix(latest, "2024-03-02_orphans_"+scope+".json") {
+		t.Errorf("Expected latest snapshot to be from day 2, got %s", latest)
+	}
+}
+
+func TestLatestSnapshotWithoutSnapshots(t *testing.T) {
+	path, err := LatestSnapshot(t.TempDir(), "orphans", SnapshotScope("acme", nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if path != "" {
+		t.Errorf("Expected no snapshot, got %s", path)
+	}
+}
+
+func TestFormatUnifiedDiff(t *testing.T) {
+	previous := Snapshot{Type: "orphans", Entries: []string{"a", "b"}}
+	current := Snapshot{Type: "orphans", Entries: []string{"b", "c"}}
+
+	out := FormatUnifiedDiff(previous, current, DiffSnapshots(previous, current))
+	if !strings.Contains(out, "\n-a\n+c\n") {
+		t.Errorf("Expected removal then addition, got:\n%s", out)
+	}
+	if !strings.HasPrefix(out, "--- orphans") || !strings.Contains(out, "+++ orphans") {
+		t.Errorf("Expected unified diff headers, got:\n%s", out)
+	}
+}
+
+func TestLatestSnapshotMatchesScope(t *testing.T) {
+	dir := t.TempDir()
+	day := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	all := SnapshotScope("", []string{"acme/api", "acme/web"})
+	one := SnapshotScope("", []string{"acme/api"})
+
+	if all != SnapshotScope("", []string{"acme/web", "acme/api"}) {
+		t.Error("Expected repo order not to change the scope")
+	}
+	if all == one || all == SnapshotScope("acme", nil) {
+		t.Error("Expected different repo sets and namespaces to have different scopes")
+	}
+
+	if err := SaveSnapshot(SnapshotPath(dir, "orphans", one, day.AddDate(0, 0, 1)), Snapshot{Type: "orphans"}); err != nil {
+		t.Fatalf("Failed to save snapshot: %v", err)
+	}
+	if err := SaveSnapshot(SnapshotPath(dir, "orphans", all, day), Snapshot{Type: "orphans"}); err != nil {
+		t.Fatalf("Failed to save snapshot: %v", err)
+	}
+
+	latest, err := LatestSnapshot(dir, "orphans", all)
+	if err != nil {
+		t.Fatalf("LatestSnapshot failed: %v", err)
+	}
+	if want := SnapshotPath(dir, "orphans", all, day); latest != want {
+		t.Errorf("Expected the newer single-repo snapshot to be ignored, got %s", latest)
+	}
+}
+
+func TestCarryForwardKeepsFailedRepoEntries(t *testing.T) {
+	previous := []string{
+		"acme/api/feature-x [merged_pr]",
+		"acme/api@v0.1.0 [stale_tag]",
+		"acme/api-v2/old [stale]",
+		"acme/web [missing_policy]",
+		"acme/docs/spike [stale]",
+	}
+	entries := []string{"acme/docs/new [stale]"}
+
+	got := CarryForward(previous, entries, []string{"acme/api", "acme/web"})
+	want := []string{
+		"acme/api/feature-x [merged_pr]",
+		"acme/api@v0.1.0 [stale_tag]",
+		"acme/docs/new [stale]",
+		"acme/web [missing_policy]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected failed repos' entries carried forward, got %v", got)
+	}
+}
@@ -4,28 +4,70 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 // Config represents the application configuration
 type Config struct {
-	DefaultOrg   string        `yaml:"default_org"`
-	Repositories []string      `yaml:"repositories"`
-	Cache        CacheConfig   `yaml:"cache"`
-	GitHub       GitHubConfig  `yaml:"github"`
-	Filters      FilterConfig  `yaml:"filters"`
-	Branches     BranchConfig  `yaml:"branches"`
-	Comments     CommentConfig `yaml:"comments"`
-	GHAPerf      GHAPerfConfig `yaml:"gha_perf"`
-	Orphans      OrphansConfig `yaml:"orphans"`
-	UI           UIConfig      `yaml:"ui"`
+	DefaultOrg   string              `yaml:"default_org"`
+	Repositories []string            `yaml:"repositories"`
+	Groups       map[string][]string `yaml:"groups"`
+	Cache        CacheConfig         `yaml:"cache"`
+	GitHub       GitHubConfig        `yaml:"github"`
+	Filters      FilterConfig        `yaml:"filters"`
+	Branches     BranchConfig        `yaml:"branches"`
+	Comments     CommentConfig       `yaml:"comments"`
+	GHAPerf      GHAPerfConfig       `yaml:"gha_perf"`
+	Orphans      OrphansConfig       `yaml:"orphans"`
+	Cleanup      CleanupConfig       `yaml:"cleanup"`
+	Security     SecurityConfig      `yaml:"security"`
+	Secrets      SecretsConfig       `yaml:"secrets"`
+	Settings     SettingsConfig      `yaml:"settings"`
+	Webhooks     WebhookConfig       `yaml:"webhooks"`
+	Releases     ReleasePolicyConfig `yaml:"releases"`
+	CI           CIConfig            `yaml:"ci"`
+	Jira         JiraConfig          `yaml:"jira"`
+	UI           UIConfig            `yaml:"ui"`
 }
 
 // CacheConfig represents cache settings
 type CacheConfig struct {
-	TTL  string `yaml:"ttl"`
-	Path string `yaml:"path"`
+	TTL        string `yaml:"ttl"`
+	Path       string `yaml:"path"`
+	Backend    string `yaml:"backend"`     // local (default) or s3
+	RemoteURL  string `yaml:"remote_url"`  // e.g. s3://bucket/prefix
+	OrphansTTL string `yaml:"orphans_ttl"` // How long the orphans TUI reuses a namespace scan
+
+	MaxSizeBytes int64 `yaml:"max_size_bytes"` // Evict least recently updated gha-perf caches above this; 0 is unlimited
+}
+
+// OrphansTTLDuration returns the parsed orphans_ttl, or 0 when unset or invalid
+func (c CacheConfig) OrphansTTLDuration() time.Duration {
+	ttl, err := ParseDuration(c.OrphansTTL)
+	if err != nil {
+		return 0
+	}
+	return ttl
+}
+
+// ParseDuration parses a duration like time.ParseDuration, also accepting a
+// whole or fractional number of days such as "7d"
+func ParseDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseFloat(days, 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n * float64(24*time.Hour)), nil
+	}
+	return time.ParseDuration(s)
 }
 
 // GitHubConfig represents GitHub API settings
@@ -59,6 +101,17 @@ type GHAPerfConfig struct {
 	DefaultWorkflows    []string `yaml:"default_workflows"`
 	CachePath           string   `yaml:"cache_path"`
 	RegressionThreshold float64  `yaml:"regression_threshold"`
+	ErrorPatterns       []string `yaml:"error_patterns"` // Extra regexes for log error extraction
+	CacheTTL            string   `yaml:"cache_ttl"`      // Cached runs older than this are refetched, e.g. "7d"
+}
+
+// CacheTTLDuration returns the parsed cache_ttl, or 0 (never expire) when unset or invalid
+func (c GHAPerfConfig) CacheTTLDuration() time.Duration {
+	ttl, err := ParseDuration(c.CacheTTL)
+	if err != nil {
+		return 0
+	}
+	return ttl
 }
 
 // OrphansConfig represents orphan branch detection settings
@@ -68,6 +121,75 @@ type OrphansConfig struct {
 	DefaultConcurrency int      `yaml:"default_concurrency"`
 }
 
+// OrphanType names an orphan classification, matching orphans.OrphanType values
+// (config can't import orphans because github imports config)
+type OrphanType string
+
+// orphanTypes are the classifications accepted in cleanup.auto_clean_types
+var orphanTypes = []OrphanType{"merged_pr", "closed_pr", "stale", "recent_no_pr"}
+
+// CleanupConfig represents automated orphan branch cleanup settings
+type CleanupConfig struct {
+	AutoCleanTypes []OrphanType `yaml:"auto_clean_types"` // Orphan types deleted by 'gh-sweep cleanup'
+	MinAgeDays     int          `yaml:"min_age_days"`     // Days since the branch's last activity before it is deleted
+	DryRun         bool         `yaml:"dry_run"`          // Report what would be deleted without deleting
+	MaxDelete      int          `yaml:"max_delete"`       // Deletions per run; 0 means unlimited
+}
+
+// SecurityConfig represents security audit settings
+type SecurityConfig struct {
+	MinPolicyLength int `yaml:"min_policy_length"`
+}
+
+// SecretsConfig represents Actions secret scanning settings
+type SecretsConfig struct {
+	NamingRegex string `yaml:"naming_regex"` // Secret name pattern in ${{ secrets.NAME }}; default [A-Za-z0-9_-]+
+}
+
+// SettingsConfig represents repository settings policy
+type SettingsConfig struct {
+	AllowedMergeStrategies []string `yaml:"allowed_merge_strategies"` // Subset of merge, squash, rebase; empty disables enforcement
+}
+
+// ReleasePolicyConfig sets how old a latest release may be, by semver bump, before it is stale
+type ReleasePolicyConfig struct {
+	MajorMaxAgeDays int `yaml:"major_max_age_days"` // X.0.0 releases
+	MinorMaxAgeDays int `yaml:"minor_max_age_days"` // X.Y.0 releases and non-semver tags
+	PatchMaxAgeDays int `yaml:"patch_max_age_days"` // X.Y.Z releases
+}
+
+// DefaultReleasePolicy returns the release age limits used when none are configured
+func DefaultReleasePolicy() ReleasePolicyConfig {
+	return ReleasePolicyConfig{
+		MajorMaxAgeDays: 365,
+		MinorMaxAgeDays: 90,
+		PatchMaxAgeDays: 30,
+	}
+}
+
+// CIConfig represents thresholds for --exit-code-on-findings
+type CIConfig struct {
+	MaxOrphans          int `yaml:"max_orphans"`           // Orphaned branches tolerated before failing
+	MaxSecurityFindings int `yaml:"max_security_findings"` // Missing or placeholder security policies tolerated
+}
+
+// WebhookConfig represents webhook audit settings
+type WebhookConfig struct {
+	RequiredWebhookEvents []string `yaml:"required_webhook_events"`
+}
+
+// DefaultRequiredWebhookEvents returns the webhook events checked when none are configured
+func DefaultRequiredWebhookEvents() []string {
+	return []string{"push", "pull_request"}
+}
+
+// JiraConfig represents Jira Cloud integration settings
+type JiraConfig struct {
+	BaseURL  string `yaml:"base_url"`  // e.g. https://your-org.atlassian.net
+	Email    string `yaml:"email"`     // Atlassian account that owns the API token
+	APIToken string `yaml:"api_token"` // https://id.atlassian.com/manage-profile/security/api-tokens
+}
+
 // UIConfig represents UI preferences
 type UIConfig struct {
 	Theme   string `yaml:"theme"`
@@ -80,8 +202,9 @@ func DefaultConfig() *Config {
 	homeDir, _ := os.UserHomeDir()
 	return &Config{
 		Cache: CacheConfig{
-			TTL:  "1h",
-			Path: filepath.Join(homeDir, ".cache", "gh-sweep"),
+			TTL:        "1h",
+			Path:       filepath.Join(homeDir, ".cache", "gh-sweep"),
+			OrphansTTL: "1h",
 		},
 		Filters: FilterConfig{
 			ExcludeUsers: []string{
@@ -108,6 +231,7 @@ func DefaultConfig() *Config {
 			DefaultWorkflows:    []string{},
 			CachePath:           filepath.Join(homeDir, ".cache", "gh-sweep", "gha-perf"),
 			RegressionThreshold: 20.0,
+			CacheTTL:            "7d",
 		},
 		Orphans: OrphansConfig{
 			StaleDaysThreshold: 7,
@@ -120,6 +244,19 @@ func DefaultConfig() *Config {
 			},
 			DefaultConcurrency: 5,
 		},
+		Cleanup: CleanupConfig{
+			AutoCleanTypes: []OrphanType{"merged_pr"},
+			MinAgeDays:     30,
+			DryRun:         true,
+			MaxDelete:      50,
+		},
+		Security: SecurityConfig{
+			MinPolicyLength: 100,
+		},
+		Webhooks: WebhookConfig{
+			RequiredWebhookEvents: DefaultRequiredWebhookEvents(),
+		},
+		Releases: DefaultReleasePolicy(),
 		UI: UIConfig{
 			Theme:   "auto",
 			Icons:   true,
@@ -161,6 +298,10 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to parse config from %s: %w", foundPath, err)
 	}
 
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config in %s: %w", foundPath, err)
+	}
+
 	// Expand cache path if needed
 	if cfg.Cache.Path == "" {
 		homeDir, _ := os.UserHomeDir()
@@ -170,6 +311,63 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// Validate checks settings that would otherwise fail at use time
+func (c *Config) Validate() error {
+	for _, pattern := range c.GHAPerf.ErrorPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("gha_perf.error_patterns: invalid regex %q: %w", pattern, err)
+		}
+	}
+	if c.Cache.OrphansTTL != "" {
+		if _, err := ParseDuration(c.Cache.OrphansTTL); err != nil {
+			return fmt.Errorf("cache.orphans_ttl: invalid duration %q: %w", c.Cache.OrphansTTL, err)
+		}
+	}
+	if c.GHAPerf.CacheTTL != "" {
+		if _, err := ParseDuration(c.GHAPerf.CacheTTL); err != nil {
+			return fmt.Errorf("gha_perf.cache_ttl: invalid duration %q: %w", c.GHAPerf.CacheTTL, err)
+		}
+	}
+	if c.Secrets.NamingRegex != "" {
+		if _, err := regexp.Compile(c.Secrets.NamingRegex); err != nil {
+			return fmt.Errorf("secrets.naming_regex: invalid regex %q: %w", c.Secrets.NamingRegex, err)
+		}
+	}
+	for _, orphanType := range c.Cleanup.AutoCleanTypes {
+		if !slices.Contains(orphanTypes, orphanType) {
+			return fmt.Errorf("cleanup.auto_clean_types: unknown orphan type %q (use merged_pr, closed_pr, stale, or recent_no_pr)", orphanType)
+		}
+	}
+	if c.Cleanup.MinAgeDays < 0 || c.Cleanup.MaxDelete < 0 {
+		return fmt.Errorf("cleanup: min_age_days and max_delete must not be negative")
+	}
+	for _, strategy := range c.Settings.AllowedMergeStrategies {
+		if strategy != "merge" && strategy != "squash" && strategy != "rebase" {
+			return fmt.Errorf("settings.allowed_merge_strategies: unknown strategy %q (use merge, squash, or rebase)", strategy)
+		}
+	}
+	return nil
+}
+
+// GroupNames returns the configured group names in sorted order
+func (c *Config) GroupNames() []string {
+	names := make([]string, 0, len(c.Groups))
+	for name := range c.Groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GroupRepos returns the repositories in a named group
+func (c *Config) GroupRepos(name string) ([]string, error) {
+	repos, ok := c.Groups[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown group %q (available: %s)", name, strings.Join(c.GroupNames(), ", "))
+	}
+	return repos, nil
+}
+
 // Save saves the configuration to a file
 func (c *Config) Save(path string) error {
 	data, err := yaml.Marshal(c)
@@ -3,7 +3,9 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -93,3 +95,187 @@ func TestSaveConfig(t *testing.T) {
 		t.Error("Saved config is empty")
 	}
 }
+
+func TestGroupRepos(t *testing.T) {
+	tmpDir := t.TempDir()
+	configContent := `
+repositories:
+  - owner/ui
+  - owner/api
+groups:
+  frontend: [owner/ui, owner/web]
+  backend: [owner/api, owner/db]
+`
+
+	if err := os.WriteFile(filepath.Join(tmpDir, ".gh-sweep.yaml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	os.Chdir(tmpDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	names := cfg.GroupNames()
+	if len(names) != 2 || names[0] != "backend" || names[1] != "frontend" {
+		t.Errorf("Expected sorted group names [backend frontend], got %v", names)
+	}
+
+	repos, err := cfg.GroupRepos("frontend")
+	if err != nil {
+		t.Fatalf("GroupRepos failed: %v", err)
+	}
+	if len(repos) != 2 || repos[0] != "owner/ui" || repos[1] != "owner/web" {
+		t.Errorf("Expected frontend repos [owner/ui owner/web], got %v", repos)
+	}
+
+	if _, err := cfg.GroupRepos("mobile"); err == nil {
+		t.Error("Expected error for unknown group")
+	}
+}
+
+func TestLoadConfigValidatesErrorPatterns(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := "gha_perf:\n  error_patterns:\n    - 'CUSTOM_ERROR:'\n    - '(unclosed'\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".gh-sweep.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	os.Chdir(tmpDir)
+
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "(unclosed") {
+		t.Errorf("Expected invalid regex error, got %v", err)
+	}
+}
+
+func TestLoadConfigSecretNamingRegex(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := "secrets:\n  naming_regex: '[a-z]+(\\.[a-z]+)*'\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".gh-sweep.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+	os.Chdir(tmpDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.Secrets.NamingRegex != `[a-z]+(\.[a-z]+)*` {
+		t.Errorf("Expected naming regex to be loaded, got %q", cfg.Secrets.NamingRegex)
+	}
+
+	cfg.Secrets.NamingRegex = "[unclosed"
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "secrets.naming_regex") {
+		t.Errorf("Expected invalid naming regex error, got %v", err)
+	}
+}
+
+func TestOrphansTTL(t *testing.T) {
+	cfg := DefaultConfig()
+	if cfg.Cache.OrphansTTLDuration() != time.Hour {
+		t.Errorf("Expected default orphans TTL of 1h, got %v", cfg.Cache.OrphansTTLDuration())
+	}
+
+	cfg.Cache.OrphansTTL = "2d"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Expected day durations to be valid, got %v", err)
+	}
+	if cfg.Cache.OrphansTTLDuration() != 48*time.Hour {
+		t.Errorf("Expected orphans TTL of 48h, got %v", cfg.Cache.OrphansTTLDuration())
+	}
+
+	cfg.Cache.OrphansTTL = "soon"
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "cache.orphans_ttl") {
+		t.Errorf("Expected invalid duration error, got %v", err)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{"7d", 7 * 24 * time.Hour, false},
+		{"1.5d", 36 * time.Hour, false},
+		{"0d", 0, false},
+		{"12h", 12 * time.Hour, false},
+		{"90m", 90 * time.Minute, false},
+		{"d", 0, true},
+		{"-1d", 0, true},
+		{"week", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("ParseDuration(%q) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestGHAPerfCacheTTL(t *testing.T) {
+	cfg := DefaultConfig()
+	if cfg.GHAPerf.CacheTTLDuration() != 7*24*time.Hour {
+		t.Errorf("Expected default gha-perf cache TTL of 7d, got %v", cfg.GHAPerf.CacheTTLDuration())
+	}
+
+	cfg.GHAPerf.CacheTTL = ""
+	if cfg.GHAPerf.CacheTTLDuration() != 0 {
+		t.Errorf("Expected no expiry when unset, got %v", cfg.GHAPerf.CacheTTLDuration())
+	}
+
+	cfg.GHAPerf.CacheTTL = "2 weeks"
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "gha_perf.cache_ttl") {
+		t.Errorf("Expected invalid duration error, got %v", err)
+	}
+}
+
+func TestAllowedMergeStrategies(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Settings.AllowedMergeStrategies = []string{"squash", "rebase"}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Expected valid strategies, got %v", err)
+	}
+
+	cfg.Settings.AllowedMergeStrategies = []string{"squash", "fast-forward"}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "settings.allowed_merge_strategies") {
+		t.Errorf("Expected unknown strategy error, got %v", err)
+	}
+}
+
+func TestCleanupConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	if !cfg.Cleanup.DryRun {
+		t.Error("Expected cleanup to default to dry run")
+	}
+
+	cfg.Cleanup.AutoCleanTypes = []OrphanType{"merged_pr", "stale"}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Expected valid cleanup types, got %v", err)
+	}
+
+	cfg.Cleanup.AutoCleanTypes = []OrphanType{"stale_tag"}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "cleanup.auto_clean_types") {
+		t.Errorf("Expected unknown orphan type error, got %v", err)
+	}
+
+	cfg.Cleanup.AutoCleanTypes = nil
+	cfg.Cleanup.MaxDelete = -1
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected negative max_delete to be rejected")
+	}
+}
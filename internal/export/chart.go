@@ -0,0 +1,124 @@
+package export
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// chartLabelWidth is the maximum label length before truncation
+const chartLabelWidth = 30
+
+// barEighths renders 1/8 through 8/8 of a character cell
+var barEighths = []rune{'▏', '▎', '▍', '▌', '▋', '▊', '▉', '█'}
+
+// RenderBarChart renders one horizontal bar per entry, scaled so the largest value fills width cells
+// Pure function: rows are sorted by duration (longest first), then by label
+func RenderBarChart(data map[string]time.Duration, width int) string {
+	labels := make([]string, 0, len(data))
+	var maxValue time.Duration
+	for label, value := range data {
+		labels = append(labels, label)
+		if value > maxValue {
+			maxValue = value
+		}
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if data[labels[i]] != data[labels[j]] {
+			return data[labels[i]] > data[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+
+	var b strings.Builder
+	for _, label := range labels {
+		value := data[label]
+		fmt.Fprintf(&b, "%-*s │%s│ %s\n",
+			chartLabelWidth, truncateLabel(label, chartLabelWidth),
+			renderBar(value, maxValue, width),
+			github.FormatDuration(value))
+	}
+
+	return b.String()
+}
+
+// renderBar renders value as a bar padded to width cells, using eighth blocks for the final cell
+func renderBar(value, maxValue time.Duration, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	eighths := 0
+	if maxValue > 0 && value > 0 {
+		eighths = int(float64(value) / float64(maxValue) * float64(width*8))
+	}
+
+	full, remainder := eighths/8, eighths%8
+	bar := strings.Repeat(string(barEighths[7]), full)
+	cells := full
+	if remainder > 0 {
+		bar += string(barEighths[remainder-1])
+		cells++
+	}
+
+	return bar + strings.Repeat(" ", width-cells)
+}
+
+// sparkLevels are the block heights of a sparkline, lowest first
+var sparkLevels = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// RenderSparkline renders the last width values as block characters scaled between their min and max
+// Pure function: NaN values render as spaces and shorter series are left-padded to width
+func RenderSparkline(values []float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+
+	minValue, maxValue := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		minValue = math.Min(minValue, v)
+		maxValue = math.Max(maxValue, v)
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat(" ", width-len(values)))
+	for _, v := range values {
+		if math.IsNaN(v) {
+			b.WriteRune(' ')
+			continue
+		}
+		b.WriteRune(sparkLevels[sparkLevel(v, minValue, maxValue)])
+	}
+
+	return b.String()
+}
+
+// sparkLevel maps v into one of len(sparkLevels) equal-width bands between minValue and maxValue
+func sparkLevel(v, minValue, maxValue float64) int {
+	if maxValue <= minValue {
+		return 0
+	}
+	level := int((v - minValue) / (maxValue - minValue) * float64(len(sparkLevels)))
+	if level >= len(sparkLevels) {
+		level = len(sparkLevels) - 1
+	}
+	return level
+}
+
+func truncateLabel(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
@@ -0,0 +1,116 @@
+package export
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// barFor extracts the bar between the │ separators for the row starting with label
+func barFor(t *testing.T, chart, label string) string {
+	t.Helper()
+
+	for _, line := range strings.Split(chart, "\n") {
+		if strings.HasPrefix(line, label) {
+			parts := strings.Split(line, "│")
+			if len(parts) != 3 {
+				t.Fatalf("Unexpected chart line: %q", line)
+			}
+			return parts[1]
+		}
+	}
+
+	t.Fatalf("No row for %s in chart:\n%s", label, chart)
+	return ""
+}
+
+// TestRenderBarChart tests bar scaling relative to the longest duration
+func TestRenderBarChart(t *testing.T) {
+	width := 20
+	chart := RenderBarChart(map[string]time.Duration{
+		"slow.yml": 10 * time.Minute,
+		"half.yml": 5 * time.Minute,
+		"tiny.yml": 75 * time.Second,
+		"none.yml": 0,
+	}, width)
+
+	full := strings.Repeat("█", width)
+	if got := barFor(t, chart, "slow.yml"); got != full {
+		t.Errorf("Expected longest bar to fill width, got %q", got)
+	}
+
+	if got := barFor(t, chart, "half.yml"); got != strings.Repeat("█", 10)+strings.Repeat(" ", 10) {
+		t.Errorf("Expected half-width bar, got %q", got)
+	}
+
+	// 75s of 600s across 20 cells is 2.5 cells: two full blocks and a half block
+	if got := barFor(t, chart, "tiny.yml"); got != "██▌"+strings.Repeat(" ", 17) {
+		t.Errorf("Expected fractional bar, got %q", got)
+	}
+
+	if got := barFor(t, chart, "none.yml"); strings.TrimSpace(got) != "" {
+		t.Errorf("Expected empty bar for zero duration, got %q", got)
+	}
+
+	lines := strings.Split(strings.TrimSpace(chart), "\n")
+	if !strings.HasPrefix(lines[0], "slow.yml") || !strings.HasPrefix(lines[len(lines)-1], "none.yml") {
+		t.Errorf("Expected rows sorted longest first, got:\n%s", chart)
+	}
+}
+
+// TestRenderBarChartTruncatesLabels tests that long workflow names are cut to 30 characters
+func TestRenderBarChartTruncatesLabels(t *testing.T) {
+	label := ".github/workflows/really-long-workflow-name.yml"
+	chart := RenderBarChart(map[string]time.Duration{label: time.Minute}, 10)
+
+	prefix := strings.Split(chart, " │")[0]
+	if len([]rune(prefix)) != 30 {
+		t.Errorf("Expected label truncated to 30 chars, got %q (%d)", prefix, len([]rune(prefix)))
+	}
+	if !strings.HasSuffix(prefix, "...") {
+		t.Errorf("Expected truncated label to end with ..., got %q", prefix)
+	}
+}
+
+// TestRenderSparklineBoundaries tests character selection at the min, max and band edges
+func TestRenderSparklineBoundaries(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   string
+	}{
+		{"min and max", []float64{0, 80}, "▁█"},
+		{"band edges", []float64{0, 9.99, 10, 19.99, 20, 69.99, 70, 80}, "▁▁▂▂▃▇██"},
+		{"all bands", []float64{0, 10, 20, 30, 40, 50, 60, 70, 80}, "▁▂▃▄▅▆▇██"},
+		{"flat series", []float64{5, 5, 5}, "▁▁▁"},
+		{"negative values", []float64{-10, 0, 10}, "▁▅█"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderSparkline(tt.values, len(tt.values)); got != tt.want {
+				t.Errorf("RenderSparkline(%v) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRenderSparklineWidth tests padding, truncation and gaps
+func TestRenderSparklineWidth(t *testing.T) {
+	if got := RenderSparkline([]float64{1, 2}, 4); got != "  ▁█" {
+		t.Errorf("Expected short series left-padded, got %q", got)
+	}
+
+	if got := RenderSparkline([]float64{100, 1, 2}, 2); got != "▁█" {
+		t.Errorf("Expected only the last width values scaled, got %q", got)
+	}
+
+	if got := RenderSparkline([]float64{1, math.NaN(), 2}, 3); got != "▁ █" {
+		t.Errorf("Expected NaN rendered as a gap, got %q", got)
+	}
+
+	if got := RenderSparkline([]float64{1, 2}, 0); got != "" {
+		t.Errorf("Expected empty sparkline for zero width, got %q", got)
+	}
+}
@@ -0,0 +1,66 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// BuildCollaboratorMatrix builds a user x repo grid of permission levels
+// Pure function: header is "User" followed by sorted repo names; rows are sorted by login
+func BuildCollaboratorMatrix(collaborators map[string][]github.Collaborator) [][]string {
+	repos := make([]string, 0, len(collaborators))
+	for repo := range collaborators {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	perms := make(map[string]map[string]string) // user -> repo -> permission
+	for repo, collabs := range collaborators {
+		for _, collab := range collabs {
+			if perms[collab.Login] == nil {
+				perms[collab.Login] = make(map[string]string)
+			}
+			perms[collab.Login][repo] = collab.Permission
+		}
+	}
+
+	users := make([]string, 0, len(perms))
+	for user := range perms {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+
+	matrix := make([][]string, 0, len(users)+1)
+	matrix = append(matrix, append([]string{"User"}, repos...))
+
+	for _, user := range users {
+		row := make([]string, 0, len(repos)+1)
+		row = append(row, user)
+		for _, repo := range repos {
+			row = append(row, perms[user][repo])
+		}
+		matrix = append(matrix, row)
+	}
+
+	return matrix
+}
+
+// ExportCollaboratorMatrix writes cross-repo access as a CSV with users as rows and repos as columns
+func ExportCollaboratorMatrix(collaborators map[string][]github.Collaborator, outputPath string) error {
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.WriteAll(BuildCollaboratorMatrix(collaborators)); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return nil
+}
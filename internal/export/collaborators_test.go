@@ -0,0 +1,73 @@
+package export
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// TestExportCollaboratorMatrix tests matrix dimensions, column order, and cell contents
+func TestExportCollaboratorMatrix(t *testing.T) {
+	collaborators := map[string][]github.Collaborator{
+		"org/web": {
+			{Login: "alice", Permission: "write", Repository: "org/web"},
+		},
+		"org/api": {
+			{Login: "alice", Permission: "admin", Repository: "org/api"},
+			{Login: "bob", Permission: "read", Repository: "org/api"},
+		},
+		"org/cli": {},
+	}
+
+	path := filepath.Join(t.TempDir(), "matrix.csv")
+	if err := ExportCollaboratorMatrix(collaborators, path); err != nil {
+		t.Fatalf("ExportCollaboratorMatrix failed: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open CSV: %v", err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to parse CSV: %v", err)
+	}
+
+	// Header plus one row per user, "User" plus one column per repo
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 rows, got %d", len(records))
+	}
+	for _, row := range records {
+		if len(row) != 4 {
+			t.Fatalf("Expected 4 columns, got %d: %v", len(row), row)
+		}
+	}
+
+	expectedHeader := []string{"User", "org/api", "org/cli", "org/web"}
+	for i, col := range expectedHeader {
+		if records[0][i] != col {
+			t.Errorf("Expected header column %d to be %s, got %s", i, col, records[0][i])
+		}
+	}
+
+	alice, bob := records[1], records[2]
+	if alice[0] != "alice" || bob[0] != "bob" {
+		t.Fatalf("Expected rows sorted by user, got %s, %s", alice[0], bob[0])
+	}
+
+	if alice[1] != "admin" {
+		t.Errorf("Expected alice to have admin on org/api, got %q", alice[1])
+	}
+	if alice[3] != "write" {
+		t.Errorf("Expected alice to have write on org/web, got %q", alice[3])
+	}
+
+	if bob[2] != "" || bob[3] != "" {
+		t.Errorf("Expected empty cells for repos bob cannot access, got %v", bob)
+	}
+}
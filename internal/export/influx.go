@@ -0,0 +1,94 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// influxMeasurement is the measurement name for per-workflow statistics
+const influxMeasurement = "gha_workflow"
+
+// measurementEscaper escapes the characters InfluxDB treats specially in measurement names
+var measurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `)
+
+// tagEscaper escapes the characters InfluxDB treats specially in tag keys and values
+var tagEscaper = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `)
+
+// FormatInfluxLineProtocol renders one gha_workflow point per workflow in InfluxDB line protocol
+// Pure function: lines are sorted by workflow, durations are float seconds, counts are integers
+func FormatInfluxLineProtocol(stats map[string]*github.WorkflowStats, repo string, timestamp time.Time) string {
+	workflows := make([]string, 0, len(stats))
+	for workflow := range stats {
+		workflows = append(workflows, workflow)
+	}
+	sort.Strings(workflows)
+
+	var b strings.Builder
+	for _, workflow := range workflows {
+		s := stats[workflow]
+		fmt.Fprintf(&b, "%s,repo=%s,workflow=%s ",
+			measurementEscaper.Replace(influxMeasurement), tagEscaper.Replace(repo), tagEscaper.Replace(workflow))
+		fmt.Fprintf(&b, "avg_duration_s=%s,min_duration_s=%s,max_duration_s=%s,success_rate=%s,total_runs=%di,failure_count=%di",
+			formatInfluxFloat(s.AvgDuration.Seconds()),
+			formatInfluxFloat(s.MinDuration.Seconds()),
+			formatInfluxFloat(s.MaxDuration.Seconds()),
+			formatInfluxFloat(s.SuccessRate),
+			s.TotalRuns,
+			s.FailureCount)
+		fmt.Fprintf(&b, " %d\n", timestamp.UnixNano())
+	}
+
+	return b.String()
+}
+
+// formatInfluxFloat formats v with the shortest exact decimal representation
+func formatInfluxFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// InfluxWriteOptions identifies an InfluxDB v2 write target
+type InfluxWriteOptions struct {
+	URL    string // Base URL, e.g. http://localhost:8086
+	Token  string
+	Org    string
+	Bucket string
+}
+
+// WriteInfluxLineProtocol posts line protocol to the InfluxDB v2 /api/v2/write endpoint
+func WriteInfluxLineProtocol(opts InfluxWriteOptions, lines string) error {
+	query := url.Values{}
+	query.Set("org", opts.Org)
+	query.Set("bucket", opts.Bucket)
+	query.Set("precision", "ns")
+	endpoint := strings.TrimRight(opts.URL, "/") + "/api/v2/write?" + query.Encode()
+
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(lines))
+	if err != nil {
+		return fmt.Errorf("failed to create InfluxDB request: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if opts.Token != "" {
+		req.Header.Set("Authorization", "Token "+opts.Token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to write to InfluxDB: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to write to InfluxDB: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package export
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// TestFormatInfluxLineProtocol tests escaping, field types, and nanosecond timestamps
+func TestFormatInfluxLineProtocol(t *testing.T) {
+	stats := map[string]*github.WorkflowStats{
+		"ci.yml": {
+			Workflow:     "ci.yml",
+			TotalRuns:    40,
+			AvgDuration:  42300 * time.Millisecond,
+			MinDuration:  30 * time.Second,
+			MaxDuration:  90 * time.Second,
+			SuccessRate:  98.5,
+			FailureCount: 1,
+		},
+		"Nightly Build, full=true": {
+			Workflow:    "Nightly Build, full=true",
+			TotalRuns:   2,
+			AvgDuration: time.Minute,
+			MinDuration: time.Minute,
+			MaxDuration: time.Minute,
+			SuccessRate: 100,
+		},
+	}
+	timestamp := time.Unix(1714000000, 0)
+
+	lines := strings.Split(strings.TrimSpace(FormatInfluxLineProtocol(stats, "owner/repo", timestamp)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d: %v", len(lines), lines)
+	}
+
+	want := `gha_workflow,repo=owner/repo,workflow=Nightly\ Build\,\ full\=true avg_duration_s=60,min_duration_s=60,max_duration_s=60,success_rate=100,total_runs=2i,failure_count=0i 1714000000000000000`
+	if lines[0] != want {
+		t.Errorf("Expected escaped tag values\n got: %s\nwant: %s", lines[0], want)
+	}
+
+	want = `gha_workflow,repo=owner/repo,workflow=ci.yml avg_duration_s=42.3,min_duration_s=30,max_duration_s=90,success_rate=98.5,total_runs=40i,failure_count=1i 1714000000000000000`
+	if lines[1] != want {
+		t.Errorf("Unexpected line\n got: %s\nwant: %s", lines[1], want)
+	}
+
+	if got := measurementEscaper.Replace("gha workflow,x"); got != `gha\ workflow\,x` {
+		t.Errorf("Expected measurement commas and spaces escaped, got %s", got)
+	}
+}
+
+// TestWriteInfluxLineProtocol tests the InfluxDB v2 write request
+func TestWriteInfluxLineProtocol(t *testing.T) {
+	var gotQuery, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/write" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		gotQuery = r.URL.RawQuery
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	lines := "gha_workflow,repo=owner/repo,workflow=ci.yml total_runs=1i 1\n"
+	opts := InfluxWriteOptions{URL: server.URL + "/", Token: "secret", Org: "sre", Bucket: "ci"}
+	if err := WriteInfluxLineProtocol(opts, lines); err != nil {
+		t.Fatalf("WriteInfluxLineProtocol failed: %v", err)
+	}
+
+	if gotQuery != "bucket=ci&org=sre&precision=ns" {
+		t.Errorf("Unexpected query %q", gotQuery)
+	}
+	if gotAuth != "Token secret" {
+		t.Errorf("Unexpected Authorization header %q", gotAuth)
+	}
+	if gotBody != lines {
+		t.Errorf("Unexpected body %q", gotBody)
+	}
+}
+
+// TestWriteInfluxLineProtocolError tests that write failures include the server message
+func TestWriteInfluxLineProtocolError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`))
+	}))
+	defer server.Close()
+
+	err := WriteInfluxLineProtocol(InfluxWriteOptions{URL: server.URL, Org: "sre", Bucket: "ci"}, "x v=1i 1\n")
+	if err == nil || !strings.Contains(err.Error(), "unauthorized access") {
+		t.Errorf("Expected unauthorized error, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+)
+
+// orphanTypeOrder lists branch classifications from safest to riskiest cleanup
+var orphanTypeOrder = []orphans.OrphanType{
+	orphans.OrphanTypeMergedPR,
+	orphans.OrphanTypeClosedPR,
+	orphans.OrphanTypeStale,
+	orphans.OrphanTypeRecentNoPR,
+}
+
+// FormatAsGitHubIssue renders an orphan scan as a GitHub issue body
+// Pure function: each orphaned branch becomes an unchecked task list item so deletions can be tracked by checking them off
+func FormatAsGitHubIssue(result *orphans.NamespaceScanResult) string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("## Orphaned branches in %s\n\n", result.Namespace))
+
+	b.WriteString("### Summary\n\n")
+	b.WriteString(fmt.Sprintf("- **Repositories scanned:** %d\n", result.ScannedRepos))
+	if result.ArchivedSkipped > 0 {
+		b.WriteString(fmt.Sprintf("- **Archived repositories skipped:** %d\n", result.ArchivedSkipped))
+	}
+	b.WriteString(fmt.Sprintf("- **Orphaned branches:** %d\n", result.TotalOrphans))
+	if result.ErrorCount > 0 {
+		b.WriteString(fmt.Sprintf("- **Repositories with scan errors:** %d\n", result.ErrorCount))
+	}
+	b.WriteString("\n")
+
+	b.WriteString("### By classification\n\n")
+	b.WriteString("| Classification | Count |\n")
+	b.WriteString("|----------------|-------|\n")
+	for _, t := range orphanTypeOrder {
+		b.WriteString(fmt.Sprintf("| %s | %d |\n", t.Label(), len(result.OrphansByType(t))))
+	}
+	b.WriteString("\n")
+
+	b.WriteString("### Branches to delete\n\n")
+	allOrphans := result.AllOrphans()
+	if len(allOrphans) == 0 {
+		b.WriteString("_No orphaned branches found._\n")
+		return b.String()
+	}
+	for _, orphan := range allOrphans {
+		b.WriteString(taskListItem(orphan.Key(), false, orphanNote(orphan)))
+	}
+
+	return b.String()
+}
+
+// taskListItem renders a GitHub-flavored Markdown task list line
+func taskListItem(text string, done bool, note string) string {
+	box := "[ ]"
+	if done {
+		box = "[x]"
+	}
+	if note != "" {
+		return fmt.Sprintf("- %s %s (%s)\n", box, text, note)
+	}
+	return fmt.Sprintf("- %s %s\n", box, text)
+}
+
+// orphanNote summarizes why a branch was flagged
+func orphanNote(orphan orphans.OrphanedBranch) string {
+	note := fmt.Sprintf("%s, %dd inactive", orphan.Type.Label(), orphan.DaysSinceActivity)
+	if orphan.PRNumber != nil {
+		note += fmt.Sprintf(", PR #%d", *orphan.PRNumber)
+	}
+	if orphan.Protected {
+		note += ", protected"
+	}
+	return note
+}
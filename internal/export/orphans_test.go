@@ -0,0 +1,95 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+)
+
+func orphanScanResult() *orphans.NamespaceScanResult {
+	pr := 12
+	return &orphans.NamespaceScanResult{
+		Namespace:    "acme",
+		ScannedRepos: 2,
+		TotalRepos:   2,
+		TotalOrphans: 3,
+		Results: []orphans.ScanResult{
+			{Orphans: []orphans.OrphanedBranch{
+				{Repository: "acme/api", BranchName: "feature/login", Type: orphans.OrphanTypeMergedPR, PRNumber: &pr, DaysSinceActivity: 40},
+				{Repository: "acme/api", BranchName: "spike", Type: orphans.OrphanTypeStale, DaysSinceActivity: 90},
+			}},
+			{Orphans: []orphans.OrphanedBranch{
+				{Repository: "acme/web", BranchName: "fix/typo", Type: orphans.OrphanTypeMergedPR, DaysSinceActivity: 10},
+			}},
+		},
+	}
+}
+
+// TestFormatAsGitHubIssue tests that one unchecked task is listed per orphan alongside the stats
+func TestFormatAsGitHubIssue(t *testing.T) {
+	result := orphanScanResult()
+	body := FormatAsGitHubIssue(result)
+
+	var tasks []string
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "- [") {
+			tasks = append(tasks, line)
+		}
+	}
+	if len(tasks) != result.TotalOrphans {
+		t.Fatalf("Expected %d task items, got %d:\n%s", result.TotalOrphans, len(tasks), body)
+	}
+	for _, task := range tasks {
+		if !strings.HasPrefix(task, "- [ ] acme/") {
+			t.Errorf("Expected unchecked task for an orphan, got %q", task)
+		}
+	}
+	if tasks[0] != "- [ ] acme/api/feature/login (Merged PR, 40d inactive, PR #12)" {
+		t.Errorf("Unexpected first task: %q", tasks[0])
+	}
+
+	for _, want := range []string{
+		"- **Repositories scanned:** 2",
+		"- **Orphaned branches:** 3",
+		"| Merged PR | 2 |",
+		"| Stale | 1 |",
+		"| Closed PR | 0 |",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected body to contain %q:\n%s", want, body)
+		}
+	}
+}
+
+// TestFormatAsGitHubIssueEmpty tests the body when nothing was found
+func TestFormatAsGitHubIssueEmpty(t *testing.T) {
+	body := FormatAsGitHubIssue(&orphans.NamespaceScanResult{Namespace: "acme"})
+
+	if strings.Contains(body, "- [") {
+		t.Errorf("Expected no task items, got:\n%s", body)
+	}
+	if !strings.Contains(body, "_No orphaned branches found._") {
+		t.Errorf("Expected empty notice, got:\n%s", body)
+	}
+}
+
+// TestTaskListItem tests GFM checkbox syntax for checked and unchecked items
+func TestTaskListItem(t *testing.T) {
+	tests := []struct {
+		text string
+		done bool
+		note string
+		want string
+	}{
+		{"acme/api/old", false, "", "- [ ] acme/api/old\n"},
+		{"acme/api/old", true, "", "- [x] acme/api/old\n"},
+		{"acme/api/old", false, "Stale, 9d inactive", "- [ ] acme/api/old (Stale, 9d inactive)\n"},
+	}
+
+	for _, tt := range tests {
+		if got := taskListItem(tt.text, tt.done, tt.note); got != tt.want {
+			t.Errorf("taskListItem(%q, %v, %q) = %q, want %q", tt.text, tt.done, tt.note, got, tt.want)
+		}
+	}
+}
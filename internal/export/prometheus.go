@@ -0,0 +1,101 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// prometheusLabelEscaper escapes the characters the text exposition format treats specially in label values
+var prometheusLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// workflowMetric is a gauge family with one sample per workflow
+type workflowMetric struct {
+	name  string
+	help  string
+	value func(*github.WorkflowStats) float64
+}
+
+// jobMetric is a gauge family with one sample per workflow job
+type jobMetric struct {
+	name  string
+	help  string
+	value func(*github.JobStats) float64
+}
+
+var workflowMetrics = []workflowMetric{
+	{"gh_sweep_workflow_duration_seconds_avg", "Average workflow run duration in seconds.", func(s *github.WorkflowStats) float64 { return s.AvgDuration.Seconds() }},
+	{"gh_sweep_workflow_duration_seconds_min", "Shortest workflow run duration in seconds.", func(s *github.WorkflowStats) float64 { return s.MinDuration.Seconds() }},
+	{"gh_sweep_workflow_duration_seconds_max", "Longest workflow run duration in seconds.", func(s *github.WorkflowStats) float64 { return s.MaxDuration.Seconds() }},
+	{"gh_sweep_workflow_duration_seconds_p50", "Median workflow run duration in seconds.", func(s *github.WorkflowStats) float64 { return s.P50.Seconds() }},
+	{"gh_sweep_workflow_duration_seconds_p75", "75th percentile workflow run duration in seconds.", func(s *github.WorkflowStats) float64 { return s.P75.Seconds() }},
+	{"gh_sweep_workflow_duration_seconds_p95", "95th percentile workflow run duration in seconds.", func(s *github.WorkflowStats) float64 { return s.P95.Seconds() }},
+	{"gh_sweep_workflow_success_rate", "Fraction of workflow runs that succeeded, from 0 to 1.", func(s *github.WorkflowStats) float64 { return s.SuccessRate / 100 }},
+	{"gh_sweep_workflow_runs", "Workflow runs in the lookback period.", func(s *github.WorkflowStats) float64 { return float64(s.TotalRuns) }},
+	{"gh_sweep_workflow_failures", "Failed workflow runs in the lookback period.", func(s *github.WorkflowStats) float64 { return float64(s.FailureCount) }},
+}
+
+var jobMetrics = []jobMetric{
+	{"gh_sweep_job_duration_seconds_avg", "Average job duration in seconds.", func(s *github.JobStats) float64 { return s.AvgDuration.Seconds() }},
+	{"gh_sweep_job_duration_seconds_min", "Shortest job duration in seconds.", func(s *github.JobStats) float64 { return s.MinDuration.Seconds() }},
+	{"gh_sweep_job_duration_seconds_max", "Longest job duration in seconds.", func(s *github.JobStats) float64 { return s.MaxDuration.Seconds() }},
+	{"gh_sweep_job_runs", "Job runs in the lookback period.", func(s *github.JobStats) float64 { return float64(s.TotalRuns) }},
+}
+
+// ExportPrometheus writes workflow and job stats as gauges in the Prometheus text exposition format
+// Samples are labelled by repo and workflow (and job), sorted so output is stable between runs
+func ExportPrometheus(stats map[string]*github.WorkflowStats, jobStats map[string]*github.JobStats, repo string, w io.Writer) error {
+	workflows := make([]string, 0, len(stats))
+	for workflow := range stats {
+		workflows = append(workflows, workflow)
+	}
+	sort.Strings(workflows)
+
+	jobs := make([]string, 0, len(jobStats))
+	for key := range jobStats {
+		jobs = append(jobs, key)
+	}
+	sort.Strings(jobs)
+
+	var b strings.Builder
+	for _, metric := range workflowMetrics {
+		writePrometheusHeader(&b, metric.name, metric.help)
+		for _, workflow := range workflows {
+			fmt.Fprintf(&b, "%s{repo=\"%s\",workflow=\"%s\"} %s\n", metric.name,
+				prometheusLabelEscaper.Replace(repo),
+				prometheusLabelEscaper.Replace(workflow),
+				formatPrometheusFloat(metric.value(stats[workflow])))
+		}
+	}
+	for _, metric := range jobMetrics {
+		writePrometheusHeader(&b, metric.name, metric.help)
+		for _, key := range jobs {
+			s := jobStats[key]
+			fmt.Fprintf(&b, "%s{repo=\"%s\",workflow=\"%s\",job=\"%s\"} %s\n", metric.name,
+				prometheusLabelEscaper.Replace(repo),
+				prometheusLabelEscaper.Replace(s.Workflow),
+				prometheusLabelEscaper.Replace(s.Job),
+				formatPrometheusFloat(metric.value(s)))
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write Prometheus metrics: %w", err)
+	}
+	return nil
+}
+
+// writePrometheusHeader writes the HELP and TYPE lines that start a gauge family
+func writePrometheusHeader(b *strings.Builder, name, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(b, "# TYPE %s gauge\n", name)
+}
+
+// formatPrometheusFloat formats v with the shortest exact decimal representation
+func formatPrometheusFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,121 @@
+package export
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// parsePrometheusText parses text with the reference exposition format parser and returns
+// sample values keyed by "name{label=value,...}", with labels sorted by name and unescaped
+func parsePrometheusText(t *testing.T, text string) map[string]float64 {
+	t.Helper()
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("Output is not valid exposition format: %v\n%s", err, text)
+	}
+
+	samples := make(map[string]float64)
+	for name, family := range families {
+		if family.GetType() != dto.MetricType_GAUGE {
+			t.Errorf("Expected %s to be a gauge, got %s", name, family.GetType())
+		}
+		for _, metric := range family.GetMetric() {
+			var labels []string
+			for _, label := range metric.GetLabel() {
+				labels = append(labels, label.GetName()+"="+label.GetValue())
+			}
+			sort.Strings(labels)
+
+			key := name + "{" + strings.Join(labels, ",") + "}"
+			if _, dup := samples[key]; dup {
+				t.Errorf("Duplicate series %s", key)
+			}
+			samples[key] = metric.GetGauge().GetValue()
+		}
+	}
+	return samples
+}
+
+// TestExportPrometheusRoundTrip tests that the output parses as exposition format and keeps every value
+func TestExportPrometheusRoundTrip(t *testing.T) {
+	stats := map[string]*github.WorkflowStats{
+		"ci.yml": {
+			Workflow:     "ci.yml",
+			TotalRuns:    40,
+			AvgDuration:  42300 * time.Millisecond,
+			MinDuration:  30 * time.Second,
+			MaxDuration:  90 * time.Second,
+			P50:          40 * time.Second,
+			P75:          55500 * time.Millisecond,
+			P95:          80 * time.Second,
+			SuccessRate:  97.5,
+			FailureCount: 1,
+		},
+		`Deploy "prod" \ nightly`: {
+			Workflow:    `Deploy "prod" \ nightly`,
+			TotalRuns:   2,
+			AvgDuration: time.Minute,
+			MinDuration: time.Minute,
+			MaxDuration: time.Minute,
+			P95:         time.Minute,
+			SuccessRate: 100,
+		},
+	}
+	jobStats := map[string]*github.JobStats{
+		"ci.yml:test": {WorkflowJob: "ci.yml:test", Workflow: "ci.yml", Job: "test", TotalRuns: 40, AvgDuration: 20 * time.Second, MinDuration: 15 * time.Second, MaxDuration: 45 * time.Second},
+	}
+
+	var b strings.Builder
+	if err := ExportPrometheus(stats, jobStats, "owner/repo", &b); err != nil {
+		t.Fatalf("ExportPrometheus failed: %v", err)
+	}
+
+	samples := parsePrometheusText(t, b.String())
+
+	want := map[string]float64{
+		`gh_sweep_workflow_duration_seconds_avg{repo=owner/repo,workflow=ci.yml}`:          42.3,
+		`gh_sweep_workflow_duration_seconds_p50{repo=owner/repo,workflow=ci.yml}`:          40,
+		`gh_sweep_workflow_duration_seconds_p75{repo=owner/repo,workflow=ci.yml}`:          55.5,
+		`gh_sweep_workflow_duration_seconds_p95{repo=owner/repo,workflow=ci.yml}`:          80,
+		`gh_sweep_workflow_success_rate{repo=owner/repo,workflow=ci.yml}`:                  0.975,
+		`gh_sweep_workflow_failures{repo=owner/repo,workflow=ci.yml}`:                      1,
+		`gh_sweep_workflow_runs{repo=owner/repo,workflow=Deploy "prod" \ nightly}`:         2,
+		`gh_sweep_workflow_success_rate{repo=owner/repo,workflow=Deploy "prod" \ nightly}`: 1,
+		`gh_sweep_job_duration_seconds_avg{job=test,repo=owner/repo,workflow=ci.yml}`:      20,
+		`gh_sweep_job_duration_seconds_max{job=test,repo=owner/repo,workflow=ci.yml}`:      45,
+		`gh_sweep_job_runs{job=test,repo=owner/repo,workflow=ci.yml}`:                      40,
+	}
+	for key, value := range want {
+		if got, ok := samples[key]; !ok || got != value {
+			t.Errorf("Expected %s = %v, got %v (present: %v)", key, value, got, ok)
+		}
+	}
+
+	if wantSamples := 2*len(workflowMetrics) + len(jobMetrics); len(samples) != wantSamples {
+		t.Errorf("Expected %d samples, got %d", wantSamples, len(samples))
+	}
+}
+
+// TestExportPrometheusEmpty tests that families are still declared when there are no runs
+func TestExportPrometheusEmpty(t *testing.T) {
+	var b strings.Builder
+	if err := ExportPrometheus(nil, nil, "owner/repo", &b); err != nil {
+		t.Fatalf("ExportPrometheus failed: %v", err)
+	}
+
+	samples := parsePrometheusText(t, b.String())
+	if len(samples) != 0 {
+		t.Errorf("Expected no samples, got %v", samples)
+	}
+	if !strings.Contains(b.String(), "# TYPE gh_sweep_workflow_success_rate gauge") {
+		t.Errorf("Expected TYPE line for success rate, got:\n%s", b.String())
+	}
+}
@@ -0,0 +1,66 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// BuildSecretMatrixRows builds a secret x repo grid of workflow file counts
+// Pure function: header is "Secret" followed by sorted repo names; rows are sorted by secret name
+// Cells are empty where a repo cannot use the secret and "0" where it can but no workflow references it
+func BuildSecretMatrixRows(matrix github.SecretMatrix) [][]string {
+	repoSet := make(map[string]bool)
+	secrets := make([]string, 0, len(matrix))
+	for name, repos := range matrix {
+		secrets = append(secrets, name)
+		for repo := range repos {
+			repoSet[repo] = true
+		}
+	}
+	sort.Strings(secrets)
+
+	repos := make([]string, 0, len(repoSet))
+	for repo := range repoSet {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	rows := make([][]string, 0, len(secrets)+1)
+	rows = append(rows, append([]string{"Secret"}, repos...))
+
+	for _, name := range secrets {
+		row := make([]string, 0, len(repos)+1)
+		row = append(row, name)
+		for _, repo := range repos {
+			cell := ""
+			if access, ok := matrix[name][repo]; ok {
+				cell = strconv.Itoa(len(access.WorkflowFiles))
+			}
+			row = append(row, cell)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// ExportSecretMatrix writes secret usage as a CSV with secrets as rows and repos as columns
+func ExportSecretMatrix(matrix github.SecretMatrix, outputPath string) error {
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.WriteAll(BuildSecretMatrixRows(matrix)); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+// FormatTerraform exports protection rules as Terraform HCL
+const FormatTerraform ExportFormat = "terraform"
+
+var terraformNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// hclAttribute is a single "key = value" line in an HCL block
+type hclAttribute struct {
+	key   string
+	value string
+}
+
+// ExportProtectionRulesToTerraform renders rules as github_branch_protection resources
+// Pure function: output matches `terraform fmt` alignment
+func ExportProtectionRulesToTerraform(rules []*github.ProtectionRule) string {
+	var b strings.Builder
+
+	for i, rule := range rules {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeTerraformProtection(&b, rule)
+	}
+
+	return b.String()
+}
+
+func writeTerraformProtection(b *strings.Builder, rule *github.ProtectionRule) {
+	repoName := rule.Repository
+	if idx := strings.LastIndex(repoName, "/"); idx >= 0 {
+		repoName = repoName[idx+1:]
+	}
+
+	fmt.Fprintf(b, "resource \"github_branch_protection\" %s {\n", hclString(terraformResourceName(rule)))
+
+	writeHCLAttributes(b, "  ", []hclAttribute{
+		{"repository_id", hclString(repoName)},
+		{"pattern", hclString(rule.Branch)},
+	})
+	b.WriteString("\n")
+
+	writeHCLAttributes(b, "  ", []hclAttribute{
+		{"enforce_admins", strconv.FormatBool(rule.EnforceAdmins)},
+		{"required_linear_history", strconv.FormatBool(rule.RequireLinearHistory)},
+		{"allows_force_pushes", strconv.FormatBool(rule.AllowForcePushes)},
+		{"allows_deletions", strconv.FormatBool(rule.AllowDeletions)},
+	})
+
+	if len(rule.RequireStatusChecks) > 0 {
+		contexts := make([]string, len(rule.RequireStatusChecks))
+		for i, check := range rule.RequireStatusChecks {
+			contexts[i] = hclString(check)
+		}
+
+		b.WriteString("\n  required_status_checks {\n")
+		writeHCLAttributes(b, "    ", []hclAttribute{
+			{"contexts", "[" + strings.Join(contexts, ", ") + "]"},
+		})
+		b.WriteString("  }\n")
+	}
+
+	if rule.RequiredReviews > 0 {
+		b.WriteString("\n  required_pull_request_reviews {\n")
+		writeHCLAttributes(b, "    ", []hclAttribute{
+			{"required_approving_review_count", strconv.Itoa(rule.RequiredReviews)},
+			{"require_code_owner_reviews", strconv.FormatBool(rule.RequireCodeOwnerReviews)},
+		})
+		b.WriteString("  }\n")
+	}
+
+	b.WriteString("}\n")
+}
+
+// writeHCLAttributes writes attributes with their "=" signs aligned
+func writeHCLAttributes(b *strings.Builder, indent string, attrs []hclAttribute) {
+	width := 0
+	for _, attr := range attrs {
+		if len(attr.key) > width {
+			width = len(attr.key)
+		}
+	}
+
+	for _, attr := range attrs {
+		fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, attr.key, attr.value)
+	}
+}
+
+// terraformResourceName builds a resource label like "owner_repo_main"
+// Pure function: invalid identifier characters collapse to underscores
+func terraformResourceName(rule *github.ProtectionRule) string {
+	name := terraformNameInvalidChars.ReplaceAllString(rule.Repository+"_"+rule.Branch, "_")
+	name = strings.Trim(name, "_")
+
+	// Labels must not start with a digit
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	return name
+}
+
+// hclString quotes s as an HCL string literal, escaping template sequences
+func hclString(s string) string {
+	quoted := strconv.Quote(s)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	quoted = strings.ReplaceAll(quoted, "%{", "%%{")
+	return quoted
+}
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestExportProtectionRulesToTerraform tests HCL output against golden files
+func TestExportProtectionRulesToTerraform(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		rules  []*github.ProtectionRule
+	}{
+		{
+			name:   "full rule",
+			golden: "terraform_full.tf",
+			rules: []*github.ProtectionRule{
+				{
+					Repository:              "owner/api",
+					Branch:                  "main",
+					RequiredReviews:         2,
+					RequireCodeOwnerReviews: true,
+					RequireStatusChecks:     []string{"ci/build", "lint"},
+					EnforceAdmins:           true,
+					RequireLinearHistory:    true,
+				},
+			},
+		},
+		{
+			name:   "minimal rules omit empty blocks",
+			golden: "terraform_minimal.tf",
+			rules: []*github.ProtectionRule{
+				{
+					Repository: "owner/web",
+					Branch:     "release/*",
+				},
+				{
+					Repository:       "owner/2fa-docs",
+					Branch:           "main",
+					AllowForcePushes: true,
+					AllowDeletions:   true,
+				},
+			},
+		},
+		{
+			name:   "quoting",
+			golden: "terraform_quoting.tf",
+			rules: []*github.ProtectionRule{
+				{
+					Repository:          "owner/cli",
+					Branch:              "main",
+					RequireStatusChecks: []string{`check "quoted"`, "${interpolated}", "%{directive}"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExportProtectionRulesToTerraform(tt.rules)
+			goldenPath := filepath.Join("testdata", tt.golden)
+
+			if *update {
+				if err := os.WriteFile(goldenPath, []byte(got), 0644); err != nil {
+					t.Fatalf("Failed to update golden file: %v", err)
+				}
+			}
+
+			want, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("Failed to read golden file (run with -update to create): %v", err)
+			}
+
+			if got != string(want) {
+				t.Errorf("Output does not match %s\n--- got ---\n%s\n--- want ---\n%s", goldenPath, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+resource "github_branch_protection" "owner_api_main" {
+  repository_id = "api"
+  pattern       = "main"
+
+  enforce_admins          = true
+  required_linear_history = true
+  allows_force_pushes     = false
+  allows_deletions        = false
+
+  required_status_checks {
+    contexts = ["ci/build", "lint"]
+  }
+
+  required_pull_request_reviews {
+    required_approving_review_count = 2
+    require_code_owner_reviews      = true
+  }
+}
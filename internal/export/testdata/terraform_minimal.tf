@@ -0,0 +1,19 @@
+resource "github_branch_protection" "owner_web_release" {
+  repository_id = "web"
+  pattern       = "release/*"
+
+  enforce_admins          = false
+  required_linear_history = false
+  allows_force_pushes     = false
+  allows_deletions        = false
+}
+
+resource "github_branch_protection" "owner_2fa_docs_main" {
+  repository_id = "2fa-docs"
+  pattern       = "main"
+
+  enforce_admins          = false
+  required_linear_history = false
+  allows_force_pushes     = true
+  allows_deletions        = true
+}
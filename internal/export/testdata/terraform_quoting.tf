@@ -0,0 +1,13 @@
+resource "github_branch_protection" "owner_cli_main" {
+  repository_id = "cli"
+  pattern       = "main"
+
+  enforce_admins          = false
+  required_linear_history = false
+  allows_force_pushes     = false
+  allows_deletions        = false
+
+  required_status_checks {
+    contexts = ["check \"quoted\"", "$${interpolated}", "%%{directive}"]
+  }
+}
@@ -0,0 +1,132 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// hookMarker identifies hook scripts written by gh-sweep
+const hookMarker = "# Installed by gh-sweep hooks install"
+
+// HookConfig configures the orphan check run by the pre-push hook
+type HookConfig struct {
+	MaxOrphans      int
+	ExcludePatterns []string
+}
+
+// InstallPrePushHook writes a pre-push hook that runs the orphan check for the current repo
+// Reinstalling overwrites a previous gh-sweep hook but never a user-written one
+func InstallPrePushHook(repoPath string, config HookConfig) error {
+	hookPath, err := prePushHookPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	if existing, err := os.ReadFile(hookPath); err == nil && !strings.Contains(string(existing), hookMarker) {
+		return fmt.Errorf("refusing to overwrite existing pre-push hook not installed by gh-sweep: %s", hookPath)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(hookPath), 0755); err != nil {
+		return fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+
+	if err := os.WriteFile(hookPath, []byte(BuildPrePushHookScript(config)), 0755); err != nil {
+		return fmt.Errorf("failed to write pre-push hook: %w", err)
+	}
+
+	// WriteFile keeps the mode of an existing file, so set it explicitly
+	if err := os.Chmod(hookPath, 0755); err != nil {
+		return fmt.Errorf("failed to make pre-push hook executable: %w", err)
+	}
+
+	return nil
+}
+
+// UninstallHook removes the pre-push hook if it was installed by gh-sweep
+func UninstallHook(repoPath string) error {
+	hookPath, err := prePushHookPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	existing, err := os.ReadFile(hookPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read pre-push hook: %w", err)
+	}
+
+	if !strings.Contains(string(existing), hookMarker) {
+		return fmt.Errorf("refusing to remove pre-push hook not installed by gh-sweep: %s", hookPath)
+	}
+
+	if err := os.Remove(hookPath); err != nil {
+		return fmt.Errorf("failed to remove pre-push hook: %w", err)
+	}
+
+	return nil
+}
+
+// BuildPrePushHookScript renders the POSIX shell pre-push hook
+// Pure function: the repo is resolved from the origin remote when the hook runs
+func BuildPrePushHookScript(config HookConfig) string {
+	args := []string{
+		"orphans",
+		`--repos "$repo"`,
+		"--list",
+		"--dry-run",
+		"--exit-code-on-findings",
+		fmt.Sprintf("--max-orphans %d", config.MaxOrphans),
+	}
+	for _, pattern := range config.ExcludePatterns {
+		args = append(args, "--exclude "+shellQuote(pattern))
+	}
+
+	var b strings.Builder
+	b.WriteString("#!/bin/sh\n")
+	b.WriteString(hookMarker + "\n")
+	b.WriteString("# Blocks pushes when the repository has orphaned branches.\n")
+	b.WriteString("# Bypass once with: git push --no-verify\n\n")
+	b.WriteString("if ! command -v gh-sweep >/dev/null 2>&1; then\n")
+	b.WriteString("  echo \"gh-sweep not found on PATH, skipping orphan check\" >&2\n")
+	b.WriteString("  exit 0\n")
+	b.WriteString("fi\n\n")
+	b.WriteString("remote_url=$(git remote get-url origin 2>/dev/null)\n")
+	b.WriteString("repo=$(printf '%s\\n' \"$remote_url\" | sed -e 's#\\.git$##' -e 's#^.*github\\.com[:/]##')\n\n")
+	b.WriteString("if [ -z \"$repo\" ]; then\n")
+	b.WriteString("  echo \"Could not determine GitHub repository from origin, skipping orphan check\" >&2\n")
+	b.WriteString("  exit 0\n")
+	b.WriteString("fi\n\n")
+	b.WriteString("exec gh-sweep " + strings.Join(args, " ") + "\n")
+
+	return b.String()
+}
+
+// prePushHookPath asks git where the pre-push hook lives, which honours core.hooksPath,
+// linked worktrees, submodules, and repoPath being a subdirectory of the work tree
+func prePushHookPath(repoPath string) (string, error) {
+	cmd := exec.Command("git", "-C", repoPath, "rev-parse", "--git-path", "hooks/pre-push")
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to resolve pre-push hook path: %w", err)
+	}
+
+	hookPath := strings.TrimSpace(out.String())
+	if !filepath.IsAbs(hookPath) {
+		hookPath = filepath.Join(repoPath, hookPath)
+	}
+	return hookPath, nil
+}
+
+// shellQuote wraps s in single quotes for POSIX shells
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
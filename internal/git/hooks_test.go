@@ -0,0 +1,153 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInstallPrePushHook(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	hookPath := filepath.Join(repoPath, ".git", "hooks", "pre-push")
+
+	config := HookConfig{MaxOrphans: 2, ExcludePatterns: []string{"wip/*"}}
+	if err := InstallPrePushHook(repoPath, config); err != nil {
+		t.Fatalf("Failed to install hook: %v", err)
+	}
+
+	info, err := os.Stat(hookPath)
+	if err != nil {
+		t.Fatalf("Failed to stat hook: %v", err)
+	}
+
+	if info.Mode().Perm() != 0755 {
+		t.Errorf("Expected permissions 0755, got %o", info.Mode().Perm())
+	}
+
+	content, err := os.ReadFile(hookPath)
+	if err != nil {
+		t.Fatalf("Failed to read hook: %v", err)
+	}
+
+	script := string(content)
+	expected := []string{
+		"#!/bin/sh",
+		hookMarker,
+		`gh-sweep orphans --repos "$repo" --list --dry-run --exit-code-on-findings --max-orphans 2`,
+		"--exclude 'wip/*'",
+	}
+	for _, fragment := range expected {
+		if !strings.Contains(script, fragment) {
+			t.Errorf("Expected hook to contain %q, got:\n%s", fragment, script)
+		}
+	}
+
+	// Reinstalling with a changed config updates the script
+	if err := InstallPrePushHook(repoPath, HookConfig{MaxOrphans: 5}); err != nil {
+		t.Fatalf("Failed to reinstall hook: %v", err)
+	}
+
+	content, _ = os.ReadFile(hookPath)
+	if !strings.Contains(string(content), "--max-orphans 5") {
+		t.Errorf("Expected reinstalled hook to use --max-orphans 5, got:\n%s", content)
+	}
+	if strings.Contains(string(content), "wip/*") {
+		t.Error("Expected reinstalled hook to drop old exclude patterns")
+	}
+}
+
+func TestInstallPrePushHookPreservesForeignHook(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	hookPath := filepath.Join(repoPath, ".git", "hooks", "pre-push")
+
+	os.MkdirAll(filepath.Dir(hookPath), 0755)
+	os.WriteFile(hookPath, []byte("#!/bin/sh\nexit 0\n"), 0755)
+
+	if err := InstallPrePushHook(repoPath, HookConfig{}); err == nil {
+		t.Error("Expected error when overwriting a hook not installed by gh-sweep")
+	}
+
+	if err := UninstallHook(repoPath); err == nil {
+		t.Error("Expected error when removing a hook not installed by gh-sweep")
+	}
+
+	if _, err := os.Stat(hookPath); err != nil {
+		t.Errorf("Expected foreign hook to be preserved: %v", err)
+	}
+}
+
+func TestUninstallHook(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	hookPath := filepath.Join(repoPath, ".git", "hooks", "pre-push")
+
+	// Uninstalling when nothing is installed is a no-op
+	if err := UninstallHook(repoPath); err != nil {
+		t.Fatalf("Expected no error without a hook, got %v", err)
+	}
+
+	if err := InstallPrePushHook(repoPath, HookConfig{}); err != nil {
+		t.Fatalf("Failed to install hook: %v", err)
+	}
+
+	if err := UninstallHook(repoPath); err != nil {
+		t.Fatalf("Failed to uninstall hook: %v", err)
+	}
+
+	if _, err := os.Stat(hookPath); !os.IsNotExist(err) {
+		t.Error("Expected hook to be removed")
+	}
+}
+
+func TestPrePushHookScriptIsValidShell(t *testing.T) {
+	script := BuildPrePushHookScript(HookConfig{ExcludePatterns: []string{"it's/*"}})
+
+	cmd := exec.Command("sh", "-n")
+	cmd.Stdin = strings.NewReader(script)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("Hook script failed shell syntax check: %v\n%s", err, out)
+	}
+}
+
+func TestInstallPrePushHookFromSubdirectory(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	subdir := filepath.Join(repoPath, "pkg", "api")
+	if err := os.MkdirAll(subdir, 0755); err != nil {
+		t.Fatalf("Failed to create subdirectory: %v", err)
+	}
+
+	if err := InstallPrePushHook(subdir, HookConfig{}); err != nil {
+		t.Fatalf("Failed to install hook: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(repoPath, ".git", "hooks", "pre-push")); err != nil {
+		t.Errorf("Expected hook in the repository's hooks directory: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(subdir, ".git")); !os.IsNotExist(err) {
+		t.Error("Expected no .git directory created in the subdirectory")
+	}
+}
+
+func TestInstallPrePushHookHonoursHooksPath(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	cmd := exec.Command("git", "config", "core.hooksPath", "githooks")
+	cmd.Dir = repoPath
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("Failed to set core.hooksPath: %v", err)
+	}
+
+	if err := InstallPrePushHook(repoPath, HookConfig{}); err != nil {
+		t.Fatalf("Failed to install hook: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(repoPath, "githooks", "pre-push")); err != nil {
+		t.Errorf("Expected hook in core.hooksPath: %v", err)
+	}
+}
+
+func TestInstallPrePushHookOutsideRepo(t *testing.T) {
+	if err := InstallPrePushHook(t.TempDir(), HookConfig{}); err == nil {
+		t.Error("Expected error outside a git repository")
+	}
+}
@@ -186,6 +186,30 @@ func (r *LocalRepo) GetDefaultBranch() (string, error) {
 	return "", fmt.Errorf("no branches found")
 }
 
+// IsDetachedHEAD reports whether HEAD points at a commit instead of a branch
+// Returns the SHA of HEAD when detached
+func (r *LocalRepo) IsDetachedHEAD() (bool, string, error) {
+	// symbolic-ref exits non-zero when HEAD is not a branch reference
+	cmd := exec.Command("git", "symbolic-ref", "-q", "HEAD")
+	cmd.Dir = r.Path
+
+	if err := cmd.Run(); err == nil {
+		return false, "", nil
+	}
+
+	cmd = exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = r.Path
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+
+	if err := cmd.Run(); err != nil {
+		return false, "", fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	return true, strings.TrimSpace(out.String()), nil
+}
+
 // IsInsideWorkTree checks if the path is inside a Git repository
 func (r *LocalRepo) IsInsideWorkTree() bool {
 	cmd := exec.Command("git", "rev-parse", "--is-inside-work-tree")
@@ -193,3 +217,56 @@ func (r *LocalRepo) IsInsideWorkTree() bool {
 
 	return cmd.Run() == nil
 }
+
+// GetRemoteURL returns the URL configured for a remote
+func (r *LocalRepo) GetRemoteURL(remote string) (string, error) {
+	cmd := exec.Command("git", "remote", "get-url", remote)
+	cmd.Dir = r.Path
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to get URL for remote %s: %w", remote, err)
+	}
+
+	return strings.TrimSpace(out.String()), nil
+}
+
+// DetectRepoFromCWD returns owner/repo for the origin remote of the current directory
+func DetectRepoFromCWD() (string, error) {
+	return DetectRepo(".")
+}
+
+// DetectRepo returns owner/repo for the origin remote of the repository at path
+func DetectRepo(path string) (string, error) {
+	url, err := NewLocalRepo(path).GetRemoteURL("origin")
+	if err != nil {
+		return "", err
+	}
+
+	return ParseRemoteURL(url)
+}
+
+// ParseRemoteURL extracts owner/repo from an HTTPS or SSH remote URL
+// Supports https://github.com/owner/repo.git, git@github.com:owner/repo.git, and ssh://git@github.com/owner/repo.git
+func ParseRemoteURL(url string) (string, error) {
+	path := ""
+	switch {
+	case strings.Contains(url, "://"):
+		// https://host/owner/repo or ssh://git@host[:port]/owner/repo
+		_, rest, _ := strings.Cut(url, "://")
+		_, path, _ = strings.Cut(rest, "/")
+	case strings.Contains(url, ":"):
+		// scp-like syntax: git@host:owner/repo
+		_, path, _ = strings.Cut(url, ":")
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("cannot parse owner/repo from remote URL %q", url)
+	}
+
+	return parts[0] + "/" + parts[1], nil
+}
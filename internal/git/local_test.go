@@ -4,6 +4,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -119,3 +120,101 @@ func TestGetDefaultBranch(t *testing.T) {
 		t.Errorf("Expected default branch to be master or main, got %s", defaultBranch)
 	}
 }
+
+func TestIsDetachedHEAD(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	repo := NewLocalRepo(repoPath)
+
+	detached, sha, err := repo.IsDetachedHEAD()
+	if err != nil {
+		t.Fatalf("Failed to check detached HEAD: %v", err)
+	}
+
+	if detached || sha != "" {
+		t.Errorf("Expected attached HEAD, got detached at %q", sha)
+	}
+
+	// Add a second commit so HEAD~1 exists
+	os.WriteFile(filepath.Join(repoPath, "second.txt"), []byte("second"), 0644)
+
+	cmd := exec.Command("git", "add", "second.txt")
+	cmd.Dir = repoPath
+	cmd.Run()
+
+	cmd = exec.Command("git", "commit", "-m", "Second commit")
+	cmd.Dir = repoPath
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("Failed to create second commit: %v", err)
+	}
+
+	cmd = exec.Command("git", "checkout", "-q", "HEAD~1")
+	cmd.Dir = repoPath
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("Failed to detach HEAD: %v", err)
+	}
+
+	detached, sha, err = repo.IsDetachedHEAD()
+	if err != nil {
+		t.Fatalf("Failed to check detached HEAD: %v", err)
+	}
+
+	if !detached {
+		t.Fatal("Expected detached HEAD")
+	}
+
+	expected, err := exec.Command("git", "-C", repoPath, "rev-parse", "HEAD").Output()
+	if err != nil {
+		t.Fatalf("Failed to resolve HEAD: %v", err)
+	}
+
+	if sha != strings.TrimSpace(string(expected)) {
+		t.Errorf("Expected SHA %s, got %s", strings.TrimSpace(string(expected)), sha)
+	}
+}
+
+func TestDetectRepo(t *testing.T) {
+	tests := []struct {
+		name      string
+		remoteURL string
+		expected  string
+	}{
+		{"https", "https://github.com/owner/repo.git", "owner/repo"},
+		{"https without suffix", "https://github.com/owner/repo", "owner/repo"},
+		{"ssh", "git@github.com:owner/repo.git", "owner/repo"},
+		{"ssh url", "ssh://git@github.com/owner/repo.git", "owner/repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoPath := setupTestRepo(t)
+
+			cmd := exec.Command("git", "remote", "add", "origin", tt.remoteURL)
+			cmd.Dir = repoPath
+			if err := cmd.Run(); err != nil {
+				t.Fatalf("Failed to add remote: %v", err)
+			}
+
+			repo, err := DetectRepo(repoPath)
+			if err != nil {
+				t.Fatalf("DetectRepo failed: %v", err)
+			}
+			if repo != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, repo)
+			}
+		})
+	}
+}
+
+func TestDetectRepoWithoutOrigin(t *testing.T) {
+	if _, err := DetectRepo(setupTestRepo(t)); err == nil {
+		t.Error("Expected an error without an origin remote")
+	}
+}
+
+func TestParseRemoteURLRejectsUnknownFormats(t *testing.T) {
+	for _, url := range []string{"", "/local/path/repo.git", "https://github.com/owner", "git@github.com:owner/group/repo.git"} {
+		if repo, err := ParseRemoteURL(url); err == nil {
+			t.Errorf("Expected an error for %q, got %s", url, repo)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -21,16 +23,32 @@ type JobLog struct {
 
 // ErrorContext represents extracted error information
 type ErrorContext struct {
-	Repository   string    `json:"repository"`
-	WorkflowName string    `json:"workflow_name"`
-	JobName      string    `json:"job_name"`
-	StepName     string    `json:"step_name,omitempty"`
-	Conclusion   string    `json:"conclusion"`
-	Timestamp    time.Time `json:"timestamp"`
-	ErrorLines   []string  `json:"error_lines"`
-	Context      []string  `json:"context_lines,omitempty"`
-	ErrorType    string    `json:"error_type,omitempty"`
-	Summary      string    `json:"summary"`
+	Repository   string       `json:"repository"`
+	WorkflowName string       `json:"workflow_name"`
+	JobName      string       `json:"job_name"`
+	StepName     string       `json:"step_name,omitempty"`
+	Conclusion   string       `json:"conclusion"`
+	Timestamp    time.Time    `json:"timestamp"`
+	ErrorLines   []string     `json:"error_lines"`
+	Context      []string     `json:"context_lines,omitempty"`
+	ErrorType    string       `json:"error_type,omitempty"`
+	Summary      string       `json:"summary"`
+	Annotations  []Annotation `json:"annotations,omitempty"`
+}
+
+// Annotation represents a workflow command annotation such as ::error file=app.js,line=1::msg
+type Annotation struct {
+	Level   string `json:"level"` // error, warning, or notice
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Col     int    `json:"col,omitempty"`
+	Message string `json:"message"`
+}
+
+// AnnotationGroup holds the annotations reported against a single file
+type AnnotationGroup struct {
+	File        string
+	Annotations []Annotation
 }
 
 // LogExtractionConfig configures log extraction behavior
@@ -61,6 +79,25 @@ func DefaultLogConfig() LogExtractionConfig {
 	}
 }
 
+// WithExtraPatterns returns a copy of the config with additional error patterns appended
+// Returns an error if any pattern is not a valid regular expression
+func (c LogExtractionConfig) WithExtraPatterns(patterns []string) (LogExtractionConfig, error) {
+	merged := make([]string, 0, len(c.ErrorPatterns)+len(patterns))
+	merged = append(merged, c.ErrorPatterns...)
+
+	for _, p := range patterns {
+		if _, err := regexp.Compile(p); err != nil {
+			return c, fmt.Errorf("invalid error pattern %q: %w", p, err)
+		}
+		if !contains(merged, p) {
+			merged = append(merged, p)
+		}
+	}
+
+	c.ErrorPatterns = merged
+	return c, nil
+}
+
 // ExtractErrorContext extracts actionable error information from job logs
 // Pure function: deterministic, no side effects
 func ExtractErrorContext(log JobLog, workflow string, config LogExtractionConfig) *ErrorContext {
@@ -99,7 +136,87 @@ func ExtractErrorContext(log JobLog, workflow string, config LogExtractionConfig
 		Context:      contextLines,
 		ErrorType:    errorType,
 		Summary:      summary,
+		Annotations:  ExtractAnnotations(log),
+	}
+}
+
+// annotationPattern matches ::level props::message workflow commands, optionally after a log timestamp
+var annotationPattern = regexp.MustCompile(`^(?:\S*\d{4}-\d{2}-\d{2}T\S+\s+)?::(error|warning|notice)(?:\s+([^:]*))?::(.*)$`)
+
+// ExtractAnnotations parses ::error, ::warning, and ::notice commands from a job log
+// Pure function: scans every line, not just the tail used for error context
+func ExtractAnnotations(log JobLog) []Annotation {
+	var annotations []Annotation
+
+	for _, line := range log.Lines {
+		match := annotationPattern.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+
+		annotation := Annotation{
+			Level:   match[1],
+			Message: strings.TrimSpace(match[3]),
+		}
+
+		for _, prop := range strings.Split(match[2], ",") {
+			key, value, ok := strings.Cut(strings.TrimSpace(prop), "=")
+			if !ok {
+				continue
+			}
+			switch key {
+			case "file":
+				annotation.File = value
+			case "line":
+				annotation.Line, _ = strconv.Atoi(value)
+			case "col":
+				annotation.Col, _ = strconv.Atoi(value)
+			}
+		}
+
+		annotations = append(annotations, annotation)
 	}
+
+	return annotations
+}
+
+// FormatAnnotationLocation formats an annotation's position as file:line:col
+// Pure function: omits parts that were not reported
+func FormatAnnotationLocation(a Annotation) string {
+	location := a.File
+	if location == "" {
+		location = "(no file)"
+	}
+	if a.Line > 0 {
+		location += fmt.Sprintf(":%d", a.Line)
+		if a.Col > 0 {
+			location += fmt.Sprintf(":%d", a.Col)
+		}
+	}
+	return location
+}
+
+// GroupAnnotationsByFile groups annotations by file, sorted by file then line
+// Pure function: annotations without a file are grouped under an empty name
+func GroupAnnotationsByFile(annotations []Annotation) []AnnotationGroup {
+	byFile := make(map[string][]Annotation)
+	for _, annotation := range annotations {
+		byFile[annotation.File] = append(byFile[annotation.File], annotation)
+	}
+
+	groups := make([]AnnotationGroup, 0, len(byFile))
+	for file, fileAnnotations := range byFile {
+		sort.SliceStable(fileAnnotations, func(i, j int) bool {
+			return fileAnnotations[i].Line < fileAnnotations[j].Line
+		})
+		groups = append(groups, AnnotationGroup{File: file, Annotations: fileAnnotations})
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].File < groups[j].File
+	})
+
+	return groups
 }
 
 // extractTail returns the last N lines from a log
@@ -320,6 +437,14 @@ func FormatAsMarkdown(contexts []*ErrorContext) string {
 			sb.WriteString("```\n\n")
 		}
 
+		if len(ctx.Annotations) > 0 {
+			sb.WriteString("**Annotations:**\n")
+			for _, a := range ctx.Annotations {
+				sb.WriteString(fmt.Sprintf("- %s `%s`: %s\n", a.Level, FormatAnnotationLocation(a), a.Message))
+			}
+			sb.WriteString("\n")
+		}
+
 		sb.WriteString("---\n\n")
 	}
 
@@ -340,6 +465,178 @@ func BatchExtractErrors(logs []JobLog, workflow string, config LogExtractionConf
 	return contexts
 }
 
+// ErrorGroupSummary aggregates error contexts that share an error type
+type ErrorGroupSummary struct {
+	ErrorType           string    `json:"error_type"`
+	Count               int       `json:"count"`
+	AffectedJobs        []string  `json:"affected_jobs"`
+	MostRecentTimestamp time.Time `json:"most_recent_timestamp"`
+}
+
+// GroupErrorsByType buckets error contexts by their classified error type
+// Pure function: groups without reordering contexts within a type
+func GroupErrorsByType(contexts []*ErrorContext) map[string][]*ErrorContext {
+	grouped := make(map[string][]*ErrorContext)
+
+	for _, ctx := range contexts {
+		errorType := ctx.ErrorType
+		if errorType == "" {
+			errorType = "unknown"
+		}
+		grouped[errorType] = append(grouped[errorType], ctx)
+	}
+
+	return grouped
+}
+
+// SummarizeErrorGroups summarizes each group, sorted by count descending
+// Pure function: ties are broken by error type name for stable output
+func SummarizeErrorGroups(grouped map[string][]*ErrorContext) []ErrorGroupSummary {
+	summaries := make([]ErrorGroupSummary, 0, len(grouped))
+
+	for errorType, contexts := range grouped {
+		summary := ErrorGroupSummary{
+			ErrorType: errorType,
+			Count:     len(contexts),
+		}
+
+		for _, ctx := range contexts {
+			if !contains(summary.AffectedJobs, ctx.JobName) {
+				summary.AffectedJobs = append(summary.AffectedJobs, ctx.JobName)
+			}
+			if ctx.Timestamp.After(summary.MostRecentTimestamp) {
+				summary.MostRecentTimestamp = ctx.Timestamp
+			}
+		}
+		sort.Strings(summary.AffectedJobs)
+
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Count != summaries[j].Count {
+			return summaries[i].Count > summaries[j].Count
+		}
+		return summaries[i].ErrorType < summaries[j].ErrorType
+	})
+
+	return summaries
+}
+
+// StepCacheStats counts cache restores and misses for one workflow step
+type StepCacheStats struct {
+	Step   string
+	Hits   int
+	Misses int
+}
+
+// HitRate returns the percentage of cache lookups that restored a cache
+func (s StepCacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total) * 100
+}
+
+// WorkflowCacheStats aggregates step cache hit rates across runs of a workflow
+type WorkflowCacheStats struct {
+	Workflow string
+	Runs     int
+	Steps    map[string]*StepCacheStats
+}
+
+var (
+	// stepGroupPattern matches the group that opens each step, such as ##[group]Run actions/cache@v4
+	stepGroupPattern = regexp.MustCompile(`##\[group\]((?:Run|Post) .+)$`)
+	cacheHitPattern  = regexp.MustCompile(`(?i)\bcache (restored|hit)\b`)
+	cacheMissPattern = regexp.MustCompile(`(?i)\bcache (is )?(miss|not found)\b`)
+)
+
+// ParseCacheHitRate returns the cache hit rate (0-100) of each step that looked up a cache
+// Pure function: a step counts once per log, as a hit if any restore message appears
+func ParseCacheHitRate(log JobLog) map[string]float64 {
+	rates := make(map[string]float64)
+	for step, stats := range countCacheLookups(log) {
+		rates[step] = stats.HitRate()
+	}
+	return rates
+}
+
+// ComputeWorkflowCacheStats aggregates step cache lookups by workflow across runs
+// Pure function: logs are keyed by run ID; runs without logs are skipped
+func ComputeWorkflowCacheStats(runs []RunTiming, logs map[int][]JobLog) map[string]*WorkflowCacheStats {
+	result := make(map[string]*WorkflowCacheStats)
+
+	for _, run := range runs {
+		runLogs, ok := logs[run.RunID]
+		if !ok {
+			continue
+		}
+
+		ws, ok := result[run.Workflow]
+		if !ok {
+			ws = &WorkflowCacheStats{Workflow: run.Workflow, Steps: make(map[string]*StepCacheStats)}
+			result[run.Workflow] = ws
+		}
+		ws.Runs++
+
+		for _, log := range runLogs {
+			for step, counts := range countCacheLookups(log) {
+				stats, ok := ws.Steps[step]
+				if !ok {
+					stats = &StepCacheStats{Step: step}
+					ws.Steps[step] = stats
+				}
+				stats.Hits += counts.Hits
+				stats.Misses += counts.Misses
+			}
+		}
+	}
+
+	return result
+}
+
+// countCacheLookups records one hit or miss for each step whose output mentions a cache lookup
+func countCacheLookups(log JobLog) map[string]*StepCacheStats {
+	counts := make(map[string]*StepCacheStats)
+	step := ""
+	hit, miss := false, false
+
+	flush := func() {
+		if step == "" || (!hit && !miss) {
+			return
+		}
+		stats, ok := counts[step]
+		if !ok {
+			stats = &StepCacheStats{Step: step}
+			counts[step] = stats
+		}
+		if hit {
+			stats.Hits++
+		} else {
+			stats.Misses++
+		}
+	}
+
+	for _, line := range log.Lines {
+		if match := stepGroupPattern.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			flush()
+			step = strings.TrimSpace(match[1])
+			hit, miss = false, false
+			continue
+		}
+		if cacheHitPattern.MatchString(line) {
+			hit = true
+		} else if cacheMissPattern.MatchString(line) {
+			miss = true
+		}
+	}
+	flush()
+
+	return counts
+}
+
 // Helper functions
 
 func max(a, b int) int {
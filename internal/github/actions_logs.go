@@ -0,0 +1,141 @@
+package github
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultFlakyLogRuns is the number of recent failed runs whose logs are scanned for flaky tests
+const DefaultFlakyLogRuns = 10
+
+// DefaultCacheLogRuns is the number of recent runs whose logs are scanned for cache hits
+const DefaultCacheLogRuns = 10
+
+var goTestResultPattern = regexp.MustCompile(`--- (FAIL|PASS|SKIP): (\S+)`)
+
+// GetJobLog downloads the plain-text log for a workflow job
+func (c *Client) GetJobLog(owner, repo string, jobID int) ([]string, error) {
+	path := fmt.Sprintf("repos/%s/%s/actions/jobs/%d/logs", owner, repo, jobID)
+
+	resp, err := c.apiClient.Request("GET", c.resolveURL(path), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job log: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read job log: %w", err)
+	}
+
+	return lines, nil
+}
+
+// GetRunLogs downloads the job logs of each run, keyed by run ID
+// Logs expire or may be inaccessible, so jobs whose log cannot be fetched are skipped
+func (c *Client) GetRunLogs(owner, repo string, runs []RunTiming) map[int][]JobLog {
+	logs := make(map[int][]JobLog)
+	for _, run := range runs {
+		for _, job := range run.Jobs {
+			if job.ID == 0 {
+				continue
+			}
+
+			lines, err := c.GetJobLog(owner, repo, job.ID)
+			if err != nil {
+				continue
+			}
+
+			logs[run.RunID] = append(logs[run.RunID], JobLog{
+				JobID:      job.ID,
+				JobName:    job.Name,
+				WorkflowID: run.WorkflowID,
+				Repository: owner + "/" + repo,
+				Conclusion: job.Conclusion,
+				Lines:      lines,
+				Timestamp:  job.CompletedAt,
+			})
+		}
+	}
+	return logs
+}
+
+// GoTestLogConfig returns a log extraction config that keeps `go test -v` result lines
+func GoTestLogConfig() LogExtractionConfig {
+	return LogExtractionConfig{
+		TailLines:      100000,
+		ContextLines:   0,
+		FilterNoise:    true,
+		IncludeSuccess: true,
+		ErrorPatterns:  []string{`--- (FAIL|PASS|SKIP): `},
+	}
+}
+
+// SelectFailedRuns returns up to n failed runs in their existing order
+// Pure function: creates new slice
+func SelectFailedRuns(runs []RunTiming, n int) []RunTiming {
+	var failed []RunTiming
+	for _, run := range runs {
+		if len(failed) >= n {
+			break
+		}
+		if run.Conclusion == "failure" {
+			failed = append(failed, run)
+		}
+	}
+	return failed
+}
+
+// ExtractTestRuns converts go test result lines from extracted errors into test runs
+// Pure function: every result is attributed to the run's commit and creation time
+func ExtractTestRuns(contexts []*ErrorContext, run RunTiming) []TestRun {
+	var testRuns []TestRun
+	for _, ctx := range contexts {
+		for _, line := range ctx.ErrorLines {
+			match := goTestResultPattern.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+
+			status := "success"
+			switch match[1] {
+			case "FAIL":
+				status = "failure"
+			case "SKIP":
+				status = "skipped"
+			}
+
+			testRuns = append(testRuns, TestRun{
+				Name:       strings.TrimSpace(match[2]),
+				Status:     status,
+				CommitSHA:  run.HeadSHA,
+				Timestamp:  run.CreatedAt,
+				Repository: ctx.Repository,
+				WorkflowID: run.WorkflowID,
+			})
+		}
+	}
+	return testRuns
+}
+
+// DetectFlakyTestsFromRunLogs extracts go test results from job logs (keyed by run ID)
+// and detects flaky tests across the given runs
+// Pure function: no API calls
+func DetectFlakyTestsFromRunLogs(runs []RunTiming, logs map[int][]JobLog, config FlakyDetectionConfig) []FlakyTest {
+	logConfig := GoTestLogConfig()
+
+	var testRuns []TestRun
+	for _, run := range runs {
+		contexts := BatchExtractErrors(logs[run.RunID], run.Workflow, logConfig)
+		testRuns = append(testRuns, ExtractTestRuns(contexts, run)...)
+	}
+
+	return DetectFlakyTests(testRuns, config)
+}
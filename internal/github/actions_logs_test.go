@@ -0,0 +1,123 @@
+package github
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestGetJobLog tests downloading a plain-text job log
+func TestGetJobLog(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/actions/jobs/42/logs" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("=== RUN   TestA\n--- PASS: TestA (0.00s)\n"))
+	})
+
+	client := newTestClient(t, handler)
+
+	lines, err := client.GetJobLog("owner", "repo", 42)
+	if err != nil {
+		t.Fatalf("GetJobLog failed: %v", err)
+	}
+
+	expected := []string{"=== RUN   TestA", "--- PASS: TestA (0.00s)"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("Expected %v, got %v", expected, lines)
+	}
+}
+
+// TestGetRunLogs tests that logs are grouped by run and unavailable logs are skipped
+func TestGetRunLogs(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/actions/jobs/1/logs":
+			w.Write([]byte("Cache restored from key: deps\n"))
+		case "/repos/owner/repo/actions/jobs/2/logs":
+			w.WriteHeader(http.StatusGone)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(t, handler)
+
+	runs := []RunTiming{
+		{RunID: 10, Jobs: []JobTiming{{ID: 1, Name: "build"}, {Name: "no id"}}},
+		{RunID: 20, Jobs: []JobTiming{{ID: 2, Name: "expired"}}},
+	}
+	logs := client.GetRunLogs("owner", "repo", runs)
+
+	if len(logs) != 1 || len(logs[10]) != 1 {
+		t.Fatalf("Expected one log for run 10, got %+v", logs)
+	}
+	if log := logs[10][0]; log.JobName != "build" || log.Repository != "owner/repo" || len(log.Lines) != 1 {
+		t.Errorf("Unexpected log %+v", log)
+	}
+}
+
+// TestSelectFailedRuns tests picking the latest N failed runs
+func TestSelectFailedRuns(t *testing.T) {
+	runs := []RunTiming{
+		{RunID: 5, Conclusion: "failure"},
+		{RunID: 4, Conclusion: "success"},
+		{RunID: 3, Conclusion: "failure"},
+		{RunID: 2, Conclusion: "failure"},
+	}
+
+	failed := SelectFailedRuns(runs, 2)
+	if len(failed) != 2 || failed[0].RunID != 5 || failed[1].RunID != 3 {
+		t.Errorf("Expected runs 5 and 3, got %+v", failed)
+	}
+}
+
+// TestDetectFlakyTestsFromRunLogs tests flaky detection from go test output in job logs
+func TestDetectFlakyTestsFromRunLogs(t *testing.T) {
+	now := time.Now()
+
+	goTestLog := func(runID int, results ...string) []JobLog {
+		lines := []string{"2024-01-15T10:00:00.0000000Z ##[group]Run go test -v ./..."}
+		lines = append(lines, results...)
+		lines = append(lines, "FAIL", "Error: Process completed with exit code 1.")
+		return []JobLog{{JobID: runID * 10, JobName: "test", Repository: "owner/repo", Conclusion: "failure", Lines: lines}}
+	}
+
+	runs := []RunTiming{
+		{RunID: 1, Workflow: "CI", HeadSHA: "aaa", Conclusion: "failure", CreatedAt: now.Add(-4 * time.Hour)},
+		{RunID: 2, Workflow: "CI", HeadSHA: "aaa", Conclusion: "failure", CreatedAt: now.Add(-3 * time.Hour)},
+		{RunID: 3, Workflow: "CI", HeadSHA: "bbb", Conclusion: "failure", CreatedAt: now.Add(-2 * time.Hour)},
+		{RunID: 4, Workflow: "CI", HeadSHA: "ccc", Conclusion: "failure", CreatedAt: now.Add(-1 * time.Hour)},
+	}
+
+	logs := map[int][]JobLog{
+		1: goTestLog(1, "--- FAIL: TestFlaky (0.10s)", "--- FAIL: TestBroken (0.00s)", "--- PASS: TestStable (0.00s)"),
+		2: goTestLog(2, "    --- PASS: TestFlaky (0.10s)", "--- FAIL: TestBroken (0.00s)", "--- PASS: TestStable (0.00s)"),
+		3: goTestLog(3, "--- FAIL: TestFlaky (0.10s)", "--- FAIL: TestBroken (0.00s)", "--- PASS: TestStable (0.00s)"),
+		4: goTestLog(4, "--- PASS: TestFlaky (0.10s)", "--- FAIL: TestBroken (0.00s)", "--- SKIP: TestStable (0.00s)"),
+	}
+
+	flaky := DetectFlakyTestsFromRunLogs(runs, logs, DefaultFlakyConfig())
+
+	if len(flaky) != 1 {
+		t.Fatalf("Expected 1 flaky test, got %d: %+v", len(flaky), flaky)
+	}
+
+	test := flaky[0]
+	if test.Name != "TestFlaky" {
+		t.Errorf("Expected TestFlaky, got %s", test.Name)
+	}
+	if test.FlipCount != 3 {
+		t.Errorf("Expected 3 flips, got %d", test.FlipCount)
+	}
+	if test.FailureRate != 0.5 {
+		t.Errorf("Expected 50%% failure rate, got %.2f", test.FailureRate)
+	}
+	if test.Pattern != "same-commit-flip" {
+		t.Errorf("Expected same-commit-flip pattern, got %s", test.Pattern)
+	}
+}
@@ -517,3 +517,221 @@ func TestBatchExtractErrors(t *testing.T) {
 		t.Errorf("Expected 2 error contexts, got %d", len(contexts))
 	}
 }
+
+// TestLogConfigWithExtraPatterns tests that custom patterns are matched alongside the defaults
+func TestLogConfigWithExtraPatterns(t *testing.T) {
+	config, err := DefaultLogConfig().WithExtraPatterns([]string{`CUSTOM_ERROR\[\d+\]`})
+	if err != nil {
+		t.Fatalf("WithExtraPatterns failed: %v", err)
+	}
+
+	log := JobLog{
+		JobName:    "deploy",
+		Conclusion: "failure",
+		Lines: []string{
+			"Deploying...",
+			"CUSTOM_ERROR[42] quota exceeded",
+			"fatal: unable to push",
+			"done",
+		},
+	}
+
+	ctx := ExtractErrorContext(log, "deploy.yml", config)
+	if ctx == nil {
+		t.Fatal("Expected error context")
+	}
+	if !contains(ctx.ErrorLines, "CUSTOM_ERROR[42] quota exceeded") {
+		t.Errorf("Expected custom pattern match in %v", ctx.ErrorLines)
+	}
+	if !contains(ctx.ErrorLines, "fatal: unable to push") {
+		t.Errorf("Expected default pattern match in %v", ctx.ErrorLines)
+	}
+
+	if defaults := ExtractErrorContext(log, "deploy.yml", DefaultLogConfig()); contains(defaults.ErrorLines, "CUSTOM_ERROR[42] quota exceeded") {
+		t.Error("Expected custom line to be unmatched by default patterns")
+	}
+
+	if _, err := DefaultLogConfig().WithExtraPatterns([]string{`(unclosed`}); err == nil {
+		t.Error("Expected error for invalid regex")
+	}
+}
+
+// TestSummarizeErrorGroups tests grouping by error type sorted by count
+func TestSummarizeErrorGroups(t *testing.T) {
+	now := time.Now()
+	contexts := []*ErrorContext{
+		{JobName: "lint", ErrorType: "lint-error", Timestamp: now.Add(-3 * time.Hour)},
+		{JobName: "test", ErrorType: "test-failure", Timestamp: now.Add(-2 * time.Hour)},
+		{JobName: "build", ErrorType: "timeout", Timestamp: now.Add(-5 * time.Hour)},
+		{JobName: "test", ErrorType: "test-failure", Timestamp: now.Add(-1 * time.Hour)},
+		{JobName: "e2e", ErrorType: "test-failure", Timestamp: now.Add(-4 * time.Hour)},
+		{JobName: "deploy", ErrorType: "timeout", Timestamp: now},
+	}
+
+	grouped := GroupErrorsByType(contexts)
+	if len(grouped) != 3 {
+		t.Fatalf("Expected 3 error types, got %d", len(grouped))
+	}
+	if len(grouped["test-failure"]) != 3 {
+		t.Errorf("Expected 3 test failures, got %d", len(grouped["test-failure"]))
+	}
+
+	summaries := SummarizeErrorGroups(grouped)
+
+	wantOrder := []string{"test-failure", "timeout", "lint-error"}
+	wantCounts := []int{3, 2, 1}
+	for i, summary := range summaries {
+		if summary.ErrorType != wantOrder[i] || summary.Count != wantCounts[i] {
+			t.Errorf("Summary %d: expected %s x%d, got %s x%d",
+				i, wantOrder[i], wantCounts[i], summary.ErrorType, summary.Count)
+		}
+	}
+
+	if jobs := summaries[0].AffectedJobs; len(jobs) != 2 || jobs[0] != "e2e" || jobs[1] != "test" {
+		t.Errorf("Expected deduplicated sorted jobs [e2e test], got %v", jobs)
+	}
+	if !summaries[0].MostRecentTimestamp.Equal(now.Add(-1 * time.Hour)) {
+		t.Errorf("Expected most recent test failure 1h ago, got %v", summaries[0].MostRecentTimestamp)
+	}
+	if !summaries[1].MostRecentTimestamp.Equal(now) {
+		t.Errorf("Expected most recent timeout now, got %v", summaries[1].MostRecentTimestamp)
+	}
+}
+
+// TestExtractAnnotations tests parsing workflow command annotations with mixed levels
+func TestExtractAnnotations(t *testing.T) {
+	log := JobLog{
+		Lines: []string{
+			"2024-01-15T10:00:00.0000000Z Run npm run lint",
+			"2024-01-15T10:00:01.1234567Z ::error file=src/app.ts,line=12,col=5::Unexpected any",
+			"::warning file=src/util.ts,line=3,col=1::Unused variable 'x'",
+			"some regular output",
+			"::notice::Build cache restored",
+			"::error file=src/app.ts,line=40::Missing return type",
+		},
+	}
+
+	got := ExtractAnnotations(log)
+	want := []Annotation{
+		{Level: "error", File: "src/app.ts", Line: 12, Col: 5, Message: "Unexpected any"},
+		{Level: "warning", File: "src/util.ts", Line: 3, Col: 1, Message: "Unused variable 'x'"},
+		{Level: "notice", Message: "Build cache restored"},
+		{Level: "error", File: "src/app.ts", Line: 40, Message: "Missing return type"},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d annotations, got %d: %+v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Annotation %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+// TestGroupAnnotationsByFile tests grouping and ordering annotations by file and line
+func TestGroupAnnotationsByFile(t *testing.T) {
+	groups := GroupAnnotationsByFile([]Annotation{
+		{Level: "error", File: "src/app.ts", Line: 40},
+		{Level: "warning", File: "src/util.ts", Line: 3},
+		{Level: "notice"},
+		{Level: "error", File: "src/app.ts", Line: 12},
+	})
+
+	if len(groups) != 3 {
+		t.Fatalf("Expected 3 groups, got %+v", groups)
+	}
+	if groups[0].File != "" || groups[1].File != "src/app.ts" || groups[2].File != "src/util.ts" {
+		t.Errorf("Expected groups sorted by file, got %+v", groups)
+	}
+	if groups[1].Annotations[0].Line != 12 || groups[1].Annotations[1].Line != 40 {
+		t.Errorf("Expected app.ts annotations sorted by line, got %+v", groups[1].Annotations)
+	}
+}
+
+func cacheJobLog(restored bool) JobLog {
+	goCache := "2024-01-15T10:00:05.0000000Z Cache is not found"
+	depsCache := "2024-01-15T10:00:02.0000000Z Cache not found for input keys: deps-abc123"
+	if restored {
+		goCache = "2024-01-15T10:00:05.0000000Z Cache restored successfully"
+		depsCache = "2024-01-15T10:00:02.0000000Z Cache restored from key: deps-abc123"
+	}
+
+	return JobLog{
+		JobName: "build",
+		Lines: []string{
+			"2024-01-15T10:00:00.0000000Z ##[group]Run actions/checkout@v4",
+			"2024-01-15T10:00:00.5000000Z ##[endgroup]",
+			"2024-01-15T10:00:01.0000000Z ##[group]Run actions/cache@v4",
+			"2024-01-15T10:00:01.1000000Z with:",
+			"2024-01-15T10:00:01.2000000Z ##[endgroup]",
+			depsCache,
+			"2024-01-15T10:00:03.0000000Z Cache restored successfully",
+			"2024-01-15T10:00:04.0000000Z ##[group]Run actions/setup-go@v5",
+			"2024-01-15T10:00:04.5000000Z ##[endgroup]",
+			goCache,
+			"2024-01-15T10:00:06.0000000Z ##[group]Run go test ./...",
+			"2024-01-15T10:00:07.0000000Z ok  	example.com/cache	0.1s",
+		},
+	}
+}
+
+// TestParseCacheHitRate tests that each caching step is scored once per log
+func TestParseCacheHitRate(t *testing.T) {
+	rates := ParseCacheHitRate(cacheJobLog(true))
+	if len(rates) != 2 {
+		t.Fatalf("Expected 2 caching steps, got %v", rates)
+	}
+	if rates["Run actions/cache@v4"] != 100 || rates["Run actions/setup-go@v5"] != 100 {
+		t.Errorf("Expected 100%% hit rates, got %v", rates)
+	}
+	if _, ok := rates["Run go test ./..."]; ok {
+		t.Error("Expected step without cache messages to be omitted")
+	}
+
+	rates = ParseCacheHitRate(cacheJobLog(false))
+	if rates["Run actions/setup-go@v5"] != 0 {
+		t.Errorf("Expected setup-go miss, got %v", rates)
+	}
+	if rates["Run actions/cache@v4"] != 100 {
+		t.Errorf("Expected any restore message to count as a hit, got %v", rates)
+	}
+}
+
+// TestComputeWorkflowCacheStats tests hit rates aggregated across runs
+func TestComputeWorkflowCacheStats(t *testing.T) {
+	runs := []RunTiming{
+		{RunID: 1, Workflow: "CI"},
+		{RunID: 2, Workflow: "CI"},
+		{RunID: 3, Workflow: "CI"},
+		{RunID: 4, Workflow: "CI"},
+		{RunID: 5, Workflow: "Release"},
+	}
+	logs := map[int][]JobLog{
+		1: {cacheJobLog(true)},
+		2: {cacheJobLog(true)},
+		3: {cacheJobLog(true)},
+		4: {cacheJobLog(false)},
+	}
+
+	stats := ComputeWorkflowCacheStats(runs, logs)
+	if _, ok := stats["Release"]; ok {
+		t.Error("Expected runs without logs to be skipped")
+	}
+
+	ci := stats["CI"]
+	if ci == nil || ci.Runs != 4 {
+		t.Fatalf("Expected CI stats over 4 runs, got %+v", ci)
+	}
+
+	setupGo := ci.Steps["Run actions/setup-go@v5"]
+	if setupGo.Hits != 3 || setupGo.Misses != 1 {
+		t.Errorf("Expected 3 hits and 1 miss, got %+v", setupGo)
+	}
+	if rate := setupGo.HitRate(); rate != 75 {
+		t.Errorf("Expected 75%% hit rate, got %.1f", rate)
+	}
+	if rate := (StepCacheStats{}).HitRate(); rate != 0 {
+		t.Errorf("Expected 0%% without lookups, got %.1f", rate)
+	}
+}
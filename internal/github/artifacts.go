@@ -0,0 +1,145 @@
+package github
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Artifact represents a workflow run artifact
+type Artifact struct {
+	ID          int
+	Name        string
+	SizeInBytes int64
+	CreatedAt   time.Time
+	Expired     bool
+}
+
+type artifactsResponse struct {
+	TotalCount int `json:"total_count"`
+	Artifacts  []struct {
+		ID          int       `json:"id"`
+		Name        string    `json:"name"`
+		SizeInBytes int64     `json:"size_in_bytes"`
+		CreatedAt   time.Time `json:"created_at"`
+		Expired     bool      `json:"expired"`
+	} `json:"artifacts"`
+}
+
+// ArtifactCleanupPlan splits artifacts into those to delete and those to keep
+type ArtifactCleanupPlan struct {
+	ToDelete   []Artifact
+	ToKeep     []Artifact
+	FreedBytes int64 // Total size of ToDelete
+}
+
+// ListArtifacts lists all workflow run artifacts for a repository
+func (c *Client) ListArtifacts(owner, repo string) ([]Artifact, error) {
+	var artifacts []Artifact
+	perPage := 100
+
+	for page := 1; ; page++ {
+		var response artifactsResponse
+		path := fmt.Sprintf("repos/%s/%s/actions/artifacts?per_page=%d&page=%d", owner, repo, perPage, page)
+
+		if err := c.Get(path, &response); err != nil {
+			return nil, fmt.Errorf("failed to list artifacts: %w", err)
+		}
+
+		for _, a := range response.Artifacts {
+			artifacts = append(artifacts, Artifact{
+				ID:          a.ID,
+				Name:        a.Name,
+				SizeInBytes: a.SizeInBytes,
+				CreatedAt:   a.CreatedAt,
+				Expired:     a.Expired,
+			})
+		}
+
+		if len(response.Artifacts) < perPage {
+			break
+		}
+	}
+
+	return artifacts, nil
+}
+
+// DeleteArtifact deletes a workflow run artifact
+func (c *Client) DeleteArtifact(owner, repo string, artifactID int) error {
+	path := fmt.Sprintf("repos/%s/%s/actions/artifacts/%d", owner, repo, artifactID)
+
+	if err := c.Delete(path, nil); err != nil {
+		return fmt.Errorf("failed to delete artifact: %w", err)
+	}
+
+	return nil
+}
+
+// FilterArtifactsByPattern keeps artifacts whose name matches a filepath.Match pattern
+// Pure function: an invalid pattern matches nothing
+func FilterArtifactsByPattern(artifacts []Artifact, pattern string) []Artifact {
+	var filtered []Artifact
+	for _, a := range artifacts {
+		if matched, err := filepath.Match(pattern, a.Name); err == nil && matched {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered
+}
+
+// PlanArtifactCleanup keeps the newest keepLatest artifacts for each pattern and deletes the rest
+// Pure function: an artifact belongs to the first pattern it matches, and artifacts matching no
+// pattern are kept; with no patterns, artifacts are grouped by exact name
+func PlanArtifactCleanup(artifacts []Artifact, keepLatest int, patterns []string) ArtifactCleanupPlan {
+	if keepLatest < 0 {
+		keepLatest = 0
+	}
+
+	var plan ArtifactCleanupPlan
+	groups := make(map[string][]Artifact)
+	var groupOrder []string
+
+	for _, a := range artifacts {
+		key, ok := artifactGroup(a.Name, patterns)
+		if !ok {
+			plan.ToKeep = append(plan.ToKeep, a)
+			continue
+		}
+		if _, seen := groups[key]; !seen {
+			groupOrder = append(groupOrder, key)
+		}
+		groups[key] = append(groups[key], a)
+	}
+
+	for _, key := range groupOrder {
+		group := groups[key]
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].CreatedAt.After(group[j].CreatedAt)
+		})
+
+		for i, a := range group {
+			if i < keepLatest {
+				plan.ToKeep = append(plan.ToKeep, a)
+				continue
+			}
+			plan.ToDelete = append(plan.ToDelete, a)
+			plan.FreedBytes += a.SizeInBytes
+		}
+	}
+
+	return plan
+}
+
+// artifactGroup returns the cleanup group for an artifact name and whether it is in scope
+func artifactGroup(name string, patterns []string) (string, bool) {
+	if len(patterns) == 0 {
+		return name, true
+	}
+	for _, pattern := range patterns {
+		if matched, err := filepath.Match(pattern, name); err == nil && matched {
+			return pattern, true
+		}
+	}
+	return "", false
+}
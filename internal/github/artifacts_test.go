@@ -0,0 +1,99 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func coverageArtifacts() []Artifact {
+	created := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	var artifacts []Artifact
+	for i := 0; i < 5; i++ {
+		artifacts = append(artifacts, Artifact{
+			ID:          i + 1,
+			Name:        fmt.Sprintf("coverage-report-main-%03d", i),
+			SizeInBytes: 100,
+			CreatedAt:   created.AddDate(0, 0, i),
+		})
+	}
+	return artifacts
+}
+
+// TestFilterArtifactsByPattern tests glob matching on artifact names
+func TestFilterArtifactsByPattern(t *testing.T) {
+	artifacts := append(coverageArtifacts(), Artifact{ID: 9, Name: "dist"})
+
+	if got := FilterArtifactsByPattern(artifacts, "coverage-report-*"); len(got) != 5 {
+		t.Errorf("Expected 5 coverage artifacts, got %d", len(got))
+	}
+	if got := FilterArtifactsByPattern(artifacts, "dist"); len(got) != 1 || got[0].ID != 9 {
+		t.Errorf("Expected only dist, got %+v", got)
+	}
+	if got := FilterArtifactsByPattern(artifacts, "[invalid"); len(got) != 0 {
+		t.Errorf("Expected invalid pattern to match nothing, got %+v", got)
+	}
+}
+
+// TestPlanArtifactCleanup tests that the newest artifacts per pattern are kept
+func TestPlanArtifactCleanup(t *testing.T) {
+	artifacts := append(coverageArtifacts(), Artifact{ID: 9, Name: "dist", SizeInBytes: 5000})
+
+	plan := PlanArtifactCleanup(artifacts, 2, []string{"coverage-report-*"})
+
+	if len(plan.ToDelete) != 3 {
+		t.Fatalf("Expected 3 artifacts to delete, got %+v", plan.ToDelete)
+	}
+	for _, a := range plan.ToDelete {
+		if a.ID > 3 {
+			t.Errorf("Expected the oldest artifacts to be deleted, got ID %d", a.ID)
+		}
+	}
+	if plan.FreedBytes != 300 {
+		t.Errorf("Expected 300 freed bytes, got %d", plan.FreedBytes)
+	}
+
+	kept := make(map[int]bool)
+	for _, a := range plan.ToKeep {
+		kept[a.ID] = true
+	}
+	if len(kept) != 3 || !kept[4] || !kept[5] || !kept[9] {
+		t.Errorf("Expected the 2 newest coverage artifacts and unmatched dist to be kept, got %+v", plan.ToKeep)
+	}
+}
+
+// TestPlanArtifactCleanupByName tests grouping by exact name when no patterns are given
+func TestPlanArtifactCleanupByName(t *testing.T) {
+	created := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	artifacts := []Artifact{
+		{ID: 1, Name: "dist", CreatedAt: created},
+		{ID: 2, Name: "dist", CreatedAt: created.Add(time.Hour)},
+		{ID: 3, Name: "docs", CreatedAt: created},
+	}
+
+	plan := PlanArtifactCleanup(artifacts, 1, nil)
+	if len(plan.ToDelete) != 1 || plan.ToDelete[0].ID != 1 {
+		t.Errorf("Expected only the older dist to be deleted, got %+v", plan.ToDelete)
+	}
+}
+
+// TestListArtifacts tests artifact listing against a mocked API
+func TestListArtifacts(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"total_count": 1, "artifacts": [
+			{"id": 7, "name": "coverage-report-main-abc123", "size_in_bytes": 2048, "created_at": "2024-05-01T00:00:00Z", "expired": false}
+		]}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	artifacts, err := client.ListArtifacts("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListArtifacts failed: %v", err)
+	}
+	if len(artifacts) != 1 || artifacts[0].ID != 7 || artifacts[0].SizeInBytes != 2048 {
+		t.Errorf("Unexpected artifacts: %+v", artifacts)
+	}
+}
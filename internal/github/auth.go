@@ -0,0 +1,88 @@
+package github
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/cli/go-gh/pkg/auth"
+)
+
+// AuthSource identifies where the token used by a Client came from
+type AuthSource string
+
+const (
+	AuthSourceFlag        AuthSource = "--token flag"
+	AuthSourceConfig      AuthSource = "config github.token"
+	AuthSourceGHToken     AuthSource = "GH_TOKEN"
+	AuthSourceGitHubToken AuthSource = "GITHUB_TOKEN"
+	AuthSourceGHCLI       AuthSource = "gh CLI"
+)
+
+// ErrNoToken is returned when no auth source provides a token
+var ErrNoToken = errors.New("no GitHub token found: pass --token, set github.token in config, set GH_TOKEN or GITHUB_TOKEN, or run 'gh auth login'")
+
+// TokenOptions holds the explicitly configured tokens, which take precedence over the environment
+type TokenOptions struct {
+	FlagToken   string // --token
+	ConfigToken string // github.token in .gh-sweep.yaml
+}
+
+var tokenOptions TokenOptions
+
+// ghCLIToken looks up the token stored by 'gh auth login --hostname host'; replaced in tests
+var ghCLIToken = func(host string) string {
+	token, _ := auth.TokenForHost(host)
+	return token
+}
+
+// ghCLIHost returns the gh CLI login host for the API root requests are sent to,
+// e.g. github.com for https://api.github.com and ghe.corp for https://ghe.corp/api/v3
+func ghCLIHost() (string, error) {
+	host, err := apiHost(ResolveAPIURL(configAPIURL))
+	if err != nil {
+		return "", err
+	}
+	if rest, ok := strings.CutPrefix(host, "api."); ok && (rest == "github.com" || strings.HasSuffix(rest, ".ghe.com")) {
+		return rest, nil
+	}
+	return host, nil
+}
+
+// SetTokenOptions registers the tokens NewClient should prefer over the environment
+func SetTokenOptions(opts TokenOptions) {
+	tokenOptions = opts
+}
+
+// ResolveToken picks a token by precedence: --token > config > GH_TOKEN > GITHUB_TOKEN > gh CLI
+func ResolveToken(opts TokenOptions) (string, AuthSource, error) {
+	if opts.FlagToken != "" {
+		return opts.FlagToken, AuthSourceFlag, nil
+	}
+	if opts.ConfigToken != "" {
+		return opts.ConfigToken, AuthSourceConfig, nil
+	}
+	if token := os.Getenv("GH_TOKEN"); token != "" {
+		return token, AuthSourceGHToken, nil
+	}
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		return token, AuthSourceGitHubToken, nil
+	}
+	if host, err := ghCLIHost(); err == nil {
+		if token := ghCLIToken(host); token != "" {
+			return token, AuthSourceGHCLI, nil
+		}
+	}
+	return "", "", ErrNoToken
+}
+
+// resolveClientToken resolves the token for NewClient and logs its source at debug level
+func resolveClientToken() (string, error) {
+	token, source, err := ResolveToken(tokenOptions)
+	if err != nil {
+		return "", err
+	}
+	slog.Debug("resolved GitHub auth", "source", string(source))
+	return token, nil
+}
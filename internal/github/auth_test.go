@@ -0,0 +1,126 @@
+package github
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestResolveToken tests the auth source precedence: --token > config > GH_TOKEN > GITHUB_TOKEN > gh CLI
+func TestResolveToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        TokenOptions
+		ghToken     string
+		githubToken string
+		cliToken    string
+		wantToken   string
+		wantSource  AuthSource
+	}{
+		{
+			name:        "flag wins over everything",
+			opts:        TokenOptions{FlagToken: "flag", ConfigToken: "config"},
+			ghToken:     "gh",
+			githubToken: "github",
+			cliToken:    "cli",
+			wantToken:   "flag",
+			wantSource:  AuthSourceFlag,
+		},
+		{
+			name:        "config wins over environment",
+			opts:        TokenOptions{ConfigToken: "config"},
+			ghToken:     "gh",
+			githubToken: "github",
+			wantToken:   "config",
+			wantSource:  AuthSourceConfig,
+		},
+		{
+			name:        "GH_TOKEN wins over GITHUB_TOKEN",
+			ghToken:     "gh",
+			githubToken: "github",
+			wantToken:   "gh",
+			wantSource:  AuthSourceGHToken,
+		},
+		{
+			name:        "GITHUB_TOKEN wins over gh CLI",
+			githubToken: "github",
+			cliToken:    "cli",
+			wantToken:   "github",
+			wantSource:  AuthSourceGitHubToken,
+		},
+		{
+			name:       "gh CLI as last resort",
+			cliToken:   "cli",
+			wantToken:  "cli",
+			wantSource: AuthSourceGHCLI,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GH_TOKEN", tt.ghToken)
+			t.Setenv("GITHUB_TOKEN", tt.githubToken)
+			stubGHCLIToken(t, tt.cliToken)
+
+			token, source, err := ResolveToken(tt.opts)
+			if err != nil {
+				t.Fatalf("ResolveToken() error = %v", err)
+			}
+			if token != tt.wantToken || source != tt.wantSource {
+				t.Errorf("ResolveToken() = %q (%s), want %q (%s)", token, source, tt.wantToken, tt.wantSource)
+			}
+		})
+	}
+}
+
+// TestResolveToken_NoSource tests that an error is returned when no token is available
+func TestResolveToken_NoSource(t *testing.T) {
+	t.Setenv("GH_TOKEN", "")
+	t.Setenv("GITHUB_TOKEN", "")
+	stubGHCLIToken(t, "")
+
+	_, _, err := ResolveToken(TokenOptions{})
+	if !errors.Is(err, ErrNoToken) {
+		t.Errorf("Expected ErrNoToken, got %v", err)
+	}
+}
+
+func stubGHCLIToken(t *testing.T, token string) {
+	t.Helper()
+	original := ghCLIToken
+	ghCLIToken = func(string) string { return token }
+	t.Cleanup(func() { ghCLIToken = original })
+}
+
+// TestResolveToken_GHCLIHost tests that the gh CLI token is looked up for the host requests go to
+func TestResolveToken_GHCLIHost(t *testing.T) {
+	tests := []struct {
+		apiURL   string
+		wantHost string
+	}{
+		{"", "github.com"},
+		{"https://ghe.corp/api/v3", "ghe.corp"},
+		{"https://api.acme.ghe.com", "acme.ghe.com"},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("GH_TOKEN", "")
+		t.Setenv("GITHUB_TOKEN", "")
+		t.Setenv("GITHUB_API_URL", tt.apiURL)
+
+		var gotHost string
+		original := ghCLIToken
+		ghCLIToken = func(host string) string {
+			gotHost = host
+			return "cli"
+		}
+
+		_, source, err := ResolveToken(TokenOptions{})
+		ghCLIToken = original
+		if err != nil || source != AuthSourceGHCLI {
+			t.Fatalf("%q: expected gh CLI token, got %s (err: %v)", tt.apiURL, source, err)
+		}
+		if gotHost != tt.wantHost {
+			t.Errorf("%q: expected gh CLI lookup for %s, got %s", tt.apiURL, tt.wantHost, gotHost)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package github
+
+import (
+	"math"
+	"time"
+)
+
+// Default scales for ComputeBranchDivergenceMatrix; values at or beyond them get the maximum risk
+const (
+	DefaultDivergenceMaxAge    = 90 // Days since the last commit
+	DefaultDivergenceMaxBehind = 50 // Commits behind the compared branch
+)
+
+// DivergenceCell places one branch by age and by how far it is behind its base
+type DivergenceCell struct {
+	BranchName    string
+	AgeInDays     int
+	BehindCommits int
+	RiskScore     float64 // 0 (fresh, up to date) to 1 (at or beyond both maximums)
+}
+
+// ComputeBranchDivergenceMatrix scores each branch by age and divergence relative to maxAge and maxBehind
+// The branch each entry is compared to is skipped
+func ComputeBranchDivergenceMatrix(branches []BranchWithComparison, maxAge, maxBehind int) []DivergenceCell {
+	return computeBranchDivergenceMatrix(branches, maxAge, maxBehind, time.Now())
+}
+
+func computeBranchDivergenceMatrix(branches []BranchWithComparison, maxAge, maxBehind int, now time.Time) []DivergenceCell {
+	cells := make([]DivergenceCell, 0, len(branches))
+	for _, branch := range branches {
+		if branch.Name == branch.ComparedTo {
+			continue
+		}
+
+		age := 0
+		if !branch.LastCommitDate.IsZero() {
+			age = int(now.Sub(branch.LastCommitDate).Hours() / 24)
+		}
+
+		cells = append(cells, DivergenceCell{
+			BranchName:    branch.Name,
+			AgeInDays:     age,
+			BehindCommits: branch.Behind,
+			RiskScore:     (scaleToUnit(age, maxAge) + scaleToUnit(branch.Behind, maxBehind)) / 2,
+		})
+	}
+	return cells
+}
+
+// DivergenceGrid counts cells per bucket, indexed [age bucket][behind bucket]
+// Pure function: each axis is split into buckets equal ranges up to its maximum; larger values land in the last bucket
+func DivergenceGrid(cells []DivergenceCell, maxAge, maxBehind, buckets int) [][]int {
+	if buckets < 1 {
+		buckets = 1
+	}
+
+	grid := make([][]int, buckets)
+	for i := range grid {
+		grid[i] = make([]int, buckets)
+	}
+
+	for _, cell := range cells {
+		grid[DivergenceBucket(cell.AgeInDays, maxAge, buckets)][DivergenceBucket(cell.BehindCommits, maxBehind, buckets)]++
+	}
+	return grid
+}
+
+// DivergenceBucket returns which of buckets equal ranges in [0, max) value falls in, clamped to the last bucket
+func DivergenceBucket(value, max, buckets int) int {
+	if max <= 0 || value <= 0 {
+		return 0
+	}
+	bucket := value * buckets / max
+	if bucket >= buckets {
+		return buckets - 1
+	}
+	return bucket
+}
+
+func scaleToUnit(value, max int) float64 {
+	if max <= 0 {
+		return 0
+	}
+	return math.Min(float64(value)/float64(max), 1)
+}
@@ -0,0 +1,54 @@
+package github
+
+import (
+	"testing"
+	"time"
+)
+
+// TestComputeBranchDivergenceMatrix tests that branches in each age/behind quadrant are counted once
+func TestComputeBranchDivergenceMatrix(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	branch := func(name string, ageDays, behind int) BranchWithComparison {
+		return BranchWithComparison{
+			Branch:     Branch{Name: name, Behind: behind, LastCommitDate: now.AddDate(0, 0, -ageDays)},
+			ComparedTo: "main",
+		}
+	}
+
+	branches := []BranchWithComparison{
+		{Branch: Branch{Name: "main", LastCommitDate: now}, ComparedTo: "main"},
+		branch("fresh-close", 2, 1),
+		branch("fresh-far", 5, 40),
+		branch("old-close", 60, 3),
+		branch("old-far", 200, 120),
+	}
+
+	cells := computeBranchDivergenceMatrix(branches, 90, 50, now)
+	if len(cells) != 4 {
+		t.Fatalf("Expected the base branch to be skipped, got %d cells", len(cells))
+	}
+
+	grid := DivergenceGrid(cells, 90, 50, 2)
+	for age := 0; age < 2; age++ {
+		for behind := 0; behind < 2; behind++ {
+			if grid[age][behind] != 1 {
+				t.Errorf("Expected 1 branch in quadrant [%d][%d], got %d", age, behind, grid[age][behind])
+			}
+		}
+	}
+
+	byName := make(map[string]DivergenceCell)
+	for _, cell := range cells {
+		byName[cell.BranchName] = cell
+	}
+	if byName["old-far"].RiskScore != 1 {
+		t.Errorf("Expected maximum risk beyond both limits, got %.2f", byName["old-far"].RiskScore)
+	}
+	if byName["fresh-close"].RiskScore >= byName["old-close"].RiskScore {
+		t.Errorf("Expected older branch to be riskier, got %.2f >= %.2f",
+			byName["fresh-close"].RiskScore, byName["old-close"].RiskScore)
+	}
+	if byName["old-close"].AgeInDays != 60 || byName["old-close"].BehindCommits != 3 {
+		t.Errorf("Unexpected cell: %+v", byName["old-close"])
+	}
+}
@@ -0,0 +1,122 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// BranchImpact summarizes open work that would be affected by deleting a branch
+type BranchImpact struct {
+	OpenPRsAsHead int
+	OpenPRsAsBase int
+	LinkedIssues  []int // Open issues referenced by commits unique to the branch
+}
+
+// HasImpact reports whether deleting the branch would affect any open PR or issue
+func (i BranchImpact) HasImpact() bool {
+	return i.OpenPRsAsHead > 0 || i.OpenPRsAsBase > 0 || len(i.LinkedIssues) > 0
+}
+
+var issueReferencePattern = regexp.MustCompile(`(?:^|[^\w/])#(\d+)\b`)
+
+// GetBranchDeletionImpact counts the open PRs using branch as head or base and the open issues
+// referenced by commits on branch that are not on the default branch
+func (c *Client) GetBranchDeletionImpact(owner, repo, branch string) (*BranchImpact, error) {
+	var (
+		wg               sync.WaitGroup
+		headPRs, basePRs []PullRequest
+		headErr, baseErr error
+	)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		headPRs, headErr = c.ListPRsWithHead(owner, repo, branch)
+	}()
+	go func() {
+		defer wg.Done()
+		basePRs, baseErr = c.ListPRsWithBase(owner, repo, branch)
+	}()
+	wg.Wait()
+
+	if headErr != nil {
+		return nil, fmt.Errorf("failed to get branch deletion impact: %w", headErr)
+	}
+	if baseErr != nil {
+		return nil, fmt.Errorf("failed to get branch deletion impact: %w", baseErr)
+	}
+
+	issues, err := c.linkedOpenIssues(owner, repo, branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch deletion impact: %w", err)
+	}
+
+	return &BranchImpact{
+		OpenPRsAsHead: len(headPRs),
+		OpenPRsAsBase: len(basePRs),
+		LinkedIssues:  issues,
+	}, nil
+}
+
+// linkedOpenIssues returns the open issues referenced by commits on branch but not on the default branch
+func (c *Client) linkedOpenIssues(owner, repo, branch string) ([]int, error) {
+	defaultBranch, err := c.GetDefaultBranch(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	if defaultBranch == branch {
+		return nil, nil
+	}
+
+	var response compareCommitsResponse
+	path := fmt.Sprintf("repos/%s/%s/compare/%s...%s", owner, repo, defaultBranch, branch)
+	if err := c.Get(path, &response); err != nil {
+		return nil, fmt.Errorf("failed to compare branch: %w", err)
+	}
+
+	messages := make([]string, len(response.Commits))
+	for i, commit := range response.Commits {
+		messages[i] = commit.Commit.Message
+	}
+
+	var open []int
+	for _, number := range ExtractIssueReferences(messages) {
+		var issue struct {
+			State       string    `json:"state"`
+			PullRequest *struct{} `json:"pull_request"`
+		}
+		// Missing or transferred issues are not an impact, so lookup errors are skipped
+		if err := c.Get(fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, number), &issue); err != nil {
+			continue
+		}
+		if issue.State == "open" && issue.PullRequest == nil {
+			open = append(open, number)
+		}
+	}
+
+	return open, nil
+}
+
+// ExtractIssueReferences returns the sorted, unique #N issue numbers mentioned in messages
+// Pure function: cross-repo references like owner/repo#N are ignored
+func ExtractIssueReferences(messages []string) []int {
+	seen := make(map[int]bool)
+	var numbers []int
+
+	for _, message := range messages {
+		for _, match := range issueReferencePattern.FindAllStringSubmatch(message, -1) {
+			number, err := strconv.Atoi(match[1])
+			if err != nil || number == 0 || seen[number] {
+				continue
+			}
+			seen[number] = true
+			numbers = append(numbers, number)
+		}
+	}
+
+	sort.Ints(numbers)
+	return numbers
+}
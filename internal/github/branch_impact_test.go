@@ -0,0 +1,71 @@
+package github
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// TestGetBranchDeletionImpact tests counting open PRs and linked open issues for a branch
+func TestGetBranchDeletionImpact(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/owner/repo/pulls" && r.URL.Query().Get("head") == "owner:feature":
+			w.Write([]byte(`[
+				{"number": 1, "title": "Feature part 1", "state": "open"},
+				{"number": 2, "title": "Feature part 2", "state": "open"}
+			]`))
+		case r.URL.Path == "/repos/owner/repo/pulls" && r.URL.Query().Get("base") == "feature":
+			w.Write([]byte(`[]`))
+		case r.URL.Path == "/repos/owner/repo":
+			w.Write([]byte(`{"default_branch": "main"}`))
+		case r.URL.Path == "/repos/owner/repo/compare/main...feature":
+			w.Write([]byte(`{"commits": [
+				{"sha": "aaa", "commit": {"message": "Fix parser, closes #7"}},
+				{"sha": "bbb", "commit": {"message": "Refs #8 and other/repo#9"}}
+			]}`))
+		case r.URL.Path == "/repos/owner/repo/issues/7":
+			w.Write([]byte(`{"number": 7, "state": "open"}`))
+		case r.URL.Path == "/repos/owner/repo/issues/8":
+			w.Write([]byte(`{"number": 8, "state": "closed"}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(t, handler)
+
+	impact, err := client.GetBranchDeletionImpact("owner", "repo", "feature")
+	if err != nil {
+		t.Fatalf("GetBranchDeletionImpact failed: %v", err)
+	}
+
+	if impact.OpenPRsAsHead != 2 {
+		t.Errorf("Expected OpenPRsAsHead 2, got %d", impact.OpenPRsAsHead)
+	}
+	if impact.OpenPRsAsBase != 0 {
+		t.Errorf("Expected OpenPRsAsBase 0, got %d", impact.OpenPRsAsBase)
+	}
+	if !reflect.DeepEqual(impact.LinkedIssues, []int{7}) {
+		t.Errorf("Expected only open issue #7 to be linked, got %v", impact.LinkedIssues)
+	}
+	if !impact.HasImpact() {
+		t.Error("Expected impact to be reported")
+	}
+}
+
+// TestExtractIssueReferences tests #N parsing from commit messages
+func TestExtractIssueReferences(t *testing.T) {
+	messages := []string{
+		"Fix #12 and #3",
+		"#3 again, see https://example.com/page#4",
+		"Cross-repo owner/repo#5 is ignored",
+		"(#40)",
+	}
+
+	got := ExtractIssueReferences(messages)
+	if want := []int{3, 12, 40}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractIssueReferences() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,135 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultRestoreWindow is how far back the events API is searched for a branch deletion
+// GitHub only returns events from the past 90 days
+const DefaultRestoreWindow = 30 * 24 * time.Hour
+
+// maxEventPages caps event pagination; the events API returns at most 300 events
+const maxEventPages = 3
+
+const zeroSHA = "0000000000000000000000000000000000000000"
+
+type repoEvent struct {
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+type deleteEventPayload struct {
+	Ref     string `json:"ref"`
+	RefType string `json:"ref_type"`
+}
+
+type pushEventPayload struct {
+	Ref    string `json:"ref"`
+	Head   string `json:"head"`
+	Before string `json:"before"`
+}
+
+// CreateBranchRef creates a branch pointing at sha
+func (c *Client) CreateBranchRef(owner, repo, branch, sha string) error {
+	body := map[string]string{
+		"ref": "refs/heads/" + branch,
+		"sha": sha,
+	}
+	path := fmt.Sprintf("repos/%s/%s/git/refs", owner, repo)
+
+	if err := c.Post(path, body, nil); err != nil {
+		return fmt.Errorf("failed to create branch %s: %w", branch, err)
+	}
+
+	return nil
+}
+
+// GetDeletedBranchRef recovers the SHA a branch pointed to before it was deleted
+// A DeleteEvent for the branch since the given time confirms the deletion; the SHA comes from
+// the last PushEvent to the branch before it, since delete events do not record one
+func (c *Client) GetDeletedBranchRef(owner, repo, branchName string, since time.Time) (string, error) {
+	var events []repoEvent
+	perPage := 100
+
+	for page := 1; page <= maxEventPages; page++ {
+		var response []repoEvent
+		path := fmt.Sprintf("repos/%s/%s/events?per_page=%d&page=%d", owner, repo, perPage, page)
+
+		if err := c.Get(path, &response); err != nil {
+			return "", fmt.Errorf("failed to list repository events: %w", err)
+		}
+
+		events = append(events, response...)
+
+		// Events are newest first, so older pages cannot match
+		if len(response) < perPage || response[len(response)-1].CreatedAt.Before(since) {
+			break
+		}
+	}
+
+	return findDeletedBranchSHA(events, branchName, since)
+}
+
+// RestoreDeletedBranch recreates a deleted branch at the SHA recovered from the events API
+func (c *Client) RestoreDeletedBranch(owner, repo, branchName string, since time.Time) (string, error) {
+	sha, err := c.GetDeletedBranchRef(owner, repo, branchName, since)
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.CreateBranchRef(owner, repo, branchName, sha); err != nil {
+		return "", err
+	}
+
+	return sha, nil
+}
+
+// findDeletedBranchSHA locates the most recent deletion of branch and the SHA pushed before it
+// Pure function: events must be ordered newest first, as returned by the API
+func findDeletedBranchSHA(events []repoEvent, branch string, since time.Time) (string, error) {
+	ref := "refs/heads/" + branch
+	deleted := false
+
+	for _, event := range events {
+		if event.CreatedAt.Before(since) {
+			break
+		}
+
+		switch event.Type {
+		case "DeleteEvent":
+			var payload deleteEventPayload
+			if err := json.Unmarshal(event.Payload, &payload); err != nil {
+				continue
+			}
+			if payload.RefType == "branch" && strings.TrimPrefix(payload.Ref, "refs/heads/") == branch {
+				deleted = true
+			}
+
+		case "PushEvent":
+			var payload pushEventPayload
+			if err := json.Unmarshal(event.Payload, &payload); err != nil || payload.Ref != ref {
+				continue
+			}
+			// A push that deletes the branch moves head to the zero SHA from the last commit
+			if payload.Head == zeroSHA {
+				deleted = true
+				if payload.Before != "" && payload.Before != zeroSHA {
+					return payload.Before, nil
+				}
+				continue
+			}
+			if deleted && payload.Head != "" {
+				return payload.Head, nil
+			}
+		}
+	}
+
+	if !deleted {
+		return "", fmt.Errorf("no deletion of branch %s found since %s", branch, since.Format("2006-01-02"))
+	}
+	return "", fmt.Errorf("branch %s was deleted but no push event records its last SHA", branch)
+}
@@ -0,0 +1,85 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const restoreEventsJSON = `[
+	{"type": "DeleteEvent", "created_at": "2024-03-10T12:00:00Z", "payload": {"ref": "feature/login", "ref_type": "branch"}},
+	{"type": "PushEvent", "created_at": "2024-03-09T08:00:00Z", "payload": {"ref": "refs/heads/main", "head": "aaa111", "before": "aaa000"}},
+	{"type": "PushEvent", "created_at": "2024-03-08T08:00:00Z", "payload": {"ref": "refs/heads/feature/login", "head": "bbb222", "before": "bbb111"}},
+	{"type": "PushEvent", "created_at": "2024-03-07T08:00:00Z", "payload": {"ref": "refs/heads/feature/login", "head": "bbb111", "before": "bbb000"}}
+]`
+
+// TestRestoreDeletedBranch tests that the SHA from the events API is used to recreate the branch
+func TestRestoreDeletedBranch(t *testing.T) {
+	var created map[string]string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/acme/api/events":
+			w.Write([]byte(restoreEventsJSON))
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/acme/api/git/refs":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(t, handler)
+	since := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	sha, err := client.GetDeletedBranchRef("acme", "api", "feature/login", since)
+	if err != nil {
+		t.Fatalf("GetDeletedBranchRef failed: %v", err)
+	}
+	if sha != "bbb222" {
+		t.Errorf("Expected the last pushed SHA bbb222, got %s", sha)
+	}
+
+	sha, err = client.RestoreDeletedBranch("acme", "api", "feature/login", since)
+	if err != nil {
+		t.Fatalf("RestoreDeletedBranch failed: %v", err)
+	}
+	if sha != "bbb222" || created["ref"] != "refs/heads/feature/login" || created["sha"] != "bbb222" {
+		t.Errorf("Expected refs/heads/feature/login created at bbb222, got %v (sha %s)", created, sha)
+	}
+}
+
+// TestFindDeletedBranchSHA tests deletion matching, the time window, and deletion pushes
+func TestFindDeletedBranchSHA(t *testing.T) {
+	var events []repoEvent
+	if err := json.Unmarshal([]byte(restoreEventsJSON), &events); err != nil {
+		t.Fatal(err)
+	}
+	since := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := findDeletedBranchSHA(events, "feature/other", since); err == nil || !strings.Contains(err.Error(), "no deletion") {
+		t.Errorf("Expected no deletion for an unrelated branch, got %v", err)
+	}
+
+	if _, err := findDeletedBranchSHA(events, "feature/login", time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("Expected deletions before the window to be ignored")
+	}
+
+	withDeletionPush := []repoEvent{
+		{Type: "PushEvent", CreatedAt: time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC),
+			Payload: json.RawMessage(`{"ref": "refs/heads/hotfix", "head": "` + zeroSHA + `", "before": "ccc333"}`)},
+		{Type: "DeleteEvent", CreatedAt: time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC),
+			Payload: json.RawMessage(`{"ref": "hotfix", "ref_type": "branch"}`)},
+	}
+	if sha, err := findDeletedBranchSHA(withDeletionPush, "hotfix", since); err != nil || sha != "ccc333" {
+		t.Errorf("Expected the before SHA of the deletion push, got %q (%v)", sha, err)
+	}
+
+	deleteOnly := []repoEvent{events[0]}
+	if _, err := findDeletedBranchSHA(deleteOnly, "feature/login", since); err == nil || !strings.Contains(err.Error(), "no push event") {
+		t.Errorf("Expected missing SHA error, got %v", err)
+	}
+}
@@ -34,7 +34,7 @@ func (c *Client) ListBranches(owner, repo string) ([]Branch, error) {
 	var response []branchListResponse
 	path := fmt.Sprintf("repos/%s/%s/branches", owner, repo)
 
-	if err := c.Get(path, &response); err != nil {
+	if err := c.GetAllPages(path, &response); err != nil {
 		return nil, fmt.Errorf("failed to list branches: %w", err)
 	}
 
@@ -6,6 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/cli/go-gh"
 	"github.com/cli/go-gh/pkg/api"
@@ -16,37 +21,92 @@ type Client struct {
 	httpClient *http.Client
 	apiClient  api.RESTClient
 	ctx        context.Context
+	baseURL    string     // REST API root that relative paths are resolved against
+	etagCache  bool       // Revalidate repeated GETs with If-None-Match; see WithETagCache
+	etags      *etagCache // Nil when etagCache is disabled
+	retry      RetryConfig
 }
 
-// NewClient creates a new GitHub API client
-// It will use gh CLI authentication if available, or fall back to GITHUB_TOKEN env var
-func NewClient(ctx context.Context) (*Client, error) {
-	opts := &api.ClientOptions{}
+// ClientOption configures a Client
+type ClientOption func(*Client)
 
-	// Create REST client (will use gh CLI auth or GITHUB_TOKEN)
-	restClient, err := gh.RESTClient(opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+// WithETagCache toggles conditional requests backed by the in-memory ETag cache (enabled by default)
+func WithETagCache(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.etagCache = enabled
 	}
+}
 
-	// Create HTTP client
-	httpClient, err := gh.HTTPClient(opts)
+// DefaultAPIURL is the REST API root used when neither GITHUB_API_URL nor github.api_url is set
+const DefaultAPIURL = "https://api.github.com"
+
+var configAPIURL string
+
+// SetAPIURL registers the github.api_url config value NewClient uses when GITHUB_API_URL is unset
+func SetAPIURL(apiURL string) {
+	configAPIURL = apiURL
+}
+
+// ResolveAPIURL picks the REST API root by precedence: GITHUB_API_URL > configured > DefaultAPIURL
+// GitHub Enterprise Server roots look like https://github.mycompany.com/api/v3
+func ResolveAPIURL(configured string) string {
+	if apiURL := os.Getenv("GITHUB_API_URL"); apiURL != "" {
+		return strings.TrimSuffix(apiURL, "/")
+	}
+	if configured != "" {
+		return strings.TrimSuffix(configured, "/")
+	}
+	return DefaultAPIURL
+}
+
+// apiHost returns the hostname of apiURL, which go-gh uses to scope the auth header
+func apiHost(apiURL string) (string, error) {
+	parsed, err := url.Parse(apiURL)
+	if err != nil || parsed.Hostname() == "" {
+		return "", fmt.Errorf("invalid GitHub API URL %q", apiURL)
+	}
+	return parsed.Hostname(), nil
+}
+
+// NewClient creates a new GitHub API client
+// The token is resolved by ResolveToken: --token, config, GH_TOKEN, GITHUB_TOKEN, then gh CLI auth
+func NewClient(ctx context.Context, options ...ClientOption) (*Client, error) {
+	token, err := resolveClientToken()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
 	}
 
-	return &Client{
-		httpClient: httpClient,
-		apiClient:  restClient,
-		ctx:        ctx,
-	}, nil
+	return NewClientWithToken(ctx, token, options...)
 }
 
 // NewClientWithToken creates a new GitHub API client with an explicit token
-func NewClientWithToken(ctx context.Context, token string) (*Client, error) {
+// Requests are sent to the API root chosen by ResolveAPIURL
+func NewClientWithToken(ctx context.Context, token string, options ...ClientOption) (*Client, error) {
+	c := &Client{
+		ctx:       ctx,
+		baseURL:   ResolveAPIURL(configAPIURL),
+		etagCache: true,
+		retry:     DefaultRetryConfig,
+	}
+	for _, option := range options {
+		option(c)
+	}
+
+	host, err := apiHost(c.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
 	opts := &api.ClientOptions{
+		Host:      host,
 		AuthToken: token,
 	}
+	var transport http.RoundTripper = &retryTransport{cfg: c.retry, rt: http.DefaultTransport}
+	if c.etagCache {
+		c.etags = newETagCache(etagCacheMaxEntries)
+		transport = &etagTransport{cache: c.etags, rt: transport}
+	}
+	opts.Transport = transport
 
 	restClient, err := gh.RESTClient(opts)
 	if err != nil {
@@ -58,16 +118,79 @@ func NewClientWithToken(ctx context.Context, token string) (*Client, error) {
 		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
 	}
 
-	return &Client{
-		httpClient: httpClient,
-		apiClient:  restClient,
-		ctx:        ctx,
-	}, nil
+	c.httpClient = httpClient
+	c.apiClient = restClient
+	return c, nil
+}
+
+// resolveURL joins a relative API path onto the client's base URL
+// Absolute URLs, such as Link header pages, and clients without a base URL pass path through
+func (c *Client) resolveURL(path string) string {
+	if c.baseURL == "" || strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "http://") {
+		return path
+	}
+	return c.baseURL + "/" + strings.TrimPrefix(path, "/")
 }
 
 // Get performs a GET request to the GitHub API
 func (c *Client) Get(path string, response interface{}) error {
-	return c.apiClient.Get(path, response)
+	return c.apiClient.Get(c.resolveURL(path), response)
+}
+
+// pageSize is the per_page requested by GetAllPages, GitHub's maximum
+const pageSize = 100
+
+// linkNextPattern matches the rel="next" URL in a Link header
+var linkNextPattern = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// GetAllPages performs GET requests for every page of path, following Link rel="next" headers
+// results must be a pointer to a slice; each page's items are appended to it
+func (c *Client) GetAllPages(path string, results interface{}) error {
+	out := reflect.ValueOf(results)
+	if out.Kind() != reflect.Ptr || out.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("GetAllPages: results must be a pointer to a slice, got %T", results)
+	}
+	items := out.Elem()
+
+	next := c.resolveURL(withPerPage(path))
+	for next != "" {
+		resp, err := c.apiClient.Request(http.MethodGet, next, nil)
+		if err != nil {
+			return err
+		}
+
+		page := reflect.New(items.Type())
+		err = json.NewDecoder(resp.Body).Decode(page.Interface())
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to decode page: %w", err)
+		}
+
+		items.Set(reflect.AppendSlice(items, page.Elem()))
+		next = nextPageURL(resp.Header.Get("Link"))
+	}
+
+	return nil
+}
+
+// withPerPage adds per_page=100 to path unless it already sets per_page
+func withPerPage(path string) string {
+	if strings.Contains(path, "per_page=") {
+		return path
+	}
+	if strings.Contains(path, "?") {
+		return fmt.Sprintf("%s&per_page=%d", path, pageSize)
+	}
+	return fmt.Sprintf("%s?per_page=%d", path, pageSize)
+}
+
+// nextPageURL returns the rel="next" URL from a Link header, or "" on the last page
+func nextPageURL(link string) string {
+	match := linkNextPattern.FindStringSubmatch(link)
+	if match == nil {
+		return ""
+	}
+	return match[1]
 }
 
 // Post performs a POST request to the GitHub API
@@ -76,7 +199,7 @@ func (c *Client) Post(path string, body interface{}, response interface{}) error
 	if err != nil {
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
-	return c.apiClient.Post(path, bytes.NewReader(jsonBody), response)
+	return c.apiClient.Post(c.resolveURL(path), bytes.NewReader(jsonBody), response)
 }
 
 // Patch performs a PATCH request to the GitHub API
@@ -85,7 +208,7 @@ func (c *Client) Patch(path string, body interface{}, response interface{}) erro
 	if err != nil {
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
-	return c.apiClient.Patch(path, bytes.NewReader(jsonBody), response)
+	return c.apiClient.Patch(c.resolveURL(path), bytes.NewReader(jsonBody), response)
 }
 
 // Put performs a PUT request to the GitHub API
@@ -94,12 +217,12 @@ func (c *Client) Put(path string, body interface{}, response interface{}) error
 	if err != nil {
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
-	return c.apiClient.Put(path, bytes.NewReader(jsonBody), response)
+	return c.apiClient.Put(c.resolveURL(path), bytes.NewReader(jsonBody), response)
 }
 
 // Delete performs a DELETE request to the GitHub API
 func (c *Client) Delete(path string, response interface{}) error {
-	return c.apiClient.Delete(path, response)
+	return c.apiClient.Delete(c.resolveURL(path), response)
 }
 
 // Context returns the client's context
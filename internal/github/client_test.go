@@ -0,0 +1,194 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/cli/go-gh"
+	"github.com/cli/go-gh/pkg/api"
+)
+
+// rewriteTransport redirects all API requests to a local test server
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClient creates a Client whose requests are served by handler
+func newTestClient(t testing.TB, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse test server URL: %v", err)
+	}
+
+	opts := &api.ClientOptions{
+		Host:         "github.com",
+		AuthToken:    "test-token",
+		Transport:    rewriteTransport{target: target},
+		LogIgnoreEnv: true,
+	}
+
+	restClient, err := gh.RESTClient(opts)
+	if err != nil {
+		t.Fatalf("Failed to create REST client: %v", err)
+	}
+
+	return &Client{
+		httpClient: server.Client(),
+		apiClient:  restClient,
+		ctx:        context.Background(),
+	}
+}
+
+// pagedHandler serves pages of JSON arrays at any path, linking each page to the next
+func pagedHandler(pages []string, requests *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RequestURI())
+
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
+		}
+		if page < len(pages) {
+			next := fmt.Sprintf("http://%s%s?per_page=100&page=%d", r.Host, r.URL.Path, page+1)
+			w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next", <%s>; rel="last"`, next, next))
+		}
+		w.Write([]byte(pages[page-1]))
+	})
+}
+
+// TestGetAllPages tests that every page linked by rel="next" is collected
+func TestGetAllPages(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, pagedHandler([]string{`[{"n": 1}, {"n": 2}]`, `[{"n": 3}]`}, &requests))
+
+	var results []struct {
+		N int `json:"n"`
+	}
+	if err := client.GetAllPages("repos/owner/repo/items?state=all", &results); err != nil {
+		t.Fatalf("GetAllPages failed: %v", err)
+	}
+
+	if len(results) != 3 || results[2].N != 3 {
+		t.Errorf("Expected items 1-3 from both pages, got %+v", results)
+	}
+	if len(requests) != 2 || requests[0] != "/repos/owner/repo/items?state=all&per_page=100" {
+		t.Errorf("Expected two requests starting with per_page=100, got %v", requests)
+	}
+
+	var notSlice struct{}
+	if err := client.GetAllPages("repos/owner/repo/items", &notSlice); err == nil {
+		t.Error("Expected an error for a non-slice result")
+	}
+}
+
+// TestListBranchesPaginates tests that branches beyond the first page are returned
+func TestListBranchesPaginates(t *testing.T) {
+	var first []string
+	for i := 0; i < 100; i++ {
+		first = append(first, fmt.Sprintf(`{"name": "feature/%d", "commit": {"sha": "sha%d"}}`, i, i))
+	}
+	pages := []string{"[" + strings.Join(first, ",") + "]", `[{"name": "main", "protected": true}]`}
+
+	var requests []string
+	client := newTestClient(t, pagedHandler(pages, &requests))
+
+	branches, err := client.ListBranches("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListBranches failed: %v", err)
+	}
+
+	if len(branches) != 101 {
+		t.Fatalf("Expected 101 branches across two pages, got %d", len(branches))
+	}
+	if last := branches[100]; last.Name != "main" || !last.Protected {
+		t.Errorf("Expected main from the second page, got %+v", last)
+	}
+}
+
+// TestResolveAPIURL tests the API URL precedence: GITHUB_API_URL > config > default
+func TestResolveAPIURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        string
+		configured string
+		want       string
+	}{
+		{name: "default", want: DefaultAPIURL},
+		{name: "config", configured: "https://github.example.com/api/v3/", want: "https://github.example.com/api/v3"},
+		{name: "environment wins over config", env: "https://ghe.example.com/api/v3", configured: "https://github.example.com/api/v3", want: "https://ghe.example.com/api/v3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GITHUB_API_URL", tt.env)
+
+			if got := ResolveAPIURL(tt.configured); got != tt.want {
+				t.Errorf("ResolveAPIURL(%q) = %q, want %q", tt.configured, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewClientWithTokenRoutesToAPIURL tests that requests go to a GitHub Enterprise style API root
+func TestNewClientWithTokenRoutesToAPIURL(t *testing.T) {
+	var paths []string
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		auth = r.Header.Get("Authorization")
+		switch r.URL.Path {
+		case "/api/v3/repos/owner/repo/branches":
+			fmt.Fprint(w, `[{"name": "main", "protected": true}]`)
+		case "/api/v3/repos/owner/repo/branches/main/protection":
+			fmt.Fprint(w, `{"required_linear_history": {"enabled": true}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("GITHUB_API_URL", "")
+	SetAPIURL(server.URL + "/api/v3")
+	t.Cleanup(func() { SetAPIURL("") })
+
+	client, err := NewClientWithToken(context.Background(), "test-token")
+	if err != nil {
+		t.Fatalf("NewClientWithToken failed: %v", err)
+	}
+
+	branches, err := client.ListBranches("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListBranches failed: %v", err)
+	}
+	if len(branches) != 1 || branches[0].Name != "main" {
+		t.Errorf("Expected main branch, got %+v", branches)
+	}
+
+	if _, err := client.GetBranchProtection("owner", "repo", "main"); err != nil {
+		t.Fatalf("GetBranchProtection failed: %v", err)
+	}
+
+	if len(paths) != 2 {
+		t.Errorf("Expected 2 requests under /api/v3, got %v", paths)
+	}
+	if auth != "token test-token" {
+		t.Errorf("Expected token auth header, got %q", auth)
+	}
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -26,7 +27,7 @@ func (c *Client) ListCollaborators(owner, repo string) ([]Collaborator, error) {
 	var response []collaboratorResponse
 	path := fmt.Sprintf("repos/%s/%s/collaborators", owner, repo)
 
-	if err := c.Get(path, &response); err != nil {
+	if err := c.GetAllPages(path, &response); err != nil {
 		return nil, fmt.Errorf("failed to list collaborators: %w", err)
 	}
 
@@ -86,3 +87,140 @@ func (c *Client) RemoveCollaborator(owner, repo, username string) error {
 	return nil
 }
 
+// DormantAfterDays is how long a collaborator can go without commits or PRs before being flagged
+const DormantAfterDays = 90
+
+// CollaboratorActivity describes a collaborator's most recent activity in a repository
+type CollaboratorActivity struct {
+	LastCommitDate    *time.Time
+	LastPRDate        *time.Time
+	DaysSinceActivity int // -1 when there is no activity at all
+	Active            bool
+}
+
+type collaboratorCommitResponse struct {
+	Commit struct {
+		Author struct {
+			Date time.Time `json:"date"`
+		} `json:"author"`
+	} `json:"commit"`
+}
+
+type collaboratorPRSearchResponse struct {
+	Items []struct {
+		UpdatedAt time.Time `json:"updated_at"`
+	} `json:"items"`
+}
+
+// GetCollaboratorLastActivity finds a user's latest commit and pull request in a repository
+func (c *Client) GetCollaboratorLastActivity(owner, repo, username string) (*CollaboratorActivity, error) {
+	var commits []collaboratorCommitResponse
+	path := fmt.Sprintf("repos/%s/%s/commits?author=%s&per_page=1", owner, repo, url.QueryEscape(username))
+	if err := c.Get(path, &commits); err != nil {
+		return nil, fmt.Errorf("failed to get commits by %s: %w", username, err)
+	}
+
+	var lastCommit *time.Time
+	if len(commits) > 0 {
+		date := commits[0].Commit.Author.Date
+		lastCommit = &date
+	}
+
+	var prs collaboratorPRSearchResponse
+	query := url.QueryEscape(fmt.Sprintf("repo:%s/%s type:pr author:%s", owner, repo, username))
+	if err := c.Get(fmt.Sprintf("search/issues?q=%s&sort=updated&order=desc&per_page=1", query), &prs); err != nil {
+		return nil, fmt.Errorf("failed to search pull requests by %s: %w", username, err)
+	}
+
+	var lastPR *time.Time
+	if len(prs.Items) > 0 {
+		date := prs.Items[0].UpdatedAt
+		lastPR = &date
+	}
+
+	activity := ComputeCollaboratorActivity(lastCommit, lastPR, time.Now())
+	return &activity, nil
+}
+
+// ComputeCollaboratorActivity measures days since the later of the last commit and last PR
+// Pure function: a collaborator with no activity is never active
+func ComputeCollaboratorActivity(lastCommit, lastPR *time.Time, now time.Time) CollaboratorActivity {
+	activity := CollaboratorActivity{
+		LastCommitDate:    lastCommit,
+		LastPRDate:        lastPR,
+		DaysSinceActivity: -1,
+	}
+
+	var latest *time.Time
+	for _, date := range []*time.Time{lastCommit, lastPR} {
+		if date != nil && (latest == nil || date.After(*latest)) {
+			latest = date
+		}
+	}
+	if latest == nil {
+		return activity
+	}
+
+	activity.DaysSinceActivity = int(now.Sub(*latest).Hours() / 24)
+	activity.Active = activity.DaysSinceActivity < DormantAfterDays
+	return activity
+}
+
+// PendingInvitation represents a repository invitation that has not been accepted yet
+type PendingInvitation struct {
+	ID          int
+	Login       string
+	Email       string
+	Permission  string
+	InvitedAt   time.Time
+	DaysPending int
+}
+
+type invitationResponse struct {
+	ID      int `json:"id"`
+	Invitee *struct {
+		Login string `json:"login"`
+		Email string `json:"email"`
+	} `json:"invitee"`
+	Permissions string    `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ListPendingInvitations lists open collaborator invitations for a repository
+func (c *Client) ListPendingInvitations(owner, repo string) ([]PendingInvitation, error) {
+	var response []invitationResponse
+	path := fmt.Sprintf("repos/%s/%s/invitations", owner, repo)
+
+	if err := c.Get(path, &response); err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	now := time.Now()
+	invitations := make([]PendingInvitation, len(response))
+	for i, ir := range response {
+		invitation := PendingInvitation{
+			ID:          ir.ID,
+			Permission:  ir.Permissions,
+			InvitedAt:   ir.CreatedAt,
+			DaysPending: int(now.Sub(ir.CreatedAt).Hours() / 24),
+		}
+		if ir.Invitee != nil {
+			invitation.Login = ir.Invitee.Login
+			invitation.Email = ir.Invitee.Email
+		}
+		invitations[i] = invitation
+	}
+
+	return invitations, nil
+}
+
+// CancelInvitation deletes a pending repository invitation
+func (c *Client) CancelInvitation(owner, repo string, invitationID int) error {
+	path := fmt.Sprintf("repos/%s/%s/invitations/%d", owner, repo, invitationID)
+
+	if err := c.Delete(path, nil); err != nil {
+		return fmt.Errorf("failed to cancel invitation: %w", err)
+	}
+
+	return nil
+}
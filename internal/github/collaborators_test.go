@@ -0,0 +1,129 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// activityHandler serves one commit and no PRs for each user, dated daysAgo[user] days ago
+func activityHandler(t *testing.T, daysAgo map[string]int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/acme/api/commits":
+			days, ok := daysAgo[r.URL.Query().Get("author")]
+			if !ok {
+				w.Write([]byte(`[]`))
+				return
+			}
+			date := time.Now().AddDate(0, 0, -days).Format(time.RFC3339)
+			fmt.Fprintf(w, `[{"commit": {"author": {"date": %q}}}]`, date)
+		case "/search/issues":
+			w.Write([]byte(`{"items": []}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+// TestGetCollaboratorLastActivity tests the 90-day active threshold
+func TestGetCollaboratorLastActivity(t *testing.T) {
+	client := newTestClient(t, activityHandler(t, map[string]int{"recent": 45, "dormant": 100}))
+
+	tests := []struct {
+		user       string
+		wantActive bool
+		wantDays   int
+	}{
+		{"recent", true, 45},
+		{"dormant", false, 100},
+		{"ghost", false, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.user, func(t *testing.T) {
+			activity, err := client.GetCollaboratorLastActivity("acme", "api", tt.user)
+			if err != nil {
+				t.Fatalf("GetCollaboratorLastActivity failed: %v", err)
+			}
+			if activity.Active != tt.wantActive {
+				t.Errorf("Expected Active=%v, got %+v", tt.wantActive, activity)
+			}
+			if activity.DaysSinceActivity != tt.wantDays {
+				t.Errorf("Expected %d days since activity, got %d", tt.wantDays, activity.DaysSinceActivity)
+			}
+			if activity.LastPRDate != nil {
+				t.Errorf("Expected no PR date, got %v", activity.LastPRDate)
+			}
+		})
+	}
+}
+
+// TestComputeCollaboratorActivityUsesLatest tests that a recent PR outweighs an old commit
+func TestComputeCollaboratorActivityUsesLatest(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	commit := now.AddDate(0, 0, -200)
+	pr := now.AddDate(0, 0, -10)
+
+	activity := ComputeCollaboratorActivity(&commit, &pr, now)
+	if !activity.Active || activity.DaysSinceActivity != 10 {
+		t.Errorf("Expected active 10 days ago, got %+v", activity)
+	}
+}
+
+// TestListPendingInvitations tests invitation listing against a mocked API
+func TestListPendingInvitations(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/api/invitations", func(w http.ResponseWriter, r *http.Request) {
+		created := time.Now().AddDate(0, 0, -10).Format(time.RFC3339)
+		fmt.Fprintf(w, `[
+			{"id": 42, "invitee": {"login": "dave", "email": "dave@example.com"}, "permissions": "write", "created_at": %q},
+			{"id": 43, "invitee": null, "permissions": "read", "created_at": %q}
+		]`, created, created)
+	})
+
+	client := newTestClient(t, mux)
+
+	invitations, err := client.ListPendingInvitations("acme", "api")
+	if err != nil {
+		t.Fatalf("ListPendingInvitations failed: %v", err)
+	}
+	if len(invitations) != 2 {
+		t.Fatalf("Expected 2 invitations, got %d", len(invitations))
+	}
+
+	dave := invitations[0]
+	if dave.ID != 42 || dave.Login != "dave" || dave.Email != "dave@example.com" || dave.Permission != "write" {
+		t.Errorf("Unexpected invitation: %+v", dave)
+	}
+	if dave.DaysPending != 10 {
+		t.Errorf("Expected 10 days pending, got %d", dave.DaysPending)
+	}
+	if invitations[1].Login != "" {
+		t.Errorf("Expected no login without an invitee, got %q", invitations[1].Login)
+	}
+}
+
+// TestCancelInvitation tests that cancelling deletes the invitation
+func TestCancelInvitation(t *testing.T) {
+	called := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/api/invitations/42", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := newTestClient(t, mux)
+
+	if err := client.CancelInvitation("acme", "api", 42); err != nil {
+		t.Fatalf("CancelInvitation failed: %v", err)
+	}
+	if !called {
+		t.Error("Expected the invitation endpoint to be called")
+	}
+}
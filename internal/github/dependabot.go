@@ -0,0 +1,228 @@
+package github
+
+import (
+	"fmt"
+	"time"
+)
+
+// DependabotAlert represents a Dependabot vulnerability alert
+type DependabotAlert struct {
+	Number          int
+	Repository      string
+	State           string // open, dismissed, fixed, auto_dismissed
+	Severity        string // low, medium, high, critical
+	Package         string
+	Ecosystem       string
+	Summary         string
+	CreatedAt       time.Time
+	DismissedAt     time.Time
+	DismissedReason string // fix_started, inaccurate, no_bandwidth, not_used, tolerable_risk
+	DismissedBy     string
+	HTMLURL         string
+}
+
+type dependabotAlertResponse struct {
+	Number     int    `json:"number"`
+	State      string `json:"state"`
+	HTMLURL    string `json:"html_url"`
+	Dependency struct {
+		Package struct {
+			Name      string `json:"name"`
+			Ecosystem string `json:"ecosystem"`
+		} `json:"package"`
+	} `json:"dependency"`
+	SecurityAdvisory struct {
+		Summary  string `json:"summary"`
+		Severity string `json:"severity"`
+	} `json:"security_advisory"`
+	CreatedAt       time.Time  `json:"created_at"`
+	DismissedAt     *time.Time `json:"dismissed_at"`
+	DismissedReason *string    `json:"dismissed_reason"`
+	DismissedBy     *struct {
+		Login string `json:"login"`
+	} `json:"dismissed_by"`
+}
+
+// DismissalReport summarizes dismissed Dependabot alerts
+type DismissalReport struct {
+	TotalDismissed  int
+	ByReason        map[string]int
+	OldestDismissal time.Time
+}
+
+// ListDismissedAlerts lists Dependabot alerts that were dismissed for a repository
+// Alerts without a dismissed_at timestamp are excluded
+func (c *Client) ListDismissedAlerts(owner, repo string) ([]DependabotAlert, error) {
+	response, err := c.listDependabotAlerts(owner, repo, "dismissed")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dismissed alerts: %w", err)
+	}
+
+	var alerts []DependabotAlert
+	for _, a := range response {
+		if a.DismissedAt == nil {
+			continue
+		}
+		alerts = append(alerts, toDependabotAlert(a, fmt.Sprintf("%s/%s", owner, repo)))
+	}
+
+	return alerts, nil
+}
+
+// ListOpenAlerts lists open Dependabot alerts for a repository
+func (c *Client) ListOpenAlerts(owner, repo string) ([]DependabotAlert, error) {
+	response, err := c.listDependabotAlerts(owner, repo, "open")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open alerts: %w", err)
+	}
+
+	alerts := make([]DependabotAlert, len(response))
+	for i, a := range response {
+		alerts[i] = toDependabotAlert(a, fmt.Sprintf("%s/%s", owner, repo))
+	}
+
+	return alerts, nil
+}
+
+// listDependabotAlerts pages through all Dependabot alerts in the given state
+func (c *Client) listDependabotAlerts(owner, repo, state string) ([]dependabotAlertResponse, error) {
+	var alerts []dependabotAlertResponse
+	page := 1
+	perPage := 100
+
+	for {
+		var response []dependabotAlertResponse
+		path := fmt.Sprintf("repos/%s/%s/dependabot/alerts?state=%s&per_page=%d&page=%d", owner, repo, state, perPage, page)
+
+		if err := c.Get(path, &response); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, response...)
+
+		if len(response) < perPage {
+			break
+		}
+		page++
+	}
+
+	return alerts, nil
+}
+
+func toDependabotAlert(a dependabotAlertResponse, repository string) DependabotAlert {
+	alert := DependabotAlert{
+		Number:     a.Number,
+		Repository: repository,
+		State:      a.State,
+		Severity:   a.SecurityAdvisory.Severity,
+		Package:    a.Dependency.Package.Name,
+		Ecosystem:  a.Dependency.Package.Ecosystem,
+		Summary:    a.SecurityAdvisory.Summary,
+		CreatedAt:  a.CreatedAt,
+		HTMLURL:    a.HTMLURL,
+	}
+	if a.DismissedAt != nil {
+		alert.DismissedAt = *a.DismissedAt
+	}
+	if a.DismissedReason != nil {
+		alert.DismissedReason = *a.DismissedReason
+	}
+	if a.DismissedBy != nil {
+		alert.DismissedBy = a.DismissedBy.Login
+	}
+	return alert
+}
+
+// BuildDismissalReport groups dismissed alerts by reason and finds the oldest dismissal
+// Pure function: no API calls
+func BuildDismissalReport(alerts []DependabotAlert) DismissalReport {
+	report := DismissalReport{
+		ByReason: make(map[string]int),
+	}
+
+	for _, alert := range alerts {
+		if alert.DismissedAt.IsZero() {
+			continue
+		}
+
+		report.TotalDismissed++
+
+		reason := alert.DismissedReason
+		if reason == "" {
+			reason = "unspecified"
+		}
+		report.ByReason[reason]++
+
+		if report.OldestDismissal.IsZero() || alert.DismissedAt.Before(report.OldestDismissal) {
+			report.OldestDismissal = alert.DismissedAt
+		}
+	}
+
+	return report
+}
+
+// Trend directions reported by TrackAlertTrend
+const (
+	AlertTrendImproving = "improving"
+	AlertTrendWorsening = "worsening"
+	AlertTrendStable    = "stable"
+)
+
+// AlertTrend compares open alerts between two snapshots of a repository
+type AlertTrend struct {
+	NewAlerts      []DependabotAlert
+	ResolvedAlerts []DependabotAlert
+	NewBySeverity  map[string]int
+	TrendDirection string
+}
+
+// TrackAlertTrend finds alerts opened and resolved since the previous snapshot
+// Pure function: alerts are matched by repository and number; the trend is worsening when more
+// alerts were opened than resolved, improving when fewer, and stable otherwise
+func TrackAlertTrend(current, previous []DependabotAlert) AlertTrend {
+	trend := AlertTrend{
+		NewBySeverity:  make(map[string]int),
+		TrendDirection: AlertTrendStable,
+	}
+
+	alertKey := func(a DependabotAlert) string {
+		return fmt.Sprintf("%s#%d", a.Repository, a.Number)
+	}
+
+	seen := make(map[string]bool, len(previous))
+	for _, alert := range previous {
+		seen[alertKey(alert)] = true
+	}
+	stillOpen := make(map[string]bool, len(current))
+	for _, alert := range current {
+		stillOpen[alertKey(alert)] = true
+		if !seen[alertKey(alert)] {
+			trend.NewAlerts = append(trend.NewAlerts, alert)
+			trend.NewBySeverity[alert.Severity]++
+		}
+	}
+	for _, alert := range previous {
+		if !stillOpen[alertKey(alert)] {
+			trend.ResolvedAlerts = append(trend.ResolvedAlerts, alert)
+		}
+	}
+
+	switch {
+	case len(trend.NewAlerts) > len(trend.ResolvedAlerts):
+		trend.TrendDirection = AlertTrendWorsening
+	case len(trend.NewAlerts) < len(trend.ResolvedAlerts):
+		trend.TrendDirection = AlertTrendImproving
+	}
+
+	return trend
+}
+
+// Arrow returns ↑ when worsening, ↓ when improving, and → when stable
+func (t AlertTrend) Arrow() string {
+	switch t.TrendDirection {
+	case AlertTrendWorsening:
+		return "↑"
+	case AlertTrendImproving:
+		return "↓"
+	}
+	return "→"
+}
@@ -0,0 +1,149 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestListDismissedAlerts tests that only alerts with a dismissal timestamp are returned
+func TestListDismissedAlerts(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/dependabot/alerts" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("state") != "dismissed" {
+			t.Errorf("Expected state=dismissed, got %s", r.URL.Query().Get("state"))
+		}
+		w.Write([]byte(`[
+			{
+				"number": 1,
+				"state": "dismissed",
+				"dependency": {"package": {"name": "lodash", "ecosystem": "npm"}},
+				"security_advisory": {"summary": "Prototype pollution", "severity": "high"},
+				"created_at": "2024-01-01T00:00:00Z",
+				"dismissed_at": "2024-02-01T00:00:00Z",
+				"dismissed_reason": "tolerable_risk",
+				"dismissed_by": {"login": "octocat"}
+			},
+			{
+				"number": 2,
+				"state": "dismissed",
+				"created_at": "2024-01-01T00:00:00Z",
+				"dismissed_at": null
+			}
+		]`))
+	})
+
+	client := newTestClient(t, handler)
+
+	alerts, err := client.ListDismissedAlerts("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListDismissedAlerts failed: %v", err)
+	}
+
+	if len(alerts) != 1 {
+		t.Fatalf("Expected 1 dismissed alert, got %d", len(alerts))
+	}
+
+	alert := alerts[0]
+	if alert.Repository != "owner/repo" || alert.Package != "lodash" || alert.Severity != "high" {
+		t.Errorf("Unexpected alert fields: %+v", alert)
+	}
+	if alert.DismissedReason != "tolerable_risk" || alert.DismissedBy != "octocat" {
+		t.Errorf("Unexpected dismissal fields: %+v", alert)
+	}
+	if !alert.DismissedAt.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected dismissed at 2024-02-01, got %v", alert.DismissedAt)
+	}
+}
+
+// TestBuildDismissalReport tests grouping dismissals by reason
+func TestBuildDismissalReport(t *testing.T) {
+	oldest := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	alerts := []DependabotAlert{
+		{Number: 1, DismissedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), DismissedReason: "tolerable_risk"},
+		{Number: 2, DismissedAt: oldest, DismissedReason: "tolerable_risk"},
+		{Number: 3, DismissedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), DismissedReason: "tolerable_risk"},
+		{Number: 4, DismissedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), DismissedReason: "no_bandwidth"},
+		{Number: 5, DismissedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), DismissedReason: "not_used"},
+		{Number: 6, DismissedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), DismissedReason: "not_used"},
+		{Number: 7, State: "open"},
+	}
+
+	report := BuildDismissalReport(alerts)
+
+	if report.TotalDismissed != 6 {
+		t.Errorf("Expected 6 dismissed, got %d", report.TotalDismissed)
+	}
+
+	expected := map[string]int{"tolerable_risk": 3, "no_bandwidth": 1, "not_used": 2}
+	for reason, count := range expected {
+		if report.ByReason[reason] != count {
+			t.Errorf("Expected %d for %s, got %d", count, reason, report.ByReason[reason])
+		}
+	}
+	if len(report.ByReason) != len(expected) {
+		t.Errorf("Expected %d reasons, got %d", len(expected), len(report.ByReason))
+	}
+
+	if !report.OldestDismissal.Equal(oldest) {
+		t.Errorf("Expected oldest dismissal %v, got %v", oldest, report.OldestDismissal)
+	}
+}
+
+// TestListOpenAlerts tests that open alerts are requested by state
+func TestListOpenAlerts(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("state") != "open" {
+			t.Errorf("Expected state=open, got %s", r.URL.Query().Get("state"))
+		}
+		w.Write([]byte(`[
+			{"number": 3, "state": "open", "security_advisory": {"severity": "critical"}, "created_at": "2024-01-01T00:00:00Z"}
+		]`))
+	})
+
+	client := newTestClient(t, handler)
+
+	alerts, err := client.ListOpenAlerts("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListOpenAlerts failed: %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Number != 3 || alerts[0].Severity != "critical" {
+		t.Errorf("Unexpected alerts: %+v", alerts)
+	}
+}
+
+// TestTrackAlertTrend tests that resolving one critical while adding two medium alerts is worsening
+func TestTrackAlertTrend(t *testing.T) {
+	previous := []DependabotAlert{
+		{Repository: "owner/repo", Number: 1, Severity: "critical"},
+		{Repository: "owner/repo", Number: 2, Severity: "high"},
+	}
+	current := []DependabotAlert{
+		{Repository: "owner/repo", Number: 2, Severity: "high"},
+		{Repository: "owner/repo", Number: 3, Severity: "medium"},
+		{Repository: "owner/repo", Number: 4, Severity: "medium"},
+	}
+
+	trend := TrackAlertTrend(current, previous)
+
+	if trend.TrendDirection != AlertTrendWorsening || trend.Arrow() != "↑" {
+		t.Errorf("Expected worsening, got %s", trend.TrendDirection)
+	}
+	if len(trend.ResolvedAlerts) != 1 || trend.ResolvedAlerts[0].Number != 1 {
+		t.Errorf("Expected the critical alert to be resolved, got %+v", trend.ResolvedAlerts)
+	}
+	if len(trend.NewAlerts) != 2 || trend.NewBySeverity["medium"] != 2 || len(trend.NewBySeverity) != 1 {
+		t.Errorf("Expected 2 new medium alerts, got %+v", trend.NewBySeverity)
+	}
+
+	if reverse := TrackAlertTrend(previous, current); reverse.TrendDirection != AlertTrendImproving {
+		t.Errorf("Expected improving in reverse, got %s", reverse.TrendDirection)
+	}
+	if same := TrackAlertTrend(current, current); same.TrendDirection != AlertTrendStable || same.Arrow() != "→" {
+		t.Errorf("Expected stable, got %s", same.TrendDirection)
+	}
+}
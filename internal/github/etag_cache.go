@@ -0,0 +1,136 @@
+package github
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// cachedResponse is the last 200 response seen for a URL, replayed when GitHub answers 304
+type cachedResponse struct {
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+// etagCacheMaxEntries caps each Client's ETag cache; the least recently used URL is dropped first
+const etagCacheMaxEntries = 1000
+
+// etagCache is an LRU of responses by URL, owned by one Client so one token never sees another's data
+type etagCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List // Front is most recently used; values are *etagEntry
+	entries    map[string]*list.Element
+}
+
+type etagEntry struct {
+	url    string
+	cached cachedResponse
+}
+
+func newETagCache(maxEntries int) *etagCache {
+	return &etagCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+func (e *etagCache) get(url string) (cachedResponse, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	elem, ok := e.entries[url]
+	if !ok {
+		return cachedResponse{}, false
+	}
+	e.order.MoveToFront(elem)
+	return elem.Value.(*etagEntry).cached, true
+}
+
+func (e *etagCache) put(url string, cached cachedResponse) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if elem, ok := e.entries[url]; ok {
+		elem.Value.(*etagEntry).cached = cached
+		e.order.MoveToFront(elem)
+		return
+	}
+
+	e.entries[url] = e.order.PushFront(&etagEntry{url: url, cached: cached})
+	for e.maxEntries > 0 && e.order.Len() > e.maxEntries {
+		oldest := e.order.Back()
+		e.order.Remove(oldest)
+		delete(e.entries, oldest.Value.(*etagEntry).url)
+	}
+}
+
+func (e *etagCache) clear() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.order.Init()
+	e.entries = make(map[string]*list.Element)
+}
+
+// ClearETagCache drops this client's cached responses
+func (c *Client) ClearETagCache() {
+	if c.etags != nil {
+		c.etags.clear()
+	}
+}
+
+// etagTransport sends If-None-Match for previously seen GETs and replays the cached body on 304
+type etagTransport struct {
+	cache *etagCache
+	rt    http.RoundTripper
+}
+
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("If-None-Match") != "" {
+		return t.rt.RoundTrip(req)
+	}
+
+	url := req.URL.String()
+	cached, ok := t.cache.get(url)
+	if ok {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        cached.header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(cached.body)),
+			ContentLength: int64(len(cached.body)),
+			Request:       req,
+		}, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	t.cache.put(url, cachedResponse{etag: etag, header: resp.Header.Clone(), body: body})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
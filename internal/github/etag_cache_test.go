@@ -0,0 +1,129 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newETagTestClient creates a Client through NewClientWithToken whose requests are served by a
+// protection endpoint that honours If-None-Match; each request's If-None-Match header is recorded
+func newETagTestClient(t *testing.T, token string, options ...ClientOption) (*Client, *[]string) {
+	t.Helper()
+
+	var conditions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conditions = append(conditions, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"required_linear_history": {"enabled": true}, "allow_force_pushes": {"enabled": false}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("GITHUB_API_URL", server.URL)
+
+	client, err := NewClientWithToken(context.Background(), token, options...)
+	if err != nil {
+		t.Fatalf("NewClientWithToken failed: %v", err)
+	}
+	t.Cleanup(client.ClearETagCache)
+
+	return client, &conditions
+}
+
+// TestETagCacheReplaysNotModified tests that a repeated GET revalidates and returns the cached body on 304
+func TestETagCacheReplaysNotModified(t *testing.T) {
+	client, conditions := newETagTestClient(t, "etag-replay")
+
+	for i := 0; i < 2; i++ {
+		rule, err := client.GetBranchProtection("owner", "repo", "main")
+		if err != nil {
+			t.Fatalf("GetBranchProtection call %d failed: %v", i+1, err)
+		}
+		if !rule.RequireLinearHistory {
+			t.Errorf("Call %d: expected linear history from the (cached) body, got %+v", i+1, rule)
+		}
+	}
+
+	if want := []string{"", `"v1"`}; fmt.Sprint(*conditions) != fmt.Sprint(want) {
+		t.Errorf("Expected If-None-Match headers %q, got %q", want, *conditions)
+	}
+}
+
+// TestETagCacheScopedPerClient tests that cached ETags are not shared between clients
+func TestETagCacheScopedPerClient(t *testing.T) {
+	first, conditions := newETagTestClient(t, "etag-first")
+	if _, err := first.GetBranchProtection("owner", "repo", "main"); err != nil {
+		t.Fatalf("GetBranchProtection failed: %v", err)
+	}
+
+	second, err := NewClientWithToken(context.Background(), "etag-second")
+	if err != nil {
+		t.Fatalf("NewClientWithToken failed: %v", err)
+	}
+	t.Cleanup(second.ClearETagCache)
+	if _, err := second.GetBranchProtection("owner", "repo", "main"); err != nil {
+		t.Fatalf("GetBranchProtection failed: %v", err)
+	}
+
+	if got := (*conditions)[1]; got != "" {
+		t.Errorf("Expected no If-None-Match for a different client, got %q", got)
+	}
+}
+
+// TestClearETagCache tests that clearing the cache forces a full request
+func TestClearETagCache(t *testing.T) {
+	client, conditions := newETagTestClient(t, "etag-clear")
+
+	if _, err := client.GetBranchProtection("owner", "repo", "main"); err != nil {
+		t.Fatalf("GetBranchProtection failed: %v", err)
+	}
+	client.ClearETagCache()
+	if _, err := client.GetBranchProtection("owner", "repo", "main"); err != nil {
+		t.Fatalf("GetBranchProtection failed: %v", err)
+	}
+
+	if got := (*conditions)[1]; got != "" {
+		t.Errorf("Expected no If-None-Match after ClearETagCache, got %q", got)
+	}
+}
+
+// TestWithETagCacheDisabled tests that WithETagCache(false) never sends conditional requests
+func TestWithETagCacheDisabled(t *testing.T) {
+	client, conditions := newETagTestClient(t, "etag-disabled", WithETagCache(false))
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetBranchProtection("owner", "repo", "main"); err != nil {
+			t.Fatalf("GetBranchProtection failed: %v", err)
+		}
+	}
+
+	for i, got := range *conditions {
+		if got != "" {
+			t.Errorf("Request %d: expected no If-None-Match, got %q", i+1, got)
+		}
+	}
+}
+
+// TestETagCacheEvictsLeastRecentlyUsed tests that the cache drops the least recently used URL at capacity
+func TestETagCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newETagCache(2)
+	cache.put("a", cachedResponse{etag: `"a"`})
+	cache.put("b", cachedResponse{etag: `"b"`})
+	cache.get("a")
+	cache.put("c", cachedResponse{etag: `"c"`})
+
+	if _, ok := cache.get("b"); ok {
+		t.Error("Expected b to be evicted as least recently used")
+	}
+	for _, url := range []string{"a", "c"} {
+		if _, ok := cache.get(url); !ok {
+			t.Errorf("Expected %s to remain cached", url)
+		}
+	}
+}
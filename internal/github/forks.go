@@ -0,0 +1,101 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ForkInfo describes how far a fork has drifted from its upstream
+type ForkInfo struct {
+	Repository      string
+	ParentRepo      string
+	ParentBranch    string
+	DefaultBranch   string
+	BehindByCommits int
+	AheadByCommits  int
+	LastSynced      time.Time
+}
+
+type forkRepoResponse struct {
+	FullName      string    `json:"full_name"`
+	Fork          bool      `json:"fork"`
+	DefaultBranch string    `json:"default_branch"`
+	PushedAt      time.Time `json:"pushed_at"`
+	Parent        *struct {
+		FullName      string `json:"full_name"`
+		DefaultBranch string `json:"default_branch"`
+		Owner         struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+		Name string `json:"name"`
+	} `json:"parent"`
+}
+
+// GetRepoForkInfo fetches upstream divergence for a fork
+// Returns nil if the repository is not a fork
+func (c *Client) GetRepoForkInfo(owner, repo string) (*ForkInfo, error) {
+	var response forkRepoResponse
+	path := fmt.Sprintf("repos/%s/%s", owner, repo)
+
+	if err := c.Get(path, &response); err != nil {
+		return nil, fmt.Errorf("failed to get fork info: %w", err)
+	}
+
+	if !response.Fork || response.Parent == nil {
+		return nil, nil
+	}
+
+	parent := response.Parent
+	head := fmt.Sprintf("%s:%s", owner, response.DefaultBranch)
+	ahead, behind, err := c.CompareBranches(parent.Owner.Login, parent.Name, parent.DefaultBranch, head)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ForkInfo{
+		Repository:      fmt.Sprintf("%s/%s", owner, repo),
+		ParentRepo:      parent.FullName,
+		ParentBranch:    parent.DefaultBranch,
+		DefaultBranch:   response.DefaultBranch,
+		BehindByCommits: behind,
+		AheadByCommits:  ahead,
+		LastSynced:      response.PushedAt,
+	}, nil
+}
+
+// SyncFork merges upstream changes into the fork's default branch
+func (c *Client) SyncFork(owner, repo string) error {
+	branch, err := c.GetDefaultBranch(owner, repo)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]string{
+		"branch": branch,
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/merge-upstream", owner, repo)
+
+	if err := c.Post(path, body, nil); err != nil {
+		return fmt.Errorf("failed to sync fork: %w", err)
+	}
+
+	return nil
+}
+
+// SortForksByDivergence orders forks with the most commits behind first
+// Pure function: returns a sorted copy
+func SortForksByDivergence(forks []ForkInfo) []ForkInfo {
+	sorted := make([]ForkInfo, len(forks))
+	copy(sorted, forks)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].BehindByCommits != sorted[j].BehindByCommits {
+			return sorted[i].BehindByCommits > sorted[j].BehindByCommits
+		}
+		return sorted[i].Repository < sorted[j].Repository
+	})
+
+	return sorted
+}
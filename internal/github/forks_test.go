@@ -0,0 +1,122 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// TestGetRepoForkInfo tests fork divergence lookup against a mocked API
+func TestGetRepoForkInfo(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/me/fork", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"full_name": "me/fork",
+			"fork": true,
+			"default_branch": "main",
+			"pushed_at": "2024-01-02T03:04:05Z",
+			"parent": {
+				"full_name": "upstream/project",
+				"name": "project",
+				"default_branch": "trunk",
+				"owner": {"login": "upstream"}
+			}
+		}`))
+	})
+	mux.HandleFunc("/repos/upstream/project/compare/trunk...me:main", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ahead_by": 2, "behind_by": 17}`))
+	})
+	mux.HandleFunc("/repos/me/original", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"full_name": "me/original", "fork": false, "default_branch": "main"}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	info, err := client.GetRepoForkInfo("me", "fork")
+	if err != nil {
+		t.Fatalf("GetRepoForkInfo failed: %v", err)
+	}
+
+	if info == nil {
+		t.Fatal("Expected fork info, got nil")
+	}
+
+	if info.ParentRepo != "upstream/project" {
+		t.Errorf("Expected parent 'upstream/project', got '%s'", info.ParentRepo)
+	}
+
+	if info.BehindByCommits != 17 {
+		t.Errorf("Expected 17 commits behind, got %d", info.BehindByCommits)
+	}
+
+	if info.AheadByCommits != 2 {
+		t.Errorf("Expected 2 commits ahead, got %d", info.AheadByCommits)
+	}
+
+	if info.LastSynced.IsZero() {
+		t.Error("Expected LastSynced to be populated")
+	}
+
+	notFork, err := client.GetRepoForkInfo("me", "original")
+	if err != nil {
+		t.Fatalf("GetRepoForkInfo failed for non-fork: %v", err)
+	}
+
+	if notFork != nil {
+		t.Errorf("Expected nil for non-fork repository, got %+v", notFork)
+	}
+}
+
+// TestSyncFork tests the merge-upstream request
+func TestSyncFork(t *testing.T) {
+	var requestedBranch string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/me/fork", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"default_branch": "develop"}`))
+	})
+	mux.HandleFunc("/repos/me/fork/merge-upstream", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		requestedBranch = body["branch"]
+
+		w.Write([]byte(`{"message": "Successfully fetched and fast-forwarded from upstream", "merge_type": "fast-forward"}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	if err := client.SyncFork("me", "fork"); err != nil {
+		t.Fatalf("SyncFork failed: %v", err)
+	}
+
+	if requestedBranch != "develop" {
+		t.Errorf("Expected merge-upstream for 'develop', got '%s'", requestedBranch)
+	}
+}
+
+// TestSortForksByDivergence tests the behind-count ranking
+func TestSortForksByDivergence(t *testing.T) {
+	forks := []ForkInfo{
+		{Repository: "me/b", BehindByCommits: 3},
+		{Repository: "me/a", BehindByCommits: 40},
+		{Repository: "me/d", BehindByCommits: 0},
+		{Repository: "me/c", BehindByCommits: 3},
+	}
+
+	sorted := SortForksByDivergence(forks)
+
+	expected := []string{"me/a", "me/b", "me/c", "me/d"}
+	for i, repo := range expected {
+		if sorted[i].Repository != repo {
+			t.Errorf("Position %d: expected %s, got %s", i, repo, sorted[i].Repository)
+		}
+	}
+
+	if forks[0].Repository != "me/b" {
+		t.Error("Expected input slice to be left unmodified")
+	}
+}
@@ -2,7 +2,11 @@ package github
 
 import (
 	"fmt"
+	"log/slog"
+	"math"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -17,6 +21,7 @@ type StepTiming struct {
 }
 
 type JobTiming struct {
+	ID              int           `json:"id"`
 	Name            string        `json:"name"`
 	DurationSeconds float64       `json:"duration_seconds"`
 	Status          string        `json:"status"`
@@ -33,12 +38,17 @@ type RunTiming struct {
 	WorkflowID      int           `json:"workflow_id"`
 	Branch          string        `json:"branch"`
 	HeadSHA         string        `json:"head_sha"`
+	RunAttempt      int           `json:"run_attempt"`
 	Conclusion      string        `json:"conclusion"`
 	CreatedAt       time.Time     `json:"created_at"`
+	StartedAt       time.Time     `json:"started_at"`
 	UpdatedAt       time.Time     `json:"updated_at"`
 	DurationSeconds float64       `json:"duration_seconds"`
 	Duration        time.Duration `json:"-"`
-	Jobs            []JobTiming   `json:"jobs"`
+	// Duration split at StartedAt into time waiting for a runner and time running
+	QueueDuration     time.Duration `json:"-"`
+	ExecutionDuration time.Duration `json:"-"`
+	Jobs              []JobTiming   `json:"jobs"`
 }
 
 type WorkflowFile struct {
@@ -49,23 +59,43 @@ type WorkflowFile struct {
 }
 
 type WorkflowStats struct {
-	Workflow     string
-	TotalRuns    int
-	AvgDuration  time.Duration
-	MinDuration  time.Duration
-	MaxDuration  time.Duration
-	SuccessRate  float64
-	FailureCount int
+	Workflow        string
+	TotalRuns       int
+	AvgDuration     time.Duration
+	TimeWeightedAvg time.Duration // Set by ComputeTimeWeightedWorkflowStats
+	AvgQueue        time.Duration
+	AvgExecution    time.Duration
+	MinDuration     time.Duration
+	MaxDuration     time.Duration
+	P50             time.Duration
+	P75             time.Duration
+	P95             time.Duration
+	SuccessRate     float64
+	FailureCount    int
 }
 
 type JobStats struct {
 	WorkflowJob string
+	Workflow    string
+	Job         string
 	TotalRuns   int
 	AvgDuration time.Duration
 	MinDuration time.Duration
 	MaxDuration time.Duration
 }
 
+// StepTimingAggregate summarizes one step's duration across runs
+type StepTimingAggregate struct {
+	JobName  string
+	StepName string
+	Min      time.Duration
+	Max      time.Duration
+	Avg      time.Duration
+	P95      time.Duration
+	RunCount int
+	Samples  []time.Duration // Sorted ascending
+}
+
 type BranchStats struct {
 	Branch         string
 	TotalRuns      int
@@ -84,19 +114,23 @@ type workflowsResponse struct {
 	} `json:"workflows"`
 }
 
+type workflowRunDetail struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	WorkflowID   int       `json:"workflow_id"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	HeadBranch   string    `json:"head_branch"`
+	HeadSHA      string    `json:"head_sha"`
+	RunAttempt   int       `json:"run_attempt"`
+	CreatedAt    time.Time `json:"created_at"`
+	RunStartedAt time.Time `json:"run_started_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Path         string    `json:"path"`
+}
+
 type workflowRunsDetailResponse struct {
-	WorkflowRuns []struct {
-		ID           int       `json:"id"`
-		Name         string    `json:"name"`
-		WorkflowID   int       `json:"workflow_id"`
-		Status       string    `json:"status"`
-		Conclusion   string    `json:"conclusion"`
-		HeadBranch   string    `json:"head_branch"`
-		HeadSHA      string    `json:"head_sha"`
-		CreatedAt    time.Time `json:"created_at"`
-		UpdatedAt    time.Time `json:"updated_at"`
-		Path         string    `json:"path"`
-	} `json:"workflow_runs"`
+	WorkflowRuns []workflowRunDetail `json:"workflow_runs"`
 }
 
 type jobsResponse struct {
@@ -145,8 +179,12 @@ type FetchWorkflowRunsOptions struct {
 	Status       string
 	Limit        int
 	CreatedAfter time.Time
+	Workers      int // Concurrent run detail fetches (default: DefaultDetailWorkers)
 }
 
+// DefaultDetailWorkers is the number of run details fetched concurrently
+const DefaultDetailWorkers = 5
+
 func (c *Client) FetchWorkflowRuns(owner, repo string, opts FetchWorkflowRunsOptions) ([]RunTiming, error) {
 	limit := opts.Limit
 	if limit <= 0 {
@@ -156,21 +194,8 @@ func (c *Client) FetchWorkflowRuns(owner, repo string, opts FetchWorkflowRunsOpt
 		limit = 100
 	}
 
-	var path string
-	if opts.WorkflowFile != "" {
-		path = fmt.Sprintf("repos/%s/%s/actions/workflows/%s/runs?per_page=%d&status=completed",
-			owner, repo, opts.WorkflowFile, limit)
-	} else {
-		path = fmt.Sprintf("repos/%s/%s/actions/runs?per_page=%d&status=completed",
-			owner, repo, limit)
-	}
-
-	if opts.Branch != "" {
-		path += "&branch=" + opts.Branch
-	}
-
 	var response workflowRunsDetailResponse
-	if err := c.Get(path, &response); err != nil {
+	if err := c.Get(workflowRunsPath(owner, repo, opts, limit, 1, "completed"), &response); err != nil {
 		return nil, fmt.Errorf("failed to fetch workflow runs: %w", err)
 	}
 
@@ -182,28 +207,111 @@ func (c *Client) FetchWorkflowRuns(owner, repo string, opts FetchWorkflowRunsOpt
 		if !opts.CreatedAfter.IsZero() && r.CreatedAt.Before(opts.CreatedAfter) {
 			continue
 		}
+		runs = append(runs, r.toRunTiming())
+	}
 
-		workflowName := r.Path
-		if workflowName == "" {
-			workflowName = r.Name
+	return runs, nil
+}
+
+// FetchWorkflowRunsSince returns completed runs newer than minRunID, newest first, and the
+// lowest ID of a run still in progress (0 if none) so callers can resume below it next time
+// In-progress runs are listed rather than filtered out by the API so they can be tracked
+func (c *Client) FetchWorkflowRunsSince(owner, repo string, opts FetchWorkflowRunsOptions, minRunID int) ([]RunTiming, int, error) {
+	perPage := 100
+
+	var runs []RunTiming
+	pendingRunID := 0
+	for page := 1; ; page++ {
+		var response workflowRunsDetailResponse
+		if err := c.Get(workflowRunsPath(owner, repo, opts, perPage, page, ""), &response); err != nil {
+			return nil, 0, fmt.Errorf("failed to fetch workflow runs: %w", err)
 		}
 
-		duration := r.UpdatedAt.Sub(r.CreatedAt)
-		runs = append(runs, RunTiming{
-			RunID:           r.ID,
-			Workflow:        workflowName,
-			WorkflowID:      r.WorkflowID,
-			Branch:          r.HeadBranch,
-			HeadSHA:         r.HeadSHA,
-			Conclusion:      r.Conclusion,
-			CreatedAt:       r.CreatedAt,
-			UpdatedAt:       r.UpdatedAt,
-			DurationSeconds: duration.Seconds(),
-			Duration:        duration,
-		})
+		// Runs are returned newest first, so everything after this point is already cached
+		for _, r := range response.WorkflowRuns {
+			if r.ID <= minRunID {
+				return runs, pendingRunID, nil
+			}
+			if !opts.CreatedAfter.IsZero() && r.CreatedAt.Before(opts.CreatedAfter) {
+				return runs, pendingRunID, nil
+			}
+			if r.Conclusion == "" {
+				pendingRunID = r.ID
+				continue
+			}
+
+			runs = append(runs, r.toRunTiming())
+			if opts.Limit > 0 && len(runs) >= opts.Limit {
+				return runs, pendingRunID, nil
+			}
+		}
+
+		if len(response.WorkflowRuns) < perPage {
+			return runs, pendingRunID, nil
+		}
 	}
+}
 
-	return runs, nil
+// workflowRunsPath lists runs in any status when status is empty
+func workflowRunsPath(owner, repo string, opts FetchWorkflowRunsOptions, perPage, page int, status string) string {
+	var path string
+	if opts.WorkflowFile != "" {
+		path = fmt.Sprintf("repos/%s/%s/actions/workflows/%s/runs?per_page=%d&page=%d",
+			owner, repo, opts.WorkflowFile, perPage, page)
+	} else {
+		path = fmt.Sprintf("repos/%s/%s/actions/runs?per_page=%d&page=%d",
+			owner, repo, perPage, page)
+	}
+
+	if status != "" {
+		path += "&status=" + status
+	}
+
+	if opts.Branch != "" {
+		path += "&branch=" + opts.Branch
+	}
+
+	return path
+}
+
+func (r workflowRunDetail) toRunTiming() RunTiming {
+	workflowName := r.Path
+	if workflowName == "" {
+		workflowName = r.Name
+	}
+
+	duration := r.UpdatedAt.Sub(r.CreatedAt)
+	queue, execution := SplitRunDuration(r.CreatedAt, r.RunStartedAt, r.UpdatedAt)
+	return RunTiming{
+		RunID:           r.ID,
+		Workflow:        workflowName,
+		WorkflowID:      r.WorkflowID,
+		Branch:          r.HeadBranch,
+		HeadSHA:         r.HeadSHA,
+		RunAttempt:      r.RunAttempt,
+		Conclusion:      r.Conclusion,
+		CreatedAt:       r.CreatedAt,
+		StartedAt:       r.RunStartedAt,
+		UpdatedAt:       r.UpdatedAt,
+		DurationSeconds: duration.Seconds(),
+		Duration:        duration,
+
+		QueueDuration:     queue,
+		ExecutionDuration: execution,
+	}
+}
+
+// SplitRunDuration splits a run's created-to-updated time into queue wait and execution at startedAt
+// Pure function: without a usable startedAt (e.g. older cached runs) the whole run counts as execution
+func SplitRunDuration(createdAt, startedAt, updatedAt time.Time) (queue, execution time.Duration) {
+	total := updatedAt.Sub(createdAt)
+	if startedAt.IsZero() || startedAt.Before(createdAt) {
+		return 0, total
+	}
+	if startedAt.After(updatedAt) {
+		return total, 0
+	}
+	return startedAt.Sub(createdAt), updatedAt.Sub(startedAt)
 }
 
 func (c *Client) FetchRunDetails(owner, repo string, runID int) (*RunTiming, error) {
@@ -240,6 +348,7 @@ func (c *Client) FetchRunDetails(owner, repo string, runID int) (*RunTiming, err
 
 		jobDuration := j.CompletedAt.Sub(j.StartedAt)
 		jobs = append(jobs, JobTiming{
+			ID:              j.ID,
 			Name:            j.Name,
 			DurationSeconds: jobDuration.Seconds(),
 			Status:          j.Status,
@@ -260,19 +369,99 @@ func (c *Client) FetchWorkflowRunsWithDetails(owner, repo string, opts FetchWork
 		return nil, err
 	}
 
-	for i := range runs {
-		details, err := c.FetchRunDetails(owner, repo, runs[i].RunID)
-		if err != nil {
-			continue
+	return c.attachRunDetails(owner, repo, runs, opts.Workers), nil
+}
+
+func (c *Client) FetchWorkflowRunsSinceWithDetails(owner, repo string, opts FetchWorkflowRunsOptions, minRunID int) ([]RunTiming, int, error) {
+	runs, pendingRunID, err := c.FetchWorkflowRunsSince(owner, repo, opts, minRunID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return c.attachRunDetails(owner, repo, runs, opts.Workers), pendingRunID, nil
+}
+
+// ListRunsForCommit lists completed runs of every workflow triggered for a commit
+// The head_sha filter requires the full 40-character SHA
+func (c *Client) ListRunsForCommit(owner, repo, sha string) ([]RunTiming, error) {
+	perPage := 100
+
+	var runs []RunTiming
+	for page := 1; ; page++ {
+		var response workflowRunsDetailResponse
+		path := fmt.Sprintf("repos/%s/%s/actions/runs?head_sha=%s&per_page=%d&page=%d", owner, repo, sha, perPage, page)
+		if err := c.Get(path, &response); err != nil {
+			return nil, fmt.Errorf("failed to list runs for commit: %w", err)
+		}
+
+		for _, r := range response.WorkflowRuns {
+			if r.Conclusion == "" {
+				continue
+			}
+			runs = append(runs, r.toRunTiming())
+		}
+
+		if len(response.WorkflowRuns) < perPage {
+			return runs, nil
 		}
-		runs[i].Jobs = details.Jobs
 	}
+}
 
-	return runs, nil
+func (c *Client) ListRunsForCommitWithDetails(owner, repo, sha string) ([]RunTiming, error) {
+	runs, err := c.ListRunsForCommit(owner, repo, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.attachRunDetails(owner, repo, runs, DefaultDetailWorkers), nil
+}
+
+// runDetailsResult is the job data fetched for the run at index in attachRunDetails
+type runDetailsResult struct {
+	index int
+	jobs  []JobTiming
+}
+
+// attachRunDetails fetches job timings for runs with at most workers requests in flight
+// Runs whose details fail to load are returned without job data
+func (c *Client) attachRunDetails(owner, repo string, runs []RunTiming, workers int) []RunTiming {
+	if workers <= 0 {
+		workers = DefaultDetailWorkers
+	}
+
+	resultsCh := make(chan runDetailsResult, len(runs))
+	semaphore := make(chan struct{}, workers)
+
+	var wg sync.WaitGroup
+	for i := range runs {
+		wg.Add(1)
+		go func(index, runID int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			details, err := c.FetchRunDetails(owner, repo, runID)
+			if err != nil {
+				slog.Debug("skipping run details", "repo", owner+"/"+repo, "run_id", runID, "error", err)
+				return
+			}
+			resultsCh <- runDetailsResult{index: index, jobs: details.Jobs}
+		}(i, runs[i].RunID)
+	}
+
+	wg.Wait()
+	close(resultsCh)
+
+	for result := range resultsCh {
+		runs[result.index].Jobs = result.jobs
+	}
+
+	return runs
 }
 
 func ComputeWorkflowStats(runs []RunTiming) map[string]*WorkflowStats {
 	stats := make(map[string]*WorkflowStats)
+	durations := make(map[string][]time.Duration)
 
 	for _, r := range runs {
 		wf := r.Workflow
@@ -287,6 +476,9 @@ func ComputeWorkflowStats(runs []RunTiming) map[string]*WorkflowStats {
 		s := stats[wf]
 		s.TotalRuns++
 		s.AvgDuration += r.Duration
+		durations[wf] = append(durations[wf], r.Duration)
+		s.AvgQueue += r.QueueDuration
+		s.AvgExecution += r.ExecutionDuration
 
 		if r.Duration < s.MinDuration {
 			s.MinDuration = r.Duration
@@ -305,14 +497,110 @@ func ComputeWorkflowStats(runs []RunTiming) map[string]*WorkflowStats {
 	for _, s := range stats {
 		if s.TotalRuns > 0 {
 			s.AvgDuration = s.AvgDuration / time.Duration(s.TotalRuns)
+			s.AvgQueue = s.AvgQueue / time.Duration(s.TotalRuns)
+			s.AvgExecution = s.AvgExecution / time.Duration(s.TotalRuns)
 			successCount := s.TotalRuns - s.FailureCount
 			s.SuccessRate = float64(successCount) / float64(s.TotalRuns) * 100
 		}
+
+		sorted := durations[s.Workflow]
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		if len(sorted) > 0 {
+			s.P50 = percentileDuration(sorted, 50)
+			s.P75 = percentileDuration(sorted, 75)
+			s.P95 = percentileDuration(sorted, 95)
+		}
+	}
+
+	return stats
+}
+
+// DefaultHalfLifeDays is how many days old a run must be for its weight to halve
+const DefaultHalfLifeDays = 7.0
+
+// ComputeTimeWeightedWorkflowStats computes ComputeWorkflowStats plus an exponentially decayed average
+// A run halfLifeDays older than the newest run counts half as much; halfLifeDays <= 0 weights runs equally
+func ComputeTimeWeightedWorkflowStats(runs []RunTiming, halfLifeDays float64) map[string]*WorkflowStats {
+	stats := ComputeWorkflowStats(runs)
+
+	var newest time.Time
+	for _, r := range runs {
+		if r.CreatedAt.After(newest) {
+			newest = r.CreatedAt
+		}
+	}
+
+	weightedSums := make(map[string]float64)
+	weightTotals := make(map[string]float64)
+	for _, r := range runs {
+		weight := 1.0
+		if halfLifeDays > 0 {
+			ageDays := newest.Sub(r.CreatedAt).Hours() / 24
+			weight = math.Pow(0.5, ageDays/halfLifeDays)
+		}
+		weightedSums[r.Workflow] += weight * float64(r.Duration)
+		weightTotals[r.Workflow] += weight
+	}
+
+	for wf, s := range stats {
+		if weightTotals[wf] > 0 {
+			s.TimeWeightedAvg = time.Duration(weightedSums[wf] / weightTotals[wf])
+		}
 	}
 
 	return stats
 }
 
+// GetWorkflowDurationTimeSeries bins a workflow's runs into points equal time buckets between its first and last run
+// Pure function: returns the average duration in seconds per bucket, oldest first, with NaN for buckets without runs
+func GetWorkflowDurationTimeSeries(runs []RunTiming, workflow string, points int) []float64 {
+	if points <= 0 {
+		return nil
+	}
+
+	var matching []RunTiming
+	var start, end time.Time
+	for _, r := range runs {
+		if r.Workflow != workflow {
+			continue
+		}
+		if len(matching) == 0 || r.CreatedAt.Before(start) {
+			start = r.CreatedAt
+		}
+		if len(matching) == 0 || r.CreatedAt.After(end) {
+			end = r.CreatedAt
+		}
+		matching = append(matching, r)
+	}
+
+	series := make([]float64, points)
+	totals := make([]float64, points)
+	counts := make([]int, points)
+	span := end.Sub(start)
+	for _, r := range matching {
+		// A single instant has no span to divide, so its runs count as the most recent bucket
+		idx := points - 1
+		if span > 0 {
+			idx = int(float64(r.CreatedAt.Sub(start)) / float64(span) * float64(points))
+			if idx >= points {
+				idx = points - 1
+			}
+		}
+		totals[idx] += r.Duration.Seconds()
+		counts[idx]++
+	}
+
+	for i := range series {
+		if counts[i] == 0 {
+			series[i] = math.NaN()
+			continue
+		}
+		series[i] = totals[i] / float64(counts[i])
+	}
+
+	return series
+}
+
 func ComputeJobStats(runs []RunTiming) map[string]*JobStats {
 	stats := make(map[string]*JobStats)
 
@@ -322,6 +610,8 @@ func ComputeJobStats(runs []RunTiming) map[string]*JobStats {
 			if _, ok := stats[key]; !ok {
 				stats[key] = &JobStats{
 					WorkflowJob: key,
+					Workflow:    r.Workflow,
+					Job:         j.Name,
 					MinDuration: j.Duration,
 					MaxDuration: j.Duration,
 				}
@@ -349,6 +639,84 @@ func ComputeJobStats(runs []RunTiming) map[string]*JobStats {
 	return stats
 }
 
+// AggregateStepTimings summarizes a step's duration over every run of the named job
+// Pure function: returns nil when no run contains the step
+func AggregateStepTimings(runs []RunTiming, jobName, stepName string) *StepTimingAggregate {
+	var samples []time.Duration
+	runCount := 0
+
+	for _, r := range runs {
+		found := false
+		for _, j := range r.Jobs {
+			if j.Name != jobName {
+				continue
+			}
+			for _, s := range j.Steps {
+				if s.Name == stepName {
+					samples = append(samples, s.Duration)
+					found = true
+				}
+			}
+		}
+		if found {
+			runCount++
+		}
+	}
+
+	if len(samples) == 0 {
+		return nil
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+
+	var total time.Duration
+	for _, d := range samples {
+		total += d
+	}
+
+	return &StepTimingAggregate{
+		JobName:  jobName,
+		StepName: stepName,
+		Min:      samples[0],
+		Max:      samples[len(samples)-1],
+		Avg:      total / time.Duration(len(samples)),
+		P95:      percentileDuration(samples, 95),
+		RunCount: runCount,
+		Samples:  samples,
+	}
+}
+
+// AggregateJobStepTimings aggregates every step of the named job, in the order steps first appear
+func AggregateJobStepTimings(runs []RunTiming, jobName string) []*StepTimingAggregate {
+	var stepNames []string
+	seen := make(map[string]bool)
+	for _, r := range runs {
+		for _, j := range r.Jobs {
+			if j.Name != jobName {
+				continue
+			}
+			for _, s := range j.Steps {
+				if !seen[s.Name] {
+					seen[s.Name] = true
+					stepNames = append(stepNames, s.Name)
+				}
+			}
+		}
+	}
+
+	aggregates := make([]*StepTimingAggregate, 0, len(stepNames))
+	for _, name := range stepNames {
+		aggregates = append(aggregates, AggregateStepTimings(runs, jobName, name))
+	}
+	return aggregates
+}
+
+// percentileDuration returns the pct percentile of ascending samples using the lower nearest rank
+func percentileDuration(sorted []time.Duration, pct float64) time.Duration {
+	index := int(pct / 100 * float64(len(sorted)-1))
+	return sorted[index]
+}
+
 func ComputeBranchStats(runs []RunTiming, baseBranch string) map[string]*BranchStats {
 	stats := make(map[string]*BranchStats)
 
@@ -411,6 +779,236 @@ func ComputeBranchStats(runs []RunTiming, baseBranch string) map[string]*BranchS
 	return stats
 }
 
+// IsRegression reports whether a duration delta exceeds the regression threshold
+// Pure function: a non-positive threshold disables regression detection
+func IsRegression(deltaPct, thresholdPct float64) bool {
+	return thresholdPct > 0 && deltaPct > thresholdPct
+}
+
+// DefaultAnomalyZScore is how many standard deviations from its workflow's mean a run must be to be anomalous
+const DefaultAnomalyZScore = 2.5
+
+// AnomalousRun is a run whose duration is unusual for its workflow
+type AnomalousRun struct {
+	RunTiming
+	ZScore    float64
+	Deviation string // "slow" or "fast"
+}
+
+// ComputeAnomalousRuns flags runs whose duration is more than zScoreThreshold standard deviations
+// from the mean duration of their workflow
+// Pure function: workflows with fewer than 3 runs or no variance are skipped; results are sorted by |z| descending
+func ComputeAnomalousRuns(runs []RunTiming, zScoreThreshold float64) []AnomalousRun {
+	byWorkflow := make(map[string][]RunTiming)
+	for _, r := range runs {
+		byWorkflow[r.Workflow] = append(byWorkflow[r.Workflow], r)
+	}
+
+	var anomalies []AnomalousRun
+	for _, wfRuns := range byWorkflow {
+		if len(wfRuns) < 3 {
+			continue
+		}
+
+		var sum float64
+		for _, r := range wfRuns {
+			sum += r.Duration.Seconds()
+		}
+		mean := sum / float64(len(wfRuns))
+
+		var variance float64
+		for _, r := range wfRuns {
+			diff := r.Duration.Seconds() - mean
+			variance += diff * diff
+		}
+		stdDev := math.Sqrt(variance / float64(len(wfRuns)))
+		if stdDev == 0 {
+			continue
+		}
+
+		for _, r := range wfRuns {
+			z := (r.Duration.Seconds() - mean) / stdDev
+			if math.Abs(z) <= zScoreThreshold {
+				continue
+			}
+			deviation := "slow"
+			if z < 0 {
+				deviation = "fast"
+			}
+			anomalies = append(anomalies, AnomalousRun{RunTiming: r, ZScore: z, Deviation: deviation})
+		}
+	}
+
+	sort.Slice(anomalies, func(i, j int) bool {
+		if zi, zj := math.Abs(anomalies[i].ZScore), math.Abs(anomalies[j].ZScore); zi != zj {
+			return zi > zj
+		}
+		return anomalies[i].RunID < anomalies[j].RunID
+	})
+
+	return anomalies
+}
+
+func ComputeFailureHeatmap(runs []RunTiming) [7][24]int {
+	var heatmap [7][24]int
+	for _, r := range runs {
+		if r.Conclusion != "failure" {
+			continue
+		}
+		heatmap[r.CreatedAt.Weekday()][r.CreatedAt.Hour()]++
+	}
+	return heatmap
+}
+
+func MaxHeatmapCount(heatmap [7][24]int) int {
+	maxCount := 0
+	for _, day := range heatmap {
+		for _, count := range day {
+			if count > maxCount {
+				maxCount = count
+			}
+		}
+	}
+	return maxCount
+}
+
+// GroupRunsByWorkflowAndConclusion groups runs by workflow name, then by conclusion
+// Pure function: runs still in progress are grouped under an empty conclusion
+func GroupRunsByWorkflowAndConclusion(runs []RunTiming) map[string]map[string][]RunTiming {
+	grouped := make(map[string]map[string][]RunTiming)
+	for _, r := range runs {
+		if grouped[r.Workflow] == nil {
+			grouped[r.Workflow] = make(map[string][]RunTiming)
+		}
+		grouped[r.Workflow][r.Conclusion] = append(grouped[r.Workflow][r.Conclusion], r)
+	}
+	return grouped
+}
+
+// conclusionOrder lists conclusions worst first for FormatFailureSummary
+var conclusionOrder = map[string]int{
+	"failure":   0,
+	"timed_out": 1,
+	"cancelled": 2,
+	"success":   3,
+}
+
+var conclusionEmoji = map[string]string{
+	"failure":   "❌",
+	"timed_out": "⏱️",
+	"cancelled": "⚪",
+	"success":   "✅",
+	"skipped":   "⏭️",
+}
+
+// maxSummaryRunIDs caps how many failed run IDs FormatFailureSummary lists per workflow
+const maxSummaryRunIDs = 5
+
+// FormatFailureSummary renders grouped runs as Markdown for chat or email notifications
+// Pure function: workflows with failures are listed first, then alphabetically
+func FormatFailureSummary(grouped map[string]map[string][]RunTiming) string {
+	var b strings.Builder
+
+	failures := func(workflow string) int {
+		return len(grouped[workflow]["failure"]) + len(grouped[workflow]["timed_out"])
+	}
+
+	workflows := make([]string, 0, len(grouped))
+	totalFailures := 0
+	for workflow := range grouped {
+		workflows = append(workflows, workflow)
+		totalFailures += failures(workflow)
+	}
+	sort.Slice(workflows, func(i, j int) bool {
+		fi, fj := failures(workflows[i]) > 0, failures(workflows[j]) > 0
+		if fi != fj {
+			return fi
+		}
+		return workflows[i] < workflows[j]
+	})
+
+	b.WriteString("## Workflow run summary\n\n")
+	if len(workflows) == 0 {
+		b.WriteString("No runs found.\n")
+		return b.String()
+	}
+	if totalFailures == 0 {
+		b.WriteString("✅ No failures\n")
+	} else {
+		b.WriteString(fmt.Sprintf("❌ %d failed runs across %d workflows\n", totalFailures, len(workflows)))
+	}
+
+	for _, workflow := range workflows {
+		status := "✅"
+		if failures(workflow) > 0 {
+			status = "❌"
+		}
+		b.WriteString(fmt.Sprintf("\n### %s %s\n\n", status, workflow))
+
+		conclusions := make([]string, 0, len(grouped[workflow]))
+		for conclusion := range grouped[workflow] {
+			conclusions = append(conclusions, conclusion)
+		}
+		sort.Slice(conclusions, func(i, j int) bool {
+			oi, ok := conclusionOrder[conclusions[i]]
+			if !ok {
+				oi = len(conclusionOrder)
+			}
+			oj, ok := conclusionOrder[conclusions[j]]
+			if !ok {
+				oj = len(conclusionOrder)
+			}
+			if oi != oj {
+				return oi < oj
+			}
+			return conclusions[i] < conclusions[j]
+		})
+
+		for _, conclusion := range conclusions {
+			runs := grouped[workflow][conclusion]
+			label := conclusion
+			if label == "" {
+				label = "in_progress"
+			}
+			emoji, ok := conclusionEmoji[conclusion]
+			if !ok {
+				emoji = "🔄"
+			}
+
+			line := fmt.Sprintf("- %s %s: %d", emoji, label, len(runs))
+			if conclusion == "failure" || conclusion == "timed_out" {
+				line += " (" + formatRunIDs(runs) + ")"
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// formatRunIDs lists the newest run IDs, e.g. "#12, #9 and 3 more"
+func formatRunIDs(runs []RunTiming) string {
+	sorted := make([]RunTiming, len(runs))
+	copy(sorted, runs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].RunID > sorted[j].RunID
+	})
+
+	var ids []string
+	for i, r := range sorted {
+		if i == maxSummaryRunIDs {
+			break
+		}
+		ids = append(ids, fmt.Sprintf("#%d", r.RunID))
+	}
+
+	formatted := strings.Join(ids, ", ")
+	if extra := len(sorted) - len(ids); extra > 0 {
+		formatted += fmt.Sprintf(" and %d more", extra)
+	}
+	return formatted
+}
+
 func FilterRunsByBranch(runs []RunTiming, branch string) []RunTiming {
 	if branch == "" {
 		return runs
@@ -424,6 +1022,21 @@ func FilterRunsByBranch(runs []RunTiming, branch string) []RunTiming {
 	return filtered
 }
 
+// FilterRunsByCommit keeps runs for a commit, matching a full SHA or a 7+ character prefix
+func FilterRunsByCommit(runs []RunTiming, commitSHA string) []RunTiming {
+	if commitSHA == "" {
+		return runs
+	}
+	var filtered []RunTiming
+	for _, r := range runs {
+		if r.HeadSHA == commitSHA || (len(commitSHA) >= 7 && len(r.HeadSHA) >= 7 &&
+			r.HeadSHA[:7] == commitSHA[:7]) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
 func FilterRunsByWorkflows(runs []RunTiming, workflows []string) []RunTiming {
 	if len(workflows) == 0 {
 		return runs
@@ -471,7 +1084,10 @@ func GetTopJobsByDuration(stats map[string]*JobStats, limit int) []*JobStats {
 	}
 
 	sort.Slice(jobs, func(i, j int) bool {
-		return jobs[i].AvgDuration > jobs[j].AvgDuration
+		if jobs[i].AvgDuration != jobs[j].AvgDuration {
+			return jobs[i].AvgDuration > jobs[j].AvgDuration
+		}
+		return jobs[i].WorkflowJob < jobs[j].WorkflowJob
 	})
 
 	if limit > 0 && len(jobs) > limit {
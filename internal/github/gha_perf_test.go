@@ -0,0 +1,625 @@
+package github
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestComputeFailureHeatmap tests failure placement by weekday and hour
+func TestComputeFailureHeatmap(t *testing.T) {
+	// 2024-01-01 is a Monday
+	monday9am := time.Date(2024, 1, 1, 9, 15, 0, 0, time.UTC)
+	sunday11pm := time.Date(2024, 1, 7, 23, 59, 0, 0, time.UTC)
+
+	runs := []RunTiming{
+		{Conclusion: "failure", CreatedAt: monday9am},
+		{Conclusion: "failure", CreatedAt: monday9am.Add(30 * time.Minute)},
+		{Conclusion: "failure", CreatedAt: monday9am.AddDate(0, 0, 7)},
+		{Conclusion: "failure", CreatedAt: sunday11pm},
+		{Conclusion: "success", CreatedAt: monday9am},
+		{Conclusion: "cancelled", CreatedAt: sunday11pm},
+	}
+
+	heatmap := ComputeFailureHeatmap(runs)
+
+	if heatmap[time.Monday][9] != 3 {
+		t.Errorf("Expected 3 failures on Monday 09:00, got %d", heatmap[time.Monday][9])
+	}
+
+	if heatmap[time.Sunday][23] != 1 {
+		t.Errorf("Expected 1 failure on Sunday 23:00, got %d", heatmap[time.Sunday][23])
+	}
+
+	total := 0
+	for _, day := range heatmap {
+		for _, count := range day {
+			total += count
+		}
+	}
+	if total != 4 {
+		t.Errorf("Expected 4 failures total, got %d", total)
+	}
+
+	if MaxHeatmapCount(heatmap) != 3 {
+		t.Errorf("Expected max count 3, got %d", MaxHeatmapCount(heatmap))
+	}
+}
+
+// TestFetchWorkflowRunsSince tests that paging stops once cached runs are reached
+func TestFetchWorkflowRunsSince(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var requestedPages []string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		requestedPages = append(requestedPages, page)
+
+		pageNum, _ := strconv.Atoi(page)
+		if pageNum < 1 || pageNum > 3 {
+			w.Write([]byte(`{"workflow_runs": []}`))
+			return
+		}
+
+		// Three full pages of runs, newest first: 300..201, 200..101, 100..1
+		var response workflowRunsDetailResponse
+		for id := 300 - (pageNum-1)*100; id > 300-pageNum*100; id-- {
+			response.WorkflowRuns = append(response.WorkflowRuns, workflowRunDetail{
+				ID:         id,
+				Path:       ".github/workflows/ci.yml",
+				Conclusion: "success",
+				CreatedAt:  created,
+				UpdatedAt:  created.Add(time.Minute),
+			})
+		}
+		json.NewEncoder(w).Encode(response)
+	})
+
+	tests := []struct {
+		name          string
+		minRunID      int
+		expectedRuns  int
+		expectedPages []string
+	}{
+		{"stops mid second page", 150, 150, []string{"1", "2"}},
+		{"stops at page boundary", 200, 100, []string{"1", "2"}},
+		{"empty cache fetches until a short page", 0, 300, []string{"1", "2", "3", "4"}},
+		{"nothing new", 300, 0, []string{"1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPages = nil
+			client := newTestClient(t, handler)
+
+			runs, _, err := client.FetchWorkflowRunsSince("owner", "repo", FetchWorkflowRunsOptions{}, tt.minRunID)
+			if err != nil {
+				t.Fatalf("FetchWorkflowRunsSince failed: %v", err)
+			}
+
+			if len(runs) != tt.expectedRuns {
+				t.Errorf("Expected %d runs, got %d", tt.expectedRuns, len(runs))
+			}
+
+			for _, r := range runs {
+				if r.RunID <= tt.minRunID {
+					t.Errorf("Expected only runs after %d, got %d", tt.minRunID, r.RunID)
+				}
+			}
+
+			if strings.Join(requestedPages, ",") != strings.Join(tt.expectedPages, ",") {
+				t.Errorf("Expected pages %v, got %v", tt.expectedPages, requestedPages)
+			}
+		})
+	}
+}
+
+// TestFetchWorkflowRunsSincePending tests that in-progress runs are requested, skipped, and reported
+func TestFetchWorkflowRunsSincePending(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("status") {
+			t.Errorf("Expected runs in any status, got status=%s", r.URL.Query().Get("status"))
+		}
+		w.Write([]byte(`{"workflow_runs": [
+			{"id": 5, "conclusion": null, "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:05:00Z"},
+			{"id": 4, "conclusion": "success", "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:05:00Z"},
+			{"id": 3, "conclusion": null, "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:05:00Z"},
+			{"id": 2, "conclusion": "failure", "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:05:00Z"}
+		]}`))
+	})
+
+	client := newTestClient(t, handler)
+
+	runs, pendingRunID, err := client.FetchWorkflowRunsSince("owner", "repo", FetchWorkflowRunsOptions{}, 1)
+	if err != nil {
+		t.Fatalf("FetchWorkflowRunsSince failed: %v", err)
+	}
+
+	if len(runs) != 2 || runs[0].RunID != 4 || runs[1].RunID != 2 {
+		t.Errorf("Expected completed runs 4 and 2, got %+v", runs)
+	}
+	if pendingRunID != 3 {
+		t.Errorf("Expected oldest pending run 3, got %d", pendingRunID)
+	}
+}
+
+// TestFetchWorkflowRunsSinceRunAttempt tests that run_attempt is mapped from the API response
+func TestFetchWorkflowRunsSinceRunAttempt(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"workflow_runs": [
+			{"id": 2, "path": ".github/workflows/ci.yml", "conclusion": "success", "run_attempt": 3,
+			 "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:05:00Z"},
+			{"id": 1, "path": ".github/workflows/ci.yml", "conclusion": "failure", "run_attempt": 1,
+			 "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:05:00Z"}
+		]}`))
+	})
+
+	client := newTestClient(t, handler)
+
+	runs, _, err := client.FetchWorkflowRunsSince("owner", "repo", FetchWorkflowRunsOptions{}, 0)
+	if err != nil {
+		t.Fatalf("FetchWorkflowRunsSince failed: %v", err)
+	}
+
+	if len(runs) != 2 {
+		t.Fatalf("Expected 2 runs, got %d", len(runs))
+	}
+
+	if runs[0].RunAttempt != 3 {
+		t.Errorf("Expected run_attempt 3, got %d", runs[0].RunAttempt)
+	}
+	if runs[1].RunAttempt != 1 {
+		t.Errorf("Expected run_attempt 1, got %d", runs[1].RunAttempt)
+	}
+}
+
+// TestListRunsForCommit tests that runs from every workflow are returned for a head_sha query
+func TestListRunsForCommit(t *testing.T) {
+	sha := "0123456789abcdef0123456789abcdef01234567"
+	var requestedSHA string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedSHA = r.URL.Query().Get("head_sha")
+		w.Write([]byte(`{"workflow_runs": [
+			{"id": 3, "path": ".github/workflows/deploy.yml", "head_sha": "` + sha + `", "conclusion": "failure",
+			 "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:10:00Z"},
+			{"id": 2, "path": ".github/workflows/lint.yml", "head_sha": "` + sha + `", "conclusion": "success",
+			 "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:02:00Z"},
+			{"id": 1, "path": ".github/workflows/ci.yml", "head_sha": "` + sha + `", "conclusion": "success",
+			 "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:05:00Z"},
+			{"id": 4, "path": ".github/workflows/ci.yml", "head_sha": "` + sha + `", "conclusion": "",
+			 "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:01:00Z"}
+		]}`))
+	})
+
+	client := newTestClient(t, handler)
+
+	runs, err := client.ListRunsForCommit("owner", "repo", sha)
+	if err != nil {
+		t.Fatalf("ListRunsForCommit failed: %v", err)
+	}
+
+	if requestedSHA != sha {
+		t.Errorf("Expected head_sha=%s, got %q", sha, requestedSHA)
+	}
+
+	var workflows []string
+	for _, r := range runs {
+		workflows = append(workflows, r.Workflow)
+	}
+	expected := ".github/workflows/deploy.yml,.github/workflows/lint.yml,.github/workflows/ci.yml"
+	if strings.Join(workflows, ",") != expected {
+		t.Errorf("Expected completed runs for all workflows %s, got %v", expected, workflows)
+	}
+}
+
+// TestFilterRunsByCommit tests full SHA and short prefix matching
+func TestFilterRunsByCommit(t *testing.T) {
+	runs := []RunTiming{
+		{RunID: 1, HeadSHA: "abcdef0123456789"},
+		{RunID: 2, HeadSHA: "1234567890abcdef"},
+		{RunID: 3, HeadSHA: "fedcba0999999999"},
+	}
+
+	tests := []struct {
+		sha      string
+		expected int
+	}{
+		{"", 3},
+		{"abcdef0123456789", 1},
+		{"abcdef0", 1},
+		{"1234567", 1},
+		{"fffffff", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sha, func(t *testing.T) {
+			if got := FilterRunsByCommit(runs, tt.sha); len(got) != tt.expected {
+				t.Errorf("Expected %d runs, got %d", tt.expected, len(got))
+			}
+		})
+	}
+}
+
+// TestComputeTimeWeightedWorkflowStats tests that a slow recent run raises the weighted average
+func TestComputeTimeWeightedWorkflowStats(t *testing.T) {
+	newest := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	var runs []RunTiming
+	for i := 1; i <= 4; i++ {
+		runs = append(runs, RunTiming{
+			Workflow:   "CI",
+			Conclusion: "success",
+			CreatedAt:  newest.AddDate(0, 0, -7*i),
+			Duration:   5 * time.Minute,
+		})
+	}
+	runs = append(runs, RunTiming{Workflow: "CI", Conclusion: "success", CreatedAt: newest, Duration: 10 * time.Minute})
+
+	stats := ComputeTimeWeightedWorkflowStats(runs, 7)["CI"]
+
+	if stats.AvgDuration != 6*time.Minute {
+		t.Errorf("Expected simple average 6m, got %s", stats.AvgDuration)
+	}
+	if stats.TimeWeightedAvg <= stats.AvgDuration {
+		t.Errorf("Expected time-weighted average above %s, got %s", stats.AvgDuration, stats.TimeWeightedAvg)
+	}
+	if stats.TimeWeightedAvg >= 10*time.Minute {
+		t.Errorf("Expected older runs to still contribute, got %s", stats.TimeWeightedAvg)
+	}
+
+	unweighted := ComputeTimeWeightedWorkflowStats(runs, 0)["CI"]
+	if unweighted.TimeWeightedAvg != unweighted.AvgDuration {
+		t.Errorf("Expected equal weights without a half-life, got %s vs %s", unweighted.TimeWeightedAvg, unweighted.AvgDuration)
+	}
+}
+
+// TestSplitRunDuration tests that queue and execution time add up to the run duration
+func TestSplitRunDuration(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	detail := workflowRunDetail{
+		ID:           1,
+		Path:         ".github/workflows/ci.yml",
+		CreatedAt:    created,
+		RunStartedAt: created.Add(90*time.Second + 250*time.Millisecond),
+		UpdatedAt:    created.Add(7*time.Minute + 500*time.Millisecond),
+	}
+
+	run := detail.toRunTiming()
+
+	if run.QueueDuration != 90*time.Second+250*time.Millisecond {
+		t.Errorf("Expected queue of 1m30.25s, got %s", run.QueueDuration)
+	}
+	if diff := run.Duration - (run.QueueDuration + run.ExecutionDuration); diff < -time.Millisecond || diff > time.Millisecond {
+		t.Errorf("Expected queue + execution ≈ duration, got %s + %s vs %s", run.QueueDuration, run.ExecutionDuration, run.Duration)
+	}
+
+	queue, execution := SplitRunDuration(created, time.Time{}, created.Add(time.Minute))
+	if queue != 0 || execution != time.Minute {
+		t.Errorf("Expected a missing start time to count as execution, got %s/%s", queue, execution)
+	}
+
+	stats := ComputeWorkflowStats([]RunTiming{run})[".github/workflows/ci.yml"]
+	if stats.AvgQueue != run.QueueDuration || stats.AvgExecution != run.ExecutionDuration {
+		t.Errorf("Expected averages to match the single run, got %s/%s", stats.AvgQueue, stats.AvgExecution)
+	}
+}
+
+// TestAggregateStepTimings tests min, max, average and p95 across runs
+func TestAggregateStepTimings(t *testing.T) {
+	var runs []RunTiming
+	for _, seconds := range []int{50, 10, 40, 20, 30} {
+		runs = append(runs, RunTiming{Jobs: []JobTiming{{
+			Name: "build",
+			Steps: []StepTiming{
+				{Name: "Checkout", Duration: time.Second},
+				{Name: "Compile", Duration: time.Duration(seconds) * time.Second},
+			},
+		}}})
+	}
+
+	agg := AggregateStepTimings(runs, "build", "Compile")
+	if agg == nil {
+		t.Fatal("Expected an aggregate for build:Compile")
+	}
+
+	if agg.RunCount != 5 || len(agg.Samples) != 5 {
+		t.Errorf("Expected 5 runs and samples, got %d runs and %d samples", agg.RunCount, len(agg.Samples))
+	}
+	if agg.Min != 10*time.Second || agg.Max != 50*time.Second {
+		t.Errorf("Expected min 10s and max 50s, got %v and %v", agg.Min, agg.Max)
+	}
+	if agg.Avg != 30*time.Second {
+		t.Errorf("Expected avg 30s, got %v", agg.Avg)
+	}
+	// The fourth of five ascending samples
+	if agg.P95 != 40*time.Second {
+		t.Errorf("Expected p95 40s, got %v", agg.P95)
+	}
+
+	if AggregateStepTimings(runs, "build", "Deploy") != nil {
+		t.Error("Expected nil aggregate for a missing step")
+	}
+
+	steps := AggregateJobStepTimings(runs, "build")
+	if len(steps) != 2 || steps[0].StepName != "Checkout" || steps[1].StepName != "Compile" {
+		t.Errorf("Expected Checkout then Compile, got %v", steps)
+	}
+}
+
+// TestComputeAnomalousRuns tests that a run 3 sigma above its workflow mean is flagged as slow
+func TestComputeAnomalousRuns(t *testing.T) {
+	var runs []RunTiming
+	for i := 0; i < 20; i++ {
+		seconds := 100
+		if i%2 == 1 {
+			seconds = 110
+		}
+		runs = append(runs, RunTiming{RunID: i + 1, Workflow: "ci.yml", Duration: time.Duration(seconds) * time.Second})
+	}
+	// Mean 106s, standard deviation ~6.6s
+	runs = append(runs, RunTiming{RunID: 99, Workflow: "ci.yml", Duration: 126 * time.Second})
+	// Too few runs to judge
+	runs = append(runs,
+		RunTiming{RunID: 100, Workflow: "deploy.yml", Duration: time.Second},
+		RunTiming{RunID: 101, Workflow: "deploy.yml", Duration: time.Hour},
+	)
+
+	anomalies := ComputeAnomalousRuns(runs, DefaultAnomalyZScore)
+	if len(anomalies) != 1 {
+		t.Fatalf("Expected 1 anomaly, got %+v", anomalies)
+	}
+
+	a := anomalies[0]
+	if a.RunID != 99 || a.Deviation != "slow" {
+		t.Errorf("Expected run 99 to be slow, got run %d %s", a.RunID, a.Deviation)
+	}
+	if a.ZScore < 2.9 || a.ZScore > 3.1 {
+		t.Errorf("Expected z-score near 3, got %.2f", a.ZScore)
+	}
+
+	if anomalies := ComputeAnomalousRuns(runs, 3.5); len(anomalies) != 0 {
+		t.Errorf("Expected no anomalies above 3.5 sigma, got %+v", anomalies)
+	}
+}
+
+// TestGroupRunsByWorkflowAndConclusion tests grouping and the Markdown failure summary
+func TestGroupRunsByWorkflowAndConclusion(t *testing.T) {
+	runs := []RunTiming{
+		{RunID: 1, Workflow: "CI", Conclusion: "success"},
+		{RunID: 2, Workflow: "CI", Conclusion: "failure"},
+		{RunID: 3, Workflow: "CI", Conclusion: "failure"},
+		{RunID: 4, Workflow: "Deploy", Conclusion: "success"},
+		{RunID: 5, Workflow: "Deploy", Conclusion: "cancelled"},
+		{RunID: 6, Workflow: "Lint", Conclusion: "success"},
+		{RunID: 7, Workflow: "Lint", Conclusion: "success"},
+	}
+
+	grouped := GroupRunsByWorkflowAndConclusion(runs)
+
+	if len(grouped) != 3 {
+		t.Fatalf("Expected 3 workflows, got %d", len(grouped))
+	}
+	expected := map[string]map[string]int{
+		"CI":     {"success": 1, "failure": 2},
+		"Deploy": {"success": 1, "cancelled": 1},
+		"Lint":   {"success": 2},
+	}
+	for workflow, conclusions := range expected {
+		if len(grouped[workflow]) != len(conclusions) {
+			t.Errorf("Expected %d conclusions for %s, got %d", len(conclusions), workflow, len(grouped[workflow]))
+		}
+		for conclusion, count := range conclusions {
+			if got := len(grouped[workflow][conclusion]); got != count {
+				t.Errorf("Expected %d %s runs for %s, got %d", count, conclusion, workflow, got)
+			}
+		}
+	}
+
+	summary := FormatFailureSummary(grouped)
+	for _, want := range []string{"### ❌ CI", "### ✅ Deploy", "### ✅ Lint", "- ❌ failure: 2 (#3, #2)", "2 failed runs across 3 workflows"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("Expected %q in summary, got:\n%s", want, summary)
+		}
+	}
+	if strings.Index(summary, "CI") > strings.Index(summary, "Deploy") {
+		t.Errorf("Expected failing workflows first, got:\n%s", summary)
+	}
+}
+
+// TestGetWorkflowDurationTimeSeries tests bucket assignment, averaging and empty buckets
+func TestGetWorkflowDurationTimeSeries(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	runs := []RunTiming{
+		{Workflow: "CI", CreatedAt: start, Duration: 60 * time.Second},
+		{Workflow: "CI", CreatedAt: start.Add(time.Hour), Duration: 120 * time.Second},
+		{Workflow: "CI", CreatedAt: start.Add(6 * time.Hour), Duration: 300 * time.Second},
+		{Workflow: "CI", CreatedAt: start.Add(8 * time.Hour), Duration: 90 * time.Second},
+		{Workflow: "Lint", CreatedAt: start.Add(4 * time.Hour), Duration: time.Hour},
+	}
+
+	series := GetWorkflowDurationTimeSeries(runs, "CI", 4)
+	if len(series) != 4 {
+		t.Fatalf("Expected 4 points, got %d", len(series))
+	}
+
+	// 8h span in 2h buckets: the 0h and 1h runs share the first, the 6h and 8h runs share the last
+	if series[0] != 90 {
+		t.Errorf("Expected first bucket to average 90s, got %v", series[0])
+	}
+	if !math.IsNaN(series[1]) || !math.IsNaN(series[2]) {
+		t.Errorf("Expected empty middle buckets to be NaN, got %v", series)
+	}
+	if series[3] != 195 {
+		t.Errorf("Expected last bucket to average 195s, got %v", series[3])
+	}
+}
+
+// TestGetWorkflowDurationTimeSeriesSingleRun tests that a lone run fills the most recent bucket
+func TestGetWorkflowDurationTimeSeriesSingleRun(t *testing.T) {
+	runs := []RunTiming{{Workflow: "CI", CreatedAt: time.Now(), Duration: 30 * time.Second}}
+
+	series := GetWorkflowDurationTimeSeries(runs, "CI", 3)
+	if !math.IsNaN(series[0]) || !math.IsNaN(series[1]) || series[2] != 30 {
+		t.Errorf("Expected [NaN NaN 30], got %v", series)
+	}
+
+	if got := GetWorkflowDurationTimeSeries(runs, "CI", 0); got != nil {
+		t.Errorf("Expected nil series for zero points, got %v", got)
+	}
+}
+
+// TestComputeWorkflowStatsPercentiles tests that an outlier skews the average but not P50 or P75
+func TestComputeWorkflowStatsPercentiles(t *testing.T) {
+	var runs []RunTiming
+	for i := 1; i <= 19; i++ {
+		runs = append(runs, RunTiming{Workflow: "CI", Conclusion: "success", Duration: time.Duration(i) * time.Minute})
+	}
+	runs = append(runs, RunTiming{Workflow: "CI", Conclusion: "success", Duration: 5 * time.Hour})
+
+	s := ComputeWorkflowStats(runs)["CI"]
+
+	// Indexes into the 20 sorted durations: P50 -> 9, P75 -> 14, P95 -> 18
+	if s.P50 != 10*time.Minute {
+		t.Errorf("Expected P50 of 10m, got %s", s.P50)
+	}
+	if s.P75 != 15*time.Minute {
+		t.Errorf("Expected P75 of 15m, got %s", s.P75)
+	}
+	if s.P95 != 19*time.Minute {
+		t.Errorf("Expected P95 of 19m, got %s", s.P95)
+	}
+	if s.AvgDuration <= s.P75 {
+		t.Errorf("Expected the outlier to pull the average (%s) above P75", s.AvgDuration)
+	}
+}
+
+// TestComputeWorkflowStatsPercentilesSingleRun tests that one run sets every percentile
+func TestComputeWorkflowStatsPercentilesSingleRun(t *testing.T) {
+	s := ComputeWorkflowStats([]RunTiming{{Workflow: "CI", Duration: 3 * time.Minute}})["CI"]
+	if s.P50 != 3*time.Minute || s.P75 != 3*time.Minute || s.P95 != 3*time.Minute {
+		t.Errorf("Expected all percentiles to be 3m, got %s/%s/%s", s.P50, s.P75, s.P95)
+	}
+}
+
+// runDetailsHandler serves runIDs as completed runs, each of whose jobs requests takes delay
+// Jobs requests for failIDs return 500; peak records the most requests in flight at once
+func runDetailsHandler(runIDs []int, failIDs map[int]bool, delay time.Duration, peak *int) http.Handler {
+	var mu sync.Mutex
+	inFlight := 0
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		var response workflowRunsDetailResponse
+		for _, id := range runIDs {
+			response.WorkflowRuns = append(response.WorkflowRuns, workflowRunDetail{
+				ID:         id,
+				Path:       ".github/workflows/ci.yml",
+				Conclusion: "success",
+				CreatedAt:  created,
+				UpdatedAt:  created.Add(time.Minute),
+			})
+		}
+		json.NewEncoder(w).Encode(response)
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/runs/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > *peak {
+			*peak = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		time.Sleep(delay)
+
+		id, _ := strconv.Atoi(strings.Split(strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/actions/runs/"), "/")[0])
+		if failIDs[id] {
+			http.Error(w, `{"message": "Server Error"}`, http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"jobs": [{"name": "build", "status": "completed", "conclusion": "success",
+			"started_at": "2024-01-01T00:00:00Z", "completed_at": "2024-01-01T00:01:00Z"}]}`))
+	})
+
+	return mux
+}
+
+// TestFetchWorkflowRunsWithDetailsWorkers tests that detail fetches are bounded by Workers
+// and that a failed fetch leaves only that run without jobs
+func TestFetchWorkflowRunsWithDetailsWorkers(t *testing.T) {
+	runIDs := []int{8, 7, 6, 5, 4, 3, 2, 1}
+	peak := 0
+	client := newTestClient(t, runDetailsHandler(runIDs, map[int]bool{5: true}, 20*time.Millisecond, &peak))
+
+	runs, err := client.FetchWorkflowRunsWithDetails("owner", "repo", FetchWorkflowRunsOptions{Limit: 10, Workers: 4})
+	if err != nil {
+		t.Fatalf("FetchWorkflowRunsWithDetails failed: %v", err)
+	}
+
+	if peak != 4 {
+		t.Errorf("Expected 4 detail requests in flight at peak, got %d", peak)
+	}
+	if len(runs) != len(runIDs) {
+		t.Fatalf("Expected %d runs, got %d", len(runIDs), len(runs))
+	}
+	for i, r := range runs {
+		if r.RunID != runIDs[i] {
+			t.Errorf("Run %d: expected ID %d, got %d", i, runIDs[i], r.RunID)
+		}
+		if r.RunID == 5 {
+			if len(r.Jobs) != 0 {
+				t.Errorf("Expected no jobs for failed run 5, got %d", len(r.Jobs))
+			}
+			continue
+		}
+		if len(r.Jobs) != 1 || r.Jobs[0].Name != "build" {
+			t.Errorf("Run %d: expected build job, got %+v", r.RunID, r.Jobs)
+		}
+	}
+}
+
+// BenchmarkFetchWorkflowRunsWithDetails compares serial and concurrent detail fetches
+// against a mock server with fixed latency. Four workers cap the ideal speedup at 4x,
+// so the benchmark fails when request overhead pulls it below 3.5x
+func BenchmarkFetchWorkflowRunsWithDetails(b *testing.B) {
+	runIDs := make([]int, 16)
+	for i := range runIDs {
+		runIDs[i] = len(runIDs) - i
+	}
+	peak := 0
+	client := newTestClient(b, runDetailsHandler(runIDs, nil, 25*time.Millisecond, &peak))
+
+	runs, err := client.FetchWorkflowRuns("owner", "repo", FetchWorkflowRunsOptions{Limit: 100})
+	if err != nil {
+		b.Fatalf("FetchWorkflowRuns failed: %v", err)
+	}
+
+	timeDetails := func(workers int) time.Duration {
+		start := time.Now()
+		client.attachRunDetails("owner", "repo", append([]RunTiming(nil), runs...), workers)
+		return time.Since(start)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		serial := timeDetails(1)
+		concurrent := timeDetails(4)
+
+		speedup := float64(serial) / float64(concurrent)
+		b.ReportMetric(speedup, "speedup")
+		if speedup < 3.5 {
+			b.Errorf("Expected close to 4x speedup with 4 workers, got %.2fx (serial %v, concurrent %v)", speedup, serial, concurrent)
+		}
+	}
+}
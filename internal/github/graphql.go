@@ -0,0 +1,118 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/go-gh"
+	"github.com/cli/go-gh/pkg/api"
+)
+
+// GraphQLClient wraps the GitHub GraphQL API client
+type GraphQLClient struct {
+	gqlClient api.GQLClient
+	ctx       context.Context
+}
+
+// NewGraphQLClient creates a new GitHub GraphQL API client
+// The token is resolved like NewClient's; the host comes from ResolveAPIURL
+func NewGraphQLClient(ctx context.Context) (*GraphQLClient, error) {
+	token, err := resolveClientToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GraphQL client: %w", err)
+	}
+
+	host, err := apiHost(ResolveAPIURL(configAPIURL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GraphQL client: %w", err)
+	}
+
+	gqlClient, err := gh.GQLClient(&api.ClientOptions{
+		Host:      host,
+		AuthToken: token,
+		Transport: &retryTransport{cfg: DefaultRetryConfig, rt: http.DefaultTransport},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GraphQL client: %w", err)
+	}
+
+	return &GraphQLClient{
+		gqlClient: gqlClient,
+		ctx:       ctx,
+	}, nil
+}
+
+// RepoAlias returns the GraphQL alias used for the i-th repo in a batch query
+func RepoAlias(i int) string {
+	return fmt.Sprintf("repo%d", i)
+}
+
+// BuildBatchReposQuery builds one query that fetches fields for every repo via aliases
+// Pure function: returns the query and an alias -> "owner/repo" lookup
+func BuildBatchReposQuery(repoNames []string, fields []string) (string, map[string]string, error) {
+	aliases := make(map[string]string, len(repoNames))
+	selection := strings.Join(fields, "\n    ")
+
+	var b strings.Builder
+	b.WriteString("query {\n")
+
+	for i, repoName := range repoNames {
+		parts := strings.Split(repoName, "/")
+		if len(parts) != 2 {
+			return "", nil, fmt.Errorf("invalid repo %q, expected owner/repo", repoName)
+		}
+
+		alias := RepoAlias(i)
+		aliases[alias] = repoName
+
+		b.WriteString(fmt.Sprintf("  %s: repository(owner: %q, name: %q) {\n    %s\n  }\n",
+			alias, parts[0], parts[1], selection))
+	}
+
+	b.WriteString("}")
+
+	return b.String(), aliases, nil
+}
+
+// DemuxBatchResponse maps aliased GraphQL results back to repo names
+// Pure function: repos that resolved to null are omitted
+func DemuxBatchResponse(aliases map[string]string, data map[string]json.RawMessage) map[string]json.RawMessage {
+	results := make(map[string]json.RawMessage, len(aliases))
+
+	for alias, repoName := range aliases {
+		raw, ok := data[alias]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+		results[repoName] = raw
+	}
+
+	return results
+}
+
+// BatchRepos fetches the given repository fields for many repos in a single request
+func (g *GraphQLClient) BatchRepos(repoNames []string, fields []string) (map[string]json.RawMessage, error) {
+	if len(repoNames) == 0 {
+		return map[string]json.RawMessage{}, nil
+	}
+
+	query, aliases, err := BuildBatchReposQuery(repoNames, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]json.RawMessage
+	if err := g.gqlClient.DoWithContext(g.ctx, query, nil, &data); err != nil {
+		// Missing or inaccessible repos are reported as errors alongside partial data
+		var gqlErr api.GQLError
+		if !errors.As(err, &gqlErr) || len(data) == 0 {
+			return nil, fmt.Errorf("failed to batch fetch repos: %w", err)
+		}
+	}
+
+	return DemuxBatchResponse(aliases, data), nil
+}
@@ -0,0 +1,198 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/cli/go-gh"
+	"github.com/cli/go-gh/pkg/api"
+)
+
+// newTestGraphQLClient creates a GraphQLClient whose requests are served by handler
+func newTestGraphQLClient(t *testing.T, handler http.Handler) *GraphQLClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse test server URL: %v", err)
+	}
+
+	gqlClient, err := gh.GQLClient(&api.ClientOptions{
+		Host:         "github.com",
+		AuthToken:    "test-token",
+		Transport:    rewriteTransport{target: target},
+		LogIgnoreEnv: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create GraphQL client: %v", err)
+	}
+
+	return &GraphQLClient{gqlClient: gqlClient, ctx: context.Background()}
+}
+
+// TestBuildBatchReposQuery tests alias naming in the generated query
+func TestBuildBatchReposQuery(t *testing.T) {
+	repos := []string{"org/api", "org/web", "other/cli"}
+
+	query, aliases, err := BuildBatchReposQuery(repos, []string{"name", "hasWikiEnabled"})
+	if err != nil {
+		t.Fatalf("BuildBatchReposQuery failed: %v", err)
+	}
+
+	if len(aliases) != 3 {
+		t.Fatalf("Expected 3 aliases, got %d", len(aliases))
+	}
+
+	for i, repo := range repos {
+		alias := RepoAlias(i)
+		if aliases[alias] != repo {
+			t.Errorf("Expected alias %s -> %s, got %s", alias, repo, aliases[alias])
+		}
+	}
+
+	expectedFragments := []string{
+		`repo0: repository(owner: "org", name: "api")`,
+		`repo1: repository(owner: "org", name: "web")`,
+		`repo2: repository(owner: "other", name: "cli")`,
+		"hasWikiEnabled",
+	}
+	for _, fragment := range expectedFragments {
+		if !strings.Contains(query, fragment) {
+			t.Errorf("Expected query to contain %q, got:\n%s", fragment, query)
+		}
+	}
+
+	if _, _, err := BuildBatchReposQuery([]string{"not-a-repo"}, []string{"name"}); err == nil {
+		t.Error("Expected error for invalid repo name")
+	}
+}
+
+// TestBatchRepos tests response demultiplexing back to repo names
+func TestBatchRepos(t *testing.T) {
+	requests := 0
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/graphql" {
+			t.Errorf("Expected /graphql, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{
+			"data": {
+				"repo0": {"name": "api"},
+				"repo1": {"name": "web"},
+				"repo2": null
+			},
+			"errors": [{"type": "NOT_FOUND", "path": ["repo2"], "message": "Could not resolve to a Repository"}]
+		}`))
+	})
+
+	client := newTestGraphQLClient(t, handler)
+
+	results, err := client.BatchRepos([]string{"org/api", "org/web", "org/missing"}, []string{"name"})
+	if err != nil {
+		t.Fatalf("BatchRepos failed: %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+
+	var web struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(results["org/web"], &web); err != nil {
+		t.Fatalf("Failed to parse org/web result: %v", err)
+	}
+
+	if web.Name != "web" {
+		t.Errorf("Expected org/web to demux to name 'web', got '%s'", web.Name)
+	}
+
+	if _, ok := results["org/missing"]; ok {
+		t.Error("Expected missing repo to be omitted")
+	}
+}
+
+// roundTripFunc answers requests in-process, without a server
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestNewGraphQLClientUsesResolvedToken tests that GraphQL requests send the --token and GHE endpoint
+func TestNewGraphQLClientUsesResolvedToken(t *testing.T) {
+	t.Setenv("GITHUB_API_URL", "https://ghe.example.com/api/v3")
+	SetTokenOptions(TokenOptions{FlagToken: "flag-token"})
+	t.Cleanup(func() { SetTokenOptions(TokenOptions{}) })
+
+	var gotURL, gotAuth string
+	original := http.DefaultTransport
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotURL = req.URL.String()
+		gotAuth = req.Header.Get("Authorization")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data": {"repo0": {"name": "api"}}}`)),
+			Request:    req,
+		}, nil
+	})
+	t.Cleanup(func() { http.DefaultTransport = original })
+
+	client, err := NewGraphQLClient(context.Background())
+	if err != nil {
+		t.Fatalf("NewGraphQLClient failed: %v", err)
+	}
+	if _, err := client.BatchRepos([]string{"org/api"}, []string{"name"}); err != nil {
+		t.Fatalf("BatchRepos failed: %v", err)
+	}
+
+	if gotAuth != "token flag-token" {
+		t.Errorf("Expected Authorization %q, got %q", "token flag-token", gotAuth)
+	}
+	if gotURL != "https://ghe.example.com/api/graphql" {
+		t.Errorf("Expected GHE GraphQL endpoint, got %s", gotURL)
+	}
+}
+
+// TestGetRepoSettingsBatch tests mapping GraphQL fields onto RepoSettings
+func TestGetRepoSettingsBatch(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": {"repo0": {
+			"defaultBranchRef": {"name": "trunk"},
+			"squashMergeAllowed": true,
+			"deleteBranchOnMerge": true,
+			"hasIssuesEnabled": true
+		}}}`))
+	})
+
+	client := newTestGraphQLClient(t, handler)
+
+	settings, err := client.GetRepoSettingsBatch([]string{"org/api"})
+	if err != nil {
+		t.Fatalf("GetRepoSettingsBatch failed: %v", err)
+	}
+
+	s := settings["org/api"]
+	if s == nil {
+		t.Fatal("Expected settings for org/api")
+	}
+
+	if s.DefaultBranch != "trunk" || !s.AllowSquashMerge || s.AllowMergeCommit || !s.DeleteBranchOnMerge {
+		t.Errorf("Unexpected settings: %+v", s)
+	}
+}
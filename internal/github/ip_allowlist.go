@@ -0,0 +1,109 @@
+package github
+
+import (
+	"fmt"
+	"time"
+)
+
+// IPAllowlistEntry represents one entry in an organization's IP allow list
+type IPAllowlistEntry struct {
+	ID        string
+	CIDR      string
+	Name      string
+	IsActive  bool
+	CreatedAt time.Time
+}
+
+// The IP allow list is only exposed through GraphQL
+const ipAllowlistQuery = `query($org: String!, $after: String) {
+  organization(login: $org) {
+    ipAllowListEntries(first: 100, after: $after) {
+      nodes {
+        id
+        allowListValue
+        name
+        isActive
+        createdAt
+      }
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+    }
+  }
+}`
+
+type ipAllowlistResponse struct {
+	Organization *struct {
+		IPAllowListEntries struct {
+			Nodes []struct {
+				ID             string    `json:"id"`
+				AllowListValue string    `json:"allowListValue"`
+				Name           string    `json:"name"`
+				IsActive       bool      `json:"isActive"`
+				CreatedAt      time.Time `json:"createdAt"`
+			} `json:"nodes"`
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+		} `json:"ipAllowListEntries"`
+	} `json:"organization"`
+}
+
+// GetOrgIPAllowlist lists every IP allow list entry for an organization
+// Requires organization owner access
+func (g *GraphQLClient) GetOrgIPAllowlist(org string) ([]IPAllowlistEntry, error) {
+	var entries []IPAllowlistEntry
+	var after *string
+
+	for {
+		variables := map[string]interface{}{
+			"org":   org,
+			"after": after,
+		}
+
+		var response ipAllowlistResponse
+		if err := g.gqlClient.DoWithContext(g.ctx, ipAllowlistQuery, variables, &response); err != nil {
+			return nil, fmt.Errorf("failed to get IP allow list: %w", err)
+		}
+
+		if response.Organization == nil {
+			return nil, fmt.Errorf("failed to get IP allow list: organization %s not found", org)
+		}
+
+		list := response.Organization.IPAllowListEntries
+		for _, node := range list.Nodes {
+			entries = append(entries, IPAllowlistEntry{
+				ID:        node.ID,
+				CIDR:      node.AllowListValue,
+				Name:      node.Name,
+				IsActive:  node.IsActive,
+				CreatedAt: node.CreatedAt,
+			})
+		}
+
+		if !list.PageInfo.HasNextPage {
+			break
+		}
+		cursor := list.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	return entries, nil
+}
+
+// DetectStaleIPEntries returns entries created more than maxAgeDays ago
+// Pure function: input order is preserved
+func DetectStaleIPEntries(entries []IPAllowlistEntry, maxAgeDays int) []IPAllowlistEntry {
+	cutoff := time.Now().AddDate(0, 0, -maxAgeDays)
+
+	var stale []IPAllowlistEntry
+	for _, entry := range entries {
+		if entry.CreatedAt.Before(cutoff) {
+			stale = append(stale, entry)
+		}
+	}
+
+	return stale
+}
@@ -0,0 +1,102 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestGetOrgIPAllowlist tests entry mapping and cursor pagination
+func TestGetOrgIPAllowlist(t *testing.T) {
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/graphql" {
+			t.Errorf("Expected /graphql, got %s", r.URL.Path)
+		}
+
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if body.Variables["org"] != "myorg" {
+			t.Errorf("Expected org variable myorg, got %v", body.Variables["org"])
+		}
+
+		requests++
+		if body.Variables["after"] == nil {
+			w.Write([]byte(`{"data": {"organization": {"ipAllowListEntries": {
+				"nodes": [{"id": "A1", "allowListValue": "10.0.0.0/8", "name": "office", "isActive": true, "createdAt": "2023-01-01T00:00:00Z"}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "c1"}
+			}}}}`))
+			return
+		}
+
+		w.Write([]byte(`{"data": {"organization": {"ipAllowListEntries": {
+			"nodes": [{"id": "A2", "allowListValue": "192.168.1.1/32", "name": "vpn", "isActive": false, "createdAt": "2024-01-01T00:00:00Z"}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "c2"}
+		}}}}`))
+	})
+
+	client := newTestGraphQLClient(t, handler)
+
+	entries, err := client.GetOrgIPAllowlist("myorg")
+	if err != nil {
+		t.Fatalf("GetOrgIPAllowlist failed: %v", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+
+	if entries[0].CIDR != "10.0.0.0/8" || entries[0].Name != "office" || !entries[0].IsActive {
+		t.Errorf("Unexpected first entry: %+v", entries[0])
+	}
+
+	if entries[1].ID != "A2" || entries[1].IsActive {
+		t.Errorf("Unexpected second entry: %+v", entries[1])
+	}
+}
+
+// TestGetOrgIPAllowlistError tests that GraphQL errors are surfaced
+func TestGetOrgIPAllowlistError(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": {"organization": null}, "errors": [{"message": "Must be an organization owner"}]}`))
+	})
+
+	client := newTestGraphQLClient(t, handler)
+
+	if _, err := client.GetOrgIPAllowlist("myorg"); err == nil {
+		t.Error("Expected error when the allow list is not accessible")
+	}
+}
+
+// TestDetectStaleIPEntries tests the age threshold on either side of the boundary
+func TestDetectStaleIPEntries(t *testing.T) {
+	now := time.Now()
+	maxAgeDays := 90
+	boundary := now.AddDate(0, 0, -maxAgeDays)
+
+	entries := []IPAllowlistEntry{
+		{ID: "just-inside", CreatedAt: boundary.Add(time.Hour)},
+		{ID: "just-outside", CreatedAt: boundary.Add(-time.Hour)},
+		{ID: "recent", CreatedAt: now.AddDate(0, 0, -1)},
+		{ID: "ancient", CreatedAt: now.AddDate(-2, 0, 0)},
+	}
+
+	stale := DetectStaleIPEntries(entries, maxAgeDays)
+
+	if len(stale) != 2 {
+		t.Fatalf("Expected 2 stale entries, got %d: %+v", len(stale), stale)
+	}
+
+	if stale[0].ID != "just-outside" || stale[1].ID != "ancient" {
+		t.Errorf("Expected [just-outside ancient], got [%s %s]", stale[0].ID, stale[1].ID)
+	}
+}
@@ -0,0 +1,36 @@
+package github
+
+import "fmt"
+
+// Issue represents a created GitHub issue
+type Issue struct {
+	Number  int
+	Title   string
+	HTMLURL string
+}
+
+type issueResponse struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	HTMLURL string `json:"html_url"`
+}
+
+// CreateIssue opens an issue in a repository
+func (c *Client) CreateIssue(owner, repo, title, body string) (*Issue, error) {
+	request := map[string]string{
+		"title": title,
+		"body":  body,
+	}
+	path := fmt.Sprintf("repos/%s/%s/issues", owner, repo)
+
+	var response issueResponse
+	if err := c.Post(path, request, &response); err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+
+	return &Issue{
+		Number:  response.Number,
+		Title:   response.Title,
+		HTMLURL: response.HTMLURL,
+	}, nil
+}
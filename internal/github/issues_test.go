@@ -0,0 +1,36 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// TestCreateIssue tests that the title and body are posted and the response is decoded
+func TestCreateIssue(t *testing.T) {
+	var posted map[string]string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/acme/api/issues" {
+			t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&posted)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"number": 42, "title": "Cleanup", "html_url": "https://github.com/acme/api/issues/42"}`))
+	})
+
+	client := newTestClient(t, handler)
+
+	issue, err := client.CreateIssue("acme", "api", "Cleanup", "- [ ] acme/api/old")
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+
+	if posted["title"] != "Cleanup" || posted["body"] != "- [ ] acme/api/old" {
+		t.Errorf("Expected title and body to be posted, got %v", posted)
+	}
+	if issue.Number != 42 || issue.HTMLURL != "https://github.com/acme/api/issues/42" {
+		t.Errorf("Expected issue #42 with URL, got %+v", issue)
+	}
+}
@@ -0,0 +1,194 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Label represents an issue label
+type Label struct {
+	Name        string
+	Color       string // Hex without the leading #
+	Description string
+	Default     bool
+}
+
+type labelResponse struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+}
+
+// ListLabels lists all labels for a repository
+func (c *Client) ListLabels(owner, repo string) ([]Label, error) {
+	var labels []Label
+	page := 1
+	perPage := 100
+
+	for {
+		var response []labelResponse
+		path := fmt.Sprintf("repos/%s/%s/labels?per_page=%d&page=%d", owner, repo, perPage, page)
+
+		if err := c.Get(path, &response); err != nil {
+			return nil, fmt.Errorf("failed to list labels: %w", err)
+		}
+
+		for _, l := range response {
+			labels = append(labels, Label{
+				Name:        l.Name,
+				Color:       l.Color,
+				Description: l.Description,
+				Default:     l.Default,
+			})
+		}
+
+		if len(response) < perPage {
+			break
+		}
+		page++
+	}
+
+	return labels, nil
+}
+
+// CreateLabel creates a label in a repository
+func (c *Client) CreateLabel(owner, repo string, label Label) error {
+	body := map[string]string{
+		"name":        label.Name,
+		"color":       label.Color,
+		"description": label.Description,
+	}
+	path := fmt.Sprintf("repos/%s/%s/labels", owner, repo)
+
+	if err := c.Post(path, body, nil); err != nil {
+		return fmt.Errorf("failed to create label %s: %w", label.Name, err)
+	}
+
+	return nil
+}
+
+// SyncLabels creates the labels that are missing from a repository
+// Existing labels are left unchanged, even if their color or description differs
+func SyncLabels(client *Client, owner, repo string, labels []Label) error {
+	existing, err := client.ListLabels(owner, repo)
+	if err != nil {
+		return err
+	}
+
+	for _, label := range MissingLabels(existing, labels) {
+		if err := client.CreateLabel(owner, repo, label); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MissingLabels returns the desired labels whose names are not in existing
+// Pure function: names are compared case-insensitively, as GitHub does
+func MissingLabels(existing, desired []Label) []Label {
+	have := make(map[string]bool, len(existing))
+	for _, label := range existing {
+		have[strings.ToLower(label.Name)] = true
+	}
+
+	var missing []Label
+	for _, label := range desired {
+		if !have[strings.ToLower(label.Name)] {
+			missing = append(missing, label)
+		}
+	}
+
+	return missing
+}
+
+// LabelPresence lists which repositories have a label that is not defined everywhere
+type LabelPresence struct {
+	Name        string
+	PresentIn   []string
+	MissingFrom []string
+}
+
+// LabelColorConflict lists the colors used for a same-named label across repositories
+type LabelColorConflict struct {
+	Name   string
+	Colors map[string][]string // Lowercase hex color to the repos using it
+}
+
+// LabelDiffReport summarizes label inconsistencies across repositories
+type LabelDiffReport struct {
+	TotalRepos     int
+	Partial        []LabelPresence      // Labels present in some repos but not others
+	ColorConflicts []LabelColorConflict // Same-named labels with different colors
+}
+
+// CompareLabelSets finds labels missing from some repositories and same-named labels with different colors
+// Pure function: names match case-insensitively; results are sorted by label name
+func CompareLabelSets(repos map[string][]Label) LabelDiffReport {
+	repoNames := make([]string, 0, len(repos))
+	for name := range repos {
+		repoNames = append(repoNames, name)
+	}
+	sort.Strings(repoNames)
+
+	displayNames := make(map[string]string)
+	presentIn := make(map[string][]string)
+	colors := make(map[string]map[string][]string)
+
+	for _, repo := range repoNames {
+		for _, label := range repos[repo] {
+			key := strings.ToLower(label.Name)
+			if _, ok := displayNames[key]; !ok {
+				displayNames[key] = label.Name
+				colors[key] = make(map[string][]string)
+			}
+			presentIn[key] = append(presentIn[key], repo)
+			color := strings.ToLower(label.Color)
+			colors[key][color] = append(colors[key][color], repo)
+		}
+	}
+
+	keys := make([]string, 0, len(displayNames))
+	for key := range displayNames {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	report := LabelDiffReport{TotalRepos: len(repoNames)}
+	for _, key := range keys {
+		if len(presentIn[key]) < len(repoNames) {
+			has := make(map[string]bool, len(presentIn[key]))
+			for _, repo := range presentIn[key] {
+				has[repo] = true
+			}
+			var missing []string
+			for _, repo := range repoNames {
+				if !has[repo] {
+					missing = append(missing, repo)
+				}
+			}
+
+			report.Partial = append(report.Partial, LabelPresence{
+				Name:        displayNames[key],
+				PresentIn:   presentIn[key],
+				MissingFrom: missing,
+			})
+		}
+
+		if len(colors[key]) > 1 {
+			report.ColorConflicts = append(report.ColorConflicts, LabelColorConflict{
+				Name:   displayNames[key],
+				Colors: colors[key],
+			})
+		}
+	}
+
+	return report
+}
+
+// HasDifferences reports whether any label is partial or has conflicting colors
+func (r LabelDiffReport) HasDifferences() bool {
+	return len(r.Partial) > 0 || len(r.ColorConflicts) > 0
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func labelSets() map[string][]Label {
+	bug := Label{Name: "bug", Color: "d73a4a"}
+	docs := Label{Name: "documentation", Color: "0075ca"}
+	triage := Label{Name: "needs-triage", Color: "ededed"}
+
+	return map[string][]Label{
+		"acme/api":  {bug, docs, triage},
+		"acme/web":  {bug, docs, triage},
+		"acme/cli":  {bug, {Name: "Documentation", Color: "0075CA"}, triage},
+		"acme/docs": {bug, {Name: "documentation", Color: "00ff00"}},
+		"acme/sdk":  {bug, docs},
+	}
+}
+
+// TestCompareLabelSets tests detection of partial labels and color conflicts
+func TestCompareLabelSets(t *testing.T) {
+	report := CompareLabelSets(labelSets())
+
+	if report.TotalRepos != 5 {
+		t.Errorf("Expected 5 repos, got %d", report.TotalRepos)
+	}
+
+	if len(report.Partial) != 1 {
+		t.Fatalf("Expected 1 partial label, got %+v", report.Partial)
+	}
+	partial := report.Partial[0]
+	if partial.Name != "needs-triage" || len(partial.PresentIn) != 3 {
+		t.Errorf("Expected needs-triage in 3/5 repos, got %+v", partial)
+	}
+	if !reflect.DeepEqual(partial.MissingFrom, []string{"acme/docs", "acme/sdk"}) {
+		t.Errorf("Expected needs-triage missing from docs and sdk, got %v", partial.MissingFrom)
+	}
+
+	if len(report.ColorConflicts) != 1 {
+		t.Fatalf("Expected 1 color conflict, got %+v", report.ColorConflicts)
+	}
+	conflict := report.ColorConflicts[0]
+	if conflict.Name != "documentation" {
+		t.Errorf("Expected conflict named as in the first repo (acme/api), got %s", conflict.Name)
+	}
+	if len(conflict.Colors["0075ca"]) != 4 || !reflect.DeepEqual(conflict.Colors["00ff00"], []string{"acme/docs"}) {
+		t.Errorf("Expected 4 repos with 0075ca and docs with 00ff00, got %v", conflict.Colors)
+	}
+	if !report.HasDifferences() {
+		t.Error("Expected differences to be reported")
+	}
+}
+
+// TestSyncLabels tests that only missing labels are created
+func TestSyncLabels(t *testing.T) {
+	var created []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/acme/sdk/labels":
+			w.Write([]byte(`[{"name": "bug", "color": "d73a4a", "default": true}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/acme/sdk/labels":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			created = append(created, body["name"]+"#"+body["color"])
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(t, handler)
+
+	desired := []Label{{Name: "Bug", Color: "ff0000"}, {Name: "needs-triage", Color: "ededed"}}
+	if err := SyncLabels(client, "acme", "sdk", desired); err != nil {
+		t.Fatalf("SyncLabels failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(created, []string{"needs-triage#ededed"}) {
+		t.Errorf("Expected only needs-triage to be created, got %v", created)
+	}
+}
@@ -0,0 +1,218 @@
+package github
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// milestoneBarWidth is the number of cells in a rendered progress bar
+const milestoneBarWidth = 6
+
+// Milestone alert levels used for color coding
+const (
+	MilestoneAlertRed    = "red"    // Due in under 3 days and less than 80% complete
+	MilestoneAlertYellow = "yellow" // Due in under 7 days
+	MilestoneAlertGreen  = "green"  // On track
+)
+
+// Milestone represents a GitHub milestone
+type Milestone struct {
+	Number        int
+	Repository    string
+	Title         string
+	State         string
+	OpenIssues    int
+	ClosedIssues  int
+	CreatedAt     time.Time
+	DueOn         *time.Time
+	CompletionPct float64
+}
+
+// MilestoneAlert flags an open milestone approaching its due date
+type MilestoneAlert struct {
+	Milestone               Milestone
+	DaysUntilDue            int
+	CompletionPct           float64
+	EstimatedCompletionDate *time.Time // nil when there is no burn rate to extrapolate
+	Level                   string
+}
+
+type milestoneResponse struct {
+	Number       int        `json:"number"`
+	Title        string     `json:"title"`
+	State        string     `json:"state"`
+	OpenIssues   int        `json:"open_issues"`
+	ClosedIssues int        `json:"closed_issues"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DueOn        *time.Time `json:"due_on"`
+}
+
+// ListMilestones lists open and closed milestones for a repository
+func (c *Client) ListMilestones(owner, repo string) ([]Milestone, error) {
+	var allMilestones []Milestone
+	page := 1
+	perPage := 100
+
+	for {
+		var response []milestoneResponse
+		path := fmt.Sprintf("repos/%s/%s/milestones?state=all&per_page=%d&page=%d", owner, repo, perPage, page)
+
+		if err := c.Get(path, &response); err != nil {
+			return nil, fmt.Errorf("failed to list milestones: %w", err)
+		}
+
+		for _, m := range response {
+			allMilestones = append(allMilestones, Milestone{
+				Number:        m.Number,
+				Repository:    fmt.Sprintf("%s/%s", owner, repo),
+				Title:         m.Title,
+				State:         m.State,
+				OpenIssues:    m.OpenIssues,
+				ClosedIssues:  m.ClosedIssues,
+				CreatedAt:     m.CreatedAt,
+				DueOn:         m.DueOn,
+				CompletionPct: ComputeCompletionPct(m.OpenIssues, m.ClosedIssues),
+			})
+		}
+
+		if len(response) < perPage {
+			break
+		}
+		page++
+	}
+
+	return allMilestones, nil
+}
+
+// ComputeCompletionPct returns the percentage of closed issues
+// Pure function: returns 0 when the milestone has no issues
+func ComputeCompletionPct(openIssues, closedIssues int) float64 {
+	total := openIssues + closedIssues
+	if total == 0 {
+		return 0
+	}
+	return float64(closedIssues) / float64(total) * 100
+}
+
+// GetMilestoneProgress renders a text progress bar like "[████░░] 67%"
+// Pure function: formats CompletionPct
+func GetMilestoneProgress(m Milestone) string {
+	filled := int(math.Round(m.CompletionPct / 100 * milestoneBarWidth))
+	if filled > milestoneBarWidth {
+		filled = milestoneBarWidth
+	}
+	if filled < 0 {
+		filled = 0
+	}
+
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", milestoneBarWidth-filled)
+	return fmt.Sprintf("[%s] %.0f%%", bar, m.CompletionPct)
+}
+
+// IsMilestonePastDue reports whether an open milestone's due date is before today
+// Pure function: now is passed in for testability
+func IsMilestonePastDue(m Milestone, now time.Time) bool {
+	if m.DueOn == nil || m.State == "closed" || m.OpenIssues == 0 {
+		return false
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return m.DueOn.Before(today)
+}
+
+// FilterPastDueMilestones returns milestones that are past due with open issues
+// Pure function: creates new slice
+func FilterPastDueMilestones(milestones []Milestone, now time.Time) []Milestone {
+	var pastDue []Milestone
+	for _, m := range milestones {
+		if IsMilestonePastDue(m, now) {
+			pastDue = append(pastDue, m)
+		}
+	}
+	return pastDue
+}
+
+// DaysUntilDue returns whole days between today and the milestone due date
+// Pure function: now is passed in for testability; negative when past due
+func DaysUntilDue(m Milestone, now time.Time) int {
+	if m.DueOn == nil {
+		return 0
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	due := m.DueOn.In(now.Location())
+	dueDay := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, now.Location())
+	return int(math.Round(dueDay.Sub(today).Hours() / 24))
+}
+
+// EstimateCompletionDate extrapolates when the remaining open issues will be closed
+// based on the burn rate (closed issues per day since the milestone was created)
+// Pure function: returns nil when no issues have been closed yet
+func EstimateCompletionDate(m Milestone, now time.Time) *time.Time {
+	if m.OpenIssues == 0 {
+		done := now
+		return &done
+	}
+
+	elapsedDays := now.Sub(m.CreatedAt).Hours() / 24
+	if m.ClosedIssues == 0 || elapsedDays <= 0 {
+		return nil
+	}
+
+	burnRate := float64(m.ClosedIssues) / elapsedDays
+	remainingDays := float64(m.OpenIssues) / burnRate
+	estimate := now.Add(time.Duration(remainingDays * 24 * float64(time.Hour)))
+	return &estimate
+}
+
+// ClassifyMilestoneAlert returns the alert level for a milestone due in daysUntilDue days
+// Pure function: red is due in <3 days and <80% complete, yellow is due in <7 days
+func ClassifyMilestoneAlert(daysUntilDue int, completionPct float64) string {
+	switch {
+	case daysUntilDue < 3 && completionPct < 80:
+		return MilestoneAlertRed
+	case daysUntilDue < 7:
+		return MilestoneAlertYellow
+	default:
+		return MilestoneAlertGreen
+	}
+}
+
+// AlertApproachingMilestones returns open milestones due within warningDays
+// Pure function: creates new slice sorted by days until due
+func AlertApproachingMilestones(milestones []Milestone, warningDays int) []MilestoneAlert {
+	return AlertApproachingMilestonesAt(milestones, warningDays, time.Now())
+}
+
+// AlertApproachingMilestonesAt is AlertApproachingMilestones evaluated at now
+// Pure function: now is passed in for testability
+func AlertApproachingMilestonesAt(milestones []Milestone, warningDays int, now time.Time) []MilestoneAlert {
+	var alerts []MilestoneAlert
+	for _, m := range milestones {
+		if m.DueOn == nil || m.State == "closed" || m.OpenIssues == 0 {
+			continue
+		}
+
+		days := DaysUntilDue(m, now)
+		if days < 0 || days > warningDays {
+			continue
+		}
+
+		alerts = append(alerts, MilestoneAlert{
+			Milestone:               m,
+			DaysUntilDue:            days,
+			CompletionPct:           m.CompletionPct,
+			EstimatedCompletionDate: EstimateCompletionDate(m, now),
+			Level:                   ClassifyMilestoneAlert(days, m.CompletionPct),
+		})
+	}
+
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return alerts[i].DaysUntilDue < alerts[j].DaysUntilDue
+	})
+
+	return alerts
+}
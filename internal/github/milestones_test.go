@@ -0,0 +1,190 @@
+package github
+
+import (
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestComputeCompletionPct tests completion percentage calculation
+func TestComputeCompletionPct(t *testing.T) {
+	tests := []struct {
+		name     string
+		open     int
+		closed   int
+		expected float64
+	}{
+		{"no issues", 0, 0, 0},
+		{"all open", 4, 0, 0},
+		{"all closed", 0, 5, 100},
+		{"mixed", 1, 2, 66.666},
+		{"quarter", 9, 3, 25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ComputeCompletionPct(tt.open, tt.closed)
+			if math.Abs(got-tt.expected) > 0.01 {
+				t.Errorf("Expected %.2f%%, got %.2f%%", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestGetMilestoneProgress tests progress bar rendering
+func TestGetMilestoneProgress(t *testing.T) {
+	tests := []struct {
+		pct      float64
+		expected string
+	}{
+		{0, "[░░░░░░] 0%"},
+		{ComputeCompletionPct(1, 2), "[████░░] 67%"},
+		{100, "[██████] 100%"},
+	}
+
+	for _, tt := range tests {
+		got := GetMilestoneProgress(Milestone{CompletionPct: tt.pct})
+		if got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+	}
+}
+
+// TestIsMilestonePastDue tests past-due detection
+func TestIsMilestonePastDue(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+	earlierToday := time.Date(2024, 6, 15, 1, 0, 0, 0, time.UTC)
+	tomorrow := now.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name      string
+		milestone Milestone
+		expected  bool
+	}{
+		{"past due with open issues", Milestone{State: "open", OpenIssues: 2, DueOn: &yesterday}, true},
+		{"past due but complete", Milestone{State: "open", OpenIssues: 0, DueOn: &yesterday}, false},
+		{"closed milestone", Milestone{State: "closed", OpenIssues: 2, DueOn: &yesterday}, false},
+		{"due today", Milestone{State: "open", OpenIssues: 2, DueOn: &earlierToday}, false},
+		{"due tomorrow", Milestone{State: "open", OpenIssues: 2, DueOn: &tomorrow}, false},
+		{"no due date", Milestone{State: "open", OpenIssues: 2}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsMilestonePastDue(tt.milestone, now); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestEstimateCompletionDate tests extrapolation with a steady burn rate
+func TestEstimateCompletionDate(t *testing.T) {
+	now := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	// 10 issues closed over 10 days is 1/day, so 5 open issues take 5 more days
+	m := Milestone{
+		State:        "open",
+		OpenIssues:   5,
+		ClosedIssues: 10,
+		CreatedAt:    now.AddDate(0, 0, -10),
+	}
+
+	got := EstimateCompletionDate(m, now)
+	if got == nil {
+		t.Fatal("Expected an estimated completion date")
+	}
+
+	expected := now.AddDate(0, 0, 5)
+	if !got.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, *got)
+	}
+
+	m.ClosedIssues = 0
+	if got := EstimateCompletionDate(m, now); got != nil {
+		t.Errorf("Expected nil estimate with no closed issues, got %v", *got)
+	}
+}
+
+// TestAlertApproachingMilestones tests due-date filtering and alert levels
+func TestAlertApproachingMilestones(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	in2 := now.AddDate(0, 0, 2)
+	in5 := now.AddDate(0, 0, 5)
+	in20 := now.AddDate(0, 0, 20)
+	yesterday := now.AddDate(0, 0, -1)
+	created := now.AddDate(0, 0, -30)
+
+	milestones := []Milestone{
+		{Title: "later", State: "open", OpenIssues: 1, ClosedIssues: 1, DueOn: &in20, CreatedAt: created, CompletionPct: 50},
+		{Title: "soon", State: "open", OpenIssues: 5, ClosedIssues: 5, DueOn: &in5, CreatedAt: created, CompletionPct: 50},
+		{Title: "urgent", State: "open", OpenIssues: 8, ClosedIssues: 2, DueOn: &in2, CreatedAt: created, CompletionPct: 20},
+		{Title: "nearly", State: "open", OpenIssues: 1, ClosedIssues: 9, DueOn: &in2, CreatedAt: created, CompletionPct: 90},
+		{Title: "overdue", State: "open", OpenIssues: 1, DueOn: &yesterday, CreatedAt: created},
+		{Title: "closed", State: "closed", OpenIssues: 1, DueOn: &in2, CreatedAt: created},
+	}
+
+	alerts := AlertApproachingMilestonesAt(milestones, 7, now)
+	if len(alerts) != 3 {
+		t.Fatalf("Expected 3 alerts, got %d", len(alerts))
+	}
+
+	levels := map[string]string{}
+	for _, a := range alerts {
+		levels[a.Milestone.Title] = a.Level
+	}
+
+	expected := map[string]string{
+		"urgent": MilestoneAlertRed,
+		"nearly": MilestoneAlertYellow,
+		"soon":   MilestoneAlertYellow,
+	}
+	for title, level := range expected {
+		if levels[title] != level {
+			t.Errorf("Expected %s to be %s, got %s", title, level, levels[title])
+		}
+	}
+
+	if alerts[0].DaysUntilDue != 2 || alerts[2].DaysUntilDue != 5 {
+		t.Errorf("Expected alerts sorted by days until due, got %d..%d", alerts[0].DaysUntilDue, alerts[2].DaysUntilDue)
+	}
+}
+
+// TestListMilestones tests milestone listing against a mocked API
+func TestListMilestones(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/milestones", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("state") != "all" {
+			t.Errorf("Expected state=all, got %s", r.URL.Query().Get("state"))
+		}
+		w.Write([]byte(`[
+			{"number": 1, "title": "v1.0", "state": "open", "open_issues": 3, "closed_issues": 9, "due_on": "2024-07-01T07:00:00Z"},
+			{"number": 2, "title": "Backlog", "state": "open", "open_issues": 5, "closed_issues": 0, "due_on": null}
+		]`))
+	})
+
+	client := newTestClient(t, mux)
+
+	milestones, err := client.ListMilestones("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListMilestones failed: %v", err)
+	}
+
+	if len(milestones) != 2 {
+		t.Fatalf("Expected 2 milestones, got %d", len(milestones))
+	}
+
+	if milestones[0].CompletionPct != 75 {
+		t.Errorf("Expected 75%% completion, got %.1f%%", milestones[0].CompletionPct)
+	}
+
+	if milestones[0].DueOn == nil {
+		t.Error("Expected DueOn to be set for v1.0")
+	}
+
+	if milestones[1].DueOn != nil {
+		t.Error("Expected nil DueOn for Backlog")
+	}
+}
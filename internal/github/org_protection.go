@@ -0,0 +1,149 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BranchProtectionPolicy represents an org-wide branch protection policy from active org rulesets
+type BranchProtectionPolicy struct {
+	Pattern             string // Ref patterns the policy applies to, e.g. ~DEFAULT_BRANCH
+	RequiredApprovals   int
+	RequireStatusChecks bool
+}
+
+// ProtectionOverride is a repo whose branch protection is weaker than the org policy
+type ProtectionOverride struct {
+	Repository string
+	Reasons    []string
+}
+
+type orgRulesetSummary struct {
+	ID          int    `json:"id"`
+	Target      string `json:"target"`
+	Enforcement string `json:"enforcement"`
+}
+
+type orgRulesetResponse struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Target      string `json:"target"`
+	Enforcement string `json:"enforcement"`
+	Conditions  struct {
+		RefName struct {
+			Include []string `json:"include"`
+		} `json:"ref_name"`
+	} `json:"conditions"`
+	Rules []struct {
+		Type       string          `json:"type"`
+		Parameters json.RawMessage `json:"parameters"`
+	} `json:"rules"`
+}
+
+// GetOrgDefaultBranchProtection merges the org's active branch rulesets that cover default branches
+// Returns nil when the org has no such ruleset
+func (c *Client) GetOrgDefaultBranchProtection(org string) (*BranchProtectionPolicy, error) {
+	var summaries []orgRulesetSummary
+	if err := c.Get(fmt.Sprintf("orgs/%s/rulesets?per_page=100", org), &summaries); err != nil {
+		return nil, fmt.Errorf("failed to list org rulesets: %w", err)
+	}
+
+	var rulesets []orgRulesetResponse
+	for _, summary := range summaries {
+		if summary.Target != "branch" || summary.Enforcement != "active" {
+			continue
+		}
+
+		var ruleset orgRulesetResponse
+		if err := c.Get(fmt.Sprintf("orgs/%s/rulesets/%d", org, summary.ID), &ruleset); err != nil {
+			return nil, fmt.Errorf("failed to get org ruleset: %w", err)
+		}
+		rulesets = append(rulesets, ruleset)
+	}
+
+	return mergeDefaultBranchRulesets(rulesets), nil
+}
+
+// mergeDefaultBranchRulesets combines rulesets targeting default branches, keeping the strictest settings
+func mergeDefaultBranchRulesets(rulesets []orgRulesetResponse) *BranchProtectionPolicy {
+	var policy *BranchProtectionPolicy
+	var patterns []string
+
+	for _, ruleset := range rulesets {
+		if !coversDefaultBranch(ruleset.Conditions.RefName.Include) {
+			continue
+		}
+		if policy == nil {
+			policy = &BranchProtectionPolicy{}
+		}
+		patterns = append(patterns, ruleset.Conditions.RefName.Include...)
+
+		for _, rule := range ruleset.Rules {
+			switch rule.Type {
+			case "pull_request":
+				var params struct {
+					RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+				}
+				if err := json.Unmarshal(rule.Parameters, &params); err == nil &&
+					params.RequiredApprovingReviewCount > policy.RequiredApprovals {
+					policy.RequiredApprovals = params.RequiredApprovingReviewCount
+				}
+			case "required_status_checks":
+				policy.RequireStatusChecks = true
+			}
+		}
+	}
+
+	if policy != nil {
+		policy.Pattern = strings.Join(patterns, ", ")
+	}
+	return policy
+}
+
+func coversDefaultBranch(include []string) bool {
+	for _, pattern := range include {
+		if pattern == "~DEFAULT_BRANCH" || pattern == "~ALL" {
+			return true
+		}
+	}
+	return false
+}
+
+// FindWeakerOverrides lists repos whose protection rules (keyed by owner/repo) are weaker than the org policy
+// Pure function: no API calls
+func FindWeakerOverrides(policy *BranchProtectionPolicy, rules map[string]*ProtectionRule) []ProtectionOverride {
+	if policy == nil {
+		return nil
+	}
+
+	repos := make([]string, 0, len(rules))
+	for repo := range rules {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	var overrides []ProtectionOverride
+	for _, repo := range repos {
+		rule := rules[repo]
+		if rule == nil {
+			continue
+		}
+
+		var reasons []string
+		if rule.RequiredReviews < policy.RequiredApprovals {
+			reasons = append(reasons, fmt.Sprintf("requires %d approval(s) (org policy: %d)",
+				rule.RequiredReviews, policy.RequiredApprovals))
+		}
+		if policy.RequireStatusChecks && len(rule.RequireStatusChecks) == 0 {
+			reasons = append(reasons, "no required status checks (org policy requires them)")
+		}
+
+		if len(reasons) > 0 {
+			overrides = append(overrides, ProtectionOverride{Repository: repo, Reasons: reasons})
+		}
+	}
+
+	return overrides
+}
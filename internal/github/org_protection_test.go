@@ -0,0 +1,70 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestGetOrgDefaultBranchProtection tests that active default-branch rulesets are merged
+func TestGetOrgDefaultBranchProtection(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orgs/acme/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"id": 1, "target": "branch", "enforcement": "active"},
+			{"id": 2, "target": "tag", "enforcement": "active"},
+			{"id": 3, "target": "branch", "enforcement": "evaluate"}
+		]`))
+	})
+	mux.HandleFunc("/orgs/acme/rulesets/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"id": 1, "name": "default", "target": "branch", "enforcement": "active",
+			"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}},
+			"rules": [
+				{"type": "pull_request", "parameters": {"required_approving_review_count": 2}},
+				{"type": "required_status_checks", "parameters": {"required_status_checks": [{"context": "ci"}]}}
+			]
+		}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	policy, err := client.GetOrgDefaultBranchProtection("acme")
+	if err != nil {
+		t.Fatalf("GetOrgDefaultBranchProtection failed: %v", err)
+	}
+	if policy == nil {
+		t.Fatal("Expected a policy")
+	}
+
+	if policy.Pattern != "~DEFAULT_BRANCH" || policy.RequiredApprovals != 2 || !policy.RequireStatusChecks {
+		t.Errorf("Unexpected policy: %+v", policy)
+	}
+}
+
+// TestFindWeakerOverrides tests detection of repos weaker than the org policy
+func TestFindWeakerOverrides(t *testing.T) {
+	policy := &BranchProtectionPolicy{Pattern: "~DEFAULT_BRANCH", RequiredApprovals: 2}
+	rules := map[string]*ProtectionRule{
+		"acme/weak":   {Repository: "acme/weak", RequiredReviews: 1},
+		"acme/strict": {Repository: "acme/strict", RequiredReviews: 3},
+		"acme/equal":  {Repository: "acme/equal", RequiredReviews: 2},
+	}
+
+	overrides := FindWeakerOverrides(policy, rules)
+
+	if len(overrides) != 1 || overrides[0].Repository != "acme/weak" {
+		t.Fatalf("Expected only acme/weak flagged, got %+v", overrides)
+	}
+	if overrides[0].Reasons[0] != "requires 1 approval(s) (org policy: 2)" {
+		t.Errorf("Unexpected reason: %s", overrides[0].Reasons[0])
+	}
+
+	policy.RequireStatusChecks = true
+	if got := FindWeakerOverrides(policy, rules); len(got) != 3 {
+		t.Errorf("Expected every repo without status checks flagged, got %+v", got)
+	}
+
+	if got := FindWeakerOverrides(nil, rules); got != nil {
+		t.Errorf("Expected no overrides without an org policy, got %+v", got)
+	}
+}
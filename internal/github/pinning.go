@@ -0,0 +1,121 @@
+package github
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	usesLinePattern   = regexp.MustCompile(`^\s*(?:-\s*)?uses:\s*["']?([^"'\s#]+)`)
+	commitSHAPattern  = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+	versionTagPattern = regexp.MustCompile(`^v?\d+(\.\d+)*([-+.][0-9A-Za-z.-]+)?$`)
+)
+
+// PinningAudit classifies the action references in a workflow by how they are pinned
+// UnpinnedCount is the number of references not pinned to an immutable commit SHA
+type PinningAudit struct {
+	SHAPinned     []string
+	TagPinned     []string
+	BranchPinned  []string
+	UnpinnedCount int
+}
+
+// AuditActionPinning parses every `uses:` line in workflow content and classifies the ref
+// Local actions (./path) and docker:// images are skipped
+// Pure function: refs that look like versions (v3, 1.2.0) are tags, anything else is a branch
+func AuditActionPinning(content string) *PinningAudit {
+	audit := &PinningAudit{
+		SHAPinned:    []string{},
+		TagPinned:    []string{},
+		BranchPinned: []string{},
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		match := usesLinePattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		uses := match[1]
+		if strings.HasPrefix(uses, "./") || strings.HasPrefix(uses, "docker://") {
+			continue
+		}
+
+		at := strings.LastIndex(uses, "@")
+		if at == -1 {
+			audit.BranchPinned = append(audit.BranchPinned, uses)
+			continue
+		}
+
+		ref := uses[at+1:]
+		switch {
+		case commitSHAPattern.MatchString(ref):
+			audit.SHAPinned = append(audit.SHAPinned, uses)
+		case versionTagPattern.MatchString(ref):
+			audit.TagPinned = append(audit.TagPinned, uses)
+		default:
+			audit.BranchPinned = append(audit.BranchPinned, uses)
+		}
+	}
+
+	audit.UnpinnedCount = len(audit.TagPinned) + len(audit.BranchPinned)
+	return audit
+}
+
+// GetWorkflowContent fetches the decoded contents of a workflow file
+func (c *Client) GetWorkflowContent(owner, repo, workflowPath string) (string, error) {
+	var response contentsResponse
+	path := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, workflowPath)
+
+	if err := c.Get(path, &response); err != nil {
+		return "", fmt.Errorf("failed to get workflow content: %w", err)
+	}
+
+	if response.Encoding != "base64" {
+		return response.Content, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(response.Content)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode workflow content: %w", err)
+	}
+
+	return string(decoded), nil
+}
+
+type directoryEntry struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+// FetchWorkflowFiles fetches every workflow file in .github/workflows, keyed by path
+// Returns an empty map if the repository has no workflows directory
+func (c *Client) FetchWorkflowFiles(owner, repo string) (map[string]string, error) {
+	var entries []directoryEntry
+	path := fmt.Sprintf("repos/%s/%s/contents/.github/workflows", owner, repo)
+
+	files := make(map[string]string)
+	if err := c.Get(path, &entries); err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return files, nil
+		}
+		return nil, fmt.Errorf("failed to list workflow files: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.Type != "file" || !(strings.HasSuffix(entry.Name, ".yml") || strings.HasSuffix(entry.Name, ".yaml")) {
+			continue
+		}
+
+		content, err := c.GetWorkflowContent(owner, repo, entry.Path)
+		if err != nil {
+			return nil, err
+		}
+		files[entry.Path] = content
+	}
+
+	return files, nil
+}
@@ -0,0 +1,125 @@
+package github
+
+import (
+	"encoding/base64"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// TestAuditActionPinning tests classifying mixed pinning styles
+func TestAuditActionPinning(t *testing.T) {
+	workflow := `name: CI
+on: [push]
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@8f4b7f84864484a7bf31766abe9204da3cbe65b3
+      - uses: actions/setup-go@v5
+      - name: Cache
+        uses: "actions/cache@v4.0.2"
+      - uses: some-org/custom-action@main # tracks a branch
+      - uses: ./local-action
+      - uses: docker://alpine:3.19
+      - run: echo "uses: not-an-action@v1"
+  reusable:
+    uses: octo-org/workflows/.github/workflows/ci.yml@feature/new
+`
+
+	audit := AuditActionPinning(workflow)
+
+	expectedSHA := []string{"actions/checkout@8f4b7f84864484a7bf31766abe9204da3cbe65b3"}
+	expectedTag := []string{"actions/setup-go@v5", "actions/cache@v4.0.2"}
+	expectedBranch := []string{"some-org/custom-action@main", "octo-org/workflows/.github/workflows/ci.yml@feature/new"}
+
+	if !reflect.DeepEqual(audit.SHAPinned, expectedSHA) {
+		t.Errorf("Expected SHA pinned %v, got %v", expectedSHA, audit.SHAPinned)
+	}
+	if !reflect.DeepEqual(audit.TagPinned, expectedTag) {
+		t.Errorf("Expected tag pinned %v, got %v", expectedTag, audit.TagPinned)
+	}
+	if !reflect.DeepEqual(audit.BranchPinned, expectedBranch) {
+		t.Errorf("Expected branch pinned %v, got %v", expectedBranch, audit.BranchPinned)
+	}
+	if audit.UnpinnedCount != 4 {
+		t.Errorf("Expected 4 unpinned, got %d", audit.UnpinnedCount)
+	}
+}
+
+// TestGetWorkflowContent tests decoding workflow file contents
+func TestGetWorkflowContent(t *testing.T) {
+	content := "steps:\n  - uses: actions/checkout@v4\n"
+	encoded := base64.StdEncoding.EncodeToString([]byte(content))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/contents/.github/workflows/ci.yml", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"encoding": "base64", "content": "` + encoded + `"}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	got, err := client.GetWorkflowContent("owner", "repo", ".github/workflows/ci.yml")
+	if err != nil {
+		t.Fatalf("GetWorkflowContent failed: %v", err)
+	}
+
+	if got != content {
+		t.Errorf("Expected %q, got %q", content, got)
+	}
+}
+
+// TestFetchWorkflowFiles tests listing and decoding every workflow file
+func TestFetchWorkflowFiles(t *testing.T) {
+	ci := "env:\n  TOKEN: ${{ secrets.NPM_TOKEN }}\n"
+	release := "steps:\n  - run: echo ${{ secrets.DEPLOY_KEY }}\n"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/contents/.github/workflows", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"name": "ci.yml", "path": ".github/workflows/ci.yml", "type": "file"},
+			{"name": "release.yaml", "path": ".github/workflows/release.yaml", "type": "file"},
+			{"name": "README.md", "path": ".github/workflows/README.md", "type": "file"},
+			{"name": "scripts", "path": ".github/workflows/scripts", "type": "dir"}
+		]`))
+	})
+	mux.HandleFunc("/repos/owner/repo/contents/.github/workflows/ci.yml", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"encoding": "base64", "content": "` + base64.StdEncoding.EncodeToString([]byte(ci)) + `"}`))
+	})
+	mux.HandleFunc("/repos/owner/repo/contents/.github/workflows/release.yaml", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"encoding": "base64", "content": "` + base64.StdEncoding.EncodeToString([]byte(release)) + `"}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	files, err := client.FetchWorkflowFiles("owner", "repo")
+	if err != nil {
+		t.Fatalf("FetchWorkflowFiles failed: %v", err)
+	}
+
+	expected := map[string]string{
+		".github/workflows/ci.yml":       ci,
+		".github/workflows/release.yaml": release,
+	}
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("Expected %v, got %v", expected, files)
+	}
+}
+
+// TestFetchWorkflowFilesNoDirectory tests that a missing workflows directory is not an error
+func TestFetchWorkflowFilesNoDirectory(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/contents/.github/workflows", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "Not Found"}`, http.StatusNotFound)
+	})
+
+	client := newTestClient(t, mux)
+
+	files, err := client.FetchWorkflowFiles("owner", "repo")
+	if err != nil {
+		t.Fatalf("Expected no error for a missing directory, got %v", err)
+	}
+	if files == nil || len(files) != 0 {
+		t.Errorf("Expected an empty map, got %v", files)
+	}
+}
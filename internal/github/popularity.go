@@ -0,0 +1,66 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RepoStats holds popularity counters for a repository
+type RepoStats struct {
+	Repository string
+	Stars      int
+	Forks      int
+	Watchers   int
+	OpenIssues int
+	Network    int
+	StarDelta  int // Stars gained since the previous snapshot; set by the popularity cache
+}
+
+type repoStatsResponse struct {
+	StargazersCount int `json:"stargazers_count"`
+	ForksCount      int `json:"forks_count"`
+	WatchersCount   int `json:"watchers_count"`
+	OpenIssuesCount int `json:"open_issues_count"`
+	NetworkCount    int `json:"network_count"`
+}
+
+// GetRepoStats fetches star, fork, watcher, open issue, and network counts
+func (c *Client) GetRepoStats(owner, repo string) (*RepoStats, error) {
+	var response repoStatsResponse
+	path := fmt.Sprintf("repos/%s/%s", owner, repo)
+
+	if err := c.Get(path, &response); err != nil {
+		return nil, fmt.Errorf("failed to get repo stats: %w", err)
+	}
+
+	return &RepoStats{
+		Repository: fmt.Sprintf("%s/%s", owner, repo),
+		Stars:      response.StargazersCount,
+		Forks:      response.ForksCount,
+		Watchers:   response.WatchersCount,
+		OpenIssues: response.OpenIssuesCount,
+		Network:    response.NetworkCount,
+	}, nil
+}
+
+// RankReposByPopularity orders repository names by stars, then forks, most popular first
+// Pure function: ties are broken by name for deterministic output
+func RankReposByPopularity(stats map[string]*RepoStats) []string {
+	ranked := make([]string, 0, len(stats))
+	for name := range stats {
+		ranked = append(ranked, name)
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := stats[ranked[i]], stats[ranked[j]]
+		if a.Stars != b.Stars {
+			return a.Stars > b.Stars
+		}
+		if a.Forks != b.Forks {
+			return a.Forks > b.Forks
+		}
+		return ranked[i] < ranked[j]
+	})
+
+	return ranked
+}
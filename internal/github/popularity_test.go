@@ -0,0 +1,49 @@
+package github
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// TestGetRepoStats tests popularity counters against a mocked API
+func TestGetRepoStats(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"stargazers_count": 420, "forks_count": 37, "watchers_count": 420, "open_issues_count": 12, "network_count": 40}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	stats, err := client.GetRepoStats("owner", "repo")
+	if err != nil {
+		t.Fatalf("GetRepoStats failed: %v", err)
+	}
+
+	expected := &RepoStats{Repository: "owner/repo", Stars: 420, Forks: 37, Watchers: 420, OpenIssues: 12, Network: 40}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, stats)
+	}
+}
+
+// TestRankReposByPopularity tests ordering by stars, then forks, then name
+func TestRankReposByPopularity(t *testing.T) {
+	stats := map[string]*RepoStats{
+		"acme/cli":  {Stars: 15, Forks: 2},
+		"acme/api":  {Stars: 900, Forks: 10},
+		"acme/docs": {Stars: 15, Forks: 7},
+		"acme/sdk":  {Stars: 15, Forks: 2},
+		"acme/web":  {Stars: 0},
+	}
+
+	ranked := RankReposByPopularity(stats)
+
+	expected := []string{"acme/api", "acme/docs", "acme/cli", "acme/sdk", "acme/web"}
+	if !reflect.DeepEqual(ranked, expected) {
+		t.Errorf("Expected %v, got %v", expected, ranked)
+	}
+
+	if len(RankReposByPopularity(nil)) != 0 {
+		t.Error("Expected no repos to rank")
+	}
+}
@@ -1,6 +1,12 @@
 package github
 
-import "fmt"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
 
 // ProtectionRule represents branch protection settings
 type ProtectionRule struct {
@@ -13,16 +19,46 @@ type ProtectionRule struct {
 	RequireLinearHistory    bool
 	AllowForcePushes        bool
 	AllowDeletions          bool
+
+	StrictStatusChecks            bool              // Branches must be up to date before merging
+	DismissStaleReviews           bool              // New commits dismiss existing approvals
+	RequireLastPushApproval       bool              // The last push must be approved by someone else
+	RequireConversationResolution bool              // All review threads must be resolved
+	Restrictions                  *PushRestrictions // nil when anyone with write access can push
+}
+
+// PushRestrictions lists who may push to a protected branch
+type PushRestrictions struct {
+	Users []string // Logins
+	Teams []string // Slugs
+	Apps  []string // Slugs
 }
 
 type protectionResponse struct {
 	RequiredPullRequestReviews *struct {
 		RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
 		RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+		DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+		RequireLastPushApproval      bool `json:"require_last_push_approval"`
 	} `json:"required_pull_request_reviews"`
 	RequiredStatusChecks *struct {
+		Strict   bool     `json:"strict"`
 		Contexts []string `json:"contexts"`
 	} `json:"required_status_checks"`
+	RequiredConversationResolution struct {
+		Enabled bool `json:"enabled"`
+	} `json:"required_conversation_resolution"`
+	Restrictions *struct {
+		Users []struct {
+			Login string `json:"login"`
+		} `json:"users"`
+		Teams []struct {
+			Slug string `json:"slug"`
+		} `json:"teams"`
+		Apps []struct {
+			Slug string `json:"slug"`
+		} `json:"apps"`
+	} `json:"restrictions"`
 	EnforceAdmins struct {
 		Enabled bool `json:"enabled"`
 	} `json:"enforce_admins"`
@@ -53,20 +89,303 @@ func (c *Client) GetBranchProtection(owner, repo, branch string) (*ProtectionRul
 		RequireLinearHistory: response.RequireLinearHistory.Enabled,
 		AllowForcePushes:     response.AllowForcePushes.Enabled,
 		AllowDeletions:       response.AllowDeletions.Enabled,
+
+		RequireConversationResolution: response.RequiredConversationResolution.Enabled,
 	}
 
-	if response.RequiredPullRequestReviews != nil {
-		rule.RequiredReviews = response.RequiredPullRequestReviews.RequiredApprovingReviewCount
-		rule.RequireCodeOwnerReviews = response.RequiredPullRequestReviews.RequireCodeOwnerReviews
+	if reviews := response.RequiredPullRequestReviews; reviews != nil {
+		rule.RequiredReviews = reviews.RequiredApprovingReviewCount
+		rule.RequireCodeOwnerReviews = reviews.RequireCodeOwnerReviews
+		rule.DismissStaleReviews = reviews.DismissStaleReviews
+		rule.RequireLastPushApproval = reviews.RequireLastPushApproval
 	}
 
 	if response.RequiredStatusChecks != nil {
 		rule.RequireStatusChecks = response.RequiredStatusChecks.Contexts
+		rule.StrictStatusChecks = response.RequiredStatusChecks.Strict
+	}
+
+	if restrictions := response.Restrictions; restrictions != nil {
+		rule.Restrictions = &PushRestrictions{}
+		for _, user := range restrictions.Users {
+			rule.Restrictions.Users = append(rule.Restrictions.Users, user.Login)
+		}
+		for _, team := range restrictions.Teams {
+			rule.Restrictions.Teams = append(rule.Restrictions.Teams, team.Slug)
+		}
+		for _, app := range restrictions.Apps {
+			rule.Restrictions.Apps = append(rule.Restrictions.Apps, app.Slug)
+		}
 	}
 
 	return rule, nil
 }
 
+// MaxRequiredReviews is the highest approving review count GitHub accepts
+const MaxRequiredReviews = 6
+
+// protectionRuleJSON is the version-controlled file format for a ProtectionRule
+// Push restrictions name users and teams of one org, so they are left out and kept per repository
+type protectionRuleJSON struct {
+	Repository              string   `json:"repository,omitempty"`
+	Branch                  string   `json:"branch,omitempty"`
+	RequiredReviews         int      `json:"required_reviews"`
+	RequireCodeOwnerReviews bool     `json:"require_code_owner_reviews"`
+	RequireStatusChecks     []string `json:"required_status_checks"`
+	EnforceAdmins           bool     `json:"enforce_admins"`
+	RequireLinearHistory    bool     `json:"require_linear_history"`
+	AllowForcePushes        bool     `json:"allow_force_pushes"`
+	AllowDeletions          bool     `json:"allow_deletions"`
+
+	StrictStatusChecks            bool `json:"strict_status_checks"`
+	DismissStaleReviews           bool `json:"dismiss_stale_reviews"`
+	RequireLastPushApproval       bool `json:"require_last_push_approval"`
+	RequireConversationResolution bool `json:"require_conversation_resolution"`
+}
+
+// ExportProtectionRuleToJSON serializes a rule in the format read by LoadProtectionRuleFromJSON
+func ExportProtectionRuleToJSON(rule *ProtectionRule) ([]byte, error) {
+	checks := rule.RequireStatusChecks
+	if checks == nil {
+		checks = []string{}
+	}
+
+	data, err := json.MarshalIndent(protectionRuleJSON{
+		Repository:              rule.Repository,
+		Branch:                  rule.Branch,
+		RequiredReviews:         rule.RequiredReviews,
+		RequireCodeOwnerReviews: rule.RequireCodeOwnerReviews,
+		RequireStatusChecks:     checks,
+		EnforceAdmins:           rule.EnforceAdmins,
+		RequireLinearHistory:    rule.RequireLinearHistory,
+		AllowForcePushes:        rule.AllowForcePushes,
+		AllowDeletions:          rule.AllowDeletions,
+
+		StrictStatusChecks:            rule.StrictStatusChecks,
+		DismissStaleReviews:           rule.DismissStaleReviews,
+		RequireLastPushApproval:       rule.RequireLastPushApproval,
+		RequireConversationResolution: rule.RequireConversationResolution,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protection rule: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// LoadProtectionRuleFromJSON parses and validates a rule written by ExportProtectionRuleToJSON
+// Unknown fields are rejected so typos do not silently weaken protection
+func LoadProtectionRuleFromJSON(data []byte) (*ProtectionRule, error) {
+	var raw protectionRuleJSON
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to parse protection rule: %w", err)
+	}
+
+	if raw.RequiredReviews < 0 || raw.RequiredReviews > MaxRequiredReviews {
+		return nil, fmt.Errorf("invalid protection rule: required_reviews must be between 0 and %d, got %d", MaxRequiredReviews, raw.RequiredReviews)
+	}
+	for _, check := range raw.RequireStatusChecks {
+		if strings.TrimSpace(check) == "" {
+			return nil, fmt.Errorf("invalid protection rule: required_status_checks contains an empty name")
+		}
+	}
+
+	return &ProtectionRule{
+		Repository:              raw.Repository,
+		Branch:                  raw.Branch,
+		RequiredReviews:         raw.RequiredReviews,
+		RequireCodeOwnerReviews: raw.RequireCodeOwnerReviews,
+		RequireStatusChecks:     raw.RequireStatusChecks,
+		EnforceAdmins:           raw.EnforceAdmins,
+		RequireLinearHistory:    raw.RequireLinearHistory,
+		AllowForcePushes:        raw.AllowForcePushes,
+		AllowDeletions:          raw.AllowDeletions,
+
+		StrictStatusChecks:            raw.StrictStatusChecks,
+		DismissStaleReviews:           raw.DismissStaleReviews,
+		RequireLastPushApproval:       raw.RequireLastPushApproval,
+		RequireConversationResolution: raw.RequireConversationResolution,
+	}, nil
+}
+
+type protectionRequest struct {
+	RequiredStatusChecks *struct {
+		Strict   bool     `json:"strict"`
+		Contexts []string `json:"contexts"`
+	} `json:"required_status_checks"`
+	EnforceAdmins              bool `json:"enforce_admins"`
+	RequiredPullRequestReviews *struct {
+		RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+		RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+		DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+		RequireLastPushApproval      bool `json:"require_last_push_approval"`
+	} `json:"required_pull_request_reviews"`
+	Restrictions *struct {
+		Users []string `json:"users"`
+		Teams []string `json:"teams"`
+		Apps  []string `json:"apps"`
+	} `json:"restrictions"`
+	RequiredLinearHistory          bool `json:"required_linear_history"`
+	AllowForcePushes               bool `json:"allow_force_pushes"`
+	AllowDeletions                 bool `json:"allow_deletions"`
+	RequiredConversationResolution bool `json:"required_conversation_resolution"`
+}
+
+// SetBranchProtection replaces the protection of a branch with rule
+// Every setting is sent explicitly; a nil rule.Restrictions clears push restrictions
+func (c *Client) SetBranchProtection(owner, repo, branch string, rule *ProtectionRule) error {
+	var request protectionRequest
+	request.EnforceAdmins = rule.EnforceAdmins
+	request.RequiredLinearHistory = rule.RequireLinearHistory
+	request.AllowForcePushes = rule.AllowForcePushes
+	request.AllowDeletions = rule.AllowDeletions
+	request.RequiredConversationResolution = rule.RequireConversationResolution
+
+	if len(rule.RequireStatusChecks) > 0 || rule.StrictStatusChecks {
+		request.RequiredStatusChecks = &struct {
+			Strict   bool     `json:"strict"`
+			Contexts []string `json:"contexts"`
+		}{Strict: rule.StrictStatusChecks, Contexts: nonNilStrings(rule.RequireStatusChecks)}
+	}
+
+	if rule.RequiredReviews > 0 || rule.RequireCodeOwnerReviews || rule.DismissStaleReviews || rule.RequireLastPushApproval {
+		request.RequiredPullRequestReviews = &struct {
+			RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+			RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+			DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+			RequireLastPushApproval      bool `json:"require_last_push_approval"`
+		}{
+			RequiredApprovingReviewCount: rule.RequiredReviews,
+			RequireCodeOwnerReviews:      rule.RequireCodeOwnerReviews,
+			DismissStaleReviews:          rule.DismissStaleReviews,
+			RequireLastPushApproval:      rule.RequireLastPushApproval,
+		}
+	}
+
+	if rule.Restrictions != nil {
+		request.Restrictions = &struct {
+			Users []string `json:"users"`
+			Teams []string `json:"teams"`
+			Apps  []string `json:"apps"`
+		}{
+			Users: nonNilStrings(rule.Restrictions.Users),
+			Teams: nonNilStrings(rule.Restrictions.Teams),
+			Apps:  nonNilStrings(rule.Restrictions.Apps),
+		}
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/branches/%s/protection", owner, repo, branch)
+	if err := c.Put(path, request, nil); err != nil {
+		return fmt.Errorf("failed to set branch protection: %w", err)
+	}
+
+	return nil
+}
+
+// nonNilStrings returns an empty slice for nil so it is sent as [] rather than null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// ApplyProtectionRule replaces the protection of a branch with rule, typically a baseline
+// The branch's current push restrictions are kept, since they name users and teams of the target
+func (c *Client) ApplyProtectionRule(owner, repo, branch string, rule *ProtectionRule) error {
+	if rule == nil {
+		return fmt.Errorf("failed to apply protection rule: no rule given")
+	}
+
+	current, err := c.GetBranchProtection(owner, repo, branch)
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return fmt.Errorf("failed to apply protection rule: %w", err)
+	}
+
+	return c.SetBranchProtection(owner, repo, branch, rule.WithRestrictionsFrom(current))
+}
+
+// WithRestrictionsFrom returns a copy of rule that keeps current's push restrictions
+// A nil current means the branch is unprotected, so no restrictions are kept
+func (rule *ProtectionRule) WithRestrictionsFrom(current *ProtectionRule) *ProtectionRule {
+	merged := *rule
+	merged.Restrictions = nil
+	if current != nil {
+		merged.Restrictions = current.Restrictions
+	}
+	return &merged
+}
+
+// ProtectionRuleChanges lists every setting that differs between current and desired,
+// formatted as "Field: current → desired"
+// Pure function: a nil current is treated as an unprotected branch
+func ProtectionRuleChanges(current, desired *ProtectionRule) []string {
+	if current == nil {
+		current = &ProtectionRule{}
+	}
+
+	var changes []string
+	add := func(field string, from, to interface{}) {
+		if fmt.Sprint(from) != fmt.Sprint(to) {
+			changes = append(changes, fmt.Sprintf("%s: %v → %v", field, from, to))
+		}
+	}
+
+	add("RequiredReviews", current.RequiredReviews, desired.RequiredReviews)
+	add("RequireCodeOwnerReviews", current.RequireCodeOwnerReviews, desired.RequireCodeOwnerReviews)
+	add("DismissStaleReviews", current.DismissStaleReviews, desired.DismissStaleReviews)
+	add("RequireLastPushApproval", current.RequireLastPushApproval, desired.RequireLastPushApproval)
+	if !sameStatusChecks(current.RequireStatusChecks, desired.RequireStatusChecks) {
+		add("RequireStatusChecks", current.RequireStatusChecks, desired.RequireStatusChecks)
+	}
+	add("StrictStatusChecks", current.StrictStatusChecks, desired.StrictStatusChecks)
+	add("EnforceAdmins", current.EnforceAdmins, desired.EnforceAdmins)
+	add("RequireLinearHistory", current.RequireLinearHistory, desired.RequireLinearHistory)
+	add("RequireConversationResolution", current.RequireConversationResolution, desired.RequireConversationResolution)
+	add("AllowForcePushes", current.AllowForcePushes, desired.AllowForcePushes)
+	add("AllowDeletions", current.AllowDeletions, desired.AllowDeletions)
+	add("Restrictions", formatRestrictions(current.Restrictions), formatRestrictions(desired.Restrictions))
+
+	return changes
+}
+
+// formatRestrictions renders push restrictions for ProtectionRuleChanges
+func formatRestrictions(r *PushRestrictions) string {
+	if r == nil {
+		return "none"
+	}
+	return fmt.Sprintf("users=%v teams=%v apps=%v", r.Users, r.Teams, r.Apps)
+}
+
+// DriftedRepos returns the repositories in repos whose protection differs from
+// the baseline repository's rule, sorted by name
+// Repos with no rule in rules are unprotected, so they count as drifted
+// Pure function: returns nil when the baseline has no rule
+func DriftedRepos(baseline string, repos []string, rules map[string]*ProtectionRule) []string {
+	baselineRule := rules[baseline]
+	if baselineRule == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(repos))
+	var drifted []string
+	for _, repo := range repos {
+		if repo == baseline || seen[repo] {
+			continue
+		}
+		seen[repo] = true
+
+		rule := rules[repo]
+		if rule == nil || len(CompareProtectionRules([]*ProtectionRule{baselineRule, rule})) > 0 {
+			drifted = append(drifted, repo)
+		}
+	}
+	sort.Strings(drifted)
+
+	return drifted
+}
+
 // CompareProtectionRules compares protection rules across repositories
 func CompareProtectionRules(rules []*ProtectionRule) map[string][]string {
 	differences := make(map[string][]string)
@@ -94,7 +413,192 @@ func CompareProtectionRules(rules []*ProtectionRule) map[string][]string {
 			differences["EnforceAdmins"] = append(differences["EnforceAdmins"],
 				fmt.Sprintf("%s: %v (baseline: %v)", rule.Repository, rule.EnforceAdmins, baseline.EnforceAdmins))
 		}
+
+		if !sameStatusChecks(rule.RequireStatusChecks, baseline.RequireStatusChecks) {
+			differences["RequireStatusChecks"] = append(differences["RequireStatusChecks"],
+				fmt.Sprintf("%s: %v (baseline: %v)", rule.Repository, rule.RequireStatusChecks, baseline.RequireStatusChecks))
+		}
 	}
 
 	return differences
 }
+
+// sameStatusChecks reports whether two status check lists contain the same contexts in any order
+func sameStatusChecks(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, check := range a {
+		counts[check]++
+	}
+	for _, check := range b {
+		counts[check]--
+		if counts[check] < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Remediation priorities, ordered from most to least urgent
+const (
+	PriorityHigh   = "high"
+	PriorityMedium = "medium"
+	PriorityLow    = "low"
+)
+
+// ProtectionRemediation is a suggested change to bring a repo in line with the baseline
+type ProtectionRemediation struct {
+	Repository string
+	Action     string
+	Priority   string
+}
+
+// SuggestProtectionRemediations turns CompareProtectionRules output into actionable fixes
+// Pure function: sorted by priority, then repository, then action
+func SuggestProtectionRemediations(diffs map[string][]string, baseline *ProtectionRule) []ProtectionRemediation {
+	var remediations []ProtectionRemediation
+	if baseline == nil {
+		return remediations
+	}
+
+	for field, entries := range diffs {
+		var action, priority string
+		switch field {
+		case "EnforceAdmins":
+			action = fmt.Sprintf("Set EnforceAdmins to %v", baseline.EnforceAdmins)
+			// Admins bypassing protection is only urgent when the baseline enforces it
+			priority = PriorityLow
+			if baseline.EnforceAdmins {
+				priority = PriorityHigh
+			}
+		case "RequiredReviews":
+			action = fmt.Sprintf("Set RequiredReviews to %d", baseline.RequiredReviews)
+			priority = PriorityMedium
+		case "RequireCodeOwnerReviews":
+			action = fmt.Sprintf("Set RequireCodeOwnerReviews to %v", baseline.RequireCodeOwnerReviews)
+			priority = PriorityMedium
+		case "RequireStatusChecks":
+			action = fmt.Sprintf("Set RequireStatusChecks to %v", baseline.RequireStatusChecks)
+			priority = PriorityLow
+		default:
+			continue
+		}
+
+		for _, entry := range entries {
+			// Entries are formatted as "owner/repo: value (baseline: value)"
+			repo, _, _ := strings.Cut(entry, ": ")
+			remediations = append(remediations, ProtectionRemediation{
+				Repository: repo,
+				Action:     action,
+				Priority:   priority,
+			})
+		}
+	}
+
+	sort.Slice(remediations, func(i, j int) bool {
+		a, b := remediations[i], remediations[j]
+		if priorityRank(a.Priority) != priorityRank(b.Priority) {
+			return priorityRank(a.Priority) < priorityRank(b.Priority)
+		}
+		if a.Repository != b.Repository {
+			return a.Repository < b.Repository
+		}
+		return a.Action < b.Action
+	})
+
+	return remediations
+}
+
+func priorityRank(priority string) int {
+	switch priority {
+	case PriorityHigh:
+		return 0
+	case PriorityMedium:
+		return 1
+	default:
+		return 2
+	}
+}
+
+var protectionGraphQLFields = []string{
+	`defaultBranchRef {
+      name
+      branchProtectionRule {
+        requiredApprovingReviewCount
+        requiresCodeOwnerReviews
+        requiredStatusCheckContexts
+        isAdminEnforced
+        requiresLinearHistory
+        allowsForcePushes
+        allowsDeletions
+        requiresStrictStatusChecks
+        dismissesStaleReviews
+        requireLastPushApproval
+        requiresConversationResolution
+      }
+    }`,
+}
+
+type protectionGraphQLResponse struct {
+	DefaultBranchRef *struct {
+		Name                 string `json:"name"`
+		BranchProtectionRule *struct {
+			RequiredApprovingReviewCount   int      `json:"requiredApprovingReviewCount"`
+			RequiresCodeOwnerReviews       bool     `json:"requiresCodeOwnerReviews"`
+			RequiredStatusCheckContexts    []string `json:"requiredStatusCheckContexts"`
+			IsAdminEnforced                bool     `json:"isAdminEnforced"`
+			RequiresLinearHistory          bool     `json:"requiresLinearHistory"`
+			AllowsForcePushes              bool     `json:"allowsForcePushes"`
+			AllowsDeletions                bool     `json:"allowsDeletions"`
+			RequiresStrictStatusChecks     bool     `json:"requiresStrictStatusChecks"`
+			DismissesStaleReviews          bool     `json:"dismissesStaleReviews"`
+			RequireLastPushApproval        bool     `json:"requireLastPushApproval"`
+			RequiresConversationResolution bool     `json:"requiresConversationResolution"`
+		} `json:"branchProtectionRule"`
+	} `json:"defaultBranchRef"`
+}
+
+// GetBranchProtectionBatch retrieves default branch protection for many repositories in one GraphQL request
+// Repos without a protection rule are omitted, and push restrictions are not fetched
+func (g *GraphQLClient) GetBranchProtectionBatch(repoNames []string) (map[string]*ProtectionRule, error) {
+	results, err := g.BatchRepos(repoNames, protectionGraphQLFields)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(map[string]*ProtectionRule, len(results))
+	for repoName, raw := range results {
+		var response protectionGraphQLResponse
+		if err := json.Unmarshal(raw, &response); err != nil {
+			return nil, fmt.Errorf("failed to parse protection for %s: %w", repoName, err)
+		}
+
+		if response.DefaultBranchRef == nil || response.DefaultBranchRef.BranchProtectionRule == nil {
+			continue
+		}
+
+		rule := response.DefaultBranchRef.BranchProtectionRule
+		rules[repoName] = &ProtectionRule{
+			Repository:              repoName,
+			Branch:                  response.DefaultBranchRef.Name,
+			RequiredReviews:         rule.RequiredApprovingReviewCount,
+			RequireCodeOwnerReviews: rule.RequiresCodeOwnerReviews,
+			RequireStatusChecks:     rule.RequiredStatusCheckContexts,
+			EnforceAdmins:           rule.IsAdminEnforced,
+			RequireLinearHistory:    rule.RequiresLinearHistory,
+			AllowForcePushes:        rule.AllowsForcePushes,
+			AllowDeletions:          rule.AllowsDeletions,
+
+			StrictStatusChecks:            rule.RequiresStrictStatusChecks,
+			DismissStaleReviews:           rule.DismissesStaleReviews,
+			RequireLastPushApproval:       rule.RequireLastPushApproval,
+			RequireConversationResolution: rule.RequiresConversationResolution,
+		}
+	}
+
+	return rules, nil
+}
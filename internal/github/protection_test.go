@@ -0,0 +1,304 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestCompareProtectionRulesStatusChecks tests that status check order does not count as drift
+func TestCompareProtectionRulesStatusChecks(t *testing.T) {
+	baseline := &ProtectionRule{Repository: "org/base", RequireStatusChecks: []string{"ci", "lint"}}
+	reordered := &ProtectionRule{Repository: "org/same", RequireStatusChecks: []string{"lint", "ci"}}
+	missing := &ProtectionRule{Repository: "org/diff", RequireStatusChecks: []string{"ci"}}
+
+	diffs := CompareProtectionRules([]*ProtectionRule{baseline, reordered, missing})
+
+	if len(diffs["RequireStatusChecks"]) != 1 {
+		t.Fatalf("Expected 1 status check difference, got %v", diffs["RequireStatusChecks"])
+	}
+}
+
+// TestSuggestProtectionRemediations tests priority classification for each kind of drift
+func TestSuggestProtectionRemediations(t *testing.T) {
+	baseline := &ProtectionRule{
+		Repository:          "org/base",
+		RequiredReviews:     2,
+		EnforceAdmins:       true,
+		RequireStatusChecks: []string{"ci"},
+	}
+
+	tests := []struct {
+		name             string
+		rule             *ProtectionRule
+		expectedAction   string
+		expectedPriority string
+	}{
+		{
+			name:             "missing enforce admins",
+			rule:             &ProtectionRule{Repository: "org/admins", RequiredReviews: 2, RequireStatusChecks: []string{"ci"}},
+			expectedAction:   "Set EnforceAdmins to true",
+			expectedPriority: PriorityHigh,
+		},
+		{
+			name:             "different review count",
+			rule:             &ProtectionRule{Repository: "org/reviews", RequiredReviews: 1, EnforceAdmins: true, RequireStatusChecks: []string{"ci"}},
+			expectedAction:   "Set RequiredReviews to 2",
+			expectedPriority: PriorityMedium,
+		},
+		{
+			name:             "different status checks",
+			rule:             &ProtectionRule{Repository: "org/checks", RequiredReviews: 2, EnforceAdmins: true},
+			expectedAction:   "Set RequireStatusChecks to [ci]",
+			expectedPriority: PriorityLow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs := CompareProtectionRules([]*ProtectionRule{baseline, tt.rule})
+			remediations := SuggestProtectionRemediations(diffs, baseline)
+
+			if len(remediations) != 1 {
+				t.Fatalf("Expected 1 remediation, got %d: %+v", len(remediations), remediations)
+			}
+
+			r := remediations[0]
+			if r.Repository != tt.rule.Repository {
+				t.Errorf("Expected repository %s, got %s", tt.rule.Repository, r.Repository)
+			}
+			if r.Action != tt.expectedAction {
+				t.Errorf("Expected action %q, got %q", tt.expectedAction, r.Action)
+			}
+			if r.Priority != tt.expectedPriority {
+				t.Errorf("Expected priority %s, got %s", tt.expectedPriority, r.Priority)
+			}
+		})
+	}
+}
+
+// TestSuggestProtectionRemediationsOrdering tests that high priority suggestions come first
+func TestSuggestProtectionRemediationsOrdering(t *testing.T) {
+	baseline := &ProtectionRule{Repository: "org/base", RequiredReviews: 2, EnforceAdmins: true}
+	rule := &ProtectionRule{Repository: "org/api", RequiredReviews: 1, RequireStatusChecks: []string{"ci"}}
+
+	diffs := CompareProtectionRules([]*ProtectionRule{baseline, rule})
+	remediations := SuggestProtectionRemediations(diffs, baseline)
+
+	expected := []string{PriorityHigh, PriorityMedium, PriorityLow}
+	if len(remediations) != len(expected) {
+		t.Fatalf("Expected %d remediations, got %d", len(expected), len(remediations))
+	}
+
+	for i, priority := range expected {
+		if remediations[i].Priority != priority {
+			t.Errorf("Expected remediation %d to be %s, got %s", i, priority, remediations[i].Priority)
+		}
+	}
+}
+
+// TestProtectionRuleJSONRoundTrip tests that every field survives export and load
+func TestProtectionRuleJSONRoundTrip(t *testing.T) {
+	rule := &ProtectionRule{
+		Repository:              "org/baseline",
+		Branch:                  "main",
+		RequiredReviews:         2,
+		RequireCodeOwnerReviews: true,
+		RequireStatusChecks:     []string{"ci / test", "ci / lint", "codeql"},
+		EnforceAdmins:           true,
+		RequireLinearHistory:    true,
+		AllowForcePushes:        false,
+		AllowDeletions:          true,
+
+		StrictStatusChecks:            true,
+		DismissStaleReviews:           true,
+		RequireLastPushApproval:       true,
+		RequireConversationResolution: true,
+	}
+
+	data, err := ExportProtectionRuleToJSON(rule)
+	if err != nil {
+		t.Fatalf("ExportProtectionRuleToJSON failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"require_code_owner_reviews": true`) {
+		t.Errorf("Expected snake_case fields, got:\n%s", data)
+	}
+
+	loaded, err := LoadProtectionRuleFromJSON(data)
+	if err != nil {
+		t.Fatalf("LoadProtectionRuleFromJSON failed: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, rule) {
+		t.Errorf("Expected %+v, got %+v", rule, loaded)
+	}
+}
+
+// TestLoadProtectionRuleFromJSONValidation tests rejection of invalid rule files
+func TestLoadProtectionRuleFromJSONValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"malformed", `{"required_reviews": `},
+		{"unknown field", `{"required_review": 2}`},
+		{"too many reviews", `{"required_reviews": 7}`},
+		{"negative reviews", `{"required_reviews": -1}`},
+		{"empty status check", `{"required_status_checks": ["ci", " "]}`},
+	}
+
+	for _, tt := range tests {
+		if _, err := LoadProtectionRuleFromJSON([]byte(tt.data)); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+// TestSetBranchProtection tests the request body sent to the protection endpoint
+func TestSetBranchProtection(t *testing.T) {
+	var body map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/branches/main/protection", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT, got %s", r.Method)
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	rule := &ProtectionRule{
+		RequiredReviews:               1,
+		RequireCodeOwnerReviews:       true,
+		DismissStaleReviews:           true,
+		RequireStatusChecks:           []string{"ci"},
+		StrictStatusChecks:            true,
+		EnforceAdmins:                 true,
+		RequireConversationResolution: true,
+	}
+	if err := client.SetBranchProtection("owner", "repo", "main", rule); err != nil {
+		t.Fatalf("SetBranchProtection failed: %v", err)
+	}
+
+	reviews, _ := body["required_pull_request_reviews"].(map[string]interface{})
+	if reviews["required_approving_review_count"] != float64(1) || reviews["require_code_owner_reviews"] != true || reviews["dismiss_stale_reviews"] != true {
+		t.Errorf("Unexpected review settings: %v", body["required_pull_request_reviews"])
+	}
+	checks, _ := body["required_status_checks"].(map[string]interface{})
+	if !reflect.DeepEqual(checks["contexts"], []interface{}{"ci"}) || checks["strict"] != true {
+		t.Errorf("Unexpected status checks: %v", body["required_status_checks"])
+	}
+	if body["required_conversation_resolution"] != true {
+		t.Errorf("Expected conversation resolution, got %v", body["required_conversation_resolution"])
+	}
+	if restrictions, ok := body["restrictions"]; !ok || restrictions != nil {
+		t.Errorf("Expected explicit null restrictions, got %v", restrictions)
+	}
+
+	rule.Restrictions = &PushRestrictions{Teams: []string{"release"}}
+	if err := client.SetBranchProtection("owner", "repo", "main", rule); err != nil {
+		t.Fatalf("SetBranchProtection failed: %v", err)
+	}
+	want := map[string]interface{}{"users": []interface{}{}, "teams": []interface{}{"release"}, "apps": []interface{}{}}
+	if !reflect.DeepEqual(body["restrictions"], want) {
+		t.Errorf("Expected restrictions %v, got %v", want, body["restrictions"])
+	}
+}
+
+// TestApplyProtectionRule tests that the rule is PUT to the target branch, keeping its push restrictions
+func TestApplyProtectionRule(t *testing.T) {
+	var body map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/target/branches/develop/protection", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"restrictions": {"users": [{"login": "octocat"}], "teams": [], "apps": []}}`))
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/repos/owner/unprotected/branches/main/protection", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			http.Error(w, `{"message": "Branch not protected"}`, http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	baseline := &ProtectionRule{Repository: "owner/baseline", Branch: "main", RequiredReviews: 2, EnforceAdmins: true}
+	if err := client.ApplyProtectionRule("owner", "target", "develop", baseline); err != nil {
+		t.Fatalf("ApplyProtectionRule failed: %v", err)
+	}
+
+	reviews, _ := body["required_pull_request_reviews"].(map[string]interface{})
+	if reviews["required_approving_review_count"] != float64(2) || body["enforce_admins"] != true {
+		t.Errorf("Unexpected request body: %v", body)
+	}
+	restrictions, _ := body["restrictions"].(map[string]interface{})
+	if !reflect.DeepEqual(restrictions["users"], []interface{}{"octocat"}) {
+		t.Errorf("Expected the target's push restrictions to be kept, got %v", body["restrictions"])
+	}
+
+	if err := client.ApplyProtectionRule("owner", "unprotected", "main", baseline); err != nil {
+		t.Fatalf("ApplyProtectionRule failed for an unprotected branch: %v", err)
+	}
+	if restrictions, ok := body["restrictions"]; !ok || restrictions != nil {
+		t.Errorf("Expected null restrictions for an unprotected branch, got %v", restrictions)
+	}
+
+	if err := client.ApplyProtectionRule("owner", "target", "develop", nil); err == nil {
+		t.Error("Expected an error for a nil rule")
+	}
+}
+
+// TestProtectionRuleChanges tests that every differing setting is listed
+func TestProtectionRuleChanges(t *testing.T) {
+	current := &ProtectionRule{RequiredReviews: 1, RequireStatusChecks: []string{"lint", "ci"}, DismissStaleReviews: true}
+	desired := &ProtectionRule{RequiredReviews: 2, RequireStatusChecks: []string{"ci", "lint"}, StrictStatusChecks: true}
+
+	got := ProtectionRuleChanges(current, desired)
+	want := []string{
+		"RequiredReviews: 1 → 2",
+		"DismissStaleReviews: true → false",
+		"StrictStatusChecks: false → true",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProtectionRuleChanges() = %v, want %v", got, want)
+	}
+
+	got = ProtectionRuleChanges(nil, &ProtectionRule{EnforceAdmins: true, Restrictions: &PushRestrictions{Teams: []string{"release"}}})
+	want = []string{
+		"EnforceAdmins: false → true",
+		"Restrictions: none → users=[] teams=[release] apps=[]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProtectionRuleChanges(nil) = %v, want %v", got, want)
+	}
+}
+
+// TestDriftedRepos tests which repos differ from the baseline
+func TestDriftedRepos(t *testing.T) {
+	rules := map[string]*ProtectionRule{
+		"owner/baseline": {Repository: "owner/baseline", RequiredReviews: 2, RequireStatusChecks: []string{"ci", "lint"}},
+		"owner/same":     {Repository: "owner/same", RequiredReviews: 2, RequireStatusChecks: []string{"lint", "ci"}},
+		"owner/weaker":   {Repository: "owner/weaker", RequiredReviews: 1, RequireStatusChecks: []string{"ci", "lint"}},
+		"owner/admins":   {Repository: "owner/admins", RequiredReviews: 2, RequireStatusChecks: []string{"ci", "lint"}, EnforceAdmins: true},
+	}
+
+	repos := []string{"owner/baseline", "owner/same", "owner/weaker", "owner/admins", "owner/unprotected", "owner/weaker"}
+
+	got := DriftedRepos("owner/baseline", repos, rules)
+	want := []string{"owner/admins", "owner/unprotected", "owner/weaker"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DriftedRepos() = %v, want %v", got, want)
+	}
+
+	if got := DriftedRepos("owner/missing", repos, rules); got != nil {
+		t.Errorf("Expected nil without a baseline rule, got %v", got)
+	}
+}
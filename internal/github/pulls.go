@@ -2,6 +2,7 @@ package github
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -19,6 +20,7 @@ type PullRequest struct {
 	Base     PRRef
 	MergedAt *time.Time
 	ClosedAt *time.Time
+	Labels   []string // Only populated by callers that fetch labels via GetPRLabels
 }
 
 type prResponse struct {
@@ -44,16 +46,75 @@ type prResponse struct {
 }
 
 func (c *Client) ListPullRequests(owner, repo, state string) ([]PullRequest, error) {
+	var response []prResponse
+	path := fmt.Sprintf("repos/%s/%s/pulls?state=%s", owner, repo, state)
+
+	if err := c.GetAllPages(path, &response); err != nil {
+		return nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+
+	allPRs := make([]PullRequest, 0, len(response))
+	for _, pr := range response {
+		headRepo := ""
+		if pr.Head.Repo.FullName != "" {
+			headRepo = pr.Head.Repo.FullName
+		}
+		baseRepo := ""
+		if pr.Base.Repo.FullName != "" {
+			baseRepo = pr.Base.Repo.FullName
+		}
+
+		allPRs = append(allPRs, PullRequest{
+			Number: pr.Number,
+			Title:  pr.Title,
+			State:  pr.State,
+			Head: PRRef{
+				Ref:  pr.Head.Ref,
+				SHA:  pr.Head.SHA,
+				Repo: headRepo,
+			},
+			Base: PRRef{
+				Ref:  pr.Base.Ref,
+				SHA:  pr.Base.SHA,
+				Repo: baseRepo,
+			},
+			MergedAt: pr.MergedAt,
+			ClosedAt: pr.ClosedAt,
+		})
+	}
+
+	return allPRs, nil
+}
+
+// GetPRLabels lists the label names on a pull request
+func (c *Client) GetPRLabels(owner, repo string, prNumber int) ([]string, error) {
+	var response []struct {
+		Name string `json:"name"`
+	}
+	path := fmt.Sprintf("repos/%s/%s/issues/%d/labels?per_page=100", owner, repo, prNumber)
+
+	if err := c.Get(path, &response); err != nil {
+		return nil, fmt.Errorf("failed to get pull request labels: %w", err)
+	}
+
+	labels := make([]string, 0, len(response))
+	for _, label := range response {
+		labels = append(labels, label.Name)
+	}
+	return labels, nil
+}
+
+func (c *Client) GetPullRequestsForBranch(owner, repo, branch string) ([]PullRequest, error) {
 	var allPRs []PullRequest
 	page := 1
 	perPage := 100
 
 	for {
 		var response []prResponse
-		path := fmt.Sprintf("repos/%s/%s/pulls?state=%s&per_page=%d&page=%d", owner, repo, state, perPage, page)
+		path := fmt.Sprintf("repos/%s/%s/pulls?state=all&head=%s:%s&per_page=%d&page=%d", owner, repo, owner, branch, perPage, page)
 
 		if err := c.Get(path, &response); err != nil {
-			return nil, fmt.Errorf("failed to list pull requests: %w", err)
+			return nil, fmt.Errorf("failed to get pull requests for branch: %w", err)
 		}
 
 		if len(response) == 0 {
@@ -98,47 +159,44 @@ func (c *Client) ListPullRequests(owner, repo, state string) ([]PullRequest, err
 	return allPRs, nil
 }
 
-func (c *Client) GetPullRequestsForBranch(owner, repo, branch string) ([]PullRequest, error) {
+// ListPRsWithHead lists open pull requests whose head is branch in the same repository
+func (c *Client) ListPRsWithHead(owner, repo, branch string) ([]PullRequest, error) {
+	prs, err := c.listOpenPRs(owner, repo, fmt.Sprintf("head=%s:%s", owner, url.QueryEscape(branch)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests with head %s: %w", branch, err)
+	}
+	return prs, nil
+}
+
+// ListPRsWithBase lists open pull requests that target branch
+func (c *Client) ListPRsWithBase(owner, repo, branch string) ([]PullRequest, error) {
+	prs, err := c.listOpenPRs(owner, repo, "base="+url.QueryEscape(branch))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests with base %s: %w", branch, err)
+	}
+	return prs, nil
+}
+
+func (c *Client) listOpenPRs(owner, repo, filter string) ([]PullRequest, error) {
 	var allPRs []PullRequest
 	page := 1
 	perPage := 100
 
 	for {
 		var response []prResponse
-		path := fmt.Sprintf("repos/%s/%s/pulls?state=all&head=%s:%s&per_page=%d&page=%d", owner, repo, owner, branch, perPage, page)
+		path := fmt.Sprintf("repos/%s/%s/pulls?state=open&%s&per_page=%d&page=%d", owner, repo, filter, perPage, page)
 
 		if err := c.Get(path, &response); err != nil {
-			return nil, fmt.Errorf("failed to get pull requests for branch: %w", err)
-		}
-
-		if len(response) == 0 {
-			break
+			return nil, err
 		}
 
 		for _, pr := range response {
-			headRepo := ""
-			if pr.Head.Repo.FullName != "" {
-				headRepo = pr.Head.Repo.FullName
-			}
-			baseRepo := ""
-			if pr.Base.Repo.FullName != "" {
-				baseRepo = pr.Base.Repo.FullName
-			}
-
 			allPRs = append(allPRs, PullRequest{
-				Number: pr.Number,
-				Title:  pr.Title,
-				State:  pr.State,
-				Head: PRRef{
-					Ref:  pr.Head.Ref,
-					SHA:  pr.Head.SHA,
-					Repo: headRepo,
-				},
-				Base: PRRef{
-					Ref:  pr.Base.Ref,
-					SHA:  pr.Base.SHA,
-					Repo: baseRepo,
-				},
+				Number:   pr.Number,
+				Title:    pr.Title,
+				State:    pr.State,
+				Head:     PRRef{Ref: pr.Head.Ref, SHA: pr.Head.SHA, Repo: pr.Head.Repo.FullName},
+				Base:     PRRef{Ref: pr.Base.Ref, SHA: pr.Base.SHA, Repo: pr.Base.Repo.FullName},
 				MergedAt: pr.MergedAt,
 				ClosedAt: pr.ClosedAt,
 			})
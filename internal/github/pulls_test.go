@@ -0,0 +1,26 @@
+package github
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// TestGetPRLabels tests label names are read from the issue labels endpoint
+func TestGetPRLabels(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/issues/7/labels", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name": "do-not-delete", "color": "ff0000"}, {"name": "enhancement"}]`))
+	})
+
+	client := newTestClient(t, mux)
+
+	labels, err := client.GetPRLabels("owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("GetPRLabels failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(labels, []string{"do-not-delete", "enhancement"}) {
+		t.Errorf("Unexpected labels: %v", labels)
+	}
+}
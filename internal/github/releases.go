@@ -2,7 +2,12 @@ package github
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
 )
 
 // Release represents a GitHub release
@@ -38,7 +43,7 @@ func (c *Client) ListReleases(owner, repo string) ([]Release, error) {
 	var response []releaseResponse
 	path := fmt.Sprintf("repos/%s/%s/releases", owner, repo)
 
-	if err := c.Get(path, &response); err != nil {
+	if err := c.GetAllPages(path, &response); err != nil {
 		return nil, fmt.Errorf("failed to list releases: %w", err)
 	}
 
@@ -84,17 +89,91 @@ func (c *Client) GetLatestRelease(owner, repo string) (*Release, error) {
 	}, nil
 }
 
-// ReleaseComparison compares releases across repositories
+// ReleaseComparison summarizes the changes between two release tags
 type ReleaseComparison struct {
+	FromTag      string
+	ToTag        string
+	CommitCount  int
+	PRCount      int
+	PRTitles     []string // Merged PRs in commit order, oldest first
+	Contributors []string // Sorted commit author logins
+}
+
+type compareCommitsResponse struct {
+	TotalCommits int `json:"total_commits"`
+	Commits      []struct {
+		SHA    string `json:"sha"`
+		Author *struct {
+			Login string `json:"login"`
+		} `json:"author"`
+		Commit struct {
+			Message string `json:"message"`
+			Author  struct {
+				Name string `json:"name"`
+			} `json:"author"`
+		} `json:"commit"`
+	} `json:"commits"`
+}
+
+// CompareReleases lists the commits, merged PRs, and contributors between two tags
+func (c *Client) CompareReleases(owner, repo, fromTag, toTag string) (*ReleaseComparison, error) {
+	var response compareCommitsResponse
+	path := fmt.Sprintf("repos/%s/%s/compare/%s...%s", owner, repo, fromTag, toTag)
+
+	if err := c.Get(path, &response); err != nil {
+		return nil, fmt.Errorf("failed to compare releases: %w", err)
+	}
+
+	comparison := &ReleaseComparison{
+		FromTag:     fromTag,
+		ToTag:       toTag,
+		CommitCount: response.TotalCommits,
+	}
+
+	seenPRs := make(map[int]bool)
+	seenAuthors := make(map[string]bool)
+
+	for _, commit := range response.Commits {
+		author := commit.Commit.Author.Name
+		if commit.Author != nil && commit.Author.Login != "" {
+			author = commit.Author.Login
+		}
+		if author != "" && !seenAuthors[author] {
+			seenAuthors[author] = true
+			comparison.Contributors = append(comparison.Contributors, author)
+		}
+
+		var prs []prResponse
+		if err := c.Get(fmt.Sprintf("repos/%s/%s/commits/%s/pulls", owner, repo, commit.SHA), &prs); err != nil {
+			return nil, fmt.Errorf("failed to list pull requests for commit %s: %w", commit.SHA, err)
+		}
+
+		for _, pr := range prs {
+			if pr.MergedAt == nil || seenPRs[pr.Number] {
+				continue
+			}
+			seenPRs[pr.Number] = true
+			comparison.PRTitles = append(comparison.PRTitles, pr.Title)
+		}
+	}
+
+	comparison.PRCount = len(comparison.PRTitles)
+	sort.Strings(comparison.Contributors)
+
+	return comparison, nil
+}
+
+// CrossRepoReleaseComparison compares latest releases across repositories
+type CrossRepoReleaseComparison struct {
 	Repositories     []string
 	LatestReleases   map[string]*Release
 	OutdatedRepos    []string // Repos with no release in 90+ days
 	NonSemVerRepos   []string // Repos not following semver
 }
 
-// CompareReleases compares releases across multiple repositories
-func CompareReleases(releases map[string]*Release) ReleaseComparison {
-	comparison := ReleaseComparison{
+// CompareLatestReleases compares releases across multiple repositories
+func CompareLatestReleases(releases map[string]*Release) CrossRepoReleaseComparison {
+	comparison := CrossRepoReleaseComparison{
 		LatestReleases: releases,
 		Repositories:   make([]string, 0, len(releases)),
 	}
@@ -121,3 +200,97 @@ func CompareReleases(releases map[string]*Release) ReleaseComparison {
 
 	return comparison
 }
+
+// ReleaseAgeCategory classifies a release's age against its semver policy
+type ReleaseAgeCategory string
+
+const (
+	ReleaseAgeCurrent  ReleaseAgeCategory = "current"  // Within half the allowed age
+	ReleaseAgeAging    ReleaseAgeCategory = "aging"    // Within the allowed age
+	ReleaseAgeStale    ReleaseAgeCategory = "stale"    // Past the allowed age
+	ReleaseAgeCritical ReleaseAgeCategory = "critical" // Past twice the allowed age
+)
+
+// ReleaseBump is the semver component a release version increments
+type ReleaseBump string
+
+const (
+	ReleaseBumpMajor ReleaseBump = "major"
+	ReleaseBumpMinor ReleaseBump = "minor"
+	ReleaseBumpPatch ReleaseBump = "patch"
+)
+
+// ClassifyReleaseAge compares a release's age to the max age for its semver bump
+func ClassifyReleaseAge(release Release, policy config.ReleasePolicyConfig) ReleaseAgeCategory {
+	return classifyReleaseAgeAt(release, policy, time.Now())
+}
+
+func classifyReleaseAgeAt(release Release, policy config.ReleasePolicyConfig, now time.Time) ReleaseAgeCategory {
+	maxAge := ReleaseMaxAgeDays(release, policy)
+	ageDays := int(now.Sub(release.PublishedAt).Hours() / 24)
+
+	switch {
+	case ageDays > 2*maxAge:
+		return ReleaseAgeCritical
+	case ageDays > maxAge:
+		return ReleaseAgeStale
+	case ageDays > maxAge/2:
+		return ReleaseAgeAging
+	default:
+		return ReleaseAgeCurrent
+	}
+}
+
+// ReleaseMaxAgeDays returns the policy's max age for the release's semver bump
+// Pure function: unset limits fall back to config.DefaultReleasePolicy
+func ReleaseMaxAgeDays(release Release, policy config.ReleasePolicyConfig) int {
+	defaults := config.DefaultReleasePolicy()
+
+	switch ReleaseBumpForTag(release.TagName) {
+	case ReleaseBumpMajor:
+		return positiveOr(policy.MajorMaxAgeDays, defaults.MajorMaxAgeDays)
+	case ReleaseBumpPatch:
+		return positiveOr(policy.PatchMaxAgeDays, defaults.PatchMaxAgeDays)
+	default:
+		return positiveOr(policy.MinorMaxAgeDays, defaults.MinorMaxAgeDays)
+	}
+}
+
+// ReleaseBumpForTag infers the semver bump from a tag like v2.0.0 (major), v2.1.0 (minor), or v2.1.3 (patch)
+// Pure function: pre-release and build suffixes are ignored; non-semver tags are treated as minor
+func ReleaseBumpForTag(tag string) ReleaseBump {
+	version := strings.TrimPrefix(tag, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) != 3 {
+		return ReleaseBumpMinor
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return ReleaseBumpMinor
+		}
+		numbers[i] = n
+	}
+
+	switch {
+	case numbers[2] != 0:
+		return ReleaseBumpPatch
+	case numbers[1] != 0 || numbers[0] == 0:
+		return ReleaseBumpMinor
+	default:
+		return ReleaseBumpMajor
+	}
+}
+
+func positiveOr(value, fallback int) int {
+	if value > 0 {
+		return value
+	}
+	return fallback
+}
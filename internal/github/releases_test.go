@@ -0,0 +1,118 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+)
+
+// TestCompareReleases tests PR title and contributor extraction between two tags
+func TestCompareReleases(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/compare/v1.0.0...v1.1.0":
+			w.Write([]byte(`{
+				"total_commits": 3,
+				"commits": [
+					{"sha": "aaa", "author": {"login": "alice"}, "commit": {"author": {"name": "Alice"}}},
+					{"sha": "bbb", "author": null, "commit": {"author": {"name": "Bob Builder"}}},
+					{"sha": "ccc", "author": {"login": "alice"}, "commit": {"author": {"name": "Alice"}}}
+				]
+			}`))
+		case "/repos/owner/repo/commits/aaa/pulls":
+			w.Write([]byte(`[{"number": 10, "title": "Add caching", "merged_at": "2024-01-02T00:00:00Z"}]`))
+		case "/repos/owner/repo/commits/bbb/pulls":
+			w.Write([]byte(`[
+				{"number": 10, "title": "Add caching", "merged_at": "2024-01-02T00:00:00Z"},
+				{"number": 12, "title": "Abandoned experiment", "merged_at": null}
+			]`))
+		case "/repos/owner/repo/commits/ccc/pulls":
+			w.Write([]byte(`[{"number": 11, "title": "Fix login redirect", "merged_at": "2024-01-03T00:00:00Z"}]`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(t, handler)
+
+	comparison, err := client.CompareReleases("owner", "repo", "v1.0.0", "v1.1.0")
+	if err != nil {
+		t.Fatalf("CompareReleases failed: %v", err)
+	}
+
+	if comparison.CommitCount != 3 {
+		t.Errorf("Expected 3 commits, got %d", comparison.CommitCount)
+	}
+	if comparison.PRCount != 2 {
+		t.Errorf("Expected 2 merged PRs, got %d", comparison.PRCount)
+	}
+	if len(comparison.PRTitles) != 2 || comparison.PRTitles[0] != "Add caching" || comparison.PRTitles[1] != "Fix login redirect" {
+		t.Errorf("Expected deduplicated merged PR titles in order, got %v", comparison.PRTitles)
+	}
+	if len(comparison.Contributors) != 2 || comparison.Contributors[0] != "Bob Builder" || comparison.Contributors[1] != "alice" {
+		t.Errorf("Expected contributors [Bob Builder alice], got %v", comparison.Contributors)
+	}
+}
+
+// TestReleaseBumpForTag tests semver bump inference from tags
+func TestReleaseBumpForTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want ReleaseBump
+	}{
+		{"v2.0.0", ReleaseBumpMajor},
+		{"2.0.0", ReleaseBumpMajor},
+		{"v2.1.0", ReleaseBumpMinor},
+		{"v0.3.0", ReleaseBumpMinor},
+		{"v2.1.3", ReleaseBumpPatch},
+		{"v2.1.3-rc.1", ReleaseBumpPatch},
+		{"v3.0.0+build.5", ReleaseBumpMajor},
+		{"release-2024-01", ReleaseBumpMinor},
+		{"v1.2", ReleaseBumpMinor},
+	}
+
+	for _, tt := range tests {
+		if got := ReleaseBumpForTag(tt.tag); got != tt.want {
+			t.Errorf("ReleaseBumpForTag(%q) = %s, want %s", tt.tag, got, tt.want)
+		}
+	}
+}
+
+// TestClassifyReleaseAge tests age categories against per-bump limits
+func TestClassifyReleaseAge(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+	policy := config.ReleasePolicyConfig{MajorMaxAgeDays: 365, MinorMaxAgeDays: 30, PatchMaxAgeDays: 60}
+
+	tests := []struct {
+		name    string
+		release Release
+		want    ReleaseAgeCategory
+	}{
+		{"minor 45 days old", Release{TagName: "v1.4.0", PublishedAt: daysAgo(45)}, ReleaseAgeStale},
+		{"minor 10 days old", Release{TagName: "v1.4.0", PublishedAt: daysAgo(10)}, ReleaseAgeCurrent},
+		{"minor 20 days old", Release{TagName: "v1.4.0", PublishedAt: daysAgo(20)}, ReleaseAgeAging},
+		{"minor 61 days old", Release{TagName: "v1.4.0", PublishedAt: daysAgo(61)}, ReleaseAgeCritical},
+		{"patch 45 days old", Release{TagName: "v1.4.2", PublishedAt: daysAgo(45)}, ReleaseAgeAging},
+		{"major 400 days old", Release{TagName: "v2.0.0", PublishedAt: daysAgo(400)}, ReleaseAgeStale},
+		{"major 800 days old", Release{TagName: "v2.0.0", PublishedAt: daysAgo(800)}, ReleaseAgeCritical},
+	}
+
+	for _, tt := range tests {
+		if got := classifyReleaseAgeAt(tt.release, policy, now); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if got := ClassifyReleaseAge(Release{TagName: "v1.4.0", PublishedAt: time.Now().AddDate(0, 0, -45)}, policy); got != ReleaseAgeStale {
+		t.Errorf("Expected minor release 45 days old to be stale, got %s", got)
+	}
+
+	// Unset limits fall back to the defaults (minor: 90 days)
+	if got := classifyReleaseAgeAt(Release{TagName: "v1.4.0", PublishedAt: daysAgo(45)}, config.ReleasePolicyConfig{}, now); got != ReleaseAgeCurrent {
+		t.Errorf("Expected default policy to treat 45 days as current, got %s", got)
+	}
+}
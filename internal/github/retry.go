@@ -0,0 +1,147 @@
+package github
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig controls how rate-limited and failed GitHub requests are retried
+type RetryConfig struct {
+	MaxRetries  int           // Retries after the first attempt; 0 disables retrying
+	BackoffBase time.Duration // First 5xx wait, doubled on each retry
+	BackoffMax  time.Duration // Cap on backoff; rate-limit waits beyond this are not retried
+}
+
+// DefaultRetryConfig is used by NewClient unless WithRetry is passed
+var DefaultRetryConfig = RetryConfig{
+	MaxRetries:  3,
+	BackoffBase: time.Second,
+	BackoffMax:  time.Minute,
+}
+
+// WithRetry replaces DefaultRetryConfig for a client
+func WithRetry(cfg RetryConfig) ClientOption {
+	return func(c *Client) {
+		c.retry = cfg
+	}
+}
+
+// retrySleep waits d or until ctx is done; replaced in tests
+var retrySleep = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryTransport retries 429, rate-limited 403, 500, 502, and 503 responses
+// POSTs are only retried on 429, since a 5xx may have been returned after the write happened
+type retryTransport struct {
+	cfg RetryConfig
+	rt  http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.rt.RoundTrip(req)
+		if err != nil || attempt >= t.cfg.MaxRetries || !shouldRetry(req.Method, resp.StatusCode, resp.Header) {
+			return resp, err
+		}
+
+		wait, ok := retryDelay(resp.StatusCode, resp.Header, attempt, t.cfg, time.Now())
+		if !ok || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		slog.Debug("retrying GitHub request", "url", req.URL.String(), "status", resp.StatusCode, "wait", wait, "attempt", attempt+1)
+		if err := retrySleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry reports whether a response status is worth retrying for method
+func shouldRetry(method string, status int, header http.Header) bool {
+	switch status {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		return rateLimitExhausted(status, header)
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return method != http.MethodPost
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before retrying, preferring Retry-After then X-RateLimit-Reset
+// X-RateLimit-Reset is only used when the rate limit is exhausted, since GitHub sends it on every response
+// Without either the wait is BackoffBase doubled per attempt, capped at BackoffMax
+// Returns false when the server asks for a wait longer than BackoffMax
+// Pure function: reads only its arguments
+func retryDelay(status int, header http.Header, attempt int, cfg RetryConfig, now time.Time) (time.Duration, bool) {
+	if wait, ok := headerDelay(status, header, now); ok {
+		return wait, wait <= cfg.BackoffMax
+	}
+
+	wait := cfg.BackoffBase << attempt
+	if wait > cfg.BackoffMax || wait <= 0 {
+		wait = cfg.BackoffMax
+	}
+	return wait, true
+}
+
+// headerDelay parses Retry-After (seconds or HTTP date) or, when rate limited, X-RateLimit-Reset (Unix seconds)
+func headerDelay(status int, header http.Header, now time.Time) (time.Duration, bool) {
+	if value := header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return clampDelay(time.Duration(seconds) * time.Second), true
+		}
+		if at, err := http.ParseTime(value); err == nil {
+			return clampDelay(at.Sub(now)), true
+		}
+	}
+
+	if value := header.Get("X-RateLimit-Reset"); value != "" && rateLimitExhausted(status, header) {
+		if reset, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return clampDelay(time.Unix(reset, 0).Sub(now)), true
+		}
+	}
+
+	return 0, false
+}
+
+// rateLimitExhausted reports whether a 403 or 429 was caused by running out of rate limit
+func rateLimitExhausted(status int, header http.Header) bool {
+	if status != http.StatusForbidden && status != http.StatusTooManyRequests {
+		return false
+	}
+	return header.Get("X-RateLimit-Remaining") == "0"
+}
+
+// clampDelay treats waits in the past as no wait
+func clampDelay(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
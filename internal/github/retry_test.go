@@ -0,0 +1,212 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// stubRetrySleep records retry waits instead of sleeping
+func stubRetrySleep(t *testing.T) *[]time.Duration {
+	t.Helper()
+
+	var waits []time.Duration
+	original := retrySleep
+	retrySleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	t.Cleanup(func() { retrySleep = original })
+
+	return &waits
+}
+
+// newRetryTestClient creates a Client whose requests are answered with statuses in order, then 200
+func newRetryTestClient(t *testing.T, cfg RetryConfig, header http.Header, statuses ...int) (*Client, *[]string) {
+	t.Helper()
+
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if attempt := len(bodies) - 1; attempt < len(statuses) {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(statuses[attempt])
+			return
+		}
+		fmt.Fprint(w, `{"name": "repo"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("GITHUB_API_URL", server.URL)
+
+	client, err := NewClientWithToken(context.Background(), "retry-token", WithETagCache(false), WithRetry(cfg))
+	if err != nil {
+		t.Fatalf("NewClientWithToken failed: %v", err)
+	}
+
+	return client, &bodies
+}
+
+// TestRetryHonoursRetryAfterOn429 tests that rate-limited requests wait for Retry-After and succeed
+func TestRetryHonoursRetryAfterOn429(t *testing.T) {
+	waits := stubRetrySleep(t)
+	header := http.Header{"Retry-After": []string{"2"}}
+	client, requests := newRetryTestClient(t, DefaultRetryConfig, header, http.StatusTooManyRequests, http.StatusTooManyRequests)
+
+	var response map[string]interface{}
+	if err := client.Get("repos/owner/repo", &response); err != nil {
+		t.Fatalf("Get failed after retries: %v", err)
+	}
+
+	if len(*requests) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(*requests))
+	}
+	if want := []time.Duration{2 * time.Second, 2 * time.Second}; fmt.Sprint(*waits) != fmt.Sprint(want) {
+		t.Errorf("Expected waits %v, got %v", want, *waits)
+	}
+}
+
+// TestRetryBacksOffExponentiallyOn5xx tests that server errors are retried with doubling waits
+func TestRetryBacksOffExponentiallyOn5xx(t *testing.T) {
+	waits := stubRetrySleep(t)
+	cfg := RetryConfig{MaxRetries: 3, BackoffBase: 10 * time.Millisecond, BackoffMax: 25 * time.Millisecond}
+	client, requests := newRetryTestClient(t, cfg, nil, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable)
+
+	var response map[string]interface{}
+	if err := client.Get("repos/owner/repo", &response); err != nil {
+		t.Fatalf("Get failed after retries: %v", err)
+	}
+
+	if len(*requests) != 4 {
+		t.Errorf("Expected 4 requests, got %d", len(*requests))
+	}
+	want := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 25 * time.Millisecond}
+	if fmt.Sprint(*waits) != fmt.Sprint(want) {
+		t.Errorf("Expected waits %v, got %v", want, *waits)
+	}
+}
+
+// TestRetryIgnoresRateLimitResetOn5xx tests that a 500 carrying X-RateLimit-Reset still backs off exponentially
+func TestRetryIgnoresRateLimitResetOn5xx(t *testing.T) {
+	waits := stubRetrySleep(t)
+	cfg := RetryConfig{MaxRetries: 2, BackoffBase: 10 * time.Millisecond, BackoffMax: time.Second}
+	header := http.Header{
+		"X-Ratelimit-Remaining": []string{"4999"},
+		"X-Ratelimit-Reset":     []string{strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)},
+	}
+	client, requests := newRetryTestClient(t, cfg, header, http.StatusInternalServerError, http.StatusInternalServerError)
+
+	var response map[string]interface{}
+	if err := client.Get("repos/owner/repo", &response); err != nil {
+		t.Fatalf("Get failed after retries: %v", err)
+	}
+
+	if len(*requests) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(*requests))
+	}
+	want := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond}
+	if fmt.Sprint(*waits) != fmt.Sprint(want) {
+		t.Errorf("Expected waits %v, got %v", want, *waits)
+	}
+}
+
+// TestRetryWaitsForResetOnExhausted403 tests that a 403 with no remaining rate limit waits for X-RateLimit-Reset
+func TestRetryWaitsForResetOnExhausted403(t *testing.T) {
+	waits := stubRetrySleep(t)
+	header := http.Header{
+		"X-Ratelimit-Remaining": []string{"0"},
+		"X-Ratelimit-Reset":     []string{strconv.FormatInt(time.Now().Unix()-1, 10)},
+	}
+	client, requests := newRetryTestClient(t, DefaultRetryConfig, header, http.StatusForbidden)
+
+	var response map[string]interface{}
+	if err := client.Get("repos/owner/repo", &response); err != nil {
+		t.Fatalf("Get failed after retry: %v", err)
+	}
+
+	if len(*requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(*requests))
+	}
+	if want := []time.Duration{0}; fmt.Sprint(*waits) != fmt.Sprint(want) {
+		t.Errorf("Expected waits %v, got %v", want, *waits)
+	}
+}
+
+// TestRetryGivesUpAfterMaxRetries tests that the last error is returned once retries are exhausted
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	stubRetrySleep(t)
+	cfg := RetryConfig{MaxRetries: 2, BackoffBase: time.Millisecond, BackoffMax: time.Millisecond}
+	client, requests := newRetryTestClient(t, cfg, nil, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+
+	var response map[string]interface{}
+	if err := client.Get("repos/owner/repo", &response); err == nil {
+		t.Fatal("Expected an error after exhausting retries")
+	}
+	if len(*requests) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(*requests))
+	}
+}
+
+// TestRetryResendsBody tests that retried writes resend their body, and POSTs are not retried on 5xx
+func TestRetryResendsBody(t *testing.T) {
+	stubRetrySleep(t)
+	cfg := RetryConfig{MaxRetries: 1, BackoffBase: time.Millisecond, BackoffMax: time.Millisecond}
+
+	client, requests := newRetryTestClient(t, cfg, nil, http.StatusServiceUnavailable)
+	if err := client.Patch("repos/owner/repo", map[string]bool{"archived": true}, nil); err != nil {
+		t.Fatalf("Patch failed after retry: %v", err)
+	}
+	if len(*requests) != 2 || (*requests)[1] != `{"archived":true}` {
+		t.Errorf("Expected the PATCH body to be resent, got %q", *requests)
+	}
+
+	client, requests = newRetryTestClient(t, cfg, nil, http.StatusServiceUnavailable)
+	if err := client.Post("repos/owner/repo/issues", map[string]string{"title": "x"}, nil); err == nil {
+		t.Error("Expected the POST 503 to be returned without retrying")
+	}
+	if len(*requests) != 1 {
+		t.Errorf("Expected 1 POST request, got %d", len(*requests))
+	}
+}
+
+// TestRetryDelay tests header parsing and the BackoffMax cutoff
+func TestRetryDelay(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	cfg := RetryConfig{MaxRetries: 3, BackoffBase: time.Second, BackoffMax: time.Minute}
+
+	tests := []struct {
+		name     string
+		status   int
+		header   http.Header
+		attempt  int
+		wantWait time.Duration
+		wantOK   bool
+	}{
+		{name: "backoff", attempt: 2, wantWait: 4 * time.Second, wantOK: true},
+		{name: "backoff capped", attempt: 10, wantWait: time.Minute, wantOK: true},
+		{name: "retry after seconds", header: http.Header{"Retry-After": []string{"30"}}, wantWait: 30 * time.Second, wantOK: true},
+		{name: "retry after date", header: http.Header{"Retry-After": []string{now.Add(5 * time.Second).UTC().Format(http.TimeFormat)}}, wantWait: 5 * time.Second, wantOK: true},
+		{name: "rate limit reset", status: http.StatusTooManyRequests, header: http.Header{"X-Ratelimit-Remaining": []string{"0"}, "X-Ratelimit-Reset": []string{strconv.FormatInt(now.Unix()+10, 10)}}, wantWait: 10 * time.Second, wantOK: true},
+		{name: "rate limit reset passed", status: http.StatusForbidden, header: http.Header{"X-Ratelimit-Remaining": []string{"0"}, "X-Ratelimit-Reset": []string{strconv.FormatInt(now.Unix()-10, 10)}}, wantWait: 0, wantOK: true},
+		{name: "rate limit reset on 5xx", status: http.StatusBadGateway, attempt: 1, header: http.Header{"X-Ratelimit-Remaining": []string{"0"}, "X-Ratelimit-Reset": []string{strconv.FormatInt(now.Unix()+3600, 10)}}, wantWait: 2 * time.Second, wantOK: true},
+		{name: "rate limit not exhausted", status: http.StatusTooManyRequests, header: http.Header{"X-Ratelimit-Remaining": []string{"12"}, "X-Ratelimit-Reset": []string{strconv.FormatInt(now.Unix()+3600, 10)}}, wantWait: time.Second, wantOK: true},
+		{name: "wait beyond max", header: http.Header{"Retry-After": []string{"3600"}}, wantWait: time.Hour, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, ok := retryDelay(tt.status, tt.header, tt.attempt, cfg, now)
+			if wait != tt.wantWait || ok != tt.wantOK {
+				t.Errorf("retryDelay() = (%v, %v), want (%v, %v)", wait, ok, tt.wantWait, tt.wantOK)
+			}
+		})
+	}
+}
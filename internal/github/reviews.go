@@ -0,0 +1,114 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// PRReview represents a submitted pull request review
+type PRReview struct {
+	ID          int
+	User        string
+	State       string // APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED, PENDING
+	SubmittedAt time.Time
+}
+
+type prReviewResponse struct {
+	ID   int `json:"id"`
+	User struct {
+		Login string `json:"login"`
+	} `json:"user"`
+	State       string    `json:"state"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// ReviewCoverageReport summarizes how many pull requests received reviews
+type ReviewCoverageReport struct {
+	TotalPRs            int
+	ReviewedPRs         int
+	UnreviewedPRs       int
+	CoverageRate        float64 // Percentage of PRs with at least MinReviewers reviewers
+	UnreviewedPRNumbers []int
+	MinReviewers        int
+}
+
+// ListPRReviews lists reviews submitted on a pull request
+func (c *Client) ListPRReviews(owner, repo string, number int) ([]PRReview, error) {
+	var allReviews []PRReview
+	page := 1
+	perPage := 100
+
+	for {
+		var response []prReviewResponse
+		path := fmt.Sprintf("repos/%s/%s/pulls/%d/reviews?per_page=%d&page=%d", owner, repo, number, perPage, page)
+
+		if err := c.Get(path, &response); err != nil {
+			return nil, fmt.Errorf("failed to list reviews: %w", err)
+		}
+
+		for _, r := range response {
+			allReviews = append(allReviews, PRReview{
+				ID:          r.ID,
+				User:        r.User.Login,
+				State:       r.State,
+				SubmittedAt: r.SubmittedAt,
+			})
+		}
+
+		if len(response) < perPage {
+			break
+		}
+		page++
+	}
+
+	return allReviews, nil
+}
+
+// ComputeReviewCoverage reports PRs (reviews keyed by PR number) with at least one reviewer
+// Pure function: no API calls
+func ComputeReviewCoverage(prs []PullRequest, reviews map[int][]PRReview) ReviewCoverageReport {
+	return ComputeReviewCoverageWithMin(prs, reviews, 1)
+}
+
+// ComputeReviewCoverageWithMin reports PRs reviewed by at least minReviewers distinct users
+// Pending reviews are ignored since they have not been submitted
+// Pure function: no API calls
+func ComputeReviewCoverageWithMin(prs []PullRequest, reviews map[int][]PRReview, minReviewers int) ReviewCoverageReport {
+	if minReviewers < 1 {
+		minReviewers = 1
+	}
+
+	report := ReviewCoverageReport{
+		TotalPRs:            len(prs),
+		UnreviewedPRNumbers: []int{},
+		MinReviewers:        minReviewers,
+	}
+
+	for _, pr := range prs {
+		if countReviewers(reviews[pr.Number]) >= minReviewers {
+			report.ReviewedPRs++
+		} else {
+			report.UnreviewedPRNumbers = append(report.UnreviewedPRNumbers, pr.Number)
+		}
+	}
+
+	report.UnreviewedPRs = len(report.UnreviewedPRNumbers)
+	if report.TotalPRs > 0 {
+		report.CoverageRate = float64(report.ReviewedPRs) / float64(report.TotalPRs) * 100
+	}
+	sort.Ints(report.UnreviewedPRNumbers)
+
+	return report
+}
+
+func countReviewers(reviews []PRReview) int {
+	reviewers := make(map[string]bool)
+	for _, r := range reviews {
+		if r.State == "PENDING" {
+			continue
+		}
+		reviewers[r.User] = true
+	}
+	return len(reviewers)
+}
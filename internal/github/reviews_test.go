@@ -0,0 +1,80 @@
+package github
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// TestComputeReviewCoverage tests coverage at different reviewer minimums
+func TestComputeReviewCoverage(t *testing.T) {
+	prs := []PullRequest{{Number: 1}, {Number: 2}, {Number: 3}}
+	reviews := map[int][]PRReview{
+		2: {{User: "alice", State: "APPROVED"}},
+		3: {
+			{User: "alice", State: "COMMENTED"},
+			{User: "bob", State: "APPROVED"},
+			{User: "alice", State: "APPROVED"},
+			{User: "carol", State: "PENDING"},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		minReviewers       int
+		expectedReviewed   int
+		expectedUnreviewed []int
+		expectedRate       float64
+	}{
+		{"min 1", 1, 2, []int{1}, 200.0 / 3},
+		{"min 2", 2, 1, []int{1, 2}, 100.0 / 3},
+		{"min 3 ignores pending", 3, 0, []int{1, 2, 3}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := ComputeReviewCoverageWithMin(prs, reviews, tt.minReviewers)
+
+			if report.TotalPRs != 3 {
+				t.Errorf("Expected 3 total PRs, got %d", report.TotalPRs)
+			}
+			if report.ReviewedPRs != tt.expectedReviewed {
+				t.Errorf("Expected %d reviewed, got %d", tt.expectedReviewed, report.ReviewedPRs)
+			}
+			if report.UnreviewedPRs != len(tt.expectedUnreviewed) {
+				t.Errorf("Expected %d unreviewed, got %d", len(tt.expectedUnreviewed), report.UnreviewedPRs)
+			}
+			if !reflect.DeepEqual(report.UnreviewedPRNumbers, tt.expectedUnreviewed) {
+				t.Errorf("Expected unreviewed %v, got %v", tt.expectedUnreviewed, report.UnreviewedPRNumbers)
+			}
+			if report.CoverageRate < tt.expectedRate-0.01 || report.CoverageRate > tt.expectedRate+0.01 {
+				t.Errorf("Expected coverage %.2f%%, got %.2f%%", tt.expectedRate, report.CoverageRate)
+			}
+		})
+	}
+
+	if got := ComputeReviewCoverage(prs, reviews); got.ReviewedPRs != 2 {
+		t.Errorf("Expected default minimum of 1 reviewer, got %d reviewed", got.ReviewedPRs)
+	}
+}
+
+// TestListPRReviews tests review listing against a mocked API
+func TestListPRReviews(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/pulls/7/reviews", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"id": 1, "user": {"login": "alice"}, "state": "APPROVED", "submitted_at": "2024-01-01T00:00:00Z"}
+		]`))
+	})
+
+	client := newTestClient(t, mux)
+
+	reviews, err := client.ListPRReviews("owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("ListPRReviews failed: %v", err)
+	}
+
+	if len(reviews) != 1 || reviews[0].User != "alice" || reviews[0].State != "APPROVED" {
+		t.Errorf("Unexpected reviews: %+v", reviews)
+	}
+}
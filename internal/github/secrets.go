@@ -3,6 +3,8 @@ package github
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 )
 
 // Secret represents a GitHub Actions secret
@@ -77,9 +79,16 @@ type SecretUsage struct {
 }
 
 // DetectUnusedSecrets compares secrets against workflow references
+// Names are matched case-insensitively, as GitHub upper-cases secret names
 func DetectUnusedSecrets(secrets []Secret, workflowRefs map[string][]string) []SecretUsage {
 	usages := []SecretUsage{}
 
+	normalized := make(map[string][]string, len(workflowRefs))
+	for name, refs := range workflowRefs {
+		key := strings.ToUpper(name)
+		normalized[key] = append(normalized[key], refs...)
+	}
+
 	for _, secret := range secrets {
 		usage := SecretUsage{
 			Name:       secret.Name,
@@ -88,7 +97,7 @@ func DetectUnusedSecrets(secrets []Secret, workflowRefs map[string][]string) []S
 		}
 
 		// Check if secret is referenced
-		if refs, ok := workflowRefs[secret.Name]; ok {
+		if refs, ok := normalized[strings.ToUpper(secret.Name)]; ok {
 			usage.ReferencedIn = refs
 			usage.Unused = false
 		} else {
@@ -101,17 +110,44 @@ func DetectUnusedSecrets(secrets []Secret, workflowRefs map[string][]string) []S
 	return usages
 }
 
+// DefaultSecretNameRegex matches secret names in upper, lower, or mixed case with hyphens
+const DefaultSecretNameRegex = `[A-Za-z0-9_-]+`
+
+var defaultSecretPattern = regexp.MustCompile(secretReferenceExpr(DefaultSecretNameRegex))
+
+func secretReferenceExpr(nameRegex string) string {
+	return `\${{\s*secrets\.(` + nameRegex + `)\s*}}`
+}
+
+// SecretReferencePattern builds a ${{ secrets.NAME }} pattern whose first capture group matches nameRegex
+// An empty nameRegex uses DefaultSecretNameRegex
+func SecretReferencePattern(nameRegex string) (*regexp.Regexp, error) {
+	if nameRegex == "" {
+		return defaultSecretPattern, nil
+	}
+
+	pattern, err := regexp.Compile(secretReferenceExpr(nameRegex))
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret naming regex %q: %w", nameRegex, err)
+	}
+	return pattern, nil
+}
+
 // ScanWorkflowForSecrets extracts secret references from workflow YAML
 // Pure function: parses YAML content for secrets.* references
 func ScanWorkflowForSecrets(workflowContent string) []string {
-	// Match ${{ secrets.SECRET_NAME }} pattern (with optional spaces)
-	pattern := regexp.MustCompile(`\${{\s*secrets\.([A-Z0-9_]+)\s*}}`)
+	return ScanWorkflowForSecretsWithOptions(workflowContent, defaultSecretPattern)
+}
+
+// ScanWorkflowForSecretsWithOptions extracts secret names captured by the first group of pattern
+// Pure function: for orgs whose secret naming needs a custom pattern
+func ScanWorkflowForSecretsWithOptions(workflowContent string, pattern *regexp.Regexp) []string {
 	matches := pattern.FindAllStringSubmatch(workflowContent, -1)
 
 	// Deduplicate secret names
 	secretSet := make(map[string]bool)
 	for _, match := range matches {
-		if len(match) > 1 {
+		if len(match) > 1 && match[1] != "" {
 			secretSet[match[1]] = true
 		}
 	}
@@ -185,3 +221,127 @@ func FindDuplicateSecrets(secrets []Secret) []DuplicateSecret {
 
 	return duplicates
 }
+
+// SecretGraph maps a secret name to the repositories exposed to it
+type SecretGraph map[string][]string
+
+// SecretNode summarizes the blast radius of a single secret
+type SecretNode struct {
+	SecretName string
+	RepoCount  int
+}
+
+// BuildSecretDependencyGraph maps each secret name to the repos that can use it
+// Pure function: org secrets are treated as visible to every scanned repo
+func BuildSecretDependencyGraph(orgSecrets []Secret, repoSecrets map[string][]Secret) SecretGraph {
+	repoSets := make(map[string]map[string]bool)
+	addRepo := func(name, repo string) {
+		if repoSets[name] == nil {
+			repoSets[name] = make(map[string]bool)
+		}
+		if repo != "" {
+			repoSets[name][repo] = true
+		}
+	}
+
+	for _, secret := range orgSecrets {
+		addRepo(secret.Name, "")
+		for repo := range repoSecrets {
+			addRepo(secret.Name, repo)
+		}
+	}
+
+	for repo, secrets := range repoSecrets {
+		for _, secret := range secrets {
+			addRepo(secret.Name, repo)
+		}
+	}
+
+	graph := make(SecretGraph, len(repoSets))
+	for name, set := range repoSets {
+		repos := make([]string, 0, len(set))
+		for repo := range set {
+			repos = append(repos, repo)
+		}
+		sort.Strings(repos)
+		graph[name] = repos
+	}
+
+	return graph
+}
+
+// TopSecretsByRepoCount returns the n secrets that reach the most repos
+// Pure function: ties are broken by secret name; n <= 0 returns all secrets
+func TopSecretsByRepoCount(graph SecretGraph, n int) []SecretNode {
+	nodes := make([]SecretNode, 0, len(graph))
+	for name, repos := range graph {
+		nodes = append(nodes, SecretNode{SecretName: name, RepoCount: len(repos)})
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].RepoCount != nodes[j].RepoCount {
+			return nodes[i].RepoCount > nodes[j].RepoCount
+		}
+		return nodes[i].SecretName < nodes[j].SecretName
+	})
+
+	if n > 0 && n < len(nodes) {
+		nodes = nodes[:n]
+	}
+
+	return nodes
+}
+
+// SecretAccess describes how one repository can use a secret
+type SecretAccess struct {
+	InWorkflow    bool
+	WorkflowFiles []string // Sorted workflow paths referencing the secret
+	Scope         string   // "org" or "repo"; a repo secret shadows an org secret of the same name
+}
+
+// SecretMatrix maps secret name -> repository -> access, for repos that can use the secret
+type SecretMatrix map[string]map[string]SecretAccess
+
+// BuildOrgSecretMatrix combines secret visibility with workflow references into a secret x repo matrix
+// Pure function: workflowRefs maps repo -> secret name -> workflow files; org secrets reach every
+// repo in repoSecrets or workflowRefs, and references to unknown secrets are ignored
+func BuildOrgSecretMatrix(orgSecrets []Secret, repoSecrets map[string][]Secret, workflowRefs map[string]map[string][]string) SecretMatrix {
+	repoSet := make(map[string]bool)
+	for repo := range repoSecrets {
+		repoSet[repo] = true
+	}
+	for repo := range workflowRefs {
+		repoSet[repo] = true
+	}
+
+	matrix := make(SecretMatrix)
+	set := func(name, repo, scope string) {
+		if matrix[name] == nil {
+			matrix[name] = make(map[string]SecretAccess)
+		}
+		files := append([]string(nil), workflowRefs[repo][name]...)
+		sort.Strings(files)
+		matrix[name][repo] = SecretAccess{
+			InWorkflow:    len(files) > 0,
+			WorkflowFiles: files,
+			Scope:         scope,
+		}
+	}
+
+	for _, secret := range orgSecrets {
+		if matrix[secret.Name] == nil {
+			matrix[secret.Name] = make(map[string]SecretAccess)
+		}
+		for repo := range repoSet {
+			set(secret.Name, repo, "org")
+		}
+	}
+
+	for repo, secrets := range repoSecrets {
+		for _, secret := range secrets {
+			set(secret.Name, repo, "repo")
+		}
+	}
+
+	return matrix
+}
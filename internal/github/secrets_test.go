@@ -1,6 +1,9 @@
 package github
 
 import (
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -190,3 +193,162 @@ func TestFindDuplicateSecrets(t *testing.T) {
 		t.Errorf("Expected API_KEY to appear 3 times, got %d", duplicates[0].Count)
 	}
 }
+
+// TestBuildSecretDependencyGraph tests mapping secrets to the repos they reach
+func TestBuildSecretDependencyGraph(t *testing.T) {
+	orgSecrets := []Secret{{Name: "ORG_TOKEN", Scope: "org"}}
+	repoSecrets := map[string][]Secret{
+		"owner/repo1": {{Name: "API_KEY", Scope: "repo", Repository: "owner/repo1"}},
+		"owner/repo2": {
+			{Name: "API_KEY", Scope: "repo", Repository: "owner/repo2"},
+			{Name: "DEPLOY_KEY", Scope: "repo", Repository: "owner/repo2"},
+		},
+		"owner/repo3": {},
+	}
+
+	graph := BuildSecretDependencyGraph(orgSecrets, repoSecrets)
+
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{"ORG_TOKEN", []string{"owner/repo1", "owner/repo2", "owner/repo3"}},
+		{"API_KEY", []string{"owner/repo1", "owner/repo2"}},
+		{"DEPLOY_KEY", []string{"owner/repo2"}},
+	}
+
+	for _, tt := range tests {
+		repos := graph[tt.name]
+		if strings.Join(repos, ",") != strings.Join(tt.expected, ",") {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, repos)
+		}
+	}
+}
+
+// TestTopSecretsByRepoCount tests top-N ordering by blast radius
+func TestTopSecretsByRepoCount(t *testing.T) {
+	graph := SecretGraph{
+		"SMALL":  {"a/1"},
+		"LARGE":  {"a/1", "a/2", "a/3"},
+		"MEDIUM": {"a/1", "a/2"},
+		"ALPHA":  {"a/1", "a/2"},
+	}
+
+	top := TopSecretsByRepoCount(graph, 3)
+
+	expected := []SecretNode{
+		{SecretName: "LARGE", RepoCount: 3},
+		{SecretName: "ALPHA", RepoCount: 2},
+		{SecretName: "MEDIUM", RepoCount: 2},
+	}
+
+	if len(top) != len(expected) {
+		t.Fatalf("Expected %d nodes, got %d", len(expected), len(top))
+	}
+
+	for i, node := range expected {
+		if top[i] != node {
+			t.Errorf("Position %d: expected %+v, got %+v", i, node, top[i])
+		}
+	}
+
+	if all := TopSecretsByRepoCount(graph, 0); len(all) != 4 {
+		t.Errorf("Expected all 4 nodes for n=0, got %d", len(all))
+	}
+}
+
+// TestScanWorkflowForSecretsNamingStyles tests lowercase, hyphenated, and mixed-case secret names
+func TestScanWorkflowForSecretsNamingStyles(t *testing.T) {
+	workflowYAML := `
+steps:
+  - run: deploy
+    env:
+      A: ${{ secrets.my_token }}
+      B: ${{ secrets.my-api-key }}
+      C: ${{secrets.DeployKey}}
+      D: ${{ secrets.LEGACY_TOKEN }}
+`
+
+	refs := ScanWorkflowForSecrets(workflowYAML)
+	sort.Strings(refs)
+
+	expected := []string{"DeployKey", "LEGACY_TOKEN", "my-api-key", "my_token"}
+	if !reflect.DeepEqual(refs, expected) {
+		t.Errorf("Expected %v, got %v", expected, refs)
+	}
+}
+
+// TestScanWorkflowForSecretsWithOptions tests extraction with a custom naming pattern
+func TestScanWorkflowForSecretsWithOptions(t *testing.T) {
+	workflowYAML := `
+env:
+  A: ${{ secrets.team.deploy.key }}
+  B: ${{ secrets.OTHER }}
+`
+
+	pattern, err := SecretReferencePattern(`[a-z]+(?:\.[a-z]+)*`)
+	if err != nil {
+		t.Fatalf("SecretReferencePattern failed: %v", err)
+	}
+
+	refs := ScanWorkflowForSecretsWithOptions(workflowYAML, pattern)
+	if len(refs) != 1 || refs[0] != "team.deploy.key" {
+		t.Errorf("Expected only team.deploy.key, got %v", refs)
+	}
+
+	if _, err := SecretReferencePattern("(unclosed"); err == nil {
+		t.Error("Expected error for invalid naming regex")
+	}
+}
+
+func secretMatrixFixture() ([]Secret, map[string][]Secret, map[string]map[string][]string) {
+	orgSecrets := []Secret{{Name: "ORG_TOKEN", Scope: "org"}}
+	repoSecrets := map[string][]Secret{
+		"acme/api":  {{Name: "DEPLOY_KEY", Scope: "repo", Repository: "acme/api"}},
+		"acme/web":  {{Name: "DEPLOY_KEY", Scope: "repo", Repository: "acme/web"}},
+		"acme/cli":  {{Name: "NPM_TOKEN", Scope: "repo", Repository: "acme/cli"}},
+		"acme/docs": {},
+		"acme/sdk":  {{Name: "ORG_TOKEN", Scope: "repo", Repository: "acme/sdk"}},
+	}
+	workflowRefs := map[string]map[string][]string{
+		"acme/api": {
+			"ORG_TOKEN":  {"release.yml", "ci.yml"},
+			"DEPLOY_KEY": {"deploy.yml"},
+		},
+		"acme/cli":  {"NPM_TOKEN": {"publish.yml"}},
+		"acme/docs": {"UNKNOWN": {"ci.yml"}},
+	}
+	return orgSecrets, repoSecrets, workflowRefs
+}
+
+// TestBuildOrgSecretMatrix tests scope, workflow usage, and which secret/repo pairs are present
+func TestBuildOrgSecretMatrix(t *testing.T) {
+	matrix := BuildOrgSecretMatrix(secretMatrixFixture())
+
+	if len(matrix) != 3 {
+		t.Fatalf("Expected 3 secrets, got %d: %v", len(matrix), matrix)
+	}
+	if len(matrix["ORG_TOKEN"]) != 5 || len(matrix["DEPLOY_KEY"]) != 2 || len(matrix["NPM_TOKEN"]) != 1 {
+		t.Errorf("Unexpected repo coverage: %v", matrix)
+	}
+
+	orgInAPI := matrix["ORG_TOKEN"]["acme/api"]
+	if !orgInAPI.InWorkflow || orgInAPI.Scope != "org" || !reflect.DeepEqual(orgInAPI.WorkflowFiles, []string{"ci.yml", "release.yml"}) {
+		t.Errorf("Expected org-scoped ORG_TOKEN used by 2 sorted workflows in api, got %+v", orgInAPI)
+	}
+
+	if access := matrix["ORG_TOKEN"]["acme/sdk"]; access.Scope != "repo" || access.InWorkflow {
+		t.Errorf("Expected repo secret to shadow the org secret without workflow use, got %+v", access)
+	}
+
+	if access := matrix["DEPLOY_KEY"]["acme/web"]; access.InWorkflow || len(access.WorkflowFiles) != 0 {
+		t.Errorf("Expected DEPLOY_KEY unused in web, got %+v", access)
+	}
+
+	if _, ok := matrix["NPM_TOKEN"]["acme/api"]; ok {
+		t.Error("Expected NPM_TOKEN to be absent from api")
+	}
+	if _, ok := matrix["UNKNOWN"]; ok {
+		t.Error("Expected references to unknown secrets to be ignored")
+	}
+}
@@ -0,0 +1,95 @@
+package github
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultMinSecurityPolicyLength is the shortest policy not flagged as a placeholder
+const DefaultMinSecurityPolicyLength = 100
+
+// securityPolicyPaths lists the locations GitHub recognizes for a security policy
+var securityPolicyPaths = []string{
+	"SECURITY.md",
+	".github/SECURITY.md",
+}
+
+type contentsResponse struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+// SecurityPolicyReport summarizes security policy coverage across repositories
+type SecurityPolicyReport struct {
+	MinLength int
+	Missing   []string // No policy file or an empty one
+	TooShort  []string // Policy shorter than MinLength
+	Compliant []string
+}
+
+// GetSecurityPolicy fetches the repository's SECURITY.md contents
+// Returns an empty string if no policy file exists
+func (c *Client) GetSecurityPolicy(owner, repo string) (string, error) {
+	for _, policyPath := range securityPolicyPaths {
+		var response contentsResponse
+		path := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, policyPath)
+
+		if err := c.Get(path, &response); err != nil {
+			if strings.Contains(err.Error(), "404") {
+				continue
+			}
+			return "", fmt.Errorf("failed to get security policy: %w", err)
+		}
+
+		if response.Encoding != "base64" {
+			return response.Content, nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(response.Content)
+		if err != nil {
+			return "", fmt.Errorf("failed to decode security policy: %w", err)
+		}
+
+		return string(decoded), nil
+	}
+
+	return "", nil
+}
+
+// AuditSecurityPolicy checks policy contents (repo -> policy) against the default minimum length
+// Pure function: no API calls
+func AuditSecurityPolicy(repos map[string]string) SecurityPolicyReport {
+	return AuditSecurityPolicyWithMinLength(repos, DefaultMinSecurityPolicyLength)
+}
+
+// AuditSecurityPolicyWithMinLength checks policy contents (repo -> policy) against minLength
+// Pure function: no API calls
+func AuditSecurityPolicyWithMinLength(repos map[string]string, minLength int) SecurityPolicyReport {
+	report := SecurityPolicyReport{
+		MinLength: minLength,
+		Missing:   []string{},
+		TooShort:  []string{},
+		Compliant: []string{},
+	}
+
+	for repo, policy := range repos {
+		length := len(strings.TrimSpace(policy))
+
+		switch {
+		case length == 0:
+			report.Missing = append(report.Missing, repo)
+		case length < minLength:
+			report.TooShort = append(report.TooShort, repo)
+		default:
+			report.Compliant = append(report.Compliant, repo)
+		}
+	}
+
+	sort.Strings(report.Missing)
+	sort.Strings(report.TooShort)
+	sort.Strings(report.Compliant)
+
+	return report
+}
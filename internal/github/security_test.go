@@ -0,0 +1,79 @@
+package github
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestGetSecurityPolicy tests policy lookup in the root and .github locations
+func TestGetSecurityPolicy(t *testing.T) {
+	policy := "# Security Policy\n\nReport vulnerabilities to security@example.com"
+	encoded := base64.StdEncoding.EncodeToString([]byte(policy))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/me/root/contents/SECURITY.md", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"encoding": "base64", "content": "` + encoded + `"}`))
+	})
+	mux.HandleFunc("/repos/me/dotgithub/contents/.github/SECURITY.md", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"encoding": "base64", "content": "` + encoded + `"}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	tests := []struct {
+		repo     string
+		expected string
+	}{
+		{"root", policy},
+		{"dotgithub", policy},
+		{"none", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.repo, func(t *testing.T) {
+			got, err := client.GetSecurityPolicy("me", tt.repo)
+			if err != nil {
+				t.Fatalf("GetSecurityPolicy failed: %v", err)
+			}
+
+			if got != tt.expected {
+				t.Errorf("Expected policy %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestAuditSecurityPolicy tests separating missing from too-short policies
+func TestAuditSecurityPolicy(t *testing.T) {
+	repos := map[string]string{
+		"org/missing":  "",
+		"org/blank":    "  \n\n ",
+		"org/short":    "# Security\nTODO",
+		"org/complete": strings.Repeat("Please report vulnerabilities privately. ", 5),
+	}
+
+	report := AuditSecurityPolicy(repos)
+
+	if report.MinLength != DefaultMinSecurityPolicyLength {
+		t.Errorf("Expected min length %d, got %d", DefaultMinSecurityPolicyLength, report.MinLength)
+	}
+
+	if len(report.Missing) != 2 || report.Missing[0] != "org/blank" || report.Missing[1] != "org/missing" {
+		t.Errorf("Expected missing [org/blank org/missing], got %v", report.Missing)
+	}
+
+	if len(report.TooShort) != 1 || report.TooShort[0] != "org/short" {
+		t.Errorf("Expected too short [org/short], got %v", report.TooShort)
+	}
+
+	if len(report.Compliant) != 1 || report.Compliant[0] != "org/complete" {
+		t.Errorf("Expected compliant [org/complete], got %v", report.Compliant)
+	}
+
+	strict := AuditSecurityPolicyWithMinLength(repos, 1000)
+	if len(strict.TooShort) != 2 {
+		t.Errorf("Expected 2 too-short policies with strict minimum, got %d", len(strict.TooShort))
+	}
+}
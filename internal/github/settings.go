@@ -1,6 +1,14 @@
 package github
 
-import "fmt"
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
 
 // RepoSettings represents repository settings
 type RepoSettings struct {
@@ -49,6 +57,69 @@ func (c *Client) GetRepoSettings(owner, repo string) (*RepoSettings, error) {
 	}, nil
 }
 
+// SettingsPatchOptions controls which fields SettingsPatch may change
+type SettingsPatchOptions struct {
+	// IncludeDefaultBranch opts in to copying default_branch, which fails with 422
+	// when the target lacks the branch and retargets its open pull requests otherwise
+	IncludeDefaultBranch bool
+}
+
+// SettingsPatch returns the PATCH /repos/{owner}/{repo} fields where desired differs from current
+// Pure function: only fields CompareSettings reports on are included; empty when nothing differs
+func SettingsPatch(current, desired *RepoSettings, opts SettingsPatchOptions) map[string]interface{} {
+	patch := make(map[string]interface{})
+
+	if opts.IncludeDefaultBranch && desired.DefaultBranch != "" && desired.DefaultBranch != current.DefaultBranch {
+		patch["default_branch"] = desired.DefaultBranch
+	}
+	if desired.DeleteBranchOnMerge != current.DeleteBranchOnMerge {
+		patch["delete_branch_on_merge"] = desired.DeleteBranchOnMerge
+	}
+	if desired.AllowMergeCommit != current.AllowMergeCommit {
+		patch["allow_merge_commit"] = desired.AllowMergeCommit
+	}
+	if desired.AllowSquashMerge != current.AllowSquashMerge {
+		patch["allow_squash_merge"] = desired.AllowSquashMerge
+	}
+	if desired.AllowRebaseMerge != current.AllowRebaseMerge {
+		patch["allow_rebase_merge"] = desired.AllowRebaseMerge
+	}
+
+	return patch
+}
+
+// ApplyRepoSettings patches a repository from current to desired, sending only the fields that differ
+// Returns the fields sent; no request is made when the settings already match
+func (c *Client) ApplyRepoSettings(owner, repo string, current, desired *RepoSettings, opts SettingsPatchOptions) (map[string]interface{}, error) {
+	if current == nil || desired == nil {
+		return nil, fmt.Errorf("failed to apply repo settings: current and desired settings are required")
+	}
+
+	patch := SettingsPatch(current, desired, opts)
+	if len(patch) == 0 {
+		return patch, nil
+	}
+
+	path := fmt.Sprintf("repos/%s/%s", owner, repo)
+	if err := c.Patch(path, patch, nil); err != nil {
+		return nil, fmt.Errorf("failed to update repo settings: %w", err)
+	}
+
+	return patch, nil
+}
+
+// UpdateRepoSettings makes a repository's compared settings match settings, e.g. a baseline's
+// The current settings are fetched first so only changed fields are sent; the default branch is left alone
+func (c *Client) UpdateRepoSettings(owner, repo string, settings *RepoSettings) error {
+	current, err := c.GetRepoSettings(owner, repo)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.ApplyRepoSettings(owner, repo, current, settings, SettingsPatchOptions{})
+	return err
+}
+
 // SettingsDiff represents differences between repository settings
 type SettingsDiff struct {
 	Field    string
@@ -57,8 +128,82 @@ type SettingsDiff struct {
 	Severity string // critical, warning, info
 }
 
-// CompareSettings compares repository settings against a baseline
+// SettingsPolicy maps SettingsDiff field names to the severity reported for drift
+type SettingsPolicy map[string]string
+
+// SettingsSeverities are the severities a SettingsPolicy may assign
+var SettingsSeverities = []string{"critical", "warning", "info"}
+
+// settingsPolicyFields are the SettingsDiff field names CompareSettings can report
+var settingsPolicyFields = []string{"DefaultBranch", "DeleteBranchOnMerge", "MergeStrategies"}
+
+//go:embed settings_policy.yaml
+var defaultSettingsPolicyYAML []byte
+
+// DefaultSettingsPolicy returns the severities used by CompareSettings
+func DefaultSettingsPolicy() SettingsPolicy {
+	policy, err := parseSettingsPolicy(defaultSettingsPolicyYAML)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded settings policy: %v", err))
+	}
+	return policy
+}
+
+// LoadSettingsPolicy reads severity overrides from a YAML file of field: severity pairs
+// Fields the file omits keep their default severity
+func LoadSettingsPolicy(path string) (SettingsPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings policy: %w", err)
+	}
+
+	overrides, err := parseSettingsPolicy(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid settings policy %s: %w", path, err)
+	}
+
+	policy := DefaultSettingsPolicy()
+	for field, severity := range overrides {
+		policy[field] = severity
+	}
+
+	return policy, nil
+}
+
+// parseSettingsPolicy decodes and validates a policy document
+func parseSettingsPolicy(data []byte) (SettingsPolicy, error) {
+	policy := SettingsPolicy{}
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	for field, severity := range policy {
+		if !contains(settingsPolicyFields, field) {
+			return nil, fmt.Errorf("unknown field %q (use DefaultBranch, DeleteBranchOnMerge, or MergeStrategies)", field)
+		}
+		if !contains(SettingsSeverities, severity) {
+			return nil, fmt.Errorf("unknown severity %q for %s (use critical, warning, or info)", severity, field)
+		}
+	}
+
+	return policy, nil
+}
+
+// severity returns the policy severity for field, defaulting to info
+func (p SettingsPolicy) severity(field string) string {
+	if severity, ok := p[field]; ok {
+		return severity
+	}
+	return "info"
+}
+
+// CompareSettings compares repository settings against a baseline using the default policy
 func CompareSettings(baseline, current *RepoSettings) []SettingsDiff {
+	return CompareSettingsWithPolicy(baseline, current, DefaultSettingsPolicy())
+}
+
+// CompareSettingsWithPolicy compares repository settings against a baseline, taking severities from policy
+func CompareSettingsWithPolicy(baseline, current *RepoSettings, policy SettingsPolicy) []SettingsDiff {
 	diffs := []SettingsDiff{}
 
 	if baseline.DefaultBranch != current.DefaultBranch {
@@ -66,7 +211,7 @@ func CompareSettings(baseline, current *RepoSettings) []SettingsDiff {
 			Field:    "DefaultBranch",
 			Baseline: baseline.DefaultBranch,
 			Current:  current.DefaultBranch,
-			Severity: "warning",
+			Severity: policy.severity("DefaultBranch"),
 		})
 	}
 
@@ -75,7 +220,7 @@ func CompareSettings(baseline, current *RepoSettings) []SettingsDiff {
 			Field:    "DeleteBranchOnMerge",
 			Baseline: baseline.DeleteBranchOnMerge,
 			Current:  current.DeleteBranchOnMerge,
-			Severity: "info",
+			Severity: policy.severity("DeleteBranchOnMerge"),
 		})
 	}
 
@@ -86,9 +231,176 @@ func CompareSettings(baseline, current *RepoSettings) []SettingsDiff {
 			Field:    "MergeStrategies",
 			Baseline: fmt.Sprintf("merge:%v squash:%v rebase:%v", baseline.AllowMergeCommit, baseline.AllowSquashMerge, baseline.AllowRebaseMerge),
 			Current:  fmt.Sprintf("merge:%v squash:%v rebase:%v", current.AllowMergeCommit, current.AllowSquashMerge, current.AllowRebaseMerge),
-			Severity: "info",
+			Severity: policy.severity("MergeStrategies"),
 		})
 	}
 
 	return diffs
 }
+
+// SelectBaselineRepo picks the repository whose settings agree with the most
+// other repositories on DefaultBranch, DeleteBranchOnMerge, and MergeStrategies.
+// Pure function: ties are broken by repository name for deterministic output.
+func SelectBaselineRepo(settings map[string]*RepoSettings) (string, error) {
+	if len(settings) == 0 {
+		return "", fmt.Errorf("no repository settings to select a baseline from")
+	}
+
+	votes := make(map[string]int)
+	for _, s := range settings {
+		if s == nil {
+			continue
+		}
+		for _, key := range baselineVoteKeys(s) {
+			votes[key]++
+		}
+	}
+
+	names := make([]string, 0, len(settings))
+	for name, s := range settings {
+		if s != nil {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("no repository settings to select a baseline from")
+	}
+	sort.Strings(names)
+
+	best := ""
+	bestScore := -1
+	for _, name := range names {
+		score := 0
+		for _, key := range baselineVoteKeys(settings[name]) {
+			score += votes[key]
+		}
+		if score > bestScore {
+			best = name
+			bestScore = score
+		}
+	}
+
+	return best, nil
+}
+
+func baselineVoteKeys(s *RepoSettings) []string {
+	return []string{
+		"DefaultBranch=" + s.DefaultBranch,
+		fmt.Sprintf("DeleteBranchOnMerge=%v", s.DeleteBranchOnMerge),
+		fmt.Sprintf("MergeStrategies=merge:%v squash:%v rebase:%v", s.AllowMergeCommit, s.AllowSquashMerge, s.AllowRebaseMerge),
+	}
+}
+
+var repoSettingsGraphQLFields = []string{
+	"defaultBranchRef { name }",
+	"mergeCommitAllowed",
+	"squashMergeAllowed",
+	"rebaseMergeAllowed",
+	"deleteBranchOnMerge",
+	"hasIssuesEnabled",
+	"hasProjectsEnabled",
+	"hasWikiEnabled",
+}
+
+type repoSettingsGraphQLResponse struct {
+	DefaultBranchRef *struct {
+		Name string `json:"name"`
+	} `json:"defaultBranchRef"`
+	MergeCommitAllowed  bool `json:"mergeCommitAllowed"`
+	SquashMergeAllowed  bool `json:"squashMergeAllowed"`
+	RebaseMergeAllowed  bool `json:"rebaseMergeAllowed"`
+	DeleteBranchOnMerge bool `json:"deleteBranchOnMerge"`
+	HasIssuesEnabled    bool `json:"hasIssuesEnabled"`
+	HasProjectsEnabled  bool `json:"hasProjectsEnabled"`
+	HasWikiEnabled      bool `json:"hasWikiEnabled"`
+}
+
+// GetRepoSettingsBatch retrieves settings for many repositories in one GraphQL request
+func (g *GraphQLClient) GetRepoSettingsBatch(repoNames []string) (map[string]*RepoSettings, error) {
+	results, err := g.BatchRepos(repoNames, repoSettingsGraphQLFields)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]*RepoSettings, len(results))
+	for repoName, raw := range results {
+		var response repoSettingsGraphQLResponse
+		if err := json.Unmarshal(raw, &response); err != nil {
+			return nil, fmt.Errorf("failed to parse settings for %s: %w", repoName, err)
+		}
+
+		repoSettings := &RepoSettings{
+			Repository:          repoName,
+			AllowMergeCommit:    response.MergeCommitAllowed,
+			AllowSquashMerge:    response.SquashMergeAllowed,
+			AllowRebaseMerge:    response.RebaseMergeAllowed,
+			DeleteBranchOnMerge: response.DeleteBranchOnMerge,
+			HasIssues:           response.HasIssuesEnabled,
+			HasProjects:         response.HasProjectsEnabled,
+			HasWiki:             response.HasWikiEnabled,
+		}
+		if response.DefaultBranchRef != nil {
+			repoSettings.DefaultBranch = response.DefaultBranchRef.Name
+		}
+
+		settings[repoName] = repoSettings
+	}
+
+	return settings, nil
+}
+
+// MergeStrategies lists the pull request merge methods a repository can enable
+var MergeStrategies = []string{"merge", "squash", "rebase"}
+
+// MergeStrategyViolation reports a repository that enables merge methods outside the policy
+type MergeStrategyViolation struct {
+	Repository string
+	Disallowed []string // Enabled strategies not in the allowed list
+}
+
+// EnabledMergeStrategies returns the merge methods a repository enables, in MergeStrategies order
+func EnabledMergeStrategies(s *RepoSettings) []string {
+	var enabled []string
+	if s.AllowMergeCommit {
+		enabled = append(enabled, "merge")
+	}
+	if s.AllowSquashMerge {
+		enabled = append(enabled, "squash")
+	}
+	if s.AllowRebaseMerge {
+		enabled = append(enabled, "rebase")
+	}
+	return enabled
+}
+
+// EnforceMergeStrategy finds repositories (name -> settings) that enable strategies not in allowed
+// Pure function: violations are sorted by repository name
+func EnforceMergeStrategy(settings map[string]*RepoSettings, allowed []string) []MergeStrategyViolation {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, strategy := range allowed {
+		allowedSet[strategy] = true
+	}
+
+	violations := []MergeStrategyViolation{}
+	for name, s := range settings {
+		if s == nil {
+			continue
+		}
+
+		var disallowed []string
+		for _, strategy := range EnabledMergeStrategies(s) {
+			if !allowedSet[strategy] {
+				disallowed = append(disallowed, strategy)
+			}
+		}
+		if len(disallowed) > 0 {
+			violations = append(violations, MergeStrategyViolation{Repository: name, Disallowed: disallowed})
+		}
+	}
+
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Repository < violations[j].Repository
+	})
+
+	return violations
+}
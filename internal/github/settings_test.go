@@ -1,6 +1,12 @@
 package github
 
 import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -151,6 +157,74 @@ func TestSettingsDiffSeverity(t *testing.T) {
 	}
 }
 
+// TestCompareSettingsWithPolicy tests that a policy file overrides default severities
+func TestCompareSettingsWithPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(path, []byte("DeleteBranchOnMerge: critical\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	policy, err := LoadSettingsPolicy(path)
+	if err != nil {
+		t.Fatalf("LoadSettingsPolicy failed: %v", err)
+	}
+
+	baseline := &RepoSettings{DefaultBranch: "main", DeleteBranchOnMerge: true}
+	current := &RepoSettings{DefaultBranch: "master", DeleteBranchOnMerge: false}
+	diffs := CompareSettingsWithPolicy(baseline, current, policy)
+
+	if diff := findDiff(diffs, "DeleteBranchOnMerge"); diff == nil || diff.Severity != "critical" {
+		t.Errorf("Expected critical DeleteBranchOnMerge diff, got %+v", diff)
+	}
+	if diff := findDiff(diffs, "DefaultBranch"); diff == nil || diff.Severity != "warning" {
+		t.Errorf("Expected fields omitted from the policy to keep their default, got %+v", diff)
+	}
+}
+
+// TestLoadSettingsPolicyRejectsUnknownValues tests validation of policy fields and severities
+func TestLoadSettingsPolicyRejectsUnknownValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		errText string
+	}{
+		{"unknown field", "HasWiki: critical\n", "unknown field"},
+		{"unknown severity", "DefaultBranch: blocker\n", "unknown severity"},
+		{"invalid YAML", "DefaultBranch: [\n", "failed to parse"},
+	}
+
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "policy.yaml")
+		if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err := LoadSettingsPolicy(path)
+		if err == nil || !strings.Contains(err.Error(), tt.errText) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.errText, err)
+		}
+	}
+
+	if _, err := LoadSettingsPolicy(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("Expected error for a missing policy file")
+	}
+}
+
+// TestDefaultSettingsPolicy tests the embedded default matches the documented severities
+func TestDefaultSettingsPolicy(t *testing.T) {
+	policy := DefaultSettingsPolicy()
+	expected := SettingsPolicy{"DefaultBranch": "warning", "DeleteBranchOnMerge": "info", "MergeStrategies": "info"}
+
+	if len(policy) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, policy)
+	}
+	for field, severity := range expected {
+		if policy[field] != severity {
+			t.Errorf("Expected %s to be %s, got %s", field, severity, policy[field])
+		}
+	}
+}
+
 // TestBatchCompareSettings tests comparing multiple repositories
 func TestBatchCompareSettings(t *testing.T) {
 	baseline := &RepoSettings{
@@ -182,6 +256,38 @@ func TestBatchCompareSettings(t *testing.T) {
 	}
 }
 
+// TestSelectBaselineRepo tests picking the majority-aligned repository
+func TestSelectBaselineRepo(t *testing.T) {
+	settings := map[string]*RepoSettings{
+		"owner/alpha": {Repository: "owner/alpha", DefaultBranch: "main", AllowSquashMerge: true},
+		"owner/beta":  {Repository: "owner/beta", DefaultBranch: "main", AllowSquashMerge: true, DeleteBranchOnMerge: true},
+		"owner/gamma": {Repository: "owner/gamma", DefaultBranch: "main", AllowMergeCommit: true},
+		"owner/delta": {Repository: "owner/delta", DefaultBranch: "master", AllowSquashMerge: true},
+		"owner/omega": {Repository: "owner/omega", DefaultBranch: "develop", AllowRebaseMerge: true},
+	}
+
+	baseline, err := SelectBaselineRepo(settings)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if settings[baseline].DefaultBranch != "main" {
+		t.Errorf("Expected baseline with default branch main, got %s (%s)", baseline, settings[baseline].DefaultBranch)
+	}
+
+	// alpha matches the majority on all three fields
+	if baseline != "owner/alpha" {
+		t.Errorf("Expected owner/alpha, got %s", baseline)
+	}
+}
+
+// TestSelectBaselineRepoEmpty tests that an empty map returns an error
+func TestSelectBaselineRepoEmpty(t *testing.T) {
+	if _, err := SelectBaselineRepo(map[string]*RepoSettings{}); err == nil {
+		t.Error("Expected error for empty settings")
+	}
+}
+
 // Helper function to find a specific diff
 func findDiff(diffs []SettingsDiff, field string) *SettingsDiff {
 	for i := range diffs {
@@ -191,3 +297,123 @@ func findDiff(diffs []SettingsDiff, field string) *SettingsDiff {
 	}
 	return nil
 }
+
+// TestEnforceMergeStrategy tests that only repos enabling strategies outside the policy violate it
+func TestEnforceMergeStrategy(t *testing.T) {
+	settings := map[string]*RepoSettings{
+		"owner/squash-only": {AllowSquashMerge: true},
+		"owner/also-rebase": {AllowSquashMerge: true, AllowRebaseMerge: true},
+		"owner/missing":     nil,
+	}
+
+	violations := EnforceMergeStrategy(settings, []string{"squash"})
+
+	if len(violations) != 1 {
+		t.Fatalf("Expected 1 violation, got %+v", violations)
+	}
+	if violations[0].Repository != "owner/also-rebase" {
+		t.Errorf("Expected owner/also-rebase to violate, got %s", violations[0].Repository)
+	}
+	if len(violations[0].Disallowed) != 1 || violations[0].Disallowed[0] != "rebase" {
+		t.Errorf("Expected rebase to be disallowed, got %v", violations[0].Disallowed)
+	}
+
+	if violations := EnforceMergeStrategy(settings, []string{"squash", "rebase"}); len(violations) != 0 {
+		t.Errorf("Expected no violations when rebase is allowed, got %+v", violations)
+	}
+}
+
+// TestSettingsPatch tests that only differing compared fields are patched
+func TestSettingsPatch(t *testing.T) {
+	current := &RepoSettings{
+		DefaultBranch:    "master",
+		AllowMergeCommit: true,
+		AllowSquashMerge: true,
+		HasWiki:          true,
+	}
+	desired := &RepoSettings{
+		DefaultBranch:       "main",
+		AllowSquashMerge:    true,
+		DeleteBranchOnMerge: true,
+		HasWiki:             false, // Not compared, so never patched
+	}
+
+	expected := map[string]interface{}{
+		"allow_merge_commit":     false,
+		"delete_branch_on_merge": true,
+	}
+	if patch := SettingsPatch(current, desired, SettingsPatchOptions{}); !reflect.DeepEqual(patch, expected) {
+		t.Errorf("Expected %v, got %v", expected, patch)
+	}
+
+	expected["default_branch"] = "main"
+	if patch := SettingsPatch(current, desired, SettingsPatchOptions{IncludeDefaultBranch: true}); !reflect.DeepEqual(patch, expected) {
+		t.Errorf("Expected %v with the default branch, got %v", expected, patch)
+	}
+
+	if patch := SettingsPatch(current, current, SettingsPatchOptions{IncludeDefaultBranch: true}); len(patch) != 0 {
+		t.Errorf("Expected no fields for matching settings, got %v", patch)
+	}
+}
+
+// TestUpdateRepoSettingsSendsOnlyChangedFields tests the PATCH body after fetching current settings
+func TestUpdateRepoSettingsSendsOnlyChangedFields(t *testing.T) {
+	var patched map[string]interface{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{
+				"default_branch": "main",
+				"allow_merge_commit": true,
+				"allow_squash_merge": true,
+				"allow_rebase_merge": true,
+				"delete_branch_on_merge": false,
+				"has_issues": true
+			}`))
+		case http.MethodPatch:
+			if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+				t.Fatalf("Failed to decode body: %v", err)
+			}
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	baseline := &RepoSettings{
+		DefaultBranch:       "trunk",
+		AllowSquashMerge:    true,
+		DeleteBranchOnMerge: true,
+	}
+
+	client := newTestClient(t, handler)
+	if err := client.UpdateRepoSettings("owner", "repo", baseline); err != nil {
+		t.Fatalf("UpdateRepoSettings failed: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"allow_merge_commit":     false,
+		"allow_rebase_merge":     false,
+		"delete_branch_on_merge": true,
+	}
+	if !reflect.DeepEqual(patched, expected) {
+		t.Errorf("Expected PATCH body %v, got %v", expected, patched)
+	}
+}
+
+// TestApplyRepoSettingsInSync tests that no request is made when settings already match
+func TestApplyRepoSettingsInSync(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	settings := &RepoSettings{DefaultBranch: "main", AllowSquashMerge: true}
+	patch, err := newTestClient(t, handler).ApplyRepoSettings("owner", "repo", settings, settings, SettingsPatchOptions{IncludeDefaultBranch: true})
+	if err != nil || len(patch) != 0 {
+		t.Errorf("Expected an empty patch and no error, got %v, %v", patch, err)
+	}
+}
@@ -155,6 +155,34 @@ func (c *Client) SetRepoSubscription(owner, repo string, subscribed, ignored boo
 	}, nil
 }
 
+func (c *Client) SetWatchReason(owner, repo, reason string) error {
+	var subscribed, ignored bool
+	switch WatchState(reason) {
+	case WatchStateSubscribed:
+		subscribed = true
+	case WatchStateIgnored:
+		ignored = true
+	default:
+		// Other reasons (assigned, mentioned, ...) are set by GitHub, not by the user
+		return fmt.Errorf("unsupported watch reason %q (use subscribed or ignored)", reason)
+	}
+
+	if _, err := c.SetRepoSubscription(owner, repo, subscribed, ignored); err != nil {
+		return err
+	}
+	return nil
+}
+
+func SubscriptionReason(sub *Subscription) string {
+	if sub == nil {
+		return string(WatchStateNotWatching)
+	}
+	if sub.Reason != "" {
+		return sub.Reason
+	}
+	return string(sub.State)
+}
+
 func (c *Client) DeleteRepoSubscription(owner, repo string) error {
 	path := fmt.Sprintf("repos/%s/%s/subscription", owner, repo)
 	if err := c.Delete(path, nil); err != nil {
@@ -0,0 +1,82 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// TestSetWatchReason tests the PUT body sent for each watch reason
+func TestSetWatchReason(t *testing.T) {
+	tests := []struct {
+		reason         string
+		wantSubscribed bool
+		wantIgnored    bool
+	}{
+		{"ignored", false, true},
+		{"subscribed", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			var body map[string]bool
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				if r.URL.Path != "/repos/owner/repo/subscription" {
+					t.Errorf("Expected subscription path, got %s", r.URL.Path)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("Failed to decode body: %v", err)
+				}
+				w.Write([]byte(`{"subscribed": false, "ignored": true}`))
+			})
+
+			client := newTestClient(t, handler)
+
+			if err := client.SetWatchReason("owner", "repo", tt.reason); err != nil {
+				t.Fatalf("SetWatchReason failed: %v", err)
+			}
+
+			if body["subscribed"] != tt.wantSubscribed || body["ignored"] != tt.wantIgnored {
+				t.Errorf("Expected subscribed=%v ignored=%v, got %v", tt.wantSubscribed, tt.wantIgnored, body)
+			}
+		})
+	}
+}
+
+// TestSetWatchReasonUnsupported tests that GitHub-managed reasons are rejected
+func TestSetWatchReasonUnsupported(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %s", r.URL.Path)
+	})
+
+	client := newTestClient(t, handler)
+
+	if err := client.SetWatchReason("owner", "repo", "mentioned"); err == nil {
+		t.Error("Expected error for unsupported reason")
+	}
+}
+
+// TestSubscriptionReason tests falling back to the watch state
+func TestSubscriptionReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		sub      *Subscription
+		expected string
+	}{
+		{"nil", nil, ""},
+		{"explicit reason", &Subscription{Reason: "manual", State: WatchStateSubscribed}, "manual"},
+		{"ignored state", &Subscription{State: WatchStateIgnored}, "ignored"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SubscriptionReason(tt.sub); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
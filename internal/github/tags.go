@@ -0,0 +1,83 @@
+package github
+
+import (
+	"fmt"
+	"time"
+)
+
+// Tag represents a git tag and whether a release was published from it
+type Tag struct {
+	Name       string
+	SHA        string
+	Repository string
+	CreatedAt  time.Time
+	IsRelease  bool
+}
+
+type tagResponse struct {
+	Name   string `json:"name"`
+	Commit struct {
+		SHA string `json:"sha"`
+	} `json:"commit"`
+}
+
+type tagCommitResponse struct {
+	Commit struct {
+		Committer struct {
+			Date time.Time `json:"date"`
+		} `json:"committer"`
+	} `json:"commit"`
+}
+
+// ListTags lists all tags for a repository
+// CreatedAt is the date of the tagged commit, which costs one request per tag
+func (c *Client) ListTags(owner, repo string) ([]Tag, error) {
+	var allTags []Tag
+	page := 1
+	perPage := 100
+
+	for {
+		var response []tagResponse
+		path := fmt.Sprintf("repos/%s/%s/tags?per_page=%d&page=%d", owner, repo, perPage, page)
+
+		if err := c.Get(path, &response); err != nil {
+			return nil, fmt.Errorf("failed to list tags: %w", err)
+		}
+
+		for _, t := range response {
+			allTags = append(allTags, Tag{
+				Name:       t.Name,
+				SHA:        t.Commit.SHA,
+				Repository: fmt.Sprintf("%s/%s", owner, repo),
+			})
+		}
+
+		if len(response) < perPage {
+			break
+		}
+		page++
+	}
+
+	releases, err := c.ListReleases(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseTags := make(map[string]bool, len(releases))
+	for _, r := range releases {
+		releaseTags[r.TagName] = true
+	}
+
+	for i := range allTags {
+		allTags[i].IsRelease = releaseTags[allTags[i].Name]
+
+		var commit tagCommitResponse
+		path := fmt.Sprintf("repos/%s/%s/commits/%s", owner, repo, allTags[i].SHA)
+		if err := c.Get(path, &commit); err != nil {
+			return nil, fmt.Errorf("failed to get commit for tag %s: %w", allTags[i].Name, err)
+		}
+		allTags[i].CreatedAt = commit.Commit.Committer.Date
+	}
+
+	return allTags, nil
+}
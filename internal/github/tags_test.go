@@ -0,0 +1,53 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestListTags tests release marking and commit date lookup
+func TestListTags(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/tags":
+			w.Write([]byte(`[
+				{"name": "v1.0.0", "commit": {"sha": "aaa"}},
+				{"name": "experiment", "commit": {"sha": "bbb"}}
+			]`))
+		case "/repos/owner/repo/releases":
+			w.Write([]byte(`[{"id": 1, "tag_name": "v1.0.0"}]`))
+		case "/repos/owner/repo/commits/aaa":
+			w.Write([]byte(`{"commit": {"committer": {"date": "2024-01-01T00:00:00Z"}}}`))
+		case "/repos/owner/repo/commits/bbb":
+			w.Write([]byte(`{"commit": {"committer": {"date": "2023-06-01T00:00:00Z"}}}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(t, handler)
+
+	tags, err := client.ListTags("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListTags failed: %v", err)
+	}
+
+	if len(tags) != 2 {
+		t.Fatalf("Expected 2 tags, got %d", len(tags))
+	}
+
+	if !tags[0].IsRelease || tags[1].IsRelease {
+		t.Errorf("Expected only v1.0.0 to be a release, got %+v", tags)
+	}
+
+	expected := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	if !tags[1].CreatedAt.Equal(expected) {
+		t.Errorf("Expected experiment created at %v, got %v", expected, tags[1].CreatedAt)
+	}
+
+	if tags[1].Repository != "owner/repo" || tags[1].SHA != "bbb" {
+		t.Errorf("Unexpected tag fields: %+v", tags[1])
+	}
+}
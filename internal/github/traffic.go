@@ -0,0 +1,94 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RepoTraffic summarizes the last 14 days of repository traffic
+// Requires push access to the repository
+type RepoTraffic struct {
+	Repository   string
+	ViewsTotal   int
+	ViewsUnique  int
+	ClonesTotal  int
+	ClonesUnique int
+	TopReferrers []string
+	TopPaths     []string
+}
+
+type trafficCountResponse struct {
+	Count   int `json:"count"`
+	Uniques int `json:"uniques"`
+}
+
+type referrerResponse struct {
+	Referrer string `json:"referrer"`
+	Count    int    `json:"count"`
+	Uniques  int    `json:"uniques"`
+}
+
+type popularPathResponse struct {
+	Path    string `json:"path"`
+	Title   string `json:"title"`
+	Count   int    `json:"count"`
+	Uniques int    `json:"uniques"`
+}
+
+// GetRepoTraffic fetches views, clones, referrers, and popular paths
+func (c *Client) GetRepoTraffic(owner, repo string) (*RepoTraffic, error) {
+	var views trafficCountResponse
+	if err := c.Get(fmt.Sprintf("repos/%s/%s/traffic/views", owner, repo), &views); err != nil {
+		return nil, fmt.Errorf("failed to get traffic views: %w", err)
+	}
+
+	var clones trafficCountResponse
+	if err := c.Get(fmt.Sprintf("repos/%s/%s/traffic/clones", owner, repo), &clones); err != nil {
+		return nil, fmt.Errorf("failed to get traffic clones: %w", err)
+	}
+
+	var referrers []referrerResponse
+	if err := c.Get(fmt.Sprintf("repos/%s/%s/traffic/popular/referrers", owner, repo), &referrers); err != nil {
+		return nil, fmt.Errorf("failed to get traffic referrers: %w", err)
+	}
+
+	var paths []popularPathResponse
+	if err := c.Get(fmt.Sprintf("repos/%s/%s/traffic/popular/paths", owner, repo), &paths); err != nil {
+		return nil, fmt.Errorf("failed to get traffic paths: %w", err)
+	}
+
+	traffic := &RepoTraffic{
+		Repository:   fmt.Sprintf("%s/%s", owner, repo),
+		ViewsTotal:   views.Count,
+		ViewsUnique:  views.Uniques,
+		ClonesTotal:  clones.Count,
+		ClonesUnique: clones.Uniques,
+		TopReferrers: make([]string, len(referrers)),
+		TopPaths:     make([]string, len(paths)),
+	}
+
+	for i, r := range referrers {
+		traffic.TopReferrers[i] = r.Referrer
+	}
+	for i, p := range paths {
+		traffic.TopPaths[i] = p.Path
+	}
+
+	return traffic, nil
+}
+
+// RankReposByUniqueViews orders repos with the most unique visitors first
+// Pure function: returns a sorted copy
+func RankReposByUniqueViews(traffic []RepoTraffic) []RepoTraffic {
+	ranked := make([]RepoTraffic, len(traffic))
+	copy(ranked, traffic)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].ViewsUnique != ranked[j].ViewsUnique {
+			return ranked[i].ViewsUnique > ranked[j].ViewsUnique
+		}
+		return ranked[i].Repository < ranked[j].Repository
+	})
+
+	return ranked
+}
@@ -0,0 +1,64 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestGetRepoTraffic tests traffic extraction against a mocked API
+func TestGetRepoTraffic(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/traffic/views", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"count": 120, "uniques": 31, "views": [{"timestamp": "2024-01-01T00:00:00Z", "count": 120, "uniques": 31}]}`))
+	})
+	mux.HandleFunc("/repos/owner/repo/traffic/clones", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"count": 14, "uniques": 6, "clones": []}`))
+	})
+	mux.HandleFunc("/repos/owner/repo/traffic/popular/referrers", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"referrer": "github.com", "count": 80, "uniques": 20}, {"referrer": "google.com", "count": 10, "uniques": 5}]`))
+	})
+	mux.HandleFunc("/repos/owner/repo/traffic/popular/paths", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"path": "/owner/repo", "title": "repo", "count": 90, "uniques": 25}]`))
+	})
+
+	client := newTestClient(t, mux)
+
+	traffic, err := client.GetRepoTraffic("owner", "repo")
+	if err != nil {
+		t.Fatalf("GetRepoTraffic failed: %v", err)
+	}
+
+	if traffic.ViewsTotal != 120 || traffic.ViewsUnique != 31 {
+		t.Errorf("Expected 120 views (31 unique), got %d (%d unique)", traffic.ViewsTotal, traffic.ViewsUnique)
+	}
+
+	if traffic.ClonesTotal != 14 || traffic.ClonesUnique != 6 {
+		t.Errorf("Expected 14 clones (6 unique), got %d (%d unique)", traffic.ClonesTotal, traffic.ClonesUnique)
+	}
+
+	if len(traffic.TopReferrers) != 2 || traffic.TopReferrers[0] != "github.com" {
+		t.Errorf("Expected referrers [github.com google.com], got %v", traffic.TopReferrers)
+	}
+
+	if len(traffic.TopPaths) != 1 || traffic.TopPaths[0] != "/owner/repo" {
+		t.Errorf("Expected paths [/owner/repo], got %v", traffic.TopPaths)
+	}
+}
+
+// TestRankReposByUniqueViews tests ranking by unique visitors
+func TestRankReposByUniqueViews(t *testing.T) {
+	traffic := []RepoTraffic{
+		{Repository: "org/quiet", ViewsUnique: 2, ViewsTotal: 500},
+		{Repository: "org/popular", ViewsUnique: 40},
+		{Repository: "org/middle", ViewsUnique: 10},
+	}
+
+	ranked := RankReposByUniqueViews(traffic)
+
+	expected := []string{"org/popular", "org/middle", "org/quiet"}
+	for i, repo := range expected {
+		if ranked[i].Repository != repo {
+			t.Errorf("Position %d: expected %s, got %s", i, repo, ranked[i].Repository)
+		}
+	}
+}
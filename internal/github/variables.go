@@ -0,0 +1,123 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Variable represents a GitHub Actions configuration variable
+type Variable struct {
+	Name       string
+	Value      string
+	Scope      string // "org" or "repo"
+	Repository string // Empty for org variables
+	CreatedAt  string
+	UpdatedAt  string
+}
+
+type variablesResponse struct {
+	Variables []struct {
+		Name      string `json:"name"`
+		Value     string `json:"value"`
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	} `json:"variables"`
+}
+
+// ListOrgVariables lists organization-level variables
+func (c *Client) ListOrgVariables(org string) ([]Variable, error) {
+	var response variablesResponse
+	path := fmt.Sprintf("orgs/%s/actions/variables", org)
+
+	if err := c.Get(path, &response); err != nil {
+		return nil, fmt.Errorf("failed to list org variables: %w", err)
+	}
+
+	variables := make([]Variable, len(response.Variables))
+	for i, v := range response.Variables {
+		variables[i] = Variable{
+			Name:      v.Name,
+			Value:     v.Value,
+			Scope:     "org",
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
+		}
+	}
+
+	return variables, nil
+}
+
+// ListRepoVariables lists repository-level variables
+func (c *Client) ListRepoVariables(owner, repo string) ([]Variable, error) {
+	var response variablesResponse
+	path := fmt.Sprintf("repos/%s/%s/actions/variables", owner, repo)
+
+	if err := c.Get(path, &response); err != nil {
+		return nil, fmt.Errorf("failed to list repo variables: %w", err)
+	}
+
+	variables := make([]Variable, len(response.Variables))
+	for i, v := range response.Variables {
+		variables[i] = Variable{
+			Name:       v.Name,
+			Value:      v.Value,
+			Scope:      "repo",
+			Repository: fmt.Sprintf("%s/%s", owner, repo),
+			CreatedAt:  v.CreatedAt,
+			UpdatedAt:  v.UpdatedAt,
+		}
+	}
+
+	return variables, nil
+}
+
+// VariableUsage tracks variable usage in workflows
+type VariableUsage struct {
+	Name         string
+	Scope        string
+	Repository   string
+	ReferencedIn []string // Workflow files that reference this variable
+	Unused       bool
+}
+
+// DetectUnusedVariables compares variables against workflow references
+func DetectUnusedVariables(variables []Variable, refs map[string][]string) []VariableUsage {
+	usages := []VariableUsage{}
+
+	for _, variable := range variables {
+		usage := VariableUsage{
+			Name:       variable.Name,
+			Scope:      variable.Scope,
+			Repository: variable.Repository,
+		}
+
+		if files, ok := refs[variable.Name]; ok {
+			usage.ReferencedIn = files
+		} else {
+			usage.Unused = true
+		}
+
+		usages = append(usages, usage)
+	}
+
+	return usages
+}
+
+// ScanWorkflowForVariables extracts variable references from workflow YAML
+// Pure function: parses YAML content for vars.* references
+func ScanWorkflowForVariables(content string) []string {
+	// Match ${{ vars.VARIABLE_NAME }} pattern (with optional spaces)
+	pattern := regexp.MustCompile(`\${{\s*vars\.([A-Z0-9_]+)\s*}}`)
+	matches := pattern.FindAllStringSubmatch(content, -1)
+
+	variableSet := make(map[string]bool)
+	variables := []string{}
+	for _, match := range matches {
+		if len(match) > 1 && !variableSet[match[1]] {
+			variableSet[match[1]] = true
+			variables = append(variables, match[1])
+		}
+	}
+
+	return variables
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestScanWorkflowForVariables tests that vars.* and secrets.* references stay separate
+func TestScanWorkflowForVariables(t *testing.T) {
+	workflow := `
+name: Deploy
+on: push
+jobs:
+  deploy:
+    runs-on: ubuntu-latest
+    environment: ${{ vars.DEPLOY_ENV }}
+    steps:
+      - run: ./deploy.sh
+        env:
+          API_KEY: ${{ secrets.API_KEY }}
+          REGION: ${{vars.REGION}}
+          AGAIN: ${{ vars.DEPLOY_ENV }}
+`
+
+	variables := ScanWorkflowForVariables(workflow)
+	if len(variables) != 2 {
+		t.Fatalf("Expected 2 variables, got %d: %v", len(variables), variables)
+	}
+
+	varSet := make(map[string]bool)
+	for _, v := range variables {
+		varSet[v] = true
+	}
+
+	if !varSet["DEPLOY_ENV"] || !varSet["REGION"] {
+		t.Errorf("Expected DEPLOY_ENV and REGION, got %v", variables)
+	}
+
+	if varSet["API_KEY"] {
+		t.Error("Expected secrets.API_KEY to not be extracted as a variable")
+	}
+
+	secrets := ScanWorkflowForSecrets(workflow)
+	if len(secrets) != 1 || secrets[0] != "API_KEY" {
+		t.Errorf("Expected only API_KEY secret, got %v", secrets)
+	}
+}
+
+// TestDetectUnusedVariables tests unused variable detection
+func TestDetectUnusedVariables(t *testing.T) {
+	variables := []Variable{
+		{Name: "DEPLOY_ENV", Scope: "org"},
+		{Name: "LEGACY_FLAG", Scope: "repo", Repository: "owner/repo"},
+	}
+
+	refs := map[string][]string{
+		"DEPLOY_ENV": {".github/workflows/deploy.yml"},
+	}
+
+	usages := DetectUnusedVariables(variables, refs)
+
+	if len(usages) != 2 {
+		t.Fatalf("Expected 2 usage entries, got %d", len(usages))
+	}
+
+	if usages[0].Unused || len(usages[0].ReferencedIn) != 1 {
+		t.Errorf("Expected DEPLOY_ENV to be used in 1 workflow, got %+v", usages[0])
+	}
+
+	if !usages[1].Unused {
+		t.Error("Expected LEGACY_FLAG to be marked as unused")
+	}
+}
+
+// TestListRepoVariables tests variable listing against a mocked API
+func TestListRepoVariables(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/variables", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"total_count": 1, "variables": [{"name": "DEPLOY_ENV", "value": "prod", "updated_at": "2024-01-01T00:00:00Z"}]}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	variables, err := client.ListRepoVariables("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListRepoVariables failed: %v", err)
+	}
+
+	if len(variables) != 1 {
+		t.Fatalf("Expected 1 variable, got %d", len(variables))
+	}
+
+	if variables[0].Value != "prod" || variables[0].Repository != "owner/repo" || variables[0].Scope != "repo" {
+		t.Errorf("Unexpected variable: %+v", variables[0])
+	}
+}
@@ -1,21 +1,29 @@
 package github
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
 
 // Webhook represents a repository webhook
 type Webhook struct {
-	ID         int
-	Repository string
-	URL        string
-	Events     []string
-	Active     bool
+	ID          int
+	Repository  string
+	URL         string
+	Events      []string
+	Active      bool
+	InsecureSSL bool // SSL certificate verification is disabled for deliveries
 }
 
 type webhookResponse struct {
 	ID     int    `json:"id"`
 	URL    string `json:"url"`
 	Config struct {
-		URL string `json:"url"`
+		URL         string          `json:"url"`
+		InsecureSSL json.RawMessage `json:"insecure_ssl"`
 	} `json:"config"`
 	Events []string `json:"events"`
 	Active bool     `json:"active"`
@@ -26,24 +34,59 @@ func (c *Client) ListWebhooks(owner, repo string) ([]Webhook, error) {
 	var response []webhookResponse
 	path := fmt.Sprintf("repos/%s/%s/hooks", owner, repo)
 
-	if err := c.Get(path, &response); err != nil {
+	if err := c.GetAllPages(path, &response); err != nil {
 		return nil, fmt.Errorf("failed to list webhooks: %w", err)
 	}
 
 	webhooks := make([]Webhook, len(response))
 	for i, w := range response {
 		webhooks[i] = Webhook{
-			ID:         w.ID,
-			Repository: fmt.Sprintf("%s/%s", owner, repo),
-			URL:        w.Config.URL,
-			Events:     w.Events,
-			Active:     w.Active,
+			ID:          w.ID,
+			Repository:  fmt.Sprintf("%s/%s", owner, repo),
+			URL:         w.Config.URL,
+			Events:      w.Events,
+			Active:      w.Active,
+			InsecureSSL: parseInsecureSSL(w.Config.InsecureSSL),
 		}
 	}
 
 	return webhooks, nil
 }
 
+// parseInsecureSSL interprets the config.insecure_ssl value, which the API
+// returns as the string "0" or "1" (older hooks may use a bare number)
+func parseInsecureSSL(raw json.RawMessage) bool {
+	return strings.Trim(string(raw), `"`) == "1"
+}
+
+// CountInsecureWebhooks counts webhooks with SSL verification disabled
+// Pure function: counts across all repositories
+func CountInsecureWebhooks(webhooks map[string][]Webhook) int {
+	count := 0
+	for _, repoWebhooks := range webhooks {
+		for _, webhook := range repoWebhooks {
+			if webhook.InsecureSSL {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// FilterInsecureWebhooks keeps only webhooks with SSL verification disabled
+// Pure function: repositories without insecure webhooks are omitted
+func FilterInsecureWebhooks(webhooks map[string][]Webhook) map[string][]Webhook {
+	filtered := make(map[string][]Webhook)
+	for repo, repoWebhooks := range webhooks {
+		for _, webhook := range repoWebhooks {
+			if webhook.InsecureSSL {
+				filtered[repo] = append(filtered[repo], webhook)
+			}
+		}
+	}
+	return filtered
+}
+
 // WebhookDelivery represents a webhook delivery
 type WebhookDelivery struct{
 	ID        int
@@ -84,6 +127,45 @@ func (c *Client) ListWebhookDeliveries(owner, repo string, hookID int) ([]Webhoo
 	return deliveries, nil
 }
 
+// PollWebhookDeliveries fetches deliveries made after since, oldest first
+func (c *Client) PollWebhookDeliveries(owner, repo string, webhookID int, since time.Time) ([]WebhookDelivery, error) {
+	deliveries, err := c.ListWebhookDeliveries(owner, repo, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterDeliveriesSince(deliveries, since), nil
+}
+
+// FilterDeliveriesSince keeps deliveries with a timestamp after since, sorted oldest first
+// Pure function: deliveries with an unparseable timestamp are dropped
+func FilterDeliveriesSince(deliveries []WebhookDelivery, since time.Time) []WebhookDelivery {
+	type timedDelivery struct {
+		delivery WebhookDelivery
+		at       time.Time
+	}
+
+	var recent []timedDelivery
+	for _, d := range deliveries {
+		at, err := time.Parse(time.RFC3339, d.Timestamp)
+		if err != nil || !at.After(since) {
+			continue
+		}
+		recent = append(recent, timedDelivery{delivery: d, at: at})
+	}
+
+	sort.SliceStable(recent, func(i, j int) bool {
+		return recent[i].at.Before(recent[j].at)
+	})
+
+	filtered := make([]WebhookDelivery, len(recent))
+	for i, r := range recent {
+		filtered[i] = r.delivery
+	}
+
+	return filtered
+}
+
 // WebhookHealth represents webhook health metrics
 type WebhookHealth struct {
 	WebhookID       int
@@ -91,12 +173,16 @@ type WebhookHealth struct {
 	TotalDeliveries int
 	Failures        int
 	AvgDuration     int
+	InsecureSSL     bool
+	LastDelivery    time.Time // zero when no delivery has a parseable timestamp
 }
 
 // AnalyzeWebhookHealth analyzes webhook delivery health
-func AnalyzeWebhookHealth(deliveries []WebhookDelivery) WebhookHealth {
+func AnalyzeWebhookHealth(webhook Webhook, deliveries []WebhookDelivery) WebhookHealth {
 	health := WebhookHealth{
+		WebhookID:       webhook.ID,
 		TotalDeliveries: len(deliveries),
+		InsecureSSL:     webhook.InsecureSSL,
 	}
 
 	if len(deliveries) == 0 {
@@ -113,6 +199,10 @@ func AnalyzeWebhookHealth(deliveries []WebhookDelivery) WebhookHealth {
 			health.Failures++
 		}
 		totalDuration += d.Duration
+
+		if at, err := time.Parse(time.RFC3339, d.Timestamp); err == nil && at.After(health.LastDelivery) {
+			health.LastDelivery = at
+		}
 	}
 
 	health.SuccessRate = float64(successCount) / float64(len(deliveries)) * 100
@@ -120,3 +210,132 @@ func AnalyzeWebhookHealth(deliveries []WebhookDelivery) WebhookHealth {
 
 	return health
 }
+
+// DisableWebhook deactivates a webhook without deleting its configuration
+func (c *Client) DisableWebhook(owner, repo string, webhookID int) error {
+	path := fmt.Sprintf("repos/%s/%s/hooks/%d", owner, repo, webhookID)
+	body := map[string]interface{}{"active": false}
+
+	if err := c.Patch(path, body, nil); err != nil {
+		return fmt.Errorf("failed to disable webhook: %w", err)
+	}
+
+	return nil
+}
+
+// Webhook recommendation actions
+const (
+	WebhookActionDisable     = "disable"
+	WebhookActionInvestigate = "investigate"
+	WebhookActionKeep        = "keep"
+)
+
+// Defaults for RecommendWebhookActions
+const (
+	DefaultWebhookInactiveDays   = 30
+	DefaultWebhookMinSuccessRate = 50
+)
+
+// WebhookRecommendation suggests what to do with a webhook based on its delivery health
+type WebhookRecommendation struct {
+	WebhookID int
+	URL       string
+	Action    string // disable, investigate, or keep
+	Reason    string
+}
+
+// RecommendWebhookActions flags active webhooks that are both inactive and failing for disabling
+// Pure function: returns one recommendation per active webhook, in input order
+func RecommendWebhookActions(webhooks []Webhook, health map[int]WebhookHealth, inactiveDays, minSuccessRate int) []WebhookRecommendation {
+	return recommendWebhookActionsAt(webhooks, health, inactiveDays, minSuccessRate, time.Now())
+}
+
+func recommendWebhookActionsAt(webhooks []Webhook, health map[int]WebhookHealth, inactiveDays, minSuccessRate int, now time.Time) []WebhookRecommendation {
+	cutoff := now.AddDate(0, 0, -inactiveDays)
+
+	var recommendations []WebhookRecommendation
+	for _, webhook := range webhooks {
+		if !webhook.Active {
+			continue
+		}
+
+		rec := WebhookRecommendation{WebhookID: webhook.ID, URL: webhook.URL}
+		h := health[webhook.ID]
+		// A webhook whose last delivery is unknown counts as inactive
+		inactive := h.LastDelivery.Before(cutoff)
+		failing := h.SuccessRate < float64(minSuccessRate)
+
+		switch {
+		case h.TotalDeliveries == 0:
+			rec.Action = WebhookActionInvestigate
+			rec.Reason = "No recorded deliveries"
+		case inactive && failing:
+			rec.Action = WebhookActionDisable
+			rec.Reason = fmt.Sprintf("No deliveries in %d+ days and %.0f%% success rate", inactiveDays, h.SuccessRate)
+		case failing:
+			rec.Action = WebhookActionInvestigate
+			rec.Reason = fmt.Sprintf("%.0f%% success rate over %d deliveries", h.SuccessRate, h.TotalDeliveries)
+		case inactive:
+			rec.Action = WebhookActionInvestigate
+			rec.Reason = fmt.Sprintf("No deliveries in %d+ days", inactiveDays)
+		default:
+			rec.Action = WebhookActionKeep
+			rec.Reason = fmt.Sprintf("%.0f%% success rate", h.SuccessRate)
+		}
+
+		recommendations = append(recommendations, rec)
+	}
+
+	return recommendations
+}
+
+// WebhookCoverageReport describes which events a repository's webhooks listen for
+type WebhookCoverageReport struct {
+	CoveredEvents []string
+	MissingEvents []string
+	ByURL         map[string][]string // webhook URL -> subscribed events
+}
+
+// DetectWebhookCoverage compares active webhook events against required events
+// Pure function: a "*" subscription covers every event
+func DetectWebhookCoverage(webhooks []Webhook, required []string) *WebhookCoverageReport {
+	report := &WebhookCoverageReport{
+		CoveredEvents: []string{},
+		MissingEvents: []string{},
+		ByURL:         make(map[string][]string),
+	}
+
+	covered := make(map[string]bool)
+	wildcard := false
+
+	for _, webhook := range webhooks {
+		if !webhook.Active {
+			continue
+		}
+
+		report.ByURL[webhook.URL] = append(report.ByURL[webhook.URL], webhook.Events...)
+		for _, event := range webhook.Events {
+			if event == "*" {
+				wildcard = true
+			}
+			covered[event] = true
+		}
+	}
+
+	for event := range covered {
+		report.CoveredEvents = append(report.CoveredEvents, event)
+	}
+	sort.Strings(report.CoveredEvents)
+
+	if wildcard {
+		return report
+	}
+
+	for _, event := range required {
+		if !covered[event] {
+			report.MissingEvents = append(report.MissingEvents, event)
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,236 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestDetectWebhookCoverage tests missing event detection
+func TestDetectWebhookCoverage(t *testing.T) {
+	webhooks := []Webhook{
+		{ID: 1, URL: "https://ci.example.com/hook", Events: []string{"push", "issue"}, Active: true},
+		{ID: 2, URL: "https://old.example.com/hook", Events: []string{"pull_request"}, Active: false},
+	}
+
+	report := DetectWebhookCoverage(webhooks, []string{"push", "pull_request"})
+
+	if len(report.MissingEvents) != 1 || report.MissingEvents[0] != "pull_request" {
+		t.Errorf("Expected missing [pull_request], got %v", report.MissingEvents)
+	}
+
+	if len(report.CoveredEvents) != 2 || report.CoveredEvents[0] != "issue" || report.CoveredEvents[1] != "push" {
+		t.Errorf("Expected covered [issue push], got %v", report.CoveredEvents)
+	}
+
+	if events := report.ByURL["https://ci.example.com/hook"]; len(events) != 2 {
+		t.Errorf("Expected 2 events for ci hook, got %v", events)
+	}
+
+	if _, ok := report.ByURL["https://old.example.com/hook"]; ok {
+		t.Error("Expected inactive webhook to be excluded")
+	}
+}
+
+// TestDetectWebhookCoverageWildcard tests that "*" covers all events
+func TestDetectWebhookCoverageWildcard(t *testing.T) {
+	webhooks := []Webhook{
+		{ID: 1, URL: "https://all.example.com/hook", Events: []string{"*"}, Active: true},
+	}
+
+	report := DetectWebhookCoverage(webhooks, []string{"push", "pull_request", "release"})
+
+	if len(report.MissingEvents) != 0 {
+		t.Errorf("Expected no missing events with wildcard, got %v", report.MissingEvents)
+	}
+}
+
+// TestPollWebhookDeliveries tests that a second poll only returns deliveries newer than the first
+func TestPollWebhookDeliveries(t *testing.T) {
+	polls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/hooks/7/deliveries" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		polls++
+		if polls == 1 {
+			w.Write([]byte(`[
+				{"id": 1, "event": "push", "status_code": 200, "delivered_at": "2024-01-01T10:00:00Z"}
+			]`))
+			return
+		}
+
+		// Newest first, as returned by the API
+		w.Write([]byte(`[
+			{"id": 2, "event": "pull_request", "status_code": 500, "delivered_at": "2024-01-01T10:00:05Z"},
+			{"id": 1, "event": "push", "status_code": 200, "delivered_at": "2024-01-01T10:00:00Z"}
+		]`))
+	})
+
+	client := newTestClient(t, handler)
+	since := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	first, err := client.PollWebhookDeliveries("owner", "repo", 7, since)
+	if err != nil {
+		t.Fatalf("First poll failed: %v", err)
+	}
+	if len(first) != 1 || first[0].ID != 1 {
+		t.Fatalf("Expected first poll to return delivery 1, got %+v", first)
+	}
+
+	since, _ = time.Parse(time.RFC3339, first[0].Timestamp)
+
+	second, err := client.PollWebhookDeliveries("owner", "repo", 7, since)
+	if err != nil {
+		t.Fatalf("Second poll failed: %v", err)
+	}
+	if len(second) != 1 || second[0].ID != 2 {
+		t.Errorf("Expected second poll to return only delivery 2, got %+v", second)
+	}
+}
+
+// TestFilterDeliveriesSince tests ordering and dropping of old or malformed deliveries
+func TestFilterDeliveriesSince(t *testing.T) {
+	deliveries := []WebhookDelivery{
+		{ID: 3, Timestamp: "2024-01-01T10:00:10Z"},
+		{ID: 2, Timestamp: "2024-01-01T10:00:05Z"},
+		{ID: 1, Timestamp: "2024-01-01T09:00:00Z"},
+		{ID: 4, Timestamp: "not-a-time"},
+	}
+
+	filtered := FilterDeliveriesSince(deliveries, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC))
+
+	if len(filtered) != 2 {
+		t.Fatalf("Expected 2 deliveries, got %d", len(filtered))
+	}
+	if filtered[0].ID != 2 || filtered[1].ID != 3 {
+		t.Errorf("Expected oldest first [2 3], got [%d %d]", filtered[0].ID, filtered[1].ID)
+	}
+}
+
+// TestListWebhooksInsecureSSL tests that insecure_ssl "1" flags the webhook
+func TestListWebhooksInsecureSSL(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"id": 1, "events": ["push"], "active": true, "config": {"url": "https://ci.example.com/hook", "insecure_ssl": "0"}},
+			{"id": 2, "events": ["push"], "active": true, "config": {"url": "https://self-signed.example.com/hook", "insecure_ssl": "1"}},
+			{"id": 3, "events": ["push"], "active": true, "config": {"url": "https://legacy.example.com/hook", "insecure_ssl": 1}}
+		]`))
+	})
+
+	client := newTestClient(t, handler)
+
+	webhooks, err := client.ListWebhooks("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListWebhooks failed: %v", err)
+	}
+
+	if webhooks[0].InsecureSSL || !webhooks[1].InsecureSSL || !webhooks[2].InsecureSSL {
+		t.Errorf("Expected webhooks 2 and 3 flagged insecure, got %+v", webhooks)
+	}
+
+	health := AnalyzeWebhookHealth(webhooks[1], nil)
+	if !health.InsecureSSL || health.WebhookID != 2 {
+		t.Errorf("Expected health to carry insecure flag for webhook 2, got %+v", health)
+	}
+
+	byRepo := map[string][]Webhook{
+		"owner/repo":  webhooks,
+		"owner/clean": {webhooks[0]},
+	}
+	if count := CountInsecureWebhooks(byRepo); count != 2 {
+		t.Errorf("Expected 2 insecure webhooks, got %d", count)
+	}
+
+	filtered := FilterInsecureWebhooks(byRepo)
+	if _, ok := filtered["owner/clean"]; ok {
+		t.Error("Expected repo without insecure webhooks to be omitted")
+	}
+	if len(filtered["owner/repo"]) != 2 {
+		t.Errorf("Expected 2 insecure webhooks for owner/repo, got %+v", filtered["owner/repo"])
+	}
+}
+
+// TestRecommendWebhookActions tests disable, investigate and keep recommendations
+func TestRecommendWebhookActions(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	stale := now.AddDate(0, 0, -45)
+	recent := now.AddDate(0, 0, -2)
+
+	webhooks := []Webhook{
+		{ID: 1, URL: "https://dead.example.com/hook", Active: true},
+		{ID: 2, URL: "https://flaky.example.com/hook", Active: true},
+		{ID: 3, URL: "https://quiet.example.com/hook", Active: true},
+		{ID: 4, URL: "https://ci.example.com/hook", Active: true},
+		{ID: 5, URL: "https://new.example.com/hook", Active: true},
+		{ID: 6, URL: "https://off.example.com/hook", Active: false},
+	}
+	health := map[int]WebhookHealth{
+		1: {WebhookID: 1, SuccessRate: 20, TotalDeliveries: 5, Failures: 4, LastDelivery: stale},
+		2: {WebhookID: 2, SuccessRate: 20, TotalDeliveries: 5, Failures: 4, LastDelivery: recent},
+		3: {WebhookID: 3, SuccessRate: 100, TotalDeliveries: 3, LastDelivery: stale},
+		4: {WebhookID: 4, SuccessRate: 98, TotalDeliveries: 50, Failures: 1, LastDelivery: recent},
+		6: {WebhookID: 6, SuccessRate: 0, TotalDeliveries: 2, Failures: 2, LastDelivery: stale},
+	}
+
+	recs := recommendWebhookActionsAt(webhooks, health, DefaultWebhookInactiveDays, DefaultWebhookMinSuccessRate, now)
+	if len(recs) != 5 {
+		t.Fatalf("Expected recommendations for the 5 active webhooks, got %d: %+v", len(recs), recs)
+	}
+
+	expected := map[int]string{
+		1: WebhookActionDisable,
+		2: WebhookActionInvestigate,
+		3: WebhookActionInvestigate,
+		4: WebhookActionKeep,
+		5: WebhookActionInvestigate,
+	}
+	for _, rec := range recs {
+		if rec.Action != expected[rec.WebhookID] {
+			t.Errorf("Webhook %d: expected %s, got %s (%s)", rec.WebhookID, expected[rec.WebhookID], rec.Action, rec.Reason)
+		}
+	}
+
+	if recs[0].URL != "https://dead.example.com/hook" || recs[0].Reason != "No deliveries in 30+ days and 20% success rate" {
+		t.Errorf("Unexpected disable recommendation: %+v", recs[0])
+	}
+}
+
+// TestAnalyzeWebhookHealthLastDelivery tests that the newest parseable timestamp is kept
+func TestAnalyzeWebhookHealthLastDelivery(t *testing.T) {
+	health := AnalyzeWebhookHealth(Webhook{ID: 7}, []WebhookDelivery{
+		{ID: 2, Status: 200, Timestamp: "2024-01-02T00:00:00Z"},
+		{ID: 3, Status: 500, Timestamp: "not a time"},
+		{ID: 1, Status: 200, Timestamp: "2024-01-01T00:00:00Z"},
+	})
+
+	if expected := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC); !health.LastDelivery.Equal(expected) {
+		t.Errorf("Expected last delivery %v, got %v", expected, health.LastDelivery)
+	}
+}
+
+// TestDisableWebhook tests the PATCH request that deactivates a webhook
+func TestDisableWebhook(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/repos/owner/repo/hooks/7" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		if active, ok := body["active"].(bool); !ok || active {
+			t.Errorf("Expected active=false, got %v", body)
+		}
+
+		w.Write([]byte(`{"id": 7, "active": false}`))
+	})
+
+	client := newTestClient(t, handler)
+	if err := client.DisableWebhook("owner", "repo", 7); err != nil {
+		t.Fatalf("DisableWebhook failed: %v", err)
+	}
+}
@@ -0,0 +1,210 @@
+package jira
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Client represents a Jira Cloud REST API client
+type Client struct {
+	baseURL    string
+	authHeader string
+	httpClient *http.Client
+}
+
+// NewClient creates a Jira client for a site such as https://your-org.atlassian.net
+// Requests authenticate with an Atlassian API token for email
+func NewClient(baseURL, email, apiToken string) *Client {
+	credentials := base64.StdEncoding.EncodeToString([]byte(email + ":" + apiToken))
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		authHeader: "Basic " + credentials,
+		httpClient: &http.Client{},
+	}
+}
+
+// Issue represents a Jira issue
+type Issue struct {
+	Key      string
+	Title    string
+	Status   string
+	Assignee string
+	Project  string
+}
+
+// issueResponse is the subset of the REST v3 issue resource that is used
+type issueResponse struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary string `json:"summary"`
+		Status  struct {
+			Name string `json:"name"`
+		} `json:"status"`
+		Assignee *struct {
+			DisplayName string `json:"displayName"`
+		} `json:"assignee"`
+		Project struct {
+			Name string `json:"name"`
+		} `json:"project"`
+	} `json:"fields"`
+}
+
+// GetIssue retrieves an issue by key, e.g. PROJ-123
+func (c *Client) GetIssue(issueKey string) (*Issue, error) {
+	endpoint := fmt.Sprintf("%s/rest/api/3/issue/%s?fields=summary,status,assignee,project", c.baseURL, url.PathEscape(issueKey))
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", c.authHeader)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get issue %s: HTTP %d", issueKey, resp.StatusCode)
+	}
+
+	var response issueResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	issue := &Issue{
+		Key:     response.Key,
+		Title:   response.Fields.Summary,
+		Status:  response.Fields.Status.Name,
+		Project: response.Fields.Project.Name,
+	}
+
+	if response.Fields.Assignee != nil {
+		issue.Assignee = response.Fields.Assignee.DisplayName
+	}
+
+	return issue, nil
+}
+
+// issueKeyPattern matches Jira issue keys: an uppercase project key, a hyphen, and a number
+var issueKeyPattern = regexp.MustCompile(`\b([A-Z][A-Z0-9_]+-[1-9]\d*)\b`)
+
+// ExtractJiraIssueIDs extracts Jira issue keys such as PROJ-123 from a PR body
+// Pure function: keys are deduplicated and returned in order of first appearance
+func ExtractJiraIssueIDs(body string) []string {
+	matches := issueKeyPattern.FindAllStringSubmatch(body, -1)
+
+	seen := make(map[string]bool)
+	ids := make([]string, 0, len(matches))
+	for _, match := range matches {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			ids = append(ids, match[1])
+		}
+	}
+
+	return ids
+}
+
+// PRIssuePair represents a GitHub PR linked to a Jira issue
+type PRIssuePair struct {
+	Repository  string
+	PRNumber    int
+	PRStatus    string // open, merged, closed
+	PRTitle     string
+	IssueID     string
+	Issue       *Issue
+	InSync      bool
+	DriftReason string // Why they're out of sync
+}
+
+// Jira statuses that mean the work shipped, or was abandoned
+var (
+	doneStatuses      = []string{"Done", "Closed", "Resolved"}
+	abandonedStatuses = []string{"Closed", "Won't Do", "Canceled", "Cancelled"}
+)
+
+func hasStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if strings.EqualFold(s, status) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckPRIssueSyncStatus determines if a PR and Jira issue are in sync
+// Pure function: compares states
+func CheckPRIssueSyncStatus(prStatus, issueStatus string) (bool, string) {
+	// Expected state transitions:
+	// PR open -> Issue should be "To Do" or "In Progress"
+	// PR merged -> Issue should be "Done" or "Closed"
+	// PR closed (not merged) -> Issue should be "Closed" or "Won't Do"
+
+	switch prStatus {
+	case "merged":
+		if hasStatus(doneStatuses, issueStatus) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("PR merged but issue is '%s' (expected Done/Closed)", issueStatus)
+
+	case "closed":
+		if hasStatus(abandonedStatuses, issueStatus) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("PR closed but issue is '%s' (expected Closed/Won't Do)", issueStatus)
+
+	case "open":
+		if hasStatus(doneStatuses, issueStatus) {
+			return false, fmt.Sprintf("PR open but issue is '%s' (expected To Do/In Progress)", issueStatus)
+		}
+		return true, ""
+
+	default:
+		return true, "" // Unknown status, assume in sync
+	}
+}
+
+// AnalyzePRIssueLinks analyzes PR-issue pairs for sync status
+// Pure function: maps over pairs to check sync
+func AnalyzePRIssueLinks(pairs []PRIssuePair) []PRIssuePair {
+	analyzed := make([]PRIssuePair, len(pairs))
+
+	for i, pair := range pairs {
+		analyzed[i] = pair
+
+		if pair.Issue != nil {
+			inSync, reason := CheckPRIssueSyncStatus(pair.PRStatus, pair.Issue.Status)
+			analyzed[i].InSync = inSync
+			analyzed[i].DriftReason = reason
+		} else {
+			analyzed[i].InSync = false
+			analyzed[i].DriftReason = "Issue not found"
+		}
+	}
+
+	return analyzed
+}
+
+// FilterOutOfSyncPairs filters pairs that are out of sync
+// Pure function: filter predicate
+func FilterOutOfSyncPairs(pairs []PRIssuePair) []PRIssuePair {
+	outOfSync := make([]PRIssuePair, 0)
+
+	for _, pair := range pairs {
+		if !pair.InSync {
+			outOfSync = append(outOfSync, pair)
+		}
+	}
+
+	return outOfSync
+}
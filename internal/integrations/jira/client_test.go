@@ -0,0 +1,133 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestGetIssue tests authentication and decoding of the REST v3 issue resource
+func TestGetIssue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-123" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		// base64("me@example.com:secret")
+		if got := r.Header.Get("Authorization"); got != "Basic bWVAZXhhbXBsZS5jb206c2VjcmV0" {
+			t.Errorf("Unexpected Authorization header %q", got)
+		}
+		w.Write([]byte(`{
+			"key": "PROJ-123",
+			"fields": {
+				"summary": "Fix login",
+				"status": {"name": "In Progress"},
+				"assignee": {"displayName": "Sam Doe"},
+				"project": {"name": "Project"}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL+"/", "me@example.com", "secret")
+	issue, err := client.GetIssue("PROJ-123")
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+
+	expected := &Issue{Key: "PROJ-123", Title: "Fix login", Status: "In Progress", Assignee: "Sam Doe", Project: "Project"}
+	if !reflect.DeepEqual(issue, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, issue)
+	}
+}
+
+// TestGetIssueNotFound tests that HTTP errors are returned
+func TestGetIssueNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errorMessages": ["Issue does not exist"]}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(server.URL, "me@example.com", "secret").GetIssue("PROJ-404"); err == nil {
+		t.Error("Expected an error for a missing issue")
+	}
+}
+
+// TestExtractJiraIssueIDs tests issue key extraction from PR bodies
+func TestExtractJiraIssueIDs(t *testing.T) {
+	tests := []struct {
+		name        string
+		prBody      string
+		expectedIDs []string
+	}{
+		{"bare key", "Implements PROJ-123", []string{"PROJ-123"}},
+		{"linking keywords", "Fixes PROJ-1, closes OPS2-45", []string{"PROJ-1", "OPS2-45"}},
+		{"branch-style reference", "From feature/PROJ-77-login", []string{"PROJ-77"}},
+		{"duplicates", "PROJ-123 and again PROJ-123", []string{"PROJ-123"}},
+		{"lowercase ignored", "see proj-123", []string{}},
+		{"single letter project ignored", "A-1 is not a Jira key", []string{}},
+		{"no references", "A regular PR", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := ExtractJiraIssueIDs(tt.prBody)
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("Expected %v, got %v", tt.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+// TestCheckPRIssueSyncStatus tests sync status detection for Jira statuses
+func TestCheckPRIssueSyncStatus(t *testing.T) {
+	tests := []struct {
+		prStatus    string
+		issueStatus string
+		expectSync  bool
+	}{
+		{"merged", "Done", true},
+		{"merged", "Closed", true},
+		{"merged", "In Progress", false},
+		{"merged", "To Do", false},
+		{"closed", "Closed", true},
+		{"closed", "Won't Do", true},
+		{"closed", "Done", false},
+		{"open", "To Do", true},
+		{"open", "In Progress", true},
+		{"open", "Done", false},
+		{"open", "closed", false},
+		{"draft", "Done", true},
+	}
+
+	for _, tt := range tests {
+		inSync, reason := CheckPRIssueSyncStatus(tt.prStatus, tt.issueStatus)
+		if inSync != tt.expectSync {
+			t.Errorf("CheckPRIssueSyncStatus(%q, %q) = %v, want %v", tt.prStatus, tt.issueStatus, inSync, tt.expectSync)
+		}
+		if !inSync && reason == "" {
+			t.Errorf("CheckPRIssueSyncStatus(%q, %q): expected a drift reason", tt.prStatus, tt.issueStatus)
+		}
+	}
+}
+
+// TestAnalyzeAndFilterPRIssueLinks tests analyzing pairs and keeping those out of sync
+func TestAnalyzeAndFilterPRIssueLinks(t *testing.T) {
+	pairs := []PRIssuePair{
+		{PRNumber: 1, PRStatus: "merged", IssueID: "PROJ-1", Issue: &Issue{Status: "Done"}},
+		{PRNumber: 2, PRStatus: "merged", IssueID: "PROJ-2", Issue: &Issue{Status: "In Progress"}},
+		{PRNumber: 3, PRStatus: "open", IssueID: "PROJ-3"},
+	}
+
+	outOfSync := FilterOutOfSyncPairs(AnalyzePRIssueLinks(pairs))
+
+	if len(outOfSync) != 2 {
+		t.Fatalf("Expected 2 out-of-sync pairs, got %d", len(outOfSync))
+	}
+	if outOfSync[0].IssueID != "PROJ-2" || outOfSync[0].DriftReason == "" {
+		t.Errorf("Unexpected first pair: %+v", outOfSync[0])
+	}
+	if outOfSync[1].IssueID != "PROJ-3" || outOfSync[1].DriftReason != "Issue not found" {
+		t.Errorf("Unexpected second pair: %+v", outOfSync[1])
+	}
+}
@@ -0,0 +1,32 @@
+package orphans
+
+// CleanupCriteria selects which orphaned branches automated cleanup deletes
+type CleanupCriteria struct {
+	Types      []OrphanType // Empty selects nothing
+	MinAgeDays int
+	MaxDelete  int // 0 means unlimited
+}
+
+// SelectForCleanup returns the unprotected orphans matching criteria, highest priority first
+// Pure function: also returns how many matches were dropped by MaxDelete
+func SelectForCleanup(branches []OrphanedBranch, criteria CleanupCriteria) (selected []OrphanedBranch, overLimit int) {
+	types := make(map[OrphanType]bool, len(criteria.Types))
+	for _, t := range criteria.Types {
+		types[t] = true
+	}
+
+	for _, branch := range branches {
+		if branch.Protected || !types[branch.Type] || branch.DaysSinceActivity < criteria.MinAgeDays {
+			continue
+		}
+		selected = append(selected, branch)
+	}
+
+	SortByPriority(selected)
+	if criteria.MaxDelete > 0 && len(selected) > criteria.MaxDelete {
+		overLimit = len(selected) - criteria.MaxDelete
+		selected = selected[:criteria.MaxDelete]
+	}
+
+	return selected, overLimit
+}
@@ -0,0 +1,36 @@
+package orphans
+
+import "testing"
+
+func TestSelectForCleanup(t *testing.T) {
+	branches := []OrphanedBranch{
+		{Repository: "acme/api", BranchName: "merged-old", Type: OrphanTypeMergedPR, DaysSinceActivity: 45},
+		{Repository: "acme/api", BranchName: "merged-new", Type: OrphanTypeMergedPR, DaysSinceActivity: 5},
+		{Repository: "acme/api", BranchName: "merged-protected", Type: OrphanTypeMergedPR, DaysSinceActivity: 90, Protected: true},
+		{Repository: "acme/web", BranchName: "stale", Type: OrphanTypeStale, DaysSinceActivity: 200},
+		{Repository: "acme/web", BranchName: "closed", Type: OrphanTypeClosedPR, DaysSinceActivity: 60},
+	}
+
+	selected, overLimit := SelectForCleanup(branches, CleanupCriteria{
+		Types:      []OrphanType{OrphanTypeMergedPR, OrphanTypeStale},
+		MinAgeDays: 30,
+	})
+	if overLimit != 0 {
+		t.Errorf("Expected no matches over the limit, got %d", overLimit)
+	}
+	if len(selected) != 2 || selected[0].BranchName != "stale" || selected[1].BranchName != "merged-old" {
+		t.Errorf("Expected [stale merged-old], got %+v", selected)
+	}
+
+	selected, overLimit = SelectForCleanup(branches, CleanupCriteria{
+		Types:     []OrphanType{OrphanTypeMergedPR, OrphanTypeStale, OrphanTypeClosedPR},
+		MaxDelete: 2,
+	})
+	if len(selected) != 2 || overLimit != 2 {
+		t.Errorf("Expected 2 selected and 2 over the limit, got %d and %d", len(selected), overLimit)
+	}
+
+	if selected, _ := SelectForCleanup(branches, CleanupCriteria{}); len(selected) != 0 {
+		t.Errorf("Expected no types to select nothing, got %+v", selected)
+	}
+}
@@ -2,6 +2,7 @@ package orphans
 
 import (
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
@@ -24,6 +25,10 @@ func (d *Detector) ClassifyBranch(
 		return nil
 	}
 
+	if !matchesAnyPattern(branch.Name, d.options.IncludePatterns) {
+		return nil
+	}
+
 	if branch.Protected && !d.options.IncludeProtected {
 		return nil
 	}
@@ -31,9 +36,13 @@ func (d *Detector) ClassifyBranch(
 	daysSince := int(time.Since(branch.LastCommitDate).Hours() / 24)
 
 	var mergedPR, closedPR, openPR *github.PullRequest
+	excludedByLabel := false
 	for i := range prs {
 		pr := &prs[i]
 		if pr.Head.Ref == branch.Name {
+			if pr.State == "closed" && d.hasExcludedLabel(*pr) {
+				excludedByLabel = true
+			}
 			switch {
 			case pr.MergedAt != nil:
 				mergedPR = pr
@@ -45,7 +54,7 @@ func (d *Detector) ClassifyBranch(
 		}
 	}
 
-	if openPR != nil {
+	if openPR != nil || excludedByLabel {
 		return nil
 	}
 
@@ -83,6 +92,18 @@ func (d *Detector) ClassifyBranch(
 	return nil
 }
 
+// hasExcludedLabel reports whether a pull request carries one of the ExcludePRLabels (case-insensitive)
+func (d *Detector) hasExcludedLabel(pr github.PullRequest) bool {
+	for _, label := range pr.Labels {
+		for _, excluded := range d.options.ExcludePRLabels {
+			if strings.EqualFold(label, excluded) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (d *Detector) shouldExclude(branchName string) bool {
 	for _, pattern := range d.options.ExcludePatterns {
 		matched, err := filepath.Match(pattern, branchName)
@@ -96,3 +117,51 @@ func (d *Detector) shouldExclude(branchName string) bool {
 	}
 	return false
 }
+
+// DetectOrphanedTags flags tags that have no release, are older than MaxAgeDays, and match IncludePatterns
+func DetectOrphanedTags(tags []github.Tag, releases []github.Release, config TagOrphanConfig) []OrphanedTag {
+	releaseTags := make(map[string]bool, len(releases))
+	for _, r := range releases {
+		releaseTags[r.TagName] = true
+	}
+
+	var orphaned []OrphanedTag
+	for _, tag := range tags {
+		if tag.IsRelease || releaseTags[tag.Name] {
+			continue
+		}
+
+		if !matchesAnyPattern(tag.Name, config.IncludePatterns) {
+			continue
+		}
+
+		daysSince := int(time.Since(tag.CreatedAt).Hours() / 24)
+		if daysSince < config.MaxAgeDays {
+			continue
+		}
+
+		orphaned = append(orphaned, OrphanedTag{
+			Repository:       tag.Repository,
+			TagName:          tag.Name,
+			SHA:              tag.SHA,
+			CreatedAt:        tag.CreatedAt,
+			Type:             OrphanTypeStaleTag,
+			DaysSinceCreated: daysSince,
+		})
+	}
+
+	return orphaned
+}
+
+func matchesAnyPattern(name string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if matched, err := filepath.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
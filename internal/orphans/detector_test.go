@@ -126,6 +126,47 @@ func TestDetector_ClassifyBranch_OpenPR(t *testing.T) {
 	}
 }
 
+func TestDetector_ClassifyBranch_ExcludedPRLabel(t *testing.T) {
+	opts := DefaultScanOptions()
+	opts.ExcludePRLabels = []string{"do-not-delete", "long-lived"}
+	detector := NewDetector(opts)
+
+	repo := github.Repository{
+		Name:          "test-repo",
+		FullName:      "owner/test-repo",
+		Owner:         "owner",
+		DefaultBranch: "main",
+	}
+
+	branch := github.Branch{
+		Name:           "feature-branch",
+		SHA:            "abc123",
+		Protected:      false,
+		LastCommitDate: time.Now().Add(-24 * time.Hour),
+	}
+
+	mergedAt := time.Now().Add(-12 * time.Hour)
+	prs := []github.PullRequest{
+		{
+			Number:   4,
+			Title:    "Keep this branch",
+			State:    "closed",
+			Head:     github.PRRef{Ref: "feature-branch"},
+			MergedAt: &mergedAt,
+			Labels:   []string{"enhancement", "do-not-delete"},
+		},
+	}
+
+	if orphan := detector.ClassifyBranch(repo, branch, prs); orphan != nil {
+		t.Errorf("expected nil for PR labeled do-not-delete, got %+v", orphan)
+	}
+
+	prs[0].Labels = []string{"enhancement"}
+	if orphan := detector.ClassifyBranch(repo, branch, prs); orphan == nil || orphan.Type != OrphanTypeMergedPR {
+		t.Errorf("expected merged_pr orphan without an excluded label, got %+v", orphan)
+	}
+}
+
 func TestDetector_ClassifyBranch_Stale(t *testing.T) {
 	opts := DefaultScanOptions()
 	opts.StaleDaysThreshold = 7
@@ -268,6 +309,44 @@ func TestDetector_ClassifyBranch_ExcludeWildcard(t *testing.T) {
 	}
 }
 
+func TestDetector_ClassifyBranch_IncludePatterns(t *testing.T) {
+	opts := DefaultScanOptions()
+	opts.IncludePatterns = []string{"feature/*"}
+	detector := NewDetector(opts)
+
+	repo := github.Repository{
+		Name:          "test-repo",
+		FullName:      "owner/test-repo",
+		Owner:         "owner",
+		DefaultBranch: "main",
+	}
+
+	stale := time.Now().Add(-30 * 24 * time.Hour)
+
+	if orphan := detector.ClassifyBranch(repo, github.Branch{Name: "bugfix/foo", LastCommitDate: stale}, nil); orphan != nil {
+		t.Errorf("expected nil for branch outside include patterns, got %+v", orphan)
+	}
+
+	orphan := detector.ClassifyBranch(repo, github.Branch{Name: "feature/foo", LastCommitDate: stale}, nil)
+	if orphan == nil || orphan.Type != OrphanTypeStale {
+		t.Errorf("expected stale orphan for included branch 'feature/foo', got %+v", orphan)
+	}
+}
+
+func TestDetector_ClassifyBranch_ExcludeBeforeInclude(t *testing.T) {
+	opts := DefaultScanOptions()
+	opts.ExcludePatterns = []string{"feature/keep"}
+	opts.IncludePatterns = []string{"feature/*"}
+	detector := NewDetector(opts)
+
+	repo := github.Repository{FullName: "owner/test-repo"}
+	branch := github.Branch{Name: "feature/keep", LastCommitDate: time.Now().Add(-30 * 24 * time.Hour)}
+
+	if orphan := detector.ClassifyBranch(repo, branch, nil); orphan != nil {
+		t.Errorf("expected exclude pattern to win over include pattern, got %+v", orphan)
+	}
+}
+
 func TestDetector_ClassifyBranch_ProtectedSkipped(t *testing.T) {
 	opts := DefaultScanOptions()
 	opts.IncludeProtected = false
@@ -334,6 +413,7 @@ func TestOrphanType_Label(t *testing.T) {
 		{OrphanTypeClosedPR, "Closed PR"},
 		{OrphanTypeStale, "Stale"},
 		{OrphanTypeRecentNoPR, "Recent (no PR)"},
+		{OrphanTypeStaleTag, "Stale Tag"},
 	}
 
 	for _, tt := range tests {
@@ -381,3 +461,56 @@ func TestDefaultScanOptions(t *testing.T) {
 		t.Errorf("ExcludePatterns length = %d, want %d", len(opts.ExcludePatterns), len(expectedExcludes))
 	}
 }
+
+func TestDetectOrphanedTags_ReleaseBacked(t *testing.T) {
+	old := time.Now().Add(-400 * 24 * time.Hour)
+	tags := []github.Tag{
+		{Name: "v1.0.0", SHA: "aaa", Repository: "owner/repo", CreatedAt: old, IsRelease: true},
+		{Name: "v0.9.0", SHA: "bbb", Repository: "owner/repo", CreatedAt: old},
+	}
+	releases := []github.Release{{Repository: "owner/repo", TagName: "v0.9.0"}}
+
+	orphaned := DetectOrphanedTags(tags, releases, TagOrphanConfig{MaxAgeDays: 180})
+
+	if len(orphaned) != 0 {
+		t.Errorf("Expected release-backed tags to be skipped, got %+v", orphaned)
+	}
+}
+
+func TestDetectOrphanedTags_OldUnreleased(t *testing.T) {
+	tags := []github.Tag{
+		{Name: "experiment-1", SHA: "ccc", Repository: "owner/repo", CreatedAt: time.Now().Add(-200 * 24 * time.Hour)},
+		{Name: "experiment-2", SHA: "ddd", Repository: "owner/repo", CreatedAt: time.Now().Add(-10 * 24 * time.Hour)},
+	}
+
+	orphaned := DetectOrphanedTags(tags, nil, TagOrphanConfig{MaxAgeDays: 180})
+
+	if len(orphaned) != 1 {
+		t.Fatalf("Expected 1 orphaned tag, got %d", len(orphaned))
+	}
+
+	tag := orphaned[0]
+	if tag.TagName != "experiment-1" || tag.SHA != "ccc" {
+		t.Errorf("Expected experiment-1 to be flagged, got %s", tag.TagName)
+	}
+	if tag.Type != OrphanTypeStaleTag {
+		t.Errorf("Expected type %s, got %s", OrphanTypeStaleTag, tag.Type)
+	}
+	if tag.DaysSinceCreated < 199 {
+		t.Errorf("Expected ~200 days since created, got %d", tag.DaysSinceCreated)
+	}
+}
+
+func TestDetectOrphanedTags_IncludePatterns(t *testing.T) {
+	old := time.Now().Add(-400 * 24 * time.Hour)
+	tags := []github.Tag{
+		{Name: "deploy-2023-01", Repository: "owner/repo", CreatedAt: old},
+		{Name: "v0.1.0", Repository: "owner/repo", CreatedAt: old},
+	}
+
+	orphaned := DetectOrphanedTags(tags, nil, TagOrphanConfig{MaxAgeDays: 180, IncludePatterns: []string{"deploy-*"}})
+
+	if len(orphaned) != 1 || orphaned[0].TagName != "deploy-2023-01" {
+		t.Errorf("Expected only deploy-2023-01 to match, got %+v", orphaned)
+	}
+}
@@ -0,0 +1,31 @@
+package orphans
+
+import "sort"
+
+// PriorityScore ranks how safe and worthwhile an orphan is to clean up; higher is first
+// Merged PR branches score 10, closed PR 8, stale 5 per 30 days inactive, recent without a PR 1
+func PriorityScore(o OrphanedBranch) int {
+	switch o.Type {
+	case OrphanTypeMergedPR:
+		return 10
+	case OrphanTypeClosedPR:
+		return 8
+	case OrphanTypeStale:
+		return 5 * (o.DaysSinceActivity / 30)
+	case OrphanTypeRecentNoPR:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// SortByPriority sorts orphans by PriorityScore descending, then by key
+func SortByPriority(orphans []OrphanedBranch) {
+	sort.SliceStable(orphans, func(i, j int) bool {
+		si, sj := PriorityScore(orphans[i]), PriorityScore(orphans[j])
+		if si != sj {
+			return si > sj
+		}
+		return orphans[i].Key() < orphans[j].Key()
+	})
+}
@@ -0,0 +1,43 @@
+package orphans
+
+import "testing"
+
+func TestPriorityScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		orphan OrphanedBranch
+		want   int
+	}{
+		{"merged PR ignores age", OrphanedBranch{Type: OrphanTypeMergedPR, DaysSinceActivity: 60}, 10},
+		{"closed PR", OrphanedBranch{Type: OrphanTypeClosedPR, DaysSinceActivity: 3}, 8},
+		{"stale scales per 30 days", OrphanedBranch{Type: OrphanTypeStale, DaysSinceActivity: 95}, 15},
+		{"stale under 30 days", OrphanedBranch{Type: OrphanTypeStale, DaysSinceActivity: 20}, 0},
+		{"recent without PR", OrphanedBranch{Type: OrphanTypeRecentNoPR, DaysSinceActivity: 2}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PriorityScore(tt.orphan); got != tt.want {
+				t.Errorf("PriorityScore() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortByPriority(t *testing.T) {
+	list := []OrphanedBranch{
+		{Repository: "acme/api", BranchName: "wip", Type: OrphanTypeRecentNoPR},
+		{Repository: "acme/web", BranchName: "old", Type: OrphanTypeStale, DaysSinceActivity: 400},
+		{Repository: "acme/web", BranchName: "feat", Type: OrphanTypeMergedPR},
+		{Repository: "acme/api", BranchName: "fix", Type: OrphanTypeMergedPR},
+	}
+
+	SortByPriority(list)
+
+	want := []string{"acme/web/old", "acme/api/fix", "acme/web/feat", "acme/api/wip"}
+	for i, key := range want {
+		if list[i].Key() != key {
+			t.Errorf("Position %d: expected %s, got %s", i, key, list[i].Key())
+		}
+	}
+}
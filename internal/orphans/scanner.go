@@ -30,34 +30,33 @@ func (s *NamespaceScanner) ScanNamespace(ctx context.Context, namespace string)
 	return s.ScanNamespaceWithProgress(ctx, namespace, nil)
 }
 
+// ScanNamespaceWithProgress scans a namespace, publishing progress after each repo
+// progressCh should have a buffer of 1: it holds only the newest progress, replacing any value not yet read
 func (s *NamespaceScanner) ScanNamespaceWithProgress(
 	ctx context.Context,
 	namespace string,
-	progressCh chan<- ScanProgress,
+	progressCh chan ScanProgress,
 ) (*NamespaceScanResult, error) {
 	repos, isOrg, err := s.client.ListNamespaceRepositories(namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	var nonArchivedRepos []github.Repository
-	for _, repo := range repos {
-		if !repo.Archived {
-			nonArchivedRepos = append(nonArchivedRepos, repo)
-		}
-	}
+	scanRepos, archivedSkipped := filterArchived(repos, s.options.IncludeArchived)
 
 	result := &NamespaceScanResult{
-		Namespace:  namespace,
-		IsOrg:      isOrg,
-		TotalRepos: len(nonArchivedRepos),
+		Namespace:       namespace,
+		IsOrg:           isOrg,
+		TotalRepos:      len(repos),
+		ArchivedSkipped: archivedSkipped,
+		ScannedRepos:    len(scanRepos),
 	}
 
-	if len(nonArchivedRepos) == 0 {
+	if len(scanRepos) == 0 {
 		return result, nil
 	}
 
-	resultsCh := make(chan ScanResult, len(nonArchivedRepos))
+	resultsCh := make(chan ScanResult, len(scanRepos))
 	semaphore := make(chan struct{}, s.options.Concurrency)
 
 	var wg sync.WaitGroup
@@ -65,7 +64,7 @@ func (s *NamespaceScanner) ScanNamespaceWithProgress(
 	scannedCount := 0
 	totalOrphans := 0
 
-	for _, repo := range nonArchivedRepos {
+	for _, repo := range scanRepos {
 		wg.Add(1)
 		go func(repo github.Repository) {
 			defer wg.Done()
@@ -86,16 +85,12 @@ func (s *NamespaceScanner) ScanNamespaceWithProgress(
 				totalOrphans += len(scanResult.Orphans)
 				progress := ScanProgress{
 					Current:     scannedCount,
-					Total:       len(nonArchivedRepos),
+					Total:       len(scanRepos),
 					CurrentRepo: repo.FullName,
 					Orphans:     totalOrphans,
 				}
+				publishLatest(progressCh, progress)
 				progressMu.Unlock()
-
-				select {
-				case progressCh <- progress:
-				default:
-				}
 			}
 		}(repo)
 	}
@@ -106,13 +101,42 @@ func (s *NamespaceScanner) ScanNamespaceWithProgress(
 	}()
 
 	for scanResult := range resultsCh {
-		result.Results = append(result.Results, scanResult)
-		result.TotalOrphans += len(scanResult.Orphans)
+		result.add(scanResult)
 	}
 
 	return result, nil
 }
 
+// filterArchived drops archived repositories unless includeArchived is set
+// Pure function: returns the repositories to scan and how many were skipped
+func filterArchived(repos []github.Repository, includeArchived bool) ([]github.Repository, int) {
+	if includeArchived {
+		return repos, 0
+	}
+
+	var scan []github.Repository
+	for _, repo := range repos {
+		if !repo.Archived {
+			scan = append(scan, repo)
+		}
+	}
+	return scan, len(repos) - len(scan)
+}
+
+func (s *NamespaceScanner) ScanRepos(ctx context.Context, namespace string, repos []github.Repository) *NamespaceScanResult {
+	result := &NamespaceScanResult{
+		Namespace:    namespace,
+		TotalRepos:   len(repos),
+		ScannedRepos: len(repos),
+	}
+
+	for _, repo := range repos {
+		result.add(s.ScanRepo(ctx, repo))
+	}
+
+	return result
+}
+
 func (s *NamespaceScanner) ScanRepo(ctx context.Context, repo github.Repository) ScanResult {
 	result := ScanResult{
 		Repository:    repo,
@@ -131,6 +155,13 @@ func (s *NamespaceScanner) ScanRepo(ctx context.Context, repo github.Repository)
 		return result
 	}
 
+	if len(s.options.ExcludePRLabels) > 0 {
+		if err := s.attachClosedPRLabels(repo, branches, prs); err != nil {
+			result.Error = err
+			return result
+		}
+	}
+
 	detector := NewDetector(s.options)
 
 	for _, branch := range branches {
@@ -149,5 +180,50 @@ func (s *NamespaceScanner) ScanRepo(ctx context.Context, repo github.Repository)
 		}
 	}
 
+	if s.options.IncludeTags {
+		tags, err := s.client.ListTags(repo.Owner, repo.Name)
+		if err != nil {
+			result.Error = err
+			return result
+		}
+
+		// ListTags already marks release-backed tags
+		result.OrphanedTags = DetectOrphanedTags(tags, nil, s.options.Tags)
+	}
+
 	return result
 }
+
+// attachClosedPRLabels fetches labels for closed PRs whose head branch still exists
+// Open PRs already keep their branch, so their labels are not needed
+func (s *NamespaceScanner) attachClosedPRLabels(repo github.Repository, branches []github.Branch, prs []github.PullRequest) error {
+	existing := make(map[string]bool, len(branches))
+	for _, branch := range branches {
+		existing[branch.Name] = true
+	}
+
+	for i := range prs {
+		if prs[i].State != "closed" || !existing[prs[i].Head.Ref] {
+			continue
+		}
+		labels, err := s.client.GetPRLabels(repo.Owner, repo.Name, prs[i].Number)
+		if err != nil {
+			return err
+		}
+		prs[i].Labels = labels
+	}
+	return nil
+}
+
+// publishLatest replaces any unread value in progressCh so the newest progress always gets through
+// Callers must serialize sends, or an older value could replace a newer one
+func publishLatest(progressCh chan ScanProgress, progress ScanProgress) {
+	select {
+	case <-progressCh:
+	default:
+	}
+	select {
+	case progressCh <- progress:
+	default:
+	}
+}
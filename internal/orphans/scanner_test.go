@@ -0,0 +1,87 @@
+package orphans
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+)
+
+func namespaceRepos() []github.Repository {
+	return []github.Repository{
+		{Name: "api", FullName: "acme/api", Owner: "acme"},
+		{Name: "legacy", FullName: "acme/legacy", Owner: "acme", Archived: true},
+		{Name: "web", FullName: "acme/web", Owner: "acme"},
+		{Name: "old-docs", FullName: "acme/old-docs", Owner: "acme", Archived: true},
+		{Name: "cli", FullName: "acme/cli", Owner: "acme"},
+	}
+}
+
+func TestFilterArchived_SkipsArchived(t *testing.T) {
+	repos := namespaceRepos()
+
+	scan, skipped := filterArchived(repos, false)
+
+	if len(repos) != 5 {
+		t.Fatalf("Expected 5 repos in namespace, got %d", len(repos))
+	}
+	if skipped != 2 {
+		t.Errorf("Expected 2 archived repos skipped, got %d", skipped)
+	}
+	if len(scan) != 3 {
+		t.Fatalf("Expected 3 repos to scan, got %d", len(scan))
+	}
+	for _, repo := range scan {
+		if repo.Archived {
+			t.Errorf("Expected archived repo %s to be skipped", repo.FullName)
+		}
+	}
+}
+
+func TestFilterArchived_IncludeArchived(t *testing.T) {
+	scan, skipped := filterArchived(namespaceRepos(), true)
+
+	if skipped != 0 {
+		t.Errorf("Expected no repos skipped, got %d", skipped)
+	}
+	if len(scan) != 5 {
+		t.Errorf("Expected all 5 repos to scan, got %d", len(scan))
+	}
+}
+
+func TestNamespaceScanResult_CountsErrors(t *testing.T) {
+	repos := namespaceRepos()
+	result := &NamespaceScanResult{}
+
+	result.add(ScanResult{Repository: repos[0], Orphans: []OrphanedBranch{{BranchName: "a"}, {BranchName: "b"}}})
+	result.add(ScanResult{Repository: repos[2], Error: errors.New("403 Forbidden")})
+	result.add(ScanResult{Repository: repos[4], Orphans: []OrphanedBranch{{BranchName: "c"}}})
+
+	if result.ErrorCount != 1 {
+		t.Errorf("Expected 1 error, got %d", result.ErrorCount)
+	}
+	if result.TotalOrphans != 3 {
+		t.Errorf("Expected orphans from the other repos to be kept, got %d", result.TotalOrphans)
+	}
+
+	failed := result.FailedResults()
+	if len(failed) != 1 || failed[0].Repository.FullName != "acme/web" {
+		t.Errorf("Expected acme/web to have failed, got %+v", failed)
+	}
+}
+
+func TestPublishLatest_KeepsNewestProgress(t *testing.T) {
+	progressCh := make(chan ScanProgress, 1)
+	for i := 1; i <= 3; i++ {
+		publishLatest(progressCh, ScanProgress{Current: i, Total: 3})
+	}
+
+	select {
+	case progress := <-progressCh:
+		if progress.Current != 3 {
+			t.Errorf("Expected newest progress 3/3, got %d/%d", progress.Current, progress.Total)
+		}
+	default:
+		t.Fatal("Expected a buffered progress value")
+	}
+}
@@ -0,0 +1,19 @@
+package orphans
+
+import "sort"
+
+// SnapshotEntries lists every orphaned branch and tag in a scan as "key [type]" lines
+// Pure function: entries are sorted so snapshots of the same scan are identical
+func SnapshotEntries(result *NamespaceScanResult) []string {
+	var entries []string
+	for _, scanResult := range result.Results {
+		for _, orphan := range scanResult.Orphans {
+			entries = append(entries, orphan.Key()+" ["+string(orphan.Type)+"]")
+		}
+		for _, tag := range scanResult.OrphanedTags {
+			entries = append(entries, tag.Key()+" ["+string(tag.Type)+"]")
+		}
+	}
+	sort.Strings(entries)
+	return entries
+}
@@ -13,6 +13,7 @@ const (
 	OrphanTypeClosedPR   OrphanType = "closed_pr"
 	OrphanTypeStale      OrphanType = "stale"
 	OrphanTypeRecentNoPR OrphanType = "recent_no_pr"
+	OrphanTypeStaleTag   OrphanType = "stale_tag"
 )
 
 func (t OrphanType) Label() string {
@@ -25,6 +26,8 @@ func (t OrphanType) Label() string {
 		return "Stale"
 	case OrphanTypeRecentNoPR:
 		return "Recent (no PR)"
+	case OrphanTypeStaleTag:
+		return "Stale Tag"
 	default:
 		return string(t)
 	}
@@ -46,9 +49,24 @@ func (o OrphanedBranch) Key() string {
 	return o.Repository + "/" + o.BranchName
 }
 
+// OrphanedTag is a tag with no release that is older than the configured threshold
+type OrphanedTag struct {
+	Repository       string
+	TagName          string
+	SHA              string
+	CreatedAt        time.Time
+	Type             OrphanType
+	DaysSinceCreated int
+}
+
+func (o OrphanedTag) Key() string {
+	return o.Repository + "@" + o.TagName
+}
+
 type ScanResult struct {
 	Repository    github.Repository
 	Orphans       []OrphanedBranch
+	OrphanedTags  []OrphanedTag
 	DefaultBranch string
 	Error         error
 }
@@ -57,8 +75,33 @@ type NamespaceScanResult struct {
 	Namespace   string
 	IsOrg       bool
 	Results     []ScanResult
-	TotalRepos  int
+	TotalRepos  int // Repositories listed in the namespace, including archived ones
+	ArchivedSkipped int
+	ScannedRepos int
 	TotalOrphans int
+	TotalOrphanedTags int
+	ErrorCount   int // Repositories whose scan failed; their results are missing or partial
+}
+
+// add records one repository's scan in the totals
+func (r *NamespaceScanResult) add(scanResult ScanResult) {
+	r.Results = append(r.Results, scanResult)
+	r.TotalOrphans += len(scanResult.Orphans)
+	r.TotalOrphanedTags += len(scanResult.OrphanedTags)
+	if scanResult.Error != nil {
+		r.ErrorCount++
+	}
+}
+
+// FailedResults returns the scans that ended with an error
+func (r *NamespaceScanResult) FailedResults() []ScanResult {
+	var failed []ScanResult
+	for _, result := range r.Results {
+		if result.Error != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
 }
 
 func (r *NamespaceScanResult) AllOrphans() []OrphanedBranch {
@@ -69,6 +112,14 @@ func (r *NamespaceScanResult) AllOrphans() []OrphanedBranch {
 	return all
 }
 
+func (r *NamespaceScanResult) AllOrphanedTags() []OrphanedTag {
+	var all []OrphanedTag
+	for _, result := range r.Results {
+		all = append(all, result.OrphanedTags...)
+	}
+	return all
+}
+
 func (r *NamespaceScanResult) OrphansByType(t OrphanType) []OrphanedBranch {
 	var filtered []OrphanedBranch
 	for _, orphan := range r.AllOrphans() {
@@ -79,12 +130,23 @@ func (r *NamespaceScanResult) OrphansByType(t OrphanType) []OrphanedBranch {
 	return filtered
 }
 
+// TagOrphanConfig controls which tags are reported as orphaned
+type TagOrphanConfig struct {
+	MaxAgeDays      int
+	IncludePatterns []string // Empty matches every tag
+}
+
 type ScanOptions struct {
 	StaleDaysThreshold int
 	IncludeRecentNoPR  bool
 	ExcludePatterns    []string
+	IncludePatterns    []string // When non-empty, only matching branches are evaluated
 	IncludeProtected   bool
 	Concurrency        int
+	IncludeTags        bool
+	Tags               TagOrphanConfig
+	IncludeArchived    bool     // Scan archived repositories instead of skipping them
+	ExcludePRLabels    []string // Branches whose closed or merged PR has one of these labels are kept
 }
 
 func DefaultScanOptions() ScanOptions {
@@ -100,5 +162,9 @@ func DefaultScanOptions() ScanOptions {
 		},
 		IncludeProtected: false,
 		Concurrency:      5,
+		IncludeTags:      false,
+		Tags: TagOrphanConfig{
+			MaxAgeDays: 180,
+		},
 	}
 }
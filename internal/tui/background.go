@@ -0,0 +1,184 @@
+package tui
+
+import (
+	"sync"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// backgroundPollInterval is how often the main model collects detached task results
+const backgroundPollInterval = 500 * time.Millisecond
+
+// backgroundResultsBuffer bounds finished background results waiting to be polled
+const backgroundResultsBuffer = 16
+
+// BackgroundResults carries the results of detached tasks back to the main model
+type BackgroundResults chan tea.Msg
+
+// BackgroundTask runs a view's long-running command in its own goroutine so it can be
+// detached with ctrl+z while the user navigates to other views
+type BackgroundTask struct {
+	ID      int
+	View    ViewMode
+	Started time.Time
+
+	mu       sync.Mutex
+	detached bool
+	detach   chan struct{}
+	done     chan tea.Msg
+	ticks    chan tea.Msg // Spinner ticks from a batched Init, delivered while the load runs
+	updates  chan tea.Msg // Partial results from a batched Init, delivered before the final result
+}
+
+type taskResultMsg struct {
+	taskID  int
+	view    ViewMode
+	msg     tea.Msg
+	partial bool // From a batched Init command that finished before the last one; the task keeps running
+}
+
+// taskTickMsg carries a spinner tick from a running task to the view that started it
+type taskTickMsg struct {
+	taskID int
+	view   ViewMode
+	msg    tea.Msg
+}
+
+type backgroundPollMsg struct{}
+
+// newBackgroundTask starts cmd immediately; its result is delivered by wait until the task is detached
+func newBackgroundTask(id int, view ViewMode, cmd tea.Cmd, results BackgroundResults) *BackgroundTask {
+	t := &BackgroundTask{
+		ID:      id,
+		View:    view,
+		Started: time.Now(),
+		detach:  make(chan struct{}),
+		done:    make(chan tea.Msg, 1),
+		ticks:   make(chan tea.Msg, 1),
+		updates: make(chan tea.Msg, backgroundResultsBuffer),
+	}
+
+	go t.run(cmd, results)
+
+	return t
+}
+
+func (t *BackgroundTask) run(cmd tea.Cmd, results BackgroundResults) {
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		msg = t.runBatch(batch, results)
+	}
+	result := taskResultMsg{taskID: t.ID, view: t.View, msg: msg}
+
+	t.mu.Lock()
+	detached := t.detached
+	if !detached {
+		t.done <- result
+	}
+	t.mu.Unlock()
+
+	if detached {
+		results <- result
+	}
+}
+
+// runBatch runs a view's batched Init, e.g. a load plus a spinner tick, and returns the last result
+// Spinner ticks are handed to wait so the view animates while the load runs, and results of
+// commands that finish earlier (a progress update, a second load) are delivered as partial results
+func (t *BackgroundTask) runBatch(batch tea.BatchMsg, results BackgroundResults) tea.Msg {
+	msgs := make(chan tea.Msg, len(batch))
+	pending := 0
+	for _, cmd := range batch {
+		if cmd == nil {
+			continue
+		}
+		pending++
+		go func(cmd tea.Cmd) { msgs <- cmd() }(cmd)
+	}
+
+	var result tea.Msg
+	for ; pending > 0; pending-- {
+		msg := <-msgs
+		if _, ok := msg.(spinner.TickMsg); ok {
+			select {
+			case t.ticks <- msg:
+			default:
+			}
+			continue
+		}
+		if pending > 1 && msg != nil {
+			t.deliverPartial(msg, results)
+			continue
+		}
+		if msg != nil {
+			result = msg
+		}
+	}
+
+	return result
+}
+
+// deliverPartial sends msg to wait, or to the background channel once the task is detached
+func (t *BackgroundTask) deliverPartial(msg tea.Msg, results BackgroundResults) {
+	partial := taskResultMsg{taskID: t.ID, view: t.View, msg: msg, partial: true}
+
+	select {
+	case t.updates <- partial:
+	case <-t.detach:
+		results <- partial
+	}
+}
+
+// Detach sends the task's result to the background channel instead of the foreground
+// Returns false if the task already finished or was already detached
+func (t *BackgroundTask) Detach() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.detached || len(t.done) > 0 {
+		return false
+	}
+
+	t.detached = true
+	close(t.detach)
+	return true
+}
+
+// Detached reports whether the task is running in the background
+func (t *BackgroundTask) Detached() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.detached
+}
+
+// wait blocks until the foreground result, a partial result, or a spinner tick is ready,
+// or returns nil once the task is detached
+func (t *BackgroundTask) wait() tea.Cmd {
+	return func() tea.Msg {
+		// Partial results are queued before the final one, so deliver them first
+		select {
+		case update := <-t.updates:
+			return update
+		default:
+		}
+
+		select {
+		case update := <-t.updates:
+			return update
+		case result := <-t.done:
+			return result
+		case tick := <-t.ticks:
+			return taskTickMsg{taskID: t.ID, view: t.View, msg: tick}
+		case <-t.detach:
+			return nil
+		}
+	}
+}
+
+func pollBackground() tea.Cmd {
+	return tea.Tick(backgroundPollInterval, func(time.Time) tea.Msg {
+		return backgroundPollMsg{}
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -16,9 +17,13 @@ type Model struct {
 	repo           string
 	stats          *github.WorkflowRunStats
 	runs           []github.WorkflowRun
+	coverage       *github.ReviewCoverageReport
+	errorGroups    []github.ErrorGroupSummary
+	annotations    []github.AnnotationGroup
 	width          int
 	height         int
 	loading        bool
+	spinner        spinner.LoadingSpinner
 	err            error
 	viewMode       string // "overview", "flaky", "errors"
 }
@@ -28,19 +33,23 @@ func NewModel(repo string) Model {
 	return Model{
 		repo:     repo,
 		loading:  true,
+		spinner:  spinner.New("Loading analytics..."),
 		viewMode: "overview",
 	}
 }
 
 type analyticsLoadedMsg struct {
-	stats *github.WorkflowRunStats
-	runs  []github.WorkflowRun
-	err   error
+	stats    *github.WorkflowRunStats
+	runs     []github.WorkflowRun
+	coverage *github.ReviewCoverageReport
+	errors      []github.ErrorGroupSummary
+	annotations []github.AnnotationGroup
+	err         error
 }
 
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return m.loadAnalytics
+	return tea.Batch(m.loadAnalytics, m.spinner.Tick())
 }
 
 func (m Model) loadAnalytics() tea.Msg {
@@ -75,6 +84,8 @@ func (m Model) loadAnalytics() tea.Msg {
 		}
 	}
 
+	coverage := loadReviewCoverage(client, owner, repo)
+
 	// Load workflow runs from GitHub
 	runs, err := client.ListWorkflowRuns(owner, repo)
 	if err != nil {
@@ -86,24 +97,112 @@ func (m Model) loadAnalytics() tea.Msg {
 				FailureCount: 0,
 				AvgDuration:  0,
 			},
-			runs: []github.WorkflowRun{},
-			err:  nil, // Don't error out, just show empty
+			runs:     []github.WorkflowRun{},
+			coverage: coverage,
+			err:      nil, // Don't error out, just show empty
 		}
 	}
 
 	// Analyze runs to get statistics
 	stats := github.AnalyzeWorkflowRuns(runs)
+	errorGroups, annotations := loadErrorGroups(client, owner, repo)
 
 	return analyticsLoadedMsg{
-		stats: &stats,
-		runs:  runs,
-		err:   nil,
+		stats:       &stats,
+		runs:        runs,
+		coverage:    coverage,
+		errors:      errorGroups,
+		annotations: annotations,
+		err:         nil,
 	}
 }
 
+// errorRunLimit bounds how many failed runs have their job logs downloaded
+const errorRunLimit = 5
+
+// loadErrorGroups extracts errors from recent failed job logs and groups them by type,
+// along with any workflow annotations grouped by file
+func loadErrorGroups(client *github.Client, owner, repo string) ([]github.ErrorGroupSummary, []github.AnnotationGroup) {
+	runs, err := client.FetchWorkflowRuns(owner, repo, github.FetchWorkflowRunsOptions{Limit: 100})
+	if err != nil {
+		return nil, nil
+	}
+
+	config := github.DefaultLogConfig()
+	var contexts []*github.ErrorContext
+
+	for _, run := range github.SelectFailedRuns(runs, errorRunLimit) {
+		details, err := client.FetchRunDetails(owner, repo, run.RunID)
+		if err != nil {
+			continue
+		}
+
+		var logs []github.JobLog
+		for _, job := range details.Jobs {
+			if job.ID == 0 || job.Conclusion == "success" || job.Conclusion == "skipped" {
+				continue
+			}
+
+			lines, err := client.GetJobLog(owner, repo, job.ID)
+			if err != nil {
+				// Logs expire or may be inaccessible; skip the job
+				continue
+			}
+
+			logs = append(logs, github.JobLog{
+				JobID:      job.ID,
+				JobName:    job.Name,
+				WorkflowID: run.WorkflowID,
+				Repository: owner + "/" + repo,
+				Conclusion: job.Conclusion,
+				Lines:      lines,
+				Timestamp:  job.CompletedAt,
+			})
+		}
+
+		contexts = append(contexts, github.BatchExtractErrors(logs, run.Workflow, config)...)
+	}
+
+	var annotations []github.Annotation
+	for _, errCtx := range contexts {
+		annotations = append(annotations, errCtx.Annotations...)
+	}
+
+	return github.SummarizeErrorGroups(github.GroupErrorsByType(contexts)), github.GroupAnnotationsByFile(annotations)
+}
+
+// loadReviewCoverage computes review coverage for open PRs, or nil if PRs cannot be listed
+func loadReviewCoverage(client *github.Client, owner, repo string) *github.ReviewCoverageReport {
+	prs, err := client.ListPullRequests(owner, repo, "open")
+	if err != nil {
+		return nil
+	}
+
+	reviews := make(map[int][]github.PRReview)
+	for _, pr := range prs {
+		prReviews, err := client.ListPRReviews(owner, repo, pr.Number)
+		if err != nil {
+			// Skip PRs on error
+			continue
+		}
+		reviews[pr.Number] = prReviews
+	}
+
+	report := github.ComputeReviewCoverage(prs, reviews)
+	return &report
+}
+
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -113,6 +212,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.stats = msg.stats
 		m.runs = msg.runs
+		m.coverage = msg.coverage
+		m.errorGroups = msg.errors
+		m.annotations = msg.annotations
 		m.err = msg.err
 		return m, nil
 
@@ -136,7 +238,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // View renders the model
 func (m Model) View() string {
 	if m.loading {
-		return "Loading analytics...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -217,6 +319,32 @@ func (m Model) renderOverview() string {
 	b.WriteString(fmt.Sprintf("✗ Failure: %s (%d)\n",
 		strings.Repeat("█", m.stats.FailureCount*50/m.stats.TotalRuns), m.stats.FailureCount))
 
+	b.WriteString(m.renderReviewCoverage())
+
+	return b.String()
+}
+
+func (m Model) renderReviewCoverage() string {
+	if m.coverage == nil {
+		return ""
+	}
+
+	var b strings.Builder
+
+	b.WriteString("\n👀 Review Coverage (open PRs)\n\n")
+	b.WriteString(fmt.Sprintf("Reviewed:       %d/%d (%.1f%%)\n",
+		m.coverage.ReviewedPRs, m.coverage.TotalPRs, m.coverage.CoverageRate))
+
+	if m.coverage.UnreviewedPRs > 0 {
+		numbers := make([]string, len(m.coverage.UnreviewedPRNumbers))
+		for i, n := range m.coverage.UnreviewedPRNumbers {
+			numbers[i] = fmt.Sprintf("#%d", n)
+		}
+		warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
+		b.WriteString(warnStyle.Render(fmt.Sprintf("Unreviewed:     %s", strings.Join(numbers, ", "))))
+		b.WriteString("\n")
+	}
+
 	return b.String()
 }
 
@@ -247,19 +375,49 @@ func (m Model) renderErrors() string {
 	var b strings.Builder
 
 	b.WriteString("❌ Recent Errors\n\n")
-	b.WriteString("Extracting last 100 lines of failed jobs...\n\n")
 
-	// Mock error logs
-	b.WriteString("Latest Failure (2 hours ago):\n")
-	b.WriteString("---\n")
-	b.WriteString("FAIL: TestUserLogin (0.45s)\n")
-	b.WriteString("    user_test.go:42: expected status 200, got 401\n")
-	b.WriteString("    Stack trace:\n")
-	b.WriteString("        TestUserLogin\n")
-	b.WriteString("            /app/test/user_test.go:42\n")
-	b.WriteString("---\n\n")
+	if len(m.errorGroups) == 0 {
+		b.WriteString(fmt.Sprintf("No errors extracted from the last %d failed runs\n", errorRunLimit))
+		return b.String()
+	}
+
+	b.WriteString(fmt.Sprintf("Grouped by type from the last %d failed runs:\n\n", errorRunLimit))
+
+	typeStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FF0000"))
+	for _, group := range m.errorGroups {
+		b.WriteString(typeStyle.Render(fmt.Sprintf("%-14s", group.ErrorType)))
+		b.WriteString(fmt.Sprintf(" %3d  last: %s\n", group.Count, formatTimestamp(group.MostRecentTimestamp)))
+		b.WriteString(fmt.Sprintf("    Jobs: %s\n", strings.Join(group.AffectedJobs, ", ")))
+	}
+
+	if len(m.annotations) > 0 {
+		b.WriteString("\nAnnotations by file:\n\n")
+		levelStyles := map[string]lipgloss.Style{
+			"error":   lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000")),
+			"warning": lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00")),
+			"notice":  lipgloss.NewStyle().Foreground(lipgloss.Color("#777777")),
+		}
+		for _, group := range m.annotations {
+			file := group.File
+			if file == "" {
+				file = "(no file)"
+			}
+			b.WriteString(fmt.Sprintf("  %s\n", file))
+			for _, a := range group.Annotations {
+				b.WriteString(fmt.Sprintf("    %s %s  %s\n",
+					levelStyles[a.Level].Render(fmt.Sprintf("%-7s", a.Level)), github.FormatAnnotationLocation(a), a.Message))
+			}
+		}
+	}
 
-	b.WriteString("💡 AI-friendly format: JSON export available\n")
+	b.WriteString("\n💡 AI-friendly format: gh-sweep gha-errors --format json\n")
 
 	return b.String()
 }
+
+func formatTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format("2006-01-02 15:04")
+}
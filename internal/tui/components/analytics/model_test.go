@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestErrorsViewRendersGroupedSummaries(t *testing.T) {
+	updated, _ := NewModel("owner/repo").Update(analyticsLoadedMsg{
+		stats: &github.WorkflowRunStats{},
+		errors: []github.ErrorGroupSummary{
+			{ErrorType: "test-failure", Count: 3, AffectedJobs: []string{"e2e", "test"}},
+			{ErrorType: "timeout", Count: 1, AffectedJobs: []string{"deploy"}},
+		},
+	})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+
+	view := updated.(Model).View()
+	if strings.Contains(view, "TestUserLogin") {
+		t.Errorf("Expected mock errors to be gone, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Jobs: e2e, test") || !strings.Contains(view, "Jobs: deploy") {
+		t.Errorf("Expected affected jobs per group, got:\n%s", view)
+	}
+	if strings.Index(view, "test-failure") > strings.Index(view, "timeout") {
+		t.Errorf("Expected groups in count order, got:\n%s", view)
+	}
+}
+
+func TestErrorsViewWithoutErrors(t *testing.T) {
+	updated, _ := NewModel("owner/repo").Update(analyticsLoadedMsg{stats: &github.WorkflowRunStats{}})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+
+	if view := updated.(Model).View(); !strings.Contains(view, "No errors extracted") {
+		t.Errorf("Expected empty state, got:\n%s", view)
+	}
+}
+
+func TestErrorsViewGroupsAnnotationsByFile(t *testing.T) {
+	updated, _ := NewModel("owner/repo").Update(analyticsLoadedMsg{
+		stats:  &github.WorkflowRunStats{},
+		errors: []github.ErrorGroupSummary{{ErrorType: "lint", Count: 1, AffectedJobs: []string{"lint"}}},
+		annotations: github.GroupAnnotationsByFile([]github.Annotation{
+			{Level: "warning", File: "src/util.ts", Line: 3, Message: "Unused variable"},
+			{Level: "error", File: "src/app.ts", Line: 12, Col: 5, Message: "Unexpected any"},
+		}),
+	})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+
+	view := updated.(Model).View()
+	if !strings.Contains(view, "Annotations by file") {
+		t.Fatalf("Expected annotations section, got:\n%s", view)
+	}
+	if !strings.Contains(view, "src/app.ts:12:5  Unexpected any") {
+		t.Errorf("Expected annotation location and message, got:\n%s", view)
+	}
+	if strings.Index(view, "  src/app.ts\n") > strings.Index(view, "  src/util.ts\n") {
+		t.Errorf("Expected files in sorted order, got:\n%s", view)
+	}
+}
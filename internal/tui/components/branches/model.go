@@ -7,6 +7,9 @@ import (
 
 	"github.com/KyleKing/gh-sweep/internal/git"
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/layout"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -20,29 +23,72 @@ type Model struct {
 	width        int
 	height       int
 	loading      bool
+	spinner      spinner.LoadingSpinner
 	err          error
 	baseBranch   string
 	showTree     bool
+	showMatrix   bool // Show the age x divergence grid instead of the list
+	localPath    string
+	detachedSHA  string // Set when the local checkout is in detached HEAD
+	scrollTop    int
+	maxVisible   int
+	statusMsg    string
+	filter       string // Case-insensitive substring match on branch names
+	filtering    bool   // Set while the filter is being typed
+
+	confirmDelete bool
+	deleteTargets []string
+	impacts       map[string]*github.BranchImpact // Loaded for deleteTargets while confirming
+	impactErr     error
+}
+
+// Option configures the branch management model
+type Option func(*Model)
+
+// WithLocalPath checks the local checkout at path for detached HEAD
+func WithLocalPath(path string) Option {
+	return func(m *Model) {
+		m.localPath = path
+	}
 }
 
 // NewModel creates a new branch management model
-func NewModel(repo, baseBranch string) Model {
-	return Model{
+func NewModel(repo, baseBranch string, opts ...Option) Model {
+	m := Model{
 		repo:       repo,
 		baseBranch: baseBranch,
 		selected:   make(map[int]bool),
 		loading:    true,
+		spinner:    spinner.New("Loading branches..."),
+		maxVisible: layout.DefaultMaxVisible,
+	}
+
+	for _, opt := range opts {
+		opt(&m)
 	}
+
+	return m
 }
 
 type branchesLoadedMsg struct {
-	branches []github.BranchWithComparison
-	err      error
+	branches    []github.BranchWithComparison
+	detachedSHA string
+	err         error
+}
+
+type impactLoadedMsg struct {
+	impacts map[string]*github.BranchImpact
+	err     error
+}
+
+type deleteResultMsg struct {
+	branch string
+	err    error
 }
 
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return m.loadBranches
+	return tea.Batch(m.loadBranches, m.spinner.Tick())
 }
 
 func (m Model) loadBranches() tea.Msg {
@@ -105,27 +151,69 @@ func (m Model) loadBranches() tea.Msg {
 		})
 	}
 
+	// Detached HEAD is only a warning, so errors are ignored
+	var detachedSHA string
+	if m.localPath != "" {
+		_, detachedSHA, _ = GetLocalBranches(m.localPath)
+	}
+
 	return branchesLoadedMsg{
-		branches: branchesWithComparison,
-		err:      nil,
+		branches:    branchesWithComparison,
+		detachedSHA: detachedSHA,
+		err:         nil,
 	}
 }
 
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.maxVisible = layout.MaxVisibleForHeight(msg.Height, 8)
+		m.scrollTop = layout.ClampScroll(m.cursor, m.scrollTop, m.maxVisible)
 		return m, nil
 
 	case branchesLoadedMsg:
 		m.loading = false
 		m.branches = msg.branches
+		m.detachedSHA = msg.detachedSHA
 		m.err = msg.err
 		return m, nil
 
+	case impactLoadedMsg:
+		if !m.confirmDelete {
+			return m, nil
+		}
+		m.impacts = msg.impacts
+		m.impactErr = msg.err
+		return m, nil
+
+	case deleteResultMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to delete %s: %v", msg.branch, msg.err)
+		} else {
+			m.statusMsg = fmt.Sprintf("Deleted: %s", msg.branch)
+			m.removeBranch(msg.branch)
+		}
+		return m, nil
+
 	case tea.KeyMsg:
+		if m.confirmDelete {
+			return m.handleConfirmKeys(msg)
+		}
+		if m.filtering {
+			return m.handleFilterKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
@@ -136,14 +224,30 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case "down", "j":
-			if m.cursor < len(m.branches)-1 {
+			if m.cursor < len(m.filteredIndices())-1 {
 				m.cursor++
 			}
 
+		case "pgdown":
+			m.cursor, m.scrollTop = layout.PageDown(m.cursor, m.scrollTop, m.maxVisible, len(m.filteredIndices()))
+
+		case "pgup":
+			m.cursor, m.scrollTop = layout.PageUp(m.cursor, m.scrollTop, m.maxVisible)
+
 		case " ": // Space to select
-			m.selected[m.cursor] = !m.selected[m.cursor]
+			visible := m.filteredIndices()
+			if m.cursor < len(visible) {
+				i := visible[m.cursor]
+				m.selected[i] = !m.selected[i]
+			}
+
+		case "a": // Select all matching the filter
+			visible := m.filteredIndices()
+			for i := range m.SelectAllFiltered() {
+				m.selected[visible[i]] = true
+			}
 
-		case "a": // Select all
+		case "A": // Select all, including filtered-out branches
 			for i := range m.branches {
 				m.selected[i] = true
 			}
@@ -151,22 +255,188 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "n": // Select none
 			m.selected = make(map[int]bool)
 
+		case "/": // Filter by name
+			m.filtering = true
+
 		case "t": // Toggle tree view
 			m.showTree = !m.showTree
 
+		case "m", "M": // Toggle divergence matrix
+			m.showMatrix = !m.showMatrix
+
 		case "d": // Delete selected
-			// TODO: Implement delete confirmation
-			return m, nil
+			return m.handleDelete()
 		}
+
+		m.scrollTop = layout.ClampScroll(m.cursor, m.scrollTop, m.maxVisible)
 	}
 
 	return m, nil
 }
 
+// handleDelete asks for confirmation to delete the selected branches, or the highlighted one
+// The downstream impact of each target is loaded while the dialog is open
+func (m Model) handleDelete() (tea.Model, tea.Cmd) {
+	var targets []string
+	for i, branch := range m.branches {
+		if m.selected[i] {
+			targets = append(targets, branch.Name)
+		}
+	}
+	if visible := m.filteredIndices(); len(targets) == 0 && m.cursor < len(visible) {
+		targets = append(targets, m.branches[visible[m.cursor]].Name)
+	}
+	if len(targets) == 0 {
+		m.statusMsg = "No branches selected"
+		return m, nil
+	}
+
+	m.confirmDelete = true
+	m.deleteTargets = targets
+	m.impacts = nil
+	m.impactErr = nil
+	return m, m.loadImpact(targets)
+}
+
+// handleFilterKeys edits the name filter until enter keeps it or esc clears it
+func (m Model) handleFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filter, m.filtering = search.HandleKey(m.filter, msg)
+
+	m.cursor = 0
+	m.scrollTop = 0
+	return m, nil
+}
+
+// Searching reports whether the name filter is being typed
+func (m Model) Searching() bool {
+	return m.filtering
+}
+
+// filteredIndices returns the indices into branches whose names match the filter
+func (m Model) filteredIndices() []int {
+	indices := make([]int, 0, len(m.branches))
+	for i, branch := range m.branches {
+		if search.Matches(branch.Name, m.filter) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// SelectAllFiltered returns a selection of every branch matching the filter
+// Keys are positions in the filtered list, not indices into all branches
+func (m Model) SelectAllFiltered() map[int]bool {
+	selected := make(map[int]bool)
+	for i := range m.filteredIndices() {
+		selected[i] = true
+	}
+	return selected
+}
+
+func (m Model) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		return m.executeDelete()
+	case "n", "N", "esc":
+		m.confirmDelete = false
+		m.deleteTargets = nil
+		m.impacts = nil
+		m.statusMsg = "Delete cancelled"
+	}
+	return m, nil
+}
+
+func (m Model) loadImpact(targets []string) tea.Cmd {
+	repoName := m.repo
+	return func() tea.Msg {
+		owner, repo, err := splitRepo(repoName)
+		if err != nil {
+			return impactLoadedMsg{err: err}
+		}
+
+		client, err := github.NewClient(context.Background())
+		if err != nil {
+			return impactLoadedMsg{err: fmt.Errorf("failed to create GitHub client: %w", err)}
+		}
+
+		impacts := make(map[string]*github.BranchImpact, len(targets))
+		for _, branch := range targets {
+			impact, err := client.GetBranchDeletionImpact(owner, repo, branch)
+			if err != nil {
+				return impactLoadedMsg{impacts: impacts, err: err}
+			}
+			impacts[branch] = impact
+		}
+
+		return impactLoadedMsg{impacts: impacts}
+	}
+}
+
+func (m Model) executeDelete() (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	for _, branch := range m.deleteTargets {
+		branch := branch
+		repoName := m.repo
+		cmds = append(cmds, func() tea.Msg {
+			owner, repo, err := splitRepo(repoName)
+			if err != nil {
+				return deleteResultMsg{branch: branch, err: err}
+			}
+
+			client, err := github.NewClient(context.Background())
+			if err != nil {
+				return deleteResultMsg{branch: branch, err: err}
+			}
+
+			return deleteResultMsg{branch: branch, err: client.DeleteBranch(owner, repo, branch)}
+		})
+	}
+
+	m.confirmDelete = false
+	m.deleteTargets = nil
+	m.impacts = nil
+	return m, tea.Batch(cmds...)
+}
+
+// removeBranch drops a deleted branch from the list, keeping the remaining selections
+func (m *Model) removeBranch(name string) {
+	var kept []github.BranchWithComparison
+	selected := make(map[int]bool)
+
+	for i, branch := range m.branches {
+		if branch.Name == name {
+			continue
+		}
+		if m.selected[i] {
+			selected[len(kept)] = true
+		}
+		kept = append(kept, branch)
+	}
+
+	m.branches = kept
+	m.selected = selected
+	if visible := len(m.filteredIndices()); m.cursor >= visible && m.cursor > 0 {
+		m.cursor = visible - 1
+	}
+	m.scrollTop = layout.ClampScroll(m.cursor, m.scrollTop, m.maxVisible)
+}
+
+func splitRepo(repo string) (string, string, error) {
+	parts := strings.Split(repo, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid repo format, expected owner/repo")
+	}
+	return parts[0], parts[1], nil
+}
+
 // View renders the model
 func (m Model) View() string {
 	if m.loading {
-		return "Loading branches...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -175,6 +445,18 @@ func (m Model) View() string {
 
 	var b strings.Builder
 
+	if m.detachedSHA != "" {
+		warnStyle := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FF0000"))
+		b.WriteString(warnStyle.Render(fmt.Sprintf("⚠️  Detached HEAD at %s - commits made here can be lost", shortSHA(m.detachedSHA))))
+		b.WriteString("\n\n")
+	}
+
+	if m.confirmDelete {
+		return m.renderConfirmDialog(&b)
+	}
+
 	// Header
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -183,13 +465,35 @@ func (m Model) View() string {
 	b.WriteString(titleStyle.Render(fmt.Sprintf("📋 Branches for %s", m.repo)))
 	b.WriteString("\n\n")
 
+	if m.filtering || m.filter != "" {
+		filterLine := fmt.Sprintf("Filter: %s", m.filter)
+		if m.filtering {
+			filterLine += "_"
+		}
+		b.WriteString(filterLine)
+		b.WriteString("\n\n")
+	}
+
+	if m.showMatrix {
+		b.WriteString(m.renderMatrix())
+		return b.String()
+	}
+
 	// Branch list
+	visible := m.filteredIndices()
 	if len(m.branches) == 0 {
 		b.WriteString("No branches found.\n")
+	} else if len(visible) == 0 {
+		b.WriteString("No branches match the filter.\n")
 	} else {
-		for i, branch := range m.branches {
+		for row, i := range visible {
+			if !layout.InWindow(row, m.scrollTop, m.maxVisible) {
+				continue
+			}
+			branch := m.branches[i]
+
 			cursor := " "
-			if m.cursor == i {
+			if m.cursor == row {
 				cursor = ">"
 			}
 
@@ -207,7 +511,7 @@ func (m Model) View() string {
 				aheadBehind,
 			)
 
-			if m.cursor == i {
+			if m.cursor == row {
 				selectedStyle := lipgloss.NewStyle().
 					Bold(true).
 					Foreground(lipgloss.Color("#FFFF00"))
@@ -219,16 +523,182 @@ func (m Model) View() string {
 		}
 	}
 
+	if m.statusMsg != "" {
+		b.WriteString("\n")
+		b.WriteString(m.statusMsg)
+		b.WriteString("\n")
+	}
+
 	// Help
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("↑/↓: navigate | space: select | a: all | n: none | t: tree | d: delete | q: quit"))
+	if m.filtering {
+		b.WriteString(helpStyle.Render("type to filter | enter: apply | esc: clear"))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | pgup/pgdn: page | space: select | /: filter | a: all filtered | A: all | n: none | t: tree | m: matrix | d: delete | q: quit"))
+	}
+
+	return b.String()
+}
+
+// matrixBuckets is the number of age rows and divergence columns in the matrix view
+const matrixBuckets = 4
+
+// renderMatrix shows how many filtered branches fall in each age and commits-behind bucket
+func (m Model) renderMatrix() string {
+	var b strings.Builder
+
+	var branches []github.BranchWithComparison
+	for _, i := range m.filteredIndices() {
+		branches = append(branches, m.branches[i])
+	}
+	maxAge, maxBehind := github.DefaultDivergenceMaxAge, github.DefaultDivergenceMaxBehind
+	cells := github.ComputeBranchDivergenceMatrix(branches, maxAge, maxBehind)
+	grid := github.DivergenceGrid(cells, maxAge, maxBehind, matrixBuckets)
+
+	b.WriteString(fmt.Sprintf("[M] Matrix: age vs commits behind %s (%d branches)\n\n", m.baseBranch, len(cells)))
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#777777"))
+	header := fmt.Sprintf("%-10s", "Age")
+	for col := 0; col < matrixBuckets; col++ {
+		header += fmt.Sprintf(" %7s", bucketLabel(col, maxBehind, ""))
+	}
+	b.WriteString(headerStyle.Render(header))
+	b.WriteString("\n")
+
+	riskStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+	for row := 0; row < matrixBuckets; row++ {
+		b.WriteString(fmt.Sprintf("%-10s", bucketLabel(row, maxAge, "d")))
+		for col := 0; col < matrixBuckets; col++ {
+			cell := fmt.Sprintf(" %7s", ".")
+			if count := grid[row][col]; count > 0 {
+				cell = fmt.Sprintf(" %7d", count)
+				// Old and far behind: the riskiest corner
+				if row+col >= matrixBuckets {
+					cell = riskStyle.Render(cell)
+				}
+			}
+			b.WriteString(cell)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+	b.WriteString(helpStyle.Render("m: list | /: filter | q: quit"))
+
+	return b.String()
+}
+
+// bucketLabel names bucket i of matrixBuckets equal ranges up to max, e.g. "0-21d" or "68d+"
+func bucketLabel(i, max int, unit string) string {
+	lo := i * max / matrixBuckets
+	if i == matrixBuckets-1 {
+		return fmt.Sprintf("%d%s+", lo, unit)
+	}
+	return fmt.Sprintf("%d-%d%s", lo, (i+1)*max/matrixBuckets-1, unit)
+}
+
+func (m Model) renderConfirmDialog(b *strings.Builder) string {
+	warnStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FF0000"))
+	b.WriteString(warnStyle.Render("Confirm Delete"))
+	b.WriteString("\n\n")
+
+	b.WriteString(fmt.Sprintf("Delete %d branch(es) from %s?\n\n", len(m.deleteTargets), m.repo))
+
+	for _, branch := range m.deleteTargets {
+		b.WriteString(fmt.Sprintf("  - %s\n", branch))
+
+		impact, ok := m.impacts[branch]
+		switch {
+		case !ok && m.impactErr == nil:
+			b.WriteString("      Checking open PRs and issues...\n")
+		case !ok:
+			b.WriteString("      Impact unknown\n")
+		case !impact.HasImpact():
+			b.WriteString("      No open PRs or linked issues\n")
+		default:
+			b.WriteString(warnStyle.Render(fmt.Sprintf("      %s", formatImpact(impact))))
+			b.WriteString("\n")
+		}
+	}
+
+	if m.impactErr != nil {
+		b.WriteString(fmt.Sprintf("\nFailed to load impact: %v\n", m.impactErr))
+	}
+
+	b.WriteString("\n")
+	b.WriteString("Press 'y' to confirm, 'n' or 'esc' to cancel\n")
+
+	return b.String()
+}
+
+// formatImpact summarizes the open PRs and issues depending on a branch
+func formatImpact(impact *github.BranchImpact) string {
+	parts := []string{
+		fmt.Sprintf("%d open PR(s) as head", impact.OpenPRsAsHead),
+		fmt.Sprintf("%d open PR(s) as base", impact.OpenPRsAsBase),
+	}
+	if len(impact.LinkedIssues) > 0 {
+		issues := make([]string, len(impact.LinkedIssues))
+		for i, number := range impact.LinkedIssues {
+			issues[i] = fmt.Sprintf("#%d", number)
+		}
+		parts = append(parts, fmt.Sprintf("%d linked issue(s): %s", len(issues), strings.Join(issues, ", ")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Cursor returns the index of the highlighted branch
+func (m Model) Cursor() int {
+	return m.cursor
+}
+
+// RenderDetail describes the branch at index in the filtered list
+func (m Model) RenderDetail(index int) string {
+	visible := m.filteredIndices()
+	if index < 0 || index >= len(visible) {
+		return ""
+	}
+	branch := m.branches[visible[index]]
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Branch:    %s\n", branch.Name))
+	b.WriteString(fmt.Sprintf("SHA:       %s\n", shortSHA(branch.SHA)))
+	b.WriteString(fmt.Sprintf("Protected: %v\n", branch.Protected))
+	if !branch.LastCommitDate.IsZero() {
+		b.WriteString(fmt.Sprintf("Updated:   %s\n", branch.LastCommitDate.Format("2006-01-02 15:04")))
+	}
+	b.WriteString(fmt.Sprintf("\nCompared to %s:\n", branch.ComparedTo))
+	b.WriteString(fmt.Sprintf("  %d ahead, %d behind\n", branch.Ahead, branch.Behind))
 
 	return b.String()
 }
 
 // GetLocalBranches loads branches from local Git repository
-func GetLocalBranches(repoPath string) ([]git.BranchInfo, error) {
+// Also returns the HEAD SHA when the repository is in detached HEAD state
+func GetLocalBranches(repoPath string) ([]git.BranchInfo, string, error) {
 	repo := git.NewLocalRepo(repoPath)
-	return repo.ListBranches()
+
+	detached, sha, err := repo.IsDetachedHEAD()
+	if err != nil {
+		return nil, "", err
+	}
+	if !detached {
+		sha = ""
+	}
+
+	branches, err := repo.ListBranches()
+	if err != nil {
+		return nil, sha, err
+	}
+
+	return branches, sha, nil
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
 }
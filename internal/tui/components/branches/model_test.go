@@ -0,0 +1,183 @@
+package branches
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newLoadedModel(names ...string) Model {
+	var loaded []github.BranchWithComparison
+	for _, name := range names {
+		loaded = append(loaded, github.BranchWithComparison{Branch: github.Branch{Name: name}, ComparedTo: "main"})
+	}
+
+	updated, _ := NewModel("owner/repo", "main").Update(branchesLoadedMsg{branches: loaded})
+	return updated.(Model)
+}
+
+func pressKey(m Model, key string) (Model, tea.Cmd) {
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	return updated.(Model), cmd
+}
+
+func TestDeleteShowsImpactInConfirmation(t *testing.T) {
+	m := newLoadedModel("main", "feature")
+	m, _ = pressKey(m, "j")
+
+	m, cmd := pressKey(m, "d")
+	if !m.confirmDelete || cmd == nil {
+		t.Fatal("Expected d to open the confirmation and load impact")
+	}
+	if len(m.deleteTargets) != 1 || m.deleteTargets[0] != "feature" {
+		t.Fatalf("Expected the highlighted branch as target, got %v", m.deleteTargets)
+	}
+	if view := m.View(); !strings.Contains(view, "Checking open PRs and issues") {
+		t.Errorf("Expected pending impact in dialog, got:\n%s", view)
+	}
+
+	updated, _ := m.Update(impactLoadedMsg{impacts: map[string]*github.BranchImpact{
+		"feature": {OpenPRsAsHead: 2, LinkedIssues: []int{7}},
+	}})
+	m = updated.(Model)
+
+	view := m.View()
+	if !strings.Contains(view, "2 open PR(s) as head") || !strings.Contains(view, "#7") {
+		t.Errorf("Expected impact summary in dialog, got:\n%s", view)
+	}
+}
+
+func TestCancelDeleteKeepsBranches(t *testing.T) {
+	m := newLoadedModel("main", "feature")
+	m, _ = pressKey(m, "d")
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+
+	if m.confirmDelete || len(m.branches) != 2 {
+		t.Errorf("Expected cancel to close the dialog and keep branches")
+	}
+}
+
+func TestDeleteResultRemovesBranchAndShiftsSelection(t *testing.T) {
+	m := newLoadedModel("main", "feature", "fix")
+	m.selected[1] = true
+	m.selected[2] = true
+
+	updated, _ := m.Update(deleteResultMsg{branch: "feature"})
+	m = updated.(Model)
+
+	if len(m.branches) != 2 || m.branches[1].Name != "fix" {
+		t.Fatalf("Expected feature to be removed, got %v", m.branches)
+	}
+	if !m.selected[1] || m.selected[2] {
+		t.Errorf("Expected fix to stay selected at its new index, got %v", m.selected)
+	}
+}
+
+func TestSelectAllOnlySelectsFilteredBranches(t *testing.T) {
+	m := newLoadedModel(
+		"main", "release-1", "release-2", "feature-a", "feature-b",
+		"release-3", "fix-1", "fix-2", "docs", "chore",
+	)
+
+	m, _ = pressKey(m, "/")
+	for _, r := range "release" {
+		m, _ = pressKey(m, string(r))
+	}
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	if visible := m.filteredIndices(); len(visible) != 3 {
+		t.Fatalf("Expected filter to match 3 branches, got %d", len(visible))
+	}
+
+	filtered := m.SelectAllFiltered()
+	if len(filtered) != 3 || !filtered[0] || !filtered[1] || !filtered[2] {
+		t.Errorf("Expected filtered indices 0-2, got %v", filtered)
+	}
+
+	m, _ = pressKey(m, "a")
+	if len(m.selected) != 3 {
+		t.Fatalf("Expected 3 selected branches, got %d", len(m.selected))
+	}
+	for _, i := range m.filteredIndices() {
+		if !m.selected[i] {
+			t.Errorf("Expected %s to be selected", m.branches[i].Name)
+		}
+	}
+
+	m, _ = pressKey(m, "A")
+	if len(m.selected) != 10 {
+		t.Errorf("Expected A to select all 10 branches, got %d", len(m.selected))
+	}
+
+	m, _ = pressKey(m, "n")
+	if len(m.selected) != 0 {
+		t.Errorf("Expected n to clear the selection, got %d", len(m.selected))
+	}
+}
+
+func TestDeleteTargetsHighlightedFilteredBranch(t *testing.T) {
+	m := newLoadedModel("main", "feature", "fix")
+	m, _ = pressKey(m, "/")
+	m, _ = pressKey(m, "fix")
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	m, _ = pressKey(m, "d")
+	if len(m.deleteTargets) != 1 || m.deleteTargets[0] != "fix" {
+		t.Errorf("Expected the highlighted filtered branch as target, got %v", m.deleteTargets)
+	}
+}
+
+func TestMatrixViewCountsBranchesPerBucket(t *testing.T) {
+	now := time.Now()
+	branch := func(name string, ageDays, behind int) github.BranchWithComparison {
+		return github.BranchWithComparison{
+			Branch:     github.Branch{Name: name, Behind: behind, LastCommitDate: now.AddDate(0, 0, -ageDays)},
+			ComparedTo: "main",
+		}
+	}
+
+	updated, _ := NewModel("owner/repo", "main").Update(branchesLoadedMsg{branches: []github.BranchWithComparison{
+		{Branch: github.Branch{Name: "main", LastCommitDate: now}, ComparedTo: "main"},
+		branch("fresh-a", 1, 0),
+		branch("fresh-b", 3, 2),
+		branch("abandoned", 365, 200),
+	}})
+	m, _ := pressKey(updated.(Model), "m")
+
+	view := m.View()
+	if !strings.Contains(view, "[M] Matrix") || !strings.Contains(view, "(3 branches)") {
+		t.Fatalf("Expected the matrix view, got:\n%s", view)
+	}
+	if strings.Contains(view, "fresh-a") {
+		t.Errorf("Expected counts instead of branch names, got:\n%s", view)
+	}
+
+	lines := strings.Split(view, "\n")
+	var freshRow, oldRow string
+	for _, line := range lines {
+		if strings.HasPrefix(line, "0-21d") {
+			freshRow = line
+		}
+		if strings.HasPrefix(line, "67d+") {
+			oldRow = line
+		}
+	}
+	if fields := strings.Fields(freshRow); len(fields) != 5 || fields[1] != "2" {
+		t.Errorf("Expected 2 fresh, up-to-date branches, got row %q", freshRow)
+	}
+	if fields := strings.Fields(oldRow); len(fields) != 5 || fields[4] != "1" {
+		t.Errorf("Expected 1 old, far-behind branch, got row %q", oldRow)
+	}
+
+	m, _ = pressKey(m, "m")
+	if view := m.View(); !strings.Contains(view, "fresh-a") {
+		t.Errorf("Expected m to return to the list, got:\n%s", view)
+	}
+}
@@ -0,0 +1,18 @@
+package breadcrumb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Separator is placed between breadcrumb levels
+const Separator = " > "
+
+// Render joins the navigation stack into a trail such as "Home > Branches > Detail"
+func Render[T fmt.Stringer](stack []T) string {
+	names := make([]string, len(stack))
+	for i, level := range stack {
+		names[i] = level.String()
+	}
+	return strings.Join(names, Separator)
+}
@@ -0,0 +1,28 @@
+package breadcrumb
+
+import "testing"
+
+type level string
+
+func (l level) String() string { return string(l) }
+
+// TestRender tests joining navigation levels into a trail
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		stack    []level
+		expected string
+	}{
+		{"empty", nil, ""},
+		{"home only", []level{"Home"}, "Home"},
+		{"nested", []level{"Home", "Branches", "Detail"}, "Home > Branches > Detail"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Render(tt.stack); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -3,9 +3,13 @@ package collaborators
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/layout"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -14,12 +18,19 @@ import (
 type Model struct {
 	repos         []string
 	collaborators map[string][]github.Collaborator
+	activity      map[string]map[string]*github.CollaboratorActivity // repo -> login -> activity
+	invitations   map[string][]github.PendingInvitation
 	cursor        int
 	width         int
 	height        int
 	loading       bool
+	spinner       spinner.LoadingSpinner
 	err           error
-	viewMode      string // "byrepo", "byuser"
+	viewMode      string // "byrepo", "byuser", "pending"
+	scrollTop     int
+	maxVisible    int
+	filterQuery   string // Case-insensitive substring match on repository or user names
+	searchActive  bool   // Set while the search query is being typed
 }
 
 // NewModel creates a new collaborator management model
@@ -27,19 +38,25 @@ func NewModel(repos []string) Model {
 	return Model{
 		repos:         repos,
 		collaborators: make(map[string][]github.Collaborator),
+		activity:      make(map[string]map[string]*github.CollaboratorActivity),
+		invitations:   make(map[string][]github.PendingInvitation),
 		loading:       true,
+		spinner:       spinner.New("Loading collaborators..."),
 		viewMode:      "byrepo",
+		maxVisible:    layout.DefaultMaxVisible,
 	}
 }
 
 type collaboratorsLoadedMsg struct {
 	collaborators map[string][]github.Collaborator
+	activity      map[string]map[string]*github.CollaboratorActivity
+	invitations   map[string][]github.PendingInvitation
 	err           error
 }
 
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return m.loadCollaborators
+	return tea.Batch(m.loadCollaborators, m.spinner.Tick())
 }
 
 func (m Model) loadCollaborators() tea.Msg {
@@ -53,8 +70,10 @@ func (m Model) loadCollaborators() tea.Msg {
 		}
 	}
 
-	// Load collaborators for each repo
+	// Load collaborators and their last activity for each repo
 	collaborators := make(map[string][]github.Collaborator)
+	activity := make(map[string]map[string]*github.CollaboratorActivity)
+	invitations := make(map[string][]github.PendingInvitation)
 	for _, repoStr := range m.repos {
 		parts := strings.Split(repoStr, "/")
 		if len(parts) != 2 {
@@ -69,10 +88,29 @@ func (m Model) loadCollaborators() tea.Msg {
 		}
 
 		collaborators[repoStr] = repoCollaborators
+
+		activity[repoStr] = make(map[string]*github.CollaboratorActivity)
+		for _, collab := range repoCollaborators {
+			userActivity, err := client.GetCollaboratorLastActivity(owner, repo, collab.Login)
+			if err != nil {
+				// Leave activity unknown on error
+				continue
+			}
+			activity[repoStr][collab.Login] = userActivity
+		}
+
+		repoInvitations, err := client.ListPendingInvitations(owner, repo)
+		if err != nil {
+			// Listing invitations requires admin access; skip on error
+			continue
+		}
+		invitations[repoStr] = repoInvitations
 	}
 
 	return collaboratorsLoadedMsg{
 		collaborators: collaborators,
+		activity:      activity,
+		invitations:   invitations,
 		err:           nil,
 	}
 }
@@ -80,63 +118,156 @@ func (m Model) loadCollaborators() tea.Msg {
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.maxVisible = layout.MaxVisibleForHeight(msg.Height, 8)
+		m.scrollTop = layout.ClampScroll(m.cursor, m.scrollTop, m.maxVisible)
 		return m, nil
 
 	case collaboratorsLoadedMsg:
 		m.loading = false
 		m.collaborators = msg.collaborators
+		if msg.activity != nil {
+			m.activity = msg.activity
+		}
+		if msg.invitations != nil {
+			m.invitations = msg.invitations
+		}
 		m.err = msg.err
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
+		case "/":
+			m.searchActive = true
+
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
 			}
 
 		case "down", "j":
-			maxCursor := len(m.repos) - 1
-			if m.viewMode == "byuser" {
-				maxCursor = m.getTotalCollaborators() - 1
-			}
-			if m.cursor < maxCursor {
+			if m.cursor < m.itemCount()-1 {
 				m.cursor++
 			}
 
+		case "pgdown":
+			m.cursor, m.scrollTop = layout.PageDown(m.cursor, m.scrollTop, m.maxVisible, m.itemCount())
+
+		case "pgup":
+			m.cursor, m.scrollTop = layout.PageUp(m.cursor, m.scrollTop, m.maxVisible)
+
 		case "1":
 			m.viewMode = "byrepo"
 			m.cursor = 0
 		case "2":
 			m.viewMode = "byuser"
 			m.cursor = 0
+		case "3":
+			m.viewMode = "pending"
+			m.cursor = 0
 		}
+
+		m.scrollTop = layout.ClampScroll(m.cursor, m.scrollTop, m.maxVisible)
 	}
 
 	return m, nil
 }
 
-func (m Model) getTotalCollaborators() int {
-	// Get unique collaborators across all repos
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	m.scrollTop = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// itemCount returns the number of rows in the current view
+func (m Model) itemCount() int {
+	switch m.viewMode {
+	case "byuser":
+		return len(m.filteredUsers())
+	case "pending":
+		return len(m.filteredInvitations())
+	}
+	return len(m.filteredRepos())
+}
+
+// filteredRepos returns the repositories matching the search query
+func (m Model) filteredRepos() []string {
+	return search.Filter(m.repos, m.filterQuery, func(repo string) string { return repo })
+}
+
+// filteredUsers returns the sorted logins of collaborators matching the search query
+func (m Model) filteredUsers() []string {
 	uniqueUsers := make(map[string]bool)
 	for _, collabs := range m.collaborators {
 		for _, collab := range collabs {
 			uniqueUsers[collab.Login] = true
 		}
 	}
-	return len(uniqueUsers)
+
+	users := make([]string, 0, len(uniqueUsers))
+	for user := range uniqueUsers {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+
+	return search.Filter(users, m.filterQuery, func(user string) string { return user })
+}
+
+// filteredInvitations returns pending invitations whose repository or invitee matches the search query
+func (m Model) filteredInvitations() []pendingInvitation {
+	return search.Filter(m.pendingInvitations(), m.filterQuery, func(p pendingInvitation) string {
+		return p.repo + " " + p.invitation.Login + " " + p.invitation.Email
+	})
+}
+
+// pendingInvitation pairs an invitation with its repository
+type pendingInvitation struct {
+	repo       string
+	invitation github.PendingInvitation
+}
+
+// pendingInvitations flattens invitations in repo order
+func (m Model) pendingInvitations() []pendingInvitation {
+	var pending []pendingInvitation
+	for _, repo := range m.repos {
+		for _, invitation := range m.invitations[repo] {
+			pending = append(pending, pendingInvitation{repo: repo, invitation: invitation})
+		}
+	}
+	return pending
 }
 
 // View renders the model
 func (m Model) View() string {
 	if m.loading {
-		return "Loading collaborators...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -172,7 +303,15 @@ func (m Model) View() string {
 	} else {
 		b.WriteString(inactiveTab.Render("[2] By User"))
 	}
+	b.WriteString("  ")
+	pendingTab := fmt.Sprintf("[3] Pending (%d)", len(m.pendingInvitations()))
+	if m.viewMode == "pending" {
+		b.WriteString(activeTab.Render(pendingTab))
+	} else {
+		b.WriteString(inactiveTab.Render(pendingTab))
+	}
 	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
 
 	// Content based on view mode
 	switch m.viewMode {
@@ -180,12 +319,18 @@ func (m Model) View() string {
 		b.WriteString(m.renderByRepo())
 	case "byuser":
 		b.WriteString(m.renderByUser())
+	case "pending":
+		b.WriteString(m.renderPending())
 	}
 
 	// Help
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("↑/↓: navigate | 1/2: switch view | q: quit"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | pgup/pgdn: page | /: search | 1/2/3: switch view | q: quit"))
+	}
 
 	return b.String()
 }
@@ -195,7 +340,11 @@ func (m Model) renderByRepo() string {
 
 	b.WriteString("📦 Collaborators by Repository\n\n")
 
-	for i, repo := range m.repos {
+	for i, repo := range m.filteredRepos() {
+		if !layout.InWindow(i, m.scrollTop, m.maxVisible) {
+			continue
+		}
+
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -223,8 +372,9 @@ func (m Model) renderByRepo() string {
 				permColor = "#FFFF00"
 			}
 			permStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(permColor))
-			line += fmt.Sprintf("   - %s ", collab.Login)
-			line += permStyle.Render(fmt.Sprintf("[%s]", collab.Permission))
+			line += fmt.Sprintf("   - %-20s ", collab.Login)
+			line += permStyle.Render(fmt.Sprintf("%-8s", "["+collab.Permission+"]"))
+			line += " " + renderActivity(m.activity[repo][collab.Login])
 			line += "\n"
 		}
 
@@ -235,6 +385,25 @@ func (m Model) renderByRepo() string {
 	return b.String()
 }
 
+// renderActivity colors days since last activity: green when active, red when dormant
+func renderActivity(activity *github.CollaboratorActivity) string {
+	unknownStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+	if activity == nil {
+		return unknownStyle.Render("activity: ?")
+	}
+	if activity.DaysSinceActivity < 0 {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000")).Render("activity: none")
+	}
+
+	color := "#00FF00"
+	label := fmt.Sprintf("activity: %dd ago", activity.DaysSinceActivity)
+	if !activity.Active {
+		color = "#FF0000"
+		label += " (dormant)"
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(label)
+}
+
 func (m Model) renderByUser() string {
 	var b strings.Builder
 
@@ -255,8 +424,12 @@ func (m Model) renderByUser() string {
 	}
 
 	// Display users
-	currentIdx := 0
-	for user, repos := range userRepos {
+	for currentIdx, user := range m.filteredUsers() {
+		if !layout.InWindow(currentIdx, m.scrollTop, m.maxVisible) {
+			continue
+		}
+		repos := userRepos[user]
+
 		cursor := " "
 		if m.cursor == currentIdx {
 			cursor = ">"
@@ -290,9 +463,63 @@ func (m Model) renderByUser() string {
 
 		b.WriteString(userStyle.Render(line))
 		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// Invitations pending longer than these many days are highlighted
+const (
+	invitationWarnDays  = 7
+	invitationStaleDays = 30
+)
 
-		currentIdx++
+func (m Model) renderPending() string {
+	var b strings.Builder
+
+	b.WriteString("✉️  Pending Invitations\n\n")
+
+	pending := m.filteredInvitations()
+	if len(pending) == 0 {
+		b.WriteString("No pending invitations\n")
+		return b.String()
+	}
+
+	for i, p := range pending {
+		if !layout.InWindow(i, m.scrollTop, m.maxVisible) {
+			continue
+		}
+
+		cursor := " "
+		if m.cursor == i {
+			cursor = ">"
+		}
+
+		invitee := p.invitation.Login
+		if invitee == "" {
+			invitee = p.invitation.Email
+		}
+
+		line := fmt.Sprintf("%s %-30s %-20s %-8s ", cursor, p.repo, invitee, "["+p.invitation.Permission+"]")
+		if m.cursor == i {
+			line = lipgloss.NewStyle().Bold(true).Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString(renderDaysPending(p.invitation.DaysPending))
+		b.WriteString("\n")
 	}
 
 	return b.String()
 }
+
+// renderDaysPending colors invitation age: yellow after a week, red after a month
+func renderDaysPending(days int) string {
+	label := fmt.Sprintf("pending %dd", days)
+	switch {
+	case days > invitationStaleDays:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000")).Render(label)
+	case days > invitationWarnDays:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00")).Render(label)
+	}
+	return label
+}
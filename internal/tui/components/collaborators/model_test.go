@@ -0,0 +1,90 @@
+package collaborators
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestByRepoShowsActivity(t *testing.T) {
+	updated, _ := NewModel([]string{"acme/api"}).Update(collaboratorsLoadedMsg{
+		collaborators: map[string][]github.Collaborator{
+			"acme/api": {
+				{Login: "alice", Permission: "admin", Repository: "acme/api"},
+				{Login: "bob", Permission: "write", Repository: "acme/api"},
+				{Login: "carol", Permission: "read", Repository: "acme/api"},
+			},
+		},
+		activity: map[string]map[string]*github.CollaboratorActivity{
+			"acme/api": {
+				"alice": {DaysSinceActivity: 45, Active: true},
+				"bob":   {DaysSinceActivity: 100, Active: false},
+			},
+		},
+	})
+
+	view := updated.(Model).View()
+	for _, want := range []string{"activity: 45d ago", "activity: 100d ago (dormant)", "activity: ?"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in view, got:\n%s", want, view)
+		}
+	}
+}
+
+func TestPendingTabListsInvitations(t *testing.T) {
+	updated, _ := NewModel([]string{"acme/api", "acme/web"}).Update(collaboratorsLoadedMsg{
+		collaborators: map[string][]github.Collaborator{},
+		invitations: map[string][]github.PendingInvitation{
+			"acme/api": {
+				{ID: 1, Login: "dave", Permission: "write", DaysPending: 3},
+				{ID: 2, Email: "erin@example.com", Permission: "read", DaysPending: 45},
+			},
+			"acme/web": {
+				{ID: 3, Login: "frank", Permission: "admin", DaysPending: 10},
+			},
+		},
+	})
+	m := updated.(Model)
+
+	if view := m.View(); !strings.Contains(view, "[3] Pending (3)") {
+		t.Errorf("Expected pending tab with count, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	m = updated.(Model)
+	if m.itemCount() != 3 {
+		t.Errorf("Expected 3 rows, got %d", m.itemCount())
+	}
+
+	view := m.View()
+	for _, want := range []string{"dave", "erin@example.com", "frank", "pending 3d", "pending 45d", "pending 10d"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in view, got:\n%s", want, view)
+		}
+	}
+}
+
+func TestSearchFiltersUsersAcrossRepos(t *testing.T) {
+	var m tea.Model = NewModel([]string{"acme/api", "acme/web"})
+	m, _ = m.Update(collaboratorsLoadedMsg{
+		collaborators: map[string][]github.Collaborator{
+			"acme/api": {{Login: "carol", Permission: "read"}, {Login: "alice", Permission: "admin"}},
+			"acme/web": {{Login: "Alan", Permission: "write"}, {Login: "bob", Permission: "write"}},
+		},
+	})
+
+	for _, key := range []string{"2", "/", "a", "l"} {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	got := strings.Join(m.(Model).filteredUsers(), ",")
+	if got != "Alan,alice" {
+		t.Errorf("Expected sorted matching users, got %s", got)
+	}
+	if view := m.View(); strings.Contains(view, "bob") || !strings.Contains(view, "/al") {
+		t.Errorf("Expected only matching users and the kept filter, got:\n%s", view)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -19,9 +21,12 @@ type Model struct {
 	width        int
 	height       int
 	loading      bool
+	spinner      spinner.LoadingSpinner
 	err          error
 	filterAuthor string
 	showResolved bool
+	filterQuery  string // Case-insensitive substring match on comment author and file path
+	searchActive bool   // Set while the search query is being typed
 }
 
 // NewModel creates a new comments model
@@ -29,6 +34,7 @@ func NewModel(repo string) Model {
 	return Model{
 		repo:         repo,
 		loading:      true,
+		spinner:      spinner.New("Loading comments..."),
 		showResolved: false,
 	}
 }
@@ -41,7 +47,7 @@ type commentsLoadedMsg struct {
 
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return m.loadComments
+	return tea.Batch(m.loadComments, m.spinner.Tick())
 }
 
 func (m Model) loadComments() tea.Msg {
@@ -103,6 +109,14 @@ func (m Model) loadComments() tea.Msg {
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -116,10 +130,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
+		case "/":
+			m.searchActive = true
+
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
@@ -140,17 +161,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
 func (m Model) getActiveList() []github.Comment {
+	comments := m.unresolved
 	if m.showResolved {
-		return m.comments
+		comments = m.comments
 	}
-	return m.unresolved
+	return search.Filter(comments, m.filterQuery, func(c github.Comment) string {
+		return c.Author + " " + c.Path
+	})
 }
 
 // View renders the model
 func (m Model) View() string {
 	if m.loading {
-		return "Loading comments...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -174,6 +213,7 @@ func (m Model) View() string {
 		b.WriteString("Showing: Unresolved only\n")
 	}
 	b.WriteString(fmt.Sprintf("Total: %d | Unresolved: %d\n\n", len(m.comments), len(m.unresolved)))
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
 
 	// Comment list
 	activeList := m.getActiveList()
@@ -213,7 +253,11 @@ func (m Model) View() string {
 	// Help
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("↑/↓: navigate | r: toggle resolved | q: quit"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | /: search | r: toggle resolved | q: quit"))
+	}
 
 	return b.String()
 }
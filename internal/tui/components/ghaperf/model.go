@@ -8,7 +8,11 @@ import (
 	"time"
 
 	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/export"
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/layout"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -20,6 +24,8 @@ const (
 	viewWorkflows
 	viewJobs
 	viewBranches
+	viewHeatmap
+	viewFlaky
 )
 
 type Model struct {
@@ -29,6 +35,7 @@ type Model struct {
 	width      int
 	height     int
 	loading    bool
+	spinner    spinner.LoadingSpinner
 	err        error
 	viewMode   viewMode
 	cursor     int
@@ -39,17 +46,43 @@ type Model struct {
 	selectedWorkflow string
 	filterBranch     string
 	filterDays       int
+	filterCommit     string
 	cacheOnly        bool
 
+	commitInput bool
+	commitQuery string
+
+	filterQuery  string // Case-insensitive substring match on workflow, job, branch, or test names
+	searchActive bool   // Set while the search query is being typed
+
 	runs          []github.RunTiming
 	workflowStats map[string]*github.WorkflowStats
 	jobStats      map[string]*github.JobStats
 	branchStats   map[string]*github.BranchStats
 	baseBranch    string
+	stepJob       string // WorkflowJob key whose step breakdown is shown in the jobs tab
 
 	cacheManager *cache.GHAPerfCacheManager
 	cachedCount  int
 	newCount     int
+
+	flakyTests    []github.FlakyTest
+	flakyLoading  bool
+	flakyErr      error
+	flakyScanned  int
+	flakyRunLimit int
+
+	cacheStats   map[string]*github.WorkflowCacheStats // Step cache hit rates by workflow, from recent run logs
+	cacheLoading bool
+
+	regressionThreshold float64 // Percent slower than the base branch flagged as a regression
+
+	detailWorkers int // Run details fetched concurrently
+
+	cacheMaxSize int64                    // Run cache size cap in bytes; 0 is unlimited
+	cacheTTL     time.Duration            // Cached runs older than this are refetched; 0 never expires
+	cacheExpired bool                     // Set when the last load discarded a stale cache
+	cacheRemote  cache.RemoteCacheBackend // Shared cache store; nil uses the local cache only
 }
 
 func NewModel(repo string, opts ...Option) Model {
@@ -64,10 +97,17 @@ func NewModel(repo string, opts ...Option) Model {
 		owner:       owner,
 		repoName:    repoName,
 		loading:     true,
+		spinner:     spinner.New("Loading workflow runs..."),
 		viewMode:    viewOverview,
 		filterDays:  30,
 		baseBranch:  "main",
 		maxVisible:  15,
+
+		flakyRunLimit: github.DefaultFlakyLogRuns,
+
+		regressionThreshold: DefaultRegressionThreshold,
+
+		detailWorkers: github.DefaultDetailWorkers,
 	}
 
 	for _, opt := range opts {
@@ -77,6 +117,12 @@ func NewModel(repo string, opts ...Option) Model {
 	return m
 }
 
+// workflowSparklineWidth is the number of time buckets in the workflows tab trend column
+const workflowSparklineWidth = 15
+
+// DefaultRegressionThreshold matches the gha_perf.regression_threshold config default
+const DefaultRegressionThreshold = 20.0
+
 type Option func(*Model)
 
 func WithBranch(branch string) Option {
@@ -109,6 +155,52 @@ func WithBaseBranch(branch string) Option {
 	}
 }
 
+// WithRegressionThreshold flags branches more than pct percent slower than the base branch
+func WithRegressionThreshold(pct float64) Option {
+	return func(m *Model) {
+		m.regressionThreshold = pct
+	}
+}
+
+// WithWorkers sets how many run details are fetched concurrently
+func WithWorkers(n int) Option {
+	return func(m *Model) {
+		if n > 0 {
+			m.detailWorkers = n
+		}
+	}
+}
+
+// WithCacheMaxSize caps the run cache size in bytes, evicting the least recently updated repos
+func WithCacheMaxSize(bytes int64) Option {
+	return func(m *Model) {
+		m.cacheMaxSize = bytes
+	}
+}
+
+// WithCacheTTL discards cached runs last updated longer ago than ttl
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(m *Model) {
+		m.cacheTTL = ttl
+	}
+}
+
+// WithRemoteCache shares the run cache through remote, as the gha-perf command does
+func WithRemoteCache(remote cache.RemoteCacheBackend) Option {
+	return func(m *Model) {
+		m.cacheRemote = remote
+	}
+}
+
+// WithFlakyRuns sets how many recent failed runs have their logs scanned for flaky tests
+func WithFlakyRuns(n int) Option {
+	return func(m *Model) {
+		if n > 0 {
+			m.flakyRunLimit = n
+		}
+	}
+}
+
 type dataLoadedMsg struct {
 	runs          []github.RunTiming
 	workflows     []github.WorkflowFile
@@ -117,11 +209,22 @@ type dataLoadedMsg struct {
 	branchStats   map[string]*github.BranchStats
 	cachedCount   int
 	newCount      int
+	cacheExpired  bool
 	err           error
 }
 
+type flakyLoadedMsg struct {
+	flakyTests []github.FlakyTest
+	scanned    int
+	err        error
+}
+
+type cacheStatsLoadedMsg struct {
+	stats map[string]*github.WorkflowCacheStats
+}
+
 func (m Model) Init() tea.Cmd {
-	return m.loadData
+	return tea.Batch(m.loadData, m.spinner.Tick())
 }
 
 func (m Model) loadData() tea.Msg {
@@ -129,10 +232,14 @@ func (m Model) loadData() tea.Msg {
 		return dataLoadedMsg{err: fmt.Errorf("invalid repo format, expected owner/repo")}
 	}
 
-	cacheManager, err := cache.NewGHAPerfCacheManager("")
+	cacheManager, err := cache.NewGHAPerfCacheManager("", cache.WithMaxSize(m.cacheMaxSize))
 	if err != nil {
 		return dataLoadedMsg{err: fmt.Errorf("failed to create cache manager: %w", err)}
 	}
+	cacheManager.MaxAge = m.cacheTTL
+	if m.cacheRemote != nil {
+		cacheManager.SetRemote(m.cacheRemote)
+	}
 
 	ctx := context.Background()
 	client, err := github.NewClient(ctx)
@@ -168,9 +275,17 @@ func (m Model) loadData() tea.Msg {
 			Branch:       m.filterBranch,
 			Limit:        100,
 			CreatedAfter: since,
+			Workers:      m.detailWorkers,
 		}
 
-		newRuns, err := client.FetchWorkflowRunsWithDetails(m.owner, m.repoName, opts)
+		// Filtered fetches may not have cached every run below the resume point
+		filtered := opts.WorkflowFile != "" || opts.Branch != ""
+		resumeRunID := cachedData.ResumeRunID()
+		if filtered {
+			resumeRunID = 0
+		}
+
+		newRuns, pendingRunID, err := client.FetchWorkflowRunsSinceWithDetails(m.owner, m.repoName, opts, resumeRunID)
 		if err != nil {
 			if cachedCount > 0 {
 				allRuns = cachedData.Runs
@@ -188,7 +303,9 @@ func (m Model) loadData() tea.Msg {
 
 			allRuns = cacheManager.MergeRuns(cachedData.Runs, newRuns)
 
-			if newCount > 0 {
+			previousPending := cachedData.PendingRunID
+			cachedData.UpdatePending(pendingRunID, filtered)
+			if newCount > 0 || cachedData.PendingRunID != previousPending {
 				cachedData.Runs = allRuns
 				_ = cacheManager.Save(m.owner, m.repoName, cachedData)
 			}
@@ -216,11 +333,54 @@ func (m Model) loadData() tea.Msg {
 		branchStats:   branchStats,
 		cachedCount:   cachedCount,
 		newCount:      newCount,
+		cacheExpired:  cachedData.Expired,
+	}
+}
+
+// loadFlakyTests downloads job logs for recent failed runs and detects flaky go tests
+func (m Model) loadFlakyTests() tea.Msg {
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		return flakyLoadedMsg{err: fmt.Errorf("failed to create GitHub client: %w", err)}
+	}
+
+	failed := github.SelectFailedRuns(m.runs, m.flakyRunLimit)
+	logs := client.GetRunLogs(m.owner, m.repoName, failed)
+
+	return flakyLoadedMsg{
+		flakyTests: github.DetectFlakyTestsFromRunLogs(failed, logs, github.DefaultFlakyConfig()),
+		scanned:    len(failed),
 	}
 }
 
+// loadCacheStats downloads job logs for the most recent runs and measures step cache hit rates
+func (m Model) loadCacheStats() tea.Msg {
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		// Cache hit rates are supplementary; leave the column blank
+		return cacheStatsLoadedMsg{}
+	}
+
+	recent := m.runs
+	if len(recent) > github.DefaultCacheLogRuns {
+		recent = recent[:github.DefaultCacheLogRuns]
+	}
+	logs := client.GetRunLogs(m.owner, m.repoName, recent)
+
+	return cacheStatsLoadedMsg{stats: github.ComputeWorkflowCacheStats(recent, logs)}
+}
+
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -240,13 +400,55 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.branchStats = msg.branchStats
 		m.cachedCount = msg.cachedCount
 		m.newCount = msg.newCount
+		m.cacheExpired = msg.cacheExpired
+		m.flakyTests = nil
+		m.flakyErr = nil
+		m.flakyScanned = 0
+		m.cacheStats = nil
+		if msg.err != nil || m.cacheOnly {
+			return m, nil
+		}
+		m.flakyLoading = true
+		m.cacheLoading = true
+		return m, tea.Batch(m.loadFlakyTests, m.loadCacheStats)
+
+	case flakyLoadedMsg:
+		m.flakyLoading = false
+		m.flakyTests = msg.flakyTests
+		m.flakyScanned = msg.scanned
+		m.flakyErr = msg.err
+		return m, nil
+
+	case cacheStatsLoadedMsg:
+		m.cacheLoading = false
+		m.cacheStats = msg.stats
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.commitInput {
+			return m.updateCommitInput(msg), nil
+		}
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
+		case "/":
+			m.searchActive = true
+
+		case "c":
+			m.commitInput = true
+			m.commitQuery = m.filterCommit
+		case "esc":
+			if m.filterCommit != "" {
+				m.filterCommit = ""
+				m.cursor = 0
+				m.scrollTop = 0
+			}
+
 		case "1":
 			m.viewMode = viewOverview
 			m.cursor = 0
@@ -263,6 +465,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.viewMode = viewBranches
 			m.cursor = 0
 			m.scrollTop = 0
+		case "5":
+			m.viewMode = viewHeatmap
+			m.cursor = 0
+			m.scrollTop = 0
+		case "6":
+			m.viewMode = viewFlaky
+			m.cursor = 0
+			m.scrollTop = 0
 
 		case "up", "k":
 			if m.cursor > 0 {
@@ -279,33 +489,150 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.scrollTop = m.cursor - m.maxVisible + 1
 				}
 			}
+		case "pgdown":
+			m.cursor, m.scrollTop = layout.PageDown(m.cursor, m.scrollTop, m.maxVisible, m.getMaxCursor()+1)
+		case "pgup":
+			m.cursor, m.scrollTop = layout.PageUp(m.cursor, m.scrollTop, m.maxVisible)
+
+		case "enter":
+			if m.viewMode == viewJobs {
+				m.toggleStepBreakdown()
+			}
 
 		case "r":
 			m.loading = true
 			m.cacheOnly = false
-			return m, m.loadData
+			m.spinner = m.spinner.Restart()
+			return m, tea.Batch(m.loadData, m.spinner.Tick())
 		}
 	}
 
 	return m, nil
 }
 
+// toggleStepBreakdown shows the step breakdown for the highlighted job, or hides it if already shown
+func (m *Model) toggleStepBreakdown() {
+	jobs := m.filteredJobs()
+	if m.cursor >= len(jobs) {
+		return
+	}
+	if key := jobs[m.cursor].WorkflowJob; m.stepJob != key {
+		m.stepJob = key
+	} else {
+		m.stepJob = ""
+	}
+}
+
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	m.scrollTop = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// updateCommitInput edits the commit SHA prompt; enter applies it and esc cancels
+func (m Model) updateCommitInput(msg tea.KeyMsg) Model {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.commitInput = false
+		m.filterCommit = strings.TrimSpace(m.commitQuery)
+		m.viewMode = viewOverview
+		m.cursor = 0
+		m.scrollTop = 0
+	case tea.KeyEsc:
+		m.commitInput = false
+	case tea.KeyBackspace:
+		if len(m.commitQuery) > 0 {
+			m.commitQuery = m.commitQuery[:len(m.commitQuery)-1]
+		}
+	case tea.KeyRunes:
+		m.commitQuery += string(msg.Runes)
+	case tea.KeyCtrlC:
+		m.commitInput = false
+	}
+	return m
+}
+
+// visibleRuns returns the runs shown in the overview, narrowed to filterCommit and the search query when set
+func (m Model) visibleRuns() []github.RunTiming {
+	return search.Filter(github.FilterRunsByCommit(m.runs, m.filterCommit), m.filterQuery, func(run github.RunTiming) string {
+		return run.Workflow + " " + run.Branch
+	})
+}
+
+// filteredWorkflows returns workflow stats matching the search query, slowest first
+func (m Model) filteredWorkflows() []*github.WorkflowStats {
+	var workflows []*github.WorkflowStats
+	for _, ws := range m.workflowStats {
+		workflows = append(workflows, ws)
+	}
+	sort.Slice(workflows, func(i, j int) bool {
+		return workflows[i].AvgDuration > workflows[j].AvgDuration
+	})
+	return search.Filter(workflows, m.filterQuery, func(ws *github.WorkflowStats) string { return ws.Workflow })
+}
+
+// filteredJobs returns job stats matching the search query, slowest first
+func (m Model) filteredJobs() []*github.JobStats {
+	return search.Filter(github.GetTopJobsByDuration(m.jobStats, 0), m.filterQuery, func(js *github.JobStats) string {
+		return js.WorkflowJob
+	})
+}
+
+// filteredBranches returns branch stats matching the search query, base branch first then slowest first
+func (m Model) filteredBranches() []*github.BranchStats {
+	var branches []*github.BranchStats
+	for _, bs := range m.branchStats {
+		branches = append(branches, bs)
+	}
+
+	sort.Slice(branches, func(i, j int) bool {
+		if branches[i].Branch == m.baseBranch {
+			return true
+		}
+		if branches[j].Branch == m.baseBranch {
+			return false
+		}
+		return branches[i].AvgDuration > branches[j].AvgDuration
+	})
+
+	return search.Filter(branches, m.filterQuery, func(bs *github.BranchStats) string { return bs.Branch })
+}
+
+// filteredFlakyTests returns flaky tests whose names match the search query
+func (m Model) filteredFlakyTests() []github.FlakyTest {
+	return search.Filter(m.flakyTests, m.filterQuery, func(ft github.FlakyTest) string { return ft.Name })
+}
+
 func (m Model) getMaxCursor() int {
 	switch m.viewMode {
 	case viewWorkflows:
-		return len(m.workflowStats) - 1
+		return len(m.filteredWorkflows()) - 1
 	case viewJobs:
-		return len(m.jobStats) - 1
+		return len(m.filteredJobs()) - 1
 	case viewBranches:
-		return len(m.branchStats) - 1
+		return len(m.filteredBranches()) - 1
+	case viewHeatmap:
+		return 0
+	case viewFlaky:
+		return len(m.filteredFlakyTests()) - 1
 	default:
-		return len(m.runs) - 1
+		return len(m.visibleRuns()) - 1
 	}
 }
 
 func (m Model) View() string {
 	if m.loading {
-		return "Loading GHA performance data...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -324,11 +651,26 @@ func (m Model) View() string {
 	subtitleStyle := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#777777"))
 
-	b.WriteString(subtitleStyle.Render(fmt.Sprintf(
-		"Last %d days | %d runs (%d cached, %d new)",
-		m.filterDays, len(m.runs), m.cachedCount, m.newCount)))
+	subtitle := fmt.Sprintf("Last %d days | %d runs (%d cached, %d new)",
+		m.filterDays, len(m.runs), m.cachedCount, m.newCount)
+	if m.cacheExpired {
+		subtitle += fmt.Sprintf(" | Stale cache (>%s) discarded", m.cacheTTL)
+	}
+	if m.filterCommit != "" {
+		subtitle += fmt.Sprintf(" | Commit: %s", m.filterCommit)
+	}
+	b.WriteString(subtitleStyle.Render(subtitle))
 	b.WriteString("\n\n")
 
+	if m.commitInput {
+		promptStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
+		b.WriteString(promptStyle.Render(fmt.Sprintf("Commit SHA: %s_", m.commitQuery)))
+		b.WriteString("\n")
+		b.WriteString(subtitleStyle.Render("enter: apply (empty clears) | esc: cancel"))
+		b.WriteString("\n\n")
+	}
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
+
 	activeTab := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color("#FFFF00"))
@@ -344,6 +686,8 @@ func (m Model) View() string {
 		{"[2] Workflows", viewWorkflows},
 		{"[3] Jobs", viewJobs},
 		{"[4] Branches", viewBranches},
+		{"[5] Heatmap", viewHeatmap},
+		{"[6] Flaky", viewFlaky},
 	}
 
 	for _, tab := range tabs {
@@ -365,11 +709,19 @@ func (m Model) View() string {
 		b.WriteString(m.renderJobs())
 	case viewBranches:
 		b.WriteString(m.renderBranches())
+	case viewHeatmap:
+		b.WriteString(m.renderHeatmap())
+	case viewFlaky:
+		b.WriteString(m.renderFlaky())
 	}
 
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("1-4: views | j/k: navigate | r: refresh | esc: back | q: quit"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("1-6: views | j/k: navigate | pgup/pgdn: page | /: search | c: commit filter | r: refresh | esc: back | q: quit"))
+	}
 
 	return b.String()
 }
@@ -387,11 +739,12 @@ func (m Model) renderOverview() string {
 	b.WriteString(sectionStyle.Render("Summary"))
 	b.WriteString("\n")
 
-	totalRuns := len(m.runs)
+	runs := m.visibleRuns()
+	totalRuns := len(runs)
 	var successCount, failureCount int
 	var totalDuration time.Duration
 
-	for _, r := range m.runs {
+	for _, r := range runs {
 		totalDuration += r.Duration
 		switch r.Conclusion {
 		case "success":
@@ -412,20 +765,31 @@ func (m Model) renderOverview() string {
 	b.WriteString(fmt.Sprintf("  Success Rate:   %s\n", valueStyle.Render(fmt.Sprintf("%.1f%%", successRate))))
 	b.WriteString(fmt.Sprintf("  Failures:       %s\n", valueStyle.Render(fmt.Sprintf("%d", failureCount))))
 	b.WriteString(fmt.Sprintf("  Avg Duration:   %s\n", valueStyle.Render(github.FormatDuration(avgDuration))))
-	b.WriteString(fmt.Sprintf("  Workflows:      %s\n", valueStyle.Render(fmt.Sprintf("%d", len(m.workflowStats)))))
-	b.WriteString(fmt.Sprintf("  Branches:       %s\n", valueStyle.Render(fmt.Sprintf("%d", len(m.branchStats)))))
+	workflowCount, branchCount := len(m.workflowStats), len(m.branchStats)
+	if m.filterCommit != "" {
+		workflowCount = len(github.ComputeWorkflowStats(runs))
+		branchCount = len(github.ComputeBranchStats(runs, m.baseBranch))
+	}
+	b.WriteString(fmt.Sprintf("  Workflows:      %s\n", valueStyle.Render(fmt.Sprintf("%d", workflowCount))))
+	b.WriteString(fmt.Sprintf("  Branches:       %s\n", valueStyle.Render(fmt.Sprintf("%d", branchCount))))
 
 	b.WriteString("\n")
 	b.WriteString(sectionStyle.Render("Recent Runs"))
 	b.WriteString("\n")
 
-	displayRuns := m.runs
+	displayRuns := runs
 	if len(displayRuns) > 10 {
 		displayRuns = displayRuns[:10]
 	}
 
 	successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00"))
 	failureStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+	anomalyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
+
+	anomalous := make(map[int]github.AnomalousRun)
+	for _, a := range github.ComputeAnomalousRuns(m.runs, github.DefaultAnomalyZScore) {
+		anomalous[a.RunID] = a
+	}
 
 	for _, r := range displayRuns {
 		status := successStyle.Render("OK")
@@ -438,11 +802,15 @@ func (m Model) renderOverview() string {
 			workflow = workflow[:27] + "..."
 		}
 
-		b.WriteString(fmt.Sprintf("  %s %-30s %-15s %s\n",
+		b.WriteString(fmt.Sprintf("  %s %-30s %-15s %s",
 			status,
 			workflow,
 			r.Branch,
 			github.FormatDuration(r.Duration)))
+		if a, ok := anomalous[r.RunID]; ok {
+			b.WriteString(" " + anomalyStyle.Render(fmt.Sprintf("⚠ %s (%+.1fσ)", a.Deviation, a.ZScore)))
+		}
+		b.WriteString("\n")
 	}
 
 	return b.String()
@@ -462,16 +830,10 @@ func (m Model) renderWorkflows() string {
 		Bold(true).
 		Foreground(lipgloss.Color("#777777"))
 
-	b.WriteString(headerStyle.Render(fmt.Sprintf("  %-35s %8s %8s %8s %8s %8s\n",
-		"Workflow", "Runs", "Avg", "Min", "Max", "Success")))
+	b.WriteString(headerStyle.Render(fmt.Sprintf("  %-35s %8s %8s %8s %8s %8s %8s %8s %8s %8s %8s  %-*s\n",
+		"Workflow", "Runs", "Avg", "P50", "P75", "P95", "Queue", "Exec", "Min", "Max", "Success", workflowSparklineWidth, "Trend")))
 
-	var workflows []*github.WorkflowStats
-	for _, ws := range m.workflowStats {
-		workflows = append(workflows, ws)
-	}
-	sort.Slice(workflows, func(i, j int) bool {
-		return workflows[i].AvgDuration > workflows[j].AvgDuration
-	})
+	workflows := m.filteredWorkflows()
 
 	selectedStyle := lipgloss.NewStyle().
 		Background(lipgloss.Color("#333333"))
@@ -486,13 +848,20 @@ func (m Model) renderWorkflows() string {
 			name = name[:32] + "..."
 		}
 
-		line := fmt.Sprintf("  %-35s %8d %8s %8s %8s %7.0f%%",
+		series := github.GetWorkflowDurationTimeSeries(m.runs, ws.Workflow, workflowSparklineWidth)
+		line := fmt.Sprintf("  %-35s %8d %8s %8s %8s %8s %8s %8s %8s %8s %7.0f%%  %s",
 			name,
 			ws.TotalRuns,
 			github.FormatDuration(ws.AvgDuration),
+			github.FormatDuration(ws.P50),
+			github.FormatDuration(ws.P75),
+			github.FormatDuration(ws.P95),
+			github.FormatDuration(ws.AvgQueue),
+			github.FormatDuration(ws.AvgExecution),
 			github.FormatDuration(ws.MinDuration),
 			github.FormatDuration(ws.MaxDuration),
-			ws.SuccessRate)
+			ws.SuccessRate,
+			export.RenderSparkline(series, workflowSparklineWidth))
 
 		if i == m.cursor {
 			b.WriteString(selectedStyle.Render(line))
@@ -522,7 +891,7 @@ func (m Model) renderJobs() string {
 	b.WriteString(headerStyle.Render(fmt.Sprintf("  %-50s %8s %8s %8s %8s\n",
 		"Job", "Runs", "Avg", "Min", "Max")))
 
-	jobs := github.GetTopJobsByDuration(m.jobStats, 0)
+	jobs := m.filteredJobs()
 
 	selectedStyle := lipgloss.NewStyle().
 		Background(lipgloss.Color("#333333"))
@@ -552,9 +921,84 @@ func (m Model) renderJobs() string {
 		b.WriteString("\n")
 	}
 
+	if js, ok := m.jobStats[m.stepJob]; ok {
+		b.WriteString("\n")
+		b.WriteString(m.renderStepBreakdown(js))
+	} else {
+		b.WriteString("\n")
+		b.WriteString(headerStyle.Render("enter: step breakdown"))
+		b.WriteString("\n")
+	}
+
 	return b.String()
 }
 
+// renderStepBreakdown shows aggregated timings for each step of a job across runs of its workflow
+func (m Model) renderStepBreakdown(js *github.JobStats) string {
+	var b strings.Builder
+
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FFFFFF"))
+
+	b.WriteString(sectionStyle.Render(fmt.Sprintf("Steps: %s", js.WorkflowJob)))
+	b.WriteString("\n\n")
+
+	headerStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#777777"))
+
+	b.WriteString(headerStyle.Render(fmt.Sprintf("  %-40s %6s %8s %8s %8s %8s %7s\n",
+		"Step", "Runs", "Min", "Avg", "P95", "Max", "Cache")))
+
+	runs := github.FilterRunsByWorkflows(m.runs, []string{js.Workflow})
+	steps := github.AggregateJobStepTimings(runs, js.Job)
+	if len(steps) == 0 {
+		b.WriteString("  No step timings recorded\n")
+	}
+
+	for _, step := range steps {
+		name := step.StepName
+		if len(name) > 40 {
+			name = name[:37] + "..."
+		}
+
+		b.WriteString(fmt.Sprintf("  %-40s %6d %8s %8s %8s %8s %7s\n",
+			name,
+			step.RunCount,
+			github.FormatDuration(step.Min),
+			github.FormatDuration(step.Avg),
+			github.FormatDuration(step.P95),
+			github.FormatDuration(step.Max),
+			m.stepCacheHitRate(js.Workflow, step.StepName)))
+	}
+
+	if m.cacheLoading {
+		b.WriteString("\n")
+		b.WriteString(headerStyle.Render(fmt.Sprintf("Fetching logs for up to %d runs to measure cache hits...", github.DefaultCacheLogRuns)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(headerStyle.Render("enter: hide steps"))
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// stepCacheHitRate formats the cache hit rate of a step, or blank when its logs showed no cache lookup
+func (m Model) stepCacheHitRate(workflow, step string) string {
+	ws, ok := m.cacheStats[workflow]
+	if !ok {
+		return ""
+	}
+	stats, ok := ws.Steps[step]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%.0f%%", stats.HitRate())
+}
+
 func (m Model) renderBranches() string {
 	var b strings.Builder
 
@@ -572,20 +1016,7 @@ func (m Model) renderBranches() string {
 	b.WriteString(headerStyle.Render(fmt.Sprintf("  %-30s %8s %10s %12s\n",
 		"Branch", "Runs", "Avg", "Delta")))
 
-	var branches []*github.BranchStats
-	for _, bs := range m.branchStats {
-		branches = append(branches, bs)
-	}
-
-	sort.Slice(branches, func(i, j int) bool {
-		if branches[i].Branch == m.baseBranch {
-			return true
-		}
-		if branches[j].Branch == m.baseBranch {
-			return false
-		}
-		return branches[i].AvgDuration > branches[j].AvgDuration
-	})
+	branches := m.filteredBranches()
 
 	selectedStyle := lipgloss.NewStyle().
 		Background(lipgloss.Color("#333333"))
@@ -615,6 +1046,9 @@ func (m Model) renderBranches() string {
 				style = fasterStyle
 			}
 			delta = style.Render(fmt.Sprintf("%s%.0f%%", sign, bs.DeltaVsBasePct))
+			if github.IsRegression(bs.DeltaVsBasePct, m.regressionThreshold) {
+				delta += " " + slowerStyle.Render("⚠️ REGRESSION")
+			}
 		}
 
 		line := fmt.Sprintf("  %-30s %8d %10s %12s",
@@ -633,3 +1067,127 @@ func (m Model) renderBranches() string {
 
 	return b.String()
 }
+
+func (m Model) renderHeatmap() string {
+	var b strings.Builder
+
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FFFFFF"))
+
+	b.WriteString(sectionStyle.Render("Failures by Day and Hour (UTC)"))
+	b.WriteString("\n\n")
+
+	heatmap := github.ComputeFailureHeatmap(m.runs)
+	maxCount := github.MaxHeatmapCount(heatmap)
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#777777"))
+
+	var header strings.Builder
+	header.WriteString("      ")
+	for hour := 0; hour < 24; hour += 3 {
+		header.WriteString(fmt.Sprintf("%-6d", hour))
+	}
+	b.WriteString(headerStyle.Render(header.String()))
+	b.WriteString("\n")
+
+	noneStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00"))
+	moderateStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
+	highStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		b.WriteString(fmt.Sprintf("  %s ", day.String()[:3]))
+		for hour := 0; hour < 24; hour++ {
+			count := heatmap[day][hour]
+			switch {
+			case count == 0:
+				b.WriteString(noneStyle.Render("░░"))
+			case count*2 <= maxCount:
+				b.WriteString(moderateStyle.Render("▓▓"))
+			default:
+				b.WriteString(highStyle.Render("██"))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(headerStyle.Render(fmt.Sprintf("  Peak: %d failures in a single hour slot", maxCount)))
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+func (m Model) renderFlaky() string {
+	var b strings.Builder
+
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FFFFFF"))
+
+	b.WriteString(sectionStyle.Render("Flaky Tests (from failed run logs)"))
+	b.WriteString("\n\n")
+
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#777777"))
+
+	switch {
+	case m.cacheOnly:
+		b.WriteString(mutedStyle.Render("  Log fetching is disabled in cache-only mode"))
+		b.WriteString("\n")
+		return b.String()
+	case m.flakyLoading:
+		b.WriteString(mutedStyle.Render(fmt.Sprintf("  Fetching job logs for up to %d failed runs...", m.flakyRunLimit)))
+		b.WriteString("\n")
+		return b.String()
+	case m.flakyErr != nil:
+		b.WriteString(fmt.Sprintf("  Error: %v\n", m.flakyErr))
+		return b.String()
+	case len(m.flakyTests) == 0:
+		b.WriteString(mutedStyle.Render(fmt.Sprintf("  No flaky tests found in %d failed runs", m.flakyScanned)))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	headerStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#777777"))
+
+	b.WriteString(headerStyle.Render(fmt.Sprintf("  %-50s %8s %6s %6s  %s\n",
+		"Test", "Fail %", "Flips", "Runs", "Pattern")))
+
+	selectedStyle := lipgloss.NewStyle().
+		Background(lipgloss.Color("#333333"))
+
+	for i, ft := range m.filteredFlakyTests() {
+		if i < m.scrollTop || i >= m.scrollTop+m.maxVisible {
+			continue
+		}
+
+		name := ft.Name
+		if len(name) > 50 {
+			name = name[:47] + "..."
+		}
+
+		line := fmt.Sprintf("  %-50s %7.0f%% %6d %6d  %s",
+			name,
+			ft.FailureRate*100,
+			ft.FlipCount,
+			ft.TotalRuns,
+			ft.Pattern)
+
+		if i == m.cursor {
+			b.WriteString(selectedStyle.Render(line))
+		} else {
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(mutedStyle.Render(fmt.Sprintf("  Scanned logs from %d failed runs", m.flakyScanned)))
+	b.WriteString("\n")
+
+	return b.String()
+}
@@ -0,0 +1,297 @@
+package ghaperf
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFlakyTabShowsProgressThenResults(t *testing.T) {
+	now := time.Now()
+	runs := []github.RunTiming{
+		{RunID: 2, Workflow: "CI", HeadSHA: "aaa", Conclusion: "failure", CreatedAt: now.Add(-time.Hour)},
+		{RunID: 1, Workflow: "CI", HeadSHA: "aaa", Conclusion: "failure", CreatedAt: now.Add(-2 * time.Hour)},
+	}
+
+	updated, cmd := NewModel("owner/repo").Update(dataLoadedMsg{runs: runs})
+	if cmd == nil {
+		t.Fatal("Expected a command to fetch job logs after runs load")
+	}
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("6")})
+	m := updated.(Model)
+
+	if view := m.View(); !strings.Contains(view, "Fetching job logs") {
+		t.Errorf("Expected progress message while fetching logs, got:\n%s", view)
+	}
+
+	logs := map[int][]github.JobLog{
+		1: {{Repository: "owner/repo", Conclusion: "failure", Lines: []string{"--- FAIL: TestRace (0.01s)"}}},
+		2: {{Repository: "owner/repo", Conclusion: "failure", Lines: []string{"--- PASS: TestRace (0.01s)"}}},
+	}
+	flaky := github.DetectFlakyTestsFromRunLogs(runs, logs, github.DefaultFlakyConfig())
+
+	updated, _ = m.Update(flakyLoadedMsg{flakyTests: flaky, scanned: len(runs)})
+	m = updated.(Model)
+
+	view := m.View()
+	if !strings.Contains(view, "TestRace") || !strings.Contains(view, "same-commit-flip") {
+		t.Errorf("Expected flaky test listed, got:\n%s", view)
+	}
+}
+
+func TestFlakyTabSkipsLogsInCacheOnlyMode(t *testing.T) {
+	updated, cmd := NewModel("owner/repo", WithCacheOnly(true)).Update(dataLoadedMsg{})
+	if cmd != nil {
+		t.Error("Expected no log fetching in cache-only mode")
+	}
+
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("6")})
+	if view := updated.(Model).View(); !strings.Contains(view, "cache-only") {
+		t.Errorf("Expected cache-only notice, got:\n%s", view)
+	}
+}
+
+func TestCommitFilterNarrowsOverview(t *testing.T) {
+	now := time.Now()
+	runs := []github.RunTiming{
+		{RunID: 3, Workflow: "deploy.yml", HeadSHA: "abc1234def", Conclusion: "success", CreatedAt: now},
+		{RunID: 2, Workflow: "lint.yml", HeadSHA: "abc1234def", Conclusion: "failure", CreatedAt: now},
+		{RunID: 1, Workflow: "release.yml", HeadSHA: "9999999aaa", Conclusion: "success", CreatedAt: now},
+	}
+
+	updated, _ := NewModel("owner/repo", WithCacheOnly(true)).Update(dataLoadedMsg{runs: runs})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("abc1234")})
+	m := updated.(Model)
+
+	if view := m.View(); !strings.Contains(view, "Commit SHA: abc1234") {
+		t.Errorf("Expected commit prompt, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	view := m.View()
+	if !strings.Contains(view, "deploy.yml") || !strings.Contains(view, "lint.yml") {
+		t.Errorf("Expected runs for the commit, got:\n%s", view)
+	}
+	if strings.Contains(view, "release.yml") {
+		t.Errorf("Expected other commits filtered out, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if view := updated.(Model).View(); !strings.Contains(view, "release.yml") {
+		t.Errorf("Expected esc to clear the commit filter, got:\n%s", view)
+	}
+}
+
+func TestWorkflowsTabShowsQueueAndExecution(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	queue, execution := github.SplitRunDuration(created, created.Add(2*time.Minute), created.Add(12*time.Minute))
+	runs := []github.RunTiming{{
+		RunID:             1,
+		Workflow:          "ci.yml",
+		Conclusion:        "success",
+		CreatedAt:         created,
+		Duration:          12 * time.Minute,
+		QueueDuration:     queue,
+		ExecutionDuration: execution,
+	}}
+
+	msg := dataLoadedMsg{runs: runs, workflowStats: github.ComputeWorkflowStats(runs)}
+	updated, _ := NewModel("owner/repo", WithCacheOnly(true)).Update(msg)
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	view := updated.(Model).View()
+
+	if !strings.Contains(view, "Queue") || !strings.Contains(view, "Exec") {
+		t.Errorf("Expected queue and execution columns, got:\n%s", view)
+	}
+	if !strings.Contains(view, github.FormatDuration(2*time.Minute)) || !strings.Contains(view, github.FormatDuration(10*time.Minute)) {
+		t.Errorf("Expected 2m queue and 10m execution, got:\n%s", view)
+	}
+}
+
+func TestBranchesTabFlagsRegression(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	runs := []github.RunTiming{
+		{RunID: 1, Workflow: "ci.yml", Branch: "main", Conclusion: "success", CreatedAt: created, Duration: 8 * time.Minute},
+		{RunID: 2, Workflow: "ci.yml", Branch: "slow", Conclusion: "success", CreatedAt: created, Duration: 10 * time.Minute},
+		{RunID: 3, Workflow: "ci.yml", Branch: "fine", Conclusion: "success", CreatedAt: created, Duration: 9 * time.Minute},
+	}
+
+	msg := dataLoadedMsg{runs: runs, branchStats: github.ComputeBranchStats(runs, "main")}
+	updated, _ := NewModel("owner/repo", WithCacheOnly(true), WithRegressionThreshold(20)).Update(msg)
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("4")})
+	view := updated.(Model).View()
+
+	if strings.Count(view, "REGRESSION") != 1 {
+		t.Fatalf("Expected exactly one regression, got:\n%s", view)
+	}
+	for _, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "REGRESSION") && !strings.Contains(line, "slow") {
+			t.Errorf("Expected only the 25%% slower branch to regress, got line %q", line)
+		}
+	}
+}
+
+func TestJobsTabEnterShowsStepBreakdown(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	var runs []github.RunTiming
+	for i, seconds := range []int{30, 90} {
+		runs = append(runs, github.RunTiming{
+			RunID: i + 1, Workflow: "ci.yml", Conclusion: "success", CreatedAt: created,
+			Jobs: []github.JobTiming{{Name: "build", Duration: 2 * time.Minute, Steps: []github.StepTiming{
+				{Name: "Compile", Duration: time.Duration(seconds) * time.Second},
+			}}},
+		})
+	}
+
+	msg := dataLoadedMsg{runs: runs, jobStats: github.ComputeJobStats(runs)}
+	updated, _ := NewModel("owner/repo", WithCacheOnly(true)).Update(msg)
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	if view := updated.(Model).View(); strings.Contains(view, "Steps: ci.yml:build") {
+		t.Fatalf("Expected no step breakdown before enter, got:\n%s", view)
+	}
+
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view := updated.(Model).View()
+	if !strings.Contains(view, "Steps: ci.yml:build") || !strings.Contains(view, "Compile") {
+		t.Errorf("Expected step breakdown for build, got:\n%s", view)
+	}
+	if !strings.Contains(view, "P95") || !strings.Contains(view, github.FormatDuration(time.Minute)) {
+		t.Errorf("Expected aggregated step timings, got:\n%s", view)
+	}
+
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view := updated.(Model).View(); strings.Contains(view, "Steps: ci.yml:build") {
+		t.Errorf("Expected enter to hide the step breakdown, got:\n%s", view)
+	}
+}
+
+func TestStepBreakdownShowsCacheHitRate(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	runs := []github.RunTiming{{
+		RunID: 1, Workflow: "ci.yml", Conclusion: "success", CreatedAt: created,
+		Jobs: []github.JobTiming{{Name: "build", Duration: 2 * time.Minute, Steps: []github.StepTiming{
+			{Name: "Run actions/cache@v4", Duration: 5 * time.Second},
+			{Name: "Compile", Duration: time.Minute},
+		}}},
+	}}
+
+	updated, _ := NewModel("owner/repo").Update(dataLoadedMsg{runs: runs, jobStats: github.ComputeJobStats(runs)})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view := updated.(Model).View(); !strings.Contains(view, "measure cache hits") {
+		t.Errorf("Expected progress while cache logs load, got:\n%s", view)
+	}
+
+	stats := map[string]*github.WorkflowCacheStats{
+		"ci.yml": {Workflow: "ci.yml", Runs: 4, Steps: map[string]*github.StepCacheStats{
+			"Run actions/cache@v4": {Step: "Run actions/cache@v4", Hits: 3, Misses: 1},
+		}},
+	}
+	updated, _ = updated.Update(cacheStatsLoadedMsg{stats: stats})
+	view := updated.(Model).View()
+	if !strings.Contains(view, "Cache") || !strings.Contains(view, "75%") {
+		t.Fatalf("Expected cache hit rate column, got:\n%s", view)
+	}
+
+	for _, line := range strings.Split(view, "\n") {
+		switch {
+		case strings.Contains(line, "actions/cache@v4") && !strings.HasSuffix(strings.TrimSpace(line), "75%"):
+			t.Errorf("Expected 75%% hit rate next to the cache step, got %q", line)
+		case strings.Contains(line, "Compile") && strings.Contains(line, "%"):
+			t.Errorf("Expected no hit rate for a step without cache lookups, got %q", line)
+		}
+	}
+}
+
+func TestOverviewBadgesAnomalousRuns(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	runs := []github.RunTiming{
+		{RunID: 99, Workflow: "ci.yml", Branch: "outlier", Conclusion: "success", CreatedAt: created, Duration: 126 * time.Second},
+	}
+	for i := 0; i < 20; i++ {
+		seconds := 100
+		if i%2 == 1 {
+			seconds = 110
+		}
+		runs = append(runs, github.RunTiming{
+			RunID: i + 1, Workflow: "ci.yml", Branch: "main", Conclusion: "success", CreatedAt: created,
+			Duration: time.Duration(seconds) * time.Second,
+		})
+	}
+
+	updated, _ := NewModel("owner/repo", WithCacheOnly(true)).Update(dataLoadedMsg{runs: runs})
+	view := updated.(Model).View()
+
+	if strings.Count(view, "⚠") != 1 {
+		t.Fatalf("Expected exactly one anomaly badge, got:\n%s", view)
+	}
+	for _, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "⚠") && !strings.Contains(line, "outlier") {
+			t.Errorf("Expected the badge on the outlier run, got line %q", line)
+		}
+	}
+}
+
+func TestWorkflowsTabShowsTrendSparkline(t *testing.T) {
+	start := time.Now().Add(-14 * time.Hour)
+	runs := []github.RunTiming{
+		{RunID: 1, Workflow: "ci.yml", Conclusion: "success", CreatedAt: start, Duration: time.Minute},
+		{RunID: 2, Workflow: "ci.yml", Conclusion: "success", CreatedAt: start.Add(14 * time.Hour), Duration: 9 * time.Minute},
+	}
+
+	msg := dataLoadedMsg{runs: runs, workflowStats: github.ComputeWorkflowStats(runs)}
+	updated, _ := NewModel("owner/repo", WithCacheOnly(true)).Update(msg)
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	view := updated.(Model).View()
+
+	want := "▁" + strings.Repeat(" ", workflowSparklineWidth-2) + "█"
+	if !strings.Contains(view, "Trend") || !strings.Contains(view, want) {
+		t.Errorf("Expected trend column with %q, got:\n%s", want, view)
+	}
+}
+
+func TestWorkflowsTabShowsPercentiles(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	var runs []github.RunTiming
+	for i, minutes := range []int{2, 4, 6, 8, 60} {
+		runs = append(runs, github.RunTiming{
+			RunID:      i + 1,
+			Workflow:   "ci.yml",
+			Conclusion: "success",
+			CreatedAt:  created.Add(time.Duration(i) * time.Minute),
+			Duration:   time.Duration(minutes) * time.Minute,
+		})
+	}
+
+	msg := dataLoadedMsg{runs: runs, workflowStats: github.ComputeWorkflowStats(runs)}
+	updated, _ := NewModel("owner/repo", WithCacheOnly(true)).Update(msg)
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	view := updated.(Model).View()
+
+	for _, column := range []string{"P50", "P75", "P95"} {
+		if !strings.Contains(view, column) {
+			t.Errorf("Expected %s column, got:\n%s", column, view)
+		}
+	}
+	if !strings.Contains(view, github.FormatDuration(6*time.Minute)) {
+		t.Errorf("Expected 6m median, got:\n%s", view)
+	}
+}
+
+func TestWithWorkers(t *testing.T) {
+	if m := NewModel("owner/repo"); m.detailWorkers != github.DefaultDetailWorkers {
+		t.Errorf("detailWorkers = %d, want default %d", m.detailWorkers, github.DefaultDetailWorkers)
+	}
+	if m := NewModel("owner/repo", WithWorkers(8)); m.detailWorkers != 8 {
+		t.Errorf("detailWorkers = %d, want 8", m.detailWorkers)
+	}
+	if m := NewModel("owner/repo", WithWorkers(0)); m.detailWorkers != github.DefaultDetailWorkers {
+		t.Errorf("detailWorkers = %d, want default for 0", m.detailWorkers)
+	}
+}
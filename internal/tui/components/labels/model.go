@@ -0,0 +1,310 @@
+package labels
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Model represents the label comparison TUI state
+type Model struct {
+	repos    []string
+	labels   map[string][]github.Label
+	report   github.LabelDiffReport
+	failed   map[string]error
+	cursor   int
+	width    int
+	height   int
+	loading  bool
+	spinner  spinner.LoadingSpinner
+	err      error
+	viewMode string // "partial", "colors"
+
+	filterQuery  string // Case-insensitive substring match on label names
+	searchActive bool   // Set while the search query is being typed
+}
+
+// NewModel creates a new label comparison model
+func NewModel(repos []string) Model {
+	return Model{
+		repos:    repos,
+		labels:   make(map[string][]github.Label),
+		failed:   make(map[string]error),
+		loading:  true,
+		spinner:  spinner.New("Loading labels..."),
+		viewMode: "partial",
+	}
+}
+
+type labelsLoadedMsg struct {
+	labels map[string][]github.Label
+	failed map[string]error
+	err    error
+}
+
+// Init initializes the model
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.loadLabels, m.spinner.Tick())
+}
+
+func (m Model) loadLabels() tea.Msg {
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		return labelsLoadedMsg{err: fmt.Errorf("failed to create GitHub client: %w", err)}
+	}
+
+	labels := make(map[string][]github.Label)
+	failed := make(map[string]error)
+	for _, repoStr := range m.repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			failed[repoStr] = fmt.Errorf("expected owner/repo")
+			continue
+		}
+
+		repoLabels, err := client.ListLabels(parts[0], parts[1])
+		if err != nil {
+			failed[repoStr] = err
+			continue
+		}
+		labels[repoStr] = repoLabels
+	}
+
+	return labelsLoadedMsg{labels: labels, failed: failed}
+}
+
+// Update handles messages
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case labelsLoadedMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.labels != nil {
+			m.labels = msg.labels
+		}
+		if msg.failed != nil {
+			m.failed = msg.failed
+		}
+		m.report = github.CompareLabelSets(m.labels)
+		m.cursor = 0
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+
+		case "/":
+			m.searchActive = true
+
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+
+		case "down", "j":
+			if m.cursor < m.itemCount()-1 {
+				m.cursor++
+			}
+
+		case "1":
+			m.viewMode = "partial"
+			m.cursor = 0
+		case "2":
+			m.viewMode = "colors"
+			m.cursor = 0
+		}
+	}
+
+	return m, nil
+}
+
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+func (m Model) itemCount() int {
+	if m.viewMode == "colors" {
+		return len(m.filteredColorConflicts())
+	}
+	return len(m.filteredPartial())
+}
+
+// filteredPartial returns partially defined labels matching the search query
+func (m Model) filteredPartial() []github.LabelPresence {
+	return search.Filter(m.report.Partial, m.filterQuery, func(p github.LabelPresence) string { return p.Name })
+}
+
+// filteredColorConflicts returns color conflicts whose label name matches the search query
+func (m Model) filteredColorConflicts() []github.LabelColorConflict {
+	return search.Filter(m.report.ColorConflicts, m.filterQuery, func(c github.LabelColorConflict) string { return c.Name })
+}
+
+// View renders the model
+func (m Model) View() string {
+	if m.loading {
+		return m.spinner.View() + "\n"
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n", m.err)
+	}
+
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#00FFFF"))
+
+	b.WriteString(titleStyle.Render("🏷️  Label Consistency"))
+	b.WriteString("\n\n")
+
+	b.WriteString(fmt.Sprintf("Repositories: %d | Partial labels: %d | Color conflicts: %d\n",
+		m.report.TotalRepos, len(m.report.Partial), len(m.report.ColorConflicts)))
+	if len(m.failed) > 0 {
+		warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+		b.WriteString(warnStyle.Render(fmt.Sprintf("Failed to load %d repo(s)", len(m.failed))))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	activeTab := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FFFF00"))
+
+	inactiveTab := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#777777"))
+
+	if m.viewMode == "partial" {
+		b.WriteString(activeTab.Render("[1] Missing"))
+	} else {
+		b.WriteString(inactiveTab.Render("[1] Missing"))
+	}
+	b.WriteString("  ")
+	if m.viewMode == "colors" {
+		b.WriteString(activeTab.Render("[2] Color Conflicts"))
+	} else {
+		b.WriteString(inactiveTab.Render("[2] Color Conflicts"))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
+
+	switch m.viewMode {
+	case "partial":
+		b.WriteString(m.renderPartial())
+	case "colors":
+		b.WriteString(m.renderColors())
+	}
+
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | /: search | 1/2: switch view | q: quit"))
+	}
+
+	return b.String()
+}
+
+func (m Model) renderPartial() string {
+	if len(m.report.Partial) == 0 {
+		return "✅ Every label is defined in every repository\n"
+	}
+
+	partial := m.filteredPartial()
+	if len(partial) == 0 {
+		return "No labels match the search.\n"
+	}
+
+	var b strings.Builder
+	for i, presence := range partial {
+		cursor := " "
+		style := lipgloss.NewStyle()
+		if m.cursor == i {
+			cursor = ">"
+			style = style.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		}
+
+		b.WriteString(style.Render(fmt.Sprintf("%s %s (%d/%d repos)", cursor, presence.Name, len(presence.PresentIn), m.report.TotalRepos)))
+		b.WriteString("\n")
+		if m.cursor == i {
+			b.WriteString(fmt.Sprintf("   Missing from: %s\n", strings.Join(presence.MissingFrom, ", ")))
+		}
+	}
+
+	return b.String()
+}
+
+func (m Model) renderColors() string {
+	if len(m.report.ColorConflicts) == 0 {
+		return "✅ Same-named labels use the same color everywhere\n"
+	}
+
+	conflicts := m.filteredColorConflicts()
+	if len(conflicts) == 0 {
+		return "No labels match the search.\n"
+	}
+
+	var b strings.Builder
+	for i, conflict := range conflicts {
+		cursor := " "
+		style := lipgloss.NewStyle()
+		if m.cursor == i {
+			cursor = ">"
+			style = style.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		}
+
+		b.WriteString(style.Render(fmt.Sprintf("%s %s (%d colors)", cursor, conflict.Name, len(conflict.Colors))))
+		b.WriteString("\n")
+
+		colors := make([]string, 0, len(conflict.Colors))
+		for color := range conflict.Colors {
+			colors = append(colors, color)
+		}
+		sort.Strings(colors)
+
+		for _, color := range colors {
+			swatch := lipgloss.NewStyle().Foreground(lipgloss.Color("#" + color)).Render("■")
+			b.WriteString(fmt.Sprintf("   %s #%s: %s\n", swatch, color, strings.Join(conflict.Colors[color], ", ")))
+		}
+	}
+
+	return b.String()
+}
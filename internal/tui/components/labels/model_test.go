@@ -0,0 +1,45 @@
+package labels
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newLoadedModel() Model {
+	bug := github.Label{Name: "bug", Color: "d73a4a"}
+	triage := github.Label{Name: "needs-triage", Color: "ededed"}
+
+	m := NewModel([]string{"acme/api", "acme/web", "acme/cli"})
+	updated, _ := m.Update(labelsLoadedMsg{labels: map[string][]github.Label{
+		"acme/api": {bug, triage},
+		"acme/web": {bug, triage},
+		"acme/cli": {{Name: "bug", Color: "ff0000"}},
+	}})
+	return updated.(Model)
+}
+
+func TestViewListsPartialLabels(t *testing.T) {
+	view := newLoadedModel().View()
+
+	if !strings.Contains(view, "needs-triage (2/3 repos)") {
+		t.Errorf("Expected partial label summary, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Missing from: acme/cli") {
+		t.Errorf("Expected missing repo for highlighted label, got:\n%s", view)
+	}
+}
+
+func TestColorsTabListsConflicts(t *testing.T) {
+	updated, _ := newLoadedModel().Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	view := updated.(Model).View()
+
+	if !strings.Contains(view, "bug (2 colors)") {
+		t.Errorf("Expected bug color conflict, got:\n%s", view)
+	}
+	if !strings.Contains(view, "#ff0000: acme/cli") {
+		t.Errorf("Expected repos per color, got:\n%s", view)
+	}
+}
@@ -0,0 +1,78 @@
+package layout
+
+// DefaultMaxVisible is the number of list rows shown before a window size is known
+const DefaultMaxVisible = 15
+
+// MaxVisibleForHeight returns how many list rows fit after reserving chrome lines
+// Pure function: never returns fewer than 5 rows
+func MaxVisibleForHeight(height, chrome int) int {
+	maxVisible := height - chrome
+	if maxVisible < 5 {
+		maxVisible = 5
+	}
+	return maxVisible
+}
+
+// ClampScroll returns a scrollTop that keeps cursor within a window of pageSize rows
+// Pure function: scrollTop only moves as far as needed
+func ClampScroll(cursor, scrollTop, pageSize int) int {
+	if pageSize <= 0 {
+		return scrollTop
+	}
+	if cursor < scrollTop {
+		return cursor
+	}
+	if cursor >= scrollTop+pageSize {
+		return cursor - pageSize + 1
+	}
+	return scrollTop
+}
+
+// PageDown moves cursor and scrollTop forward by pageSize, clamped to count items
+// Pure function: returns the new cursor and scrollTop
+func PageDown(cursor, scrollTop, pageSize, count int) (int, int) {
+	if count == 0 || pageSize <= 0 {
+		return cursor, scrollTop
+	}
+
+	cursor += pageSize
+	if cursor > count-1 {
+		cursor = count - 1
+	}
+
+	scrollTop += pageSize
+	if maxTop := count - pageSize; scrollTop > maxTop {
+		scrollTop = maxTop
+	}
+	if scrollTop < 0 {
+		scrollTop = 0
+	}
+
+	return cursor, ClampScroll(cursor, scrollTop, pageSize)
+}
+
+// PageUp moves cursor and scrollTop back by pageSize, clamped at the first item
+// Pure function: returns the new cursor and scrollTop
+func PageUp(cursor, scrollTop, pageSize int) (int, int) {
+	if pageSize <= 0 {
+		return cursor, scrollTop
+	}
+
+	cursor -= pageSize
+	if cursor < 0 {
+		cursor = 0
+	}
+
+	scrollTop -= pageSize
+	if scrollTop < 0 {
+		scrollTop = 0
+	}
+
+	return cursor, ClampScroll(cursor, scrollTop, pageSize)
+}
+
+// InWindow reports whether row index is within the visible window
+// Pure function: used by list renderers to skip off-screen rows
+func InWindow(index, scrollTop, pageSize int) bool {
+	return index >= scrollTop && index < scrollTop+pageSize
+}
@@ -0,0 +1,76 @@
+package layout
+
+import "testing"
+
+// TestPageDown tests moving a full page forward and clamping at the end
+func TestPageDown(t *testing.T) {
+	tests := []struct {
+		name           string
+		cursor         int
+		scrollTop      int
+		count          int
+		expectedCursor int
+		expectedTop    int
+	}{
+		{"from top", 0, 0, 100, 10, 10},
+		{"mid list", 15, 10, 100, 25, 20},
+		{"clamped at last item", 93, 88, 100, 99, 90},
+		{"short list", 2, 0, 5, 4, 0},
+		{"empty list", 0, 0, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, top := PageDown(tt.cursor, tt.scrollTop, 10, tt.count)
+			if cursor != tt.expectedCursor || top != tt.expectedTop {
+				t.Errorf("Expected cursor %d top %d, got cursor %d top %d", tt.expectedCursor, tt.expectedTop, cursor, top)
+			}
+			if tt.count > 0 && !InWindow(cursor, top, 10) {
+				t.Errorf("Expected cursor %d visible in window starting at %d", cursor, top)
+			}
+		})
+	}
+}
+
+// TestPageUp tests moving a full page back and clamping at the start
+func TestPageUp(t *testing.T) {
+	tests := []struct {
+		name           string
+		cursor         int
+		scrollTop      int
+		expectedCursor int
+		expectedTop    int
+	}{
+		{"mid list", 25, 20, 15, 10},
+		{"clamped at first item", 4, 0, 0, 0},
+		{"scroll follows cursor", 12, 12, 2, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, top := PageUp(tt.cursor, tt.scrollTop, 10)
+			if cursor != tt.expectedCursor || top != tt.expectedTop {
+				t.Errorf("Expected cursor %d top %d, got cursor %d top %d", tt.expectedCursor, tt.expectedTop, cursor, top)
+			}
+		})
+	}
+}
+
+// TestClampScroll tests keeping the cursor inside the window
+func TestClampScroll(t *testing.T) {
+	tests := []struct {
+		cursor    int
+		scrollTop int
+		expected  int
+	}{
+		{5, 0, 0},
+		{10, 0, 1},
+		{3, 8, 3},
+	}
+
+	for _, tt := range tests {
+		if got := ClampScroll(tt.cursor, tt.scrollTop, 10); got != tt.expected {
+			t.Errorf("ClampScroll(%d, %d) expected %d, got %d", tt.cursor, tt.scrollTop, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package layout
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DefaultListPercent is the share of the width given to the list pane
+const DefaultListPercent = 60
+
+// DetailRenderer is implemented by list views that can describe the item under the cursor
+type DetailRenderer interface {
+	Cursor() int
+	RenderDetail(index int) string
+}
+
+// SplitPaneLayout renders a list and a detail panel side-by-side
+type SplitPaneLayout struct {
+	ListPercent int
+	Width       int
+	Height      int
+}
+
+// NewSplitPaneLayout creates a layout giving listPercent of the width to the list
+func NewSplitPaneLayout(listPercent int) SplitPaneLayout {
+	if listPercent <= 0 || listPercent >= 100 {
+		listPercent = DefaultListPercent
+	}
+
+	return SplitPaneLayout{ListPercent: listPercent}
+}
+
+// SetSize updates the available terminal size
+func (l *SplitPaneLayout) SetSize(width, height int) {
+	l.Width = width
+	l.Height = height
+}
+
+// ColumnWidths returns the list and detail widths for the current size
+// Pure function: the detail pane gets the remainder so widths sum to Width
+func (l SplitPaneLayout) ColumnWidths() (listWidth, detailWidth int) {
+	listWidth = l.Width * l.ListPercent / 100
+	detailWidth = l.Width - listWidth
+	return listWidth, detailWidth
+}
+
+// Render joins listView and detailView into two columns
+func (l SplitPaneLayout) Render(listView, detailView string) string {
+	listWidth, detailWidth := l.ColumnWidths()
+
+	listStyle := lipgloss.NewStyle().Width(listWidth)
+
+	// The border takes one column of the detail pane
+	detailStyle := lipgloss.NewStyle().
+		Width(max(detailWidth-1, 0)).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderLeft(true).
+		BorderForeground(lipgloss.Color("#777777")).
+		PaddingLeft(1)
+
+	if l.Height > 0 {
+		listStyle = listStyle.MaxHeight(l.Height)
+		detailStyle = detailStyle.MaxHeight(l.Height)
+	}
+
+	if strings.TrimSpace(detailView) == "" {
+		detailView = lipgloss.NewStyle().Foreground(lipgloss.Color("#777777")).Render("No item selected")
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		listStyle.Render(listView),
+		detailStyle.Render(detailView))
+}
+
+// RenderWithDetail renders listView alongside the detail for r's cursor item
+func (l SplitPaneLayout) RenderWithDetail(listView string, r DetailRenderer) string {
+	return l.Render(listView, r.RenderDetail(r.Cursor()))
+}
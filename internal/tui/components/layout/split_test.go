@@ -0,0 +1,84 @@
+package layout
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TestColumnWidths tests width allocation between the list and detail panes
+func TestColumnWidths(t *testing.T) {
+	tests := []struct {
+		name           string
+		percent        int
+		width          int
+		expectedList   int
+		expectedDetail int
+	}{
+		{"60/40 split", 60, 100, 60, 40},
+		{"odd width gives remainder to detail", 50, 81, 40, 41},
+		{"invalid percent uses default", 0, 100, DefaultListPercent, 100 - DefaultListPercent},
+		{"zero width", 70, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewSplitPaneLayout(tt.percent)
+			l.SetSize(tt.width, 20)
+
+			listWidth, detailWidth := l.ColumnWidths()
+			if listWidth != tt.expectedList || detailWidth != tt.expectedDetail {
+				t.Errorf("Expected %d/%d, got %d/%d", tt.expectedList, tt.expectedDetail, listWidth, detailWidth)
+			}
+		})
+	}
+}
+
+// TestRender tests that both panes are rendered within the total width
+func TestRender(t *testing.T) {
+	l := NewSplitPaneLayout(50)
+	l.SetSize(80, 10)
+
+	out := l.Render("item one\nitem two", "detail line")
+
+	if !strings.Contains(out, "item one") || !strings.Contains(out, "detail line") {
+		t.Errorf("Expected both panes in output, got:\n%s", out)
+	}
+
+	if width := lipgloss.Width(out); width != 80 {
+		t.Errorf("Expected rendered width 80, got %d", width)
+	}
+}
+
+type fakeList struct {
+	cursor int
+	items  []string
+}
+
+func (f fakeList) Cursor() int { return f.cursor }
+
+func (f fakeList) RenderDetail(index int) string {
+	if index < 0 || index >= len(f.items) {
+		return ""
+	}
+	return "Detail: " + f.items[index]
+}
+
+// TestRenderWithDetail tests that the detail pane follows the cursor
+func TestRenderWithDetail(t *testing.T) {
+	l := NewSplitPaneLayout(50)
+	l.SetSize(80, 10)
+
+	list := fakeList{items: []string{"alpha", "beta"}}
+
+	if out := l.RenderWithDetail("list", list); !strings.Contains(out, "Detail: alpha") {
+		t.Errorf("Expected alpha detail, got:\n%s", out)
+	}
+
+	list.cursor = 1
+	out := l.RenderWithDetail("list", list)
+	if !strings.Contains(out, "Detail: beta") || strings.Contains(out, "Detail: alpha") {
+		t.Errorf("Expected detail to follow cursor to beta, got:\n%s", out)
+	}
+}
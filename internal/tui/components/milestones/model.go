@@ -0,0 +1,298 @@
+package milestones
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// alertColors maps milestone alert levels to display colors
+var alertColors = map[string]string{
+	github.MilestoneAlertRed:    "#FF0000",
+	github.MilestoneAlertYellow: "#FFFF00",
+	github.MilestoneAlertGreen:  "#00FF00",
+}
+
+// Model represents the milestone tracking TUI state
+type Model struct {
+	repos      []string
+	milestones map[string][]github.Milestone
+	cursor     int
+	width      int
+	height     int
+	loading    bool
+	spinner    spinner.LoadingSpinner
+	err        error
+	viewMode   string // "open", "pastdue", "all"
+
+	filterQuery  string // Case-insensitive substring match on repo and milestone title
+	searchActive bool   // Set while the search query is being typed
+}
+
+// NewModel creates a new milestone tracking model
+func NewModel(repos []string) Model {
+	return Model{
+		repos:      repos,
+		milestones: make(map[string][]github.Milestone),
+		loading:    true,
+		spinner:    spinner.New("Loading milestones..."),
+		viewMode:   "open",
+	}
+}
+
+type milestonesLoadedMsg struct {
+	milestones map[string][]github.Milestone
+	err        error
+}
+
+// Init initializes the model
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.loadMilestones, m.spinner.Tick())
+}
+
+func (m Model) loadMilestones() tea.Msg {
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		return milestonesLoadedMsg{
+			milestones: make(map[string][]github.Milestone),
+			err:        fmt.Errorf("failed to create GitHub client: %w", err),
+		}
+	}
+
+	milestones := make(map[string][]github.Milestone)
+	for _, repoStr := range m.repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			continue
+		}
+
+		repoMilestones, err := client.ListMilestones(parts[0], parts[1])
+		if err != nil {
+			// Skip repos on error
+			continue
+		}
+		milestones[repoStr] = repoMilestones
+	}
+
+	return milestonesLoadedMsg{
+		milestones: milestones,
+		err:        nil,
+	}
+}
+
+// Update handles messages
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case milestonesLoadedMsg:
+		m.loading = false
+		m.milestones = msg.milestones
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+
+		case "/":
+			m.searchActive = true
+
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+
+		case "down", "j":
+			if m.cursor < len(m.visibleMilestones())-1 {
+				m.cursor++
+			}
+
+		case "1":
+			m.viewMode = "open"
+			m.cursor = 0
+		case "2":
+			m.viewMode = "pastdue"
+			m.cursor = 0
+		case "3":
+			m.viewMode = "all"
+			m.cursor = 0
+		}
+	}
+
+	return m, nil
+}
+
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+func (m Model) visibleMilestones() []github.Milestone {
+	now := time.Now()
+
+	var visible []github.Milestone
+	for _, repo := range m.repos {
+		for _, milestone := range m.milestones[repo] {
+			switch m.viewMode {
+			case "open":
+				if milestone.State != "open" {
+					continue
+				}
+			case "pastdue":
+				if !github.IsMilestonePastDue(milestone, now) {
+					continue
+				}
+			}
+			if !search.Matches(milestone.Repository+" "+milestone.Title, m.filterQuery) {
+				continue
+			}
+			visible = append(visible, milestone)
+		}
+	}
+
+	return visible
+}
+
+// View renders the model
+func (m Model) View() string {
+	if m.loading {
+		return m.spinner.View() + "\n"
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n", m.err)
+	}
+
+	var b strings.Builder
+
+	// Header
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#00FFFF"))
+
+	b.WriteString(titleStyle.Render("🎯 Milestones"))
+	b.WriteString("\n\n")
+
+	// View mode tabs
+	activeTab := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FFFF00"))
+
+	inactiveTab := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#777777"))
+
+	tabs := []struct {
+		mode  string
+		label string
+	}{
+		{"open", "[1] Open"},
+		{"pastdue", "[2] Past Due"},
+		{"all", "[3] All"},
+	}
+	for i, tab := range tabs {
+		if i > 0 {
+			b.WriteString("  ")
+		}
+		if m.viewMode == tab.mode {
+			b.WriteString(activeTab.Render(tab.label))
+		} else {
+			b.WriteString(inactiveTab.Render(tab.label))
+		}
+	}
+	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
+
+	b.WriteString(m.renderMilestones())
+
+	// Help
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | /: search | 1/2/3: switch view | q: quit"))
+	}
+
+	return b.String()
+}
+
+func (m Model) renderMilestones() string {
+	var b strings.Builder
+
+	visible := m.visibleMilestones()
+	if len(visible) == 0 {
+		b.WriteString("No milestones found.\n")
+		return b.String()
+	}
+
+	now := time.Now()
+	pastDueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+
+	for i, milestone := range visible {
+		cursor := " "
+		style := lipgloss.NewStyle()
+		if m.cursor == i {
+			cursor = ">"
+			style = style.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		}
+
+		due := "no due date"
+		if milestone.DueOn != nil {
+			due = "due " + milestone.DueOn.Format("2006-01-02")
+		}
+
+		line := fmt.Sprintf("%s %s #%d %s\n", cursor, milestone.Repository, milestone.Number, milestone.Title)
+		b.WriteString(style.Render(line))
+
+		detail := fmt.Sprintf("   %s  %d open / %d closed  %s",
+			github.GetMilestoneProgress(milestone),
+			milestone.OpenIssues,
+			milestone.ClosedIssues,
+			due)
+		if github.IsMilestonePastDue(milestone, now) {
+			detail = pastDueStyle.Render(detail + "  ⚠ PAST DUE")
+		} else if milestone.DueOn != nil && milestone.State == "open" {
+			level := github.ClassifyMilestoneAlert(github.DaysUntilDue(milestone, now), milestone.CompletionPct)
+			detail = lipgloss.NewStyle().Foreground(lipgloss.Color(alertColors[level])).Render(detail)
+		}
+		b.WriteString(detail)
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
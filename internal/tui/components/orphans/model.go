@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/KyleKing/gh-sweep/internal/cache"
 	"github.com/KyleKing/gh-sweep/internal/github"
 	"github.com/KyleKing/gh-sweep/internal/orphans"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/layout"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -15,9 +20,10 @@ import (
 type ViewMode string
 
 const (
-	ViewModeByRepo ViewMode = "by_repo"
-	ViewModeByType ViewMode = "by_type"
-	ViewModeFlat   ViewMode = "flat"
+	ViewModeByRepo     ViewMode = "by_repo"
+	ViewModeByType     ViewMode = "by_type"
+	ViewModeFlat       ViewMode = "flat"
+	ViewModeByPriority ViewMode = "by_priority"
 )
 
 type Model struct {
@@ -28,7 +34,10 @@ type Model struct {
 	cursor         int
 	selected       map[string]bool
 	filterType     *orphans.OrphanType
+	filterQuery    string // Case-insensitive substring match on repo/branch or repo@tag
+	searchActive   bool   // Set while the search query is being typed
 	loading        bool
+	spinner        spinner.LoadingSpinner
 	scanning       string
 	progress       int
 	total          int
@@ -39,21 +48,54 @@ type Model struct {
 	height         int
 	confirmDelete  bool
 	deleteTargets  []orphans.OrphanedBranch
+	selectionAnchor int // Start of a shift+arrow range selection, -1 when inactive
+	scrollTop       int
+	maxVisible      int
+	cacheTTL        time.Duration
+	cachedAt        time.Time // When the displayed result was cached; zero for a fresh scan
+	progressCh      chan orphans.ScanProgress // Progress of the running scan; closed when it finishes
+
+	// Branches deleted this session, most recent last; u restores the last one
+	undoBuffer []orphans.OrphanedBranch
 }
 
-func NewModel(namespace string, options orphans.ScanOptions) Model {
-	return Model{
+// Option configures the orphans model
+type Option func(*Model)
+
+// WithCacheTTL sets how long a previous scan of the namespace is reused
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(m *Model) {
+		m.cacheTTL = ttl
+	}
+}
+
+func NewModel(namespace string, options orphans.ScanOptions, opts ...Option) Model {
+	m := Model{
 		namespace: namespace,
 		options:   options,
 		viewMode:  ViewModeByRepo,
 		selected:  make(map[string]bool),
 		loading:   true,
+		spinner:   spinner.New("Loading repositories..."),
+
+		maxVisible:      layout.DefaultMaxVisible,
+
+		selectionAnchor: -1,
+
+		progressCh: make(chan orphans.ScanProgress, 1),
 	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
 }
 
 type scanCompleteMsg struct {
-	result *orphans.NamespaceScanResult
-	err    error
+	result   *orphans.NamespaceScanResult
+	cachedAt time.Time
+	err      error
 }
 
 type scanProgressMsg struct {
@@ -65,14 +107,58 @@ type scanProgressMsg struct {
 
 type deleteResultMsg struct {
 	branch string
+	orphan orphans.OrphanedBranch
+	err    error
+}
+
+type restoreResultMsg struct {
+	orphan orphans.OrphanedBranch
+	sha    string
 	err    error
 }
 
 func (m Model) Init() tea.Cmd {
-	return m.startScan
+	return tea.Batch(m.startScan, listenForProgress(m.progressCh), m.spinner.Tick())
+}
+
+// listenForProgress waits for the next progress update from a running scan
+// It returns nil once the scan closes the channel
+func listenForProgress(progressCh <-chan orphans.ScanProgress) tea.Cmd {
+	return func() tea.Msg {
+		progress, ok := <-progressCh
+		if !ok {
+			return nil
+		}
+		return scanProgressMsg{
+			current:     progress.Current,
+			total:       progress.Total,
+			currentRepo: progress.CurrentRepo,
+			orphans:     progress.Orphans,
+		}
+	}
 }
 
+// startScan returns a cached scan of the namespace when one is within the TTL
 func (m Model) startScan() tea.Msg {
+	return m.scan(false)
+}
+
+// refreshScan rescans the namespace, ignoring any cached result
+func (m Model) refreshScan() tea.Msg {
+	return m.scan(true)
+}
+
+func (m Model) scan(forceRefresh bool) tea.Msg {
+	defer close(m.progressCh)
+
+	// The cache is an optimization; scan without it if it cannot be opened
+	scanCache, cacheErr := cache.NewOrphanScanCache("", m.cacheTTL)
+	if cacheErr == nil && !forceRefresh {
+		if result, savedAt, ok, err := scanCache.Load(m.namespace, m.options); err == nil && ok {
+			return scanCompleteMsg{result: result, cachedAt: savedAt}
+		}
+	}
+
 	ctx := context.Background()
 	client, err := github.NewClient(ctx)
 	if err != nil {
@@ -80,21 +166,36 @@ func (m Model) startScan() tea.Msg {
 	}
 
 	scanner := orphans.NewNamespaceScanner(client, m.options)
-	result, err := scanner.ScanNamespace(ctx, m.namespace)
+	result, err := scanner.ScanNamespaceWithProgress(ctx, m.namespace, m.progressCh)
+
+	if err == nil && cacheErr == nil {
+		_ = scanCache.Save(m.namespace, m.options, result)
+	}
 
 	return scanCompleteMsg{result: result, err: err}
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.maxVisible = layout.MaxVisibleForHeight(msg.Height, 12)
+		m.scrollTop = layout.ClampScroll(m.cursor, m.scrollTop, m.maxVisible)
 		return m, nil
 
 	case scanCompleteMsg:
 		m.loading = false
 		m.result = msg.result
+		m.cachedAt = msg.cachedAt
 		m.err = msg.err
 		return m, nil
 
@@ -103,40 +204,68 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.total = msg.total
 		m.scanning = msg.currentRepo
 		m.orphansFound = msg.orphans
-		return m, nil
+		return m, listenForProgress(m.progressCh)
 
 	case deleteResultMsg:
 		if msg.err != nil {
 			m.statusMsg = fmt.Sprintf("Failed to delete %s: %v", msg.branch, msg.err)
 		} else {
-			m.statusMsg = fmt.Sprintf("Deleted: %s", msg.branch)
+			m.statusMsg = fmt.Sprintf("Deleted: %s (u: undo)", msg.branch)
+			m.undoBuffer = append(m.undoBuffer, msg.orphan)
 			delete(m.selected, msg.branch)
-			m.removeOrphanFromResult(msg.branch)
+			m.result = removeOrphanFromResult(m.result, msg.branch)
 		}
 		m.confirmDelete = false
 		m.deleteTargets = nil
 		return m, nil
 
+	case restoreResultMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to restore %s: %v", msg.orphan.Key(), msg.err)
+			m.undoBuffer = append(m.undoBuffer, msg.orphan)
+		} else {
+			m.statusMsg = fmt.Sprintf("Restored: %s at %s (r: rescan)", msg.orphan.Key(), shortSHA(msg.sha))
+		}
+		return m, nil
+
 	case tea.KeyMsg:
 		if m.confirmDelete {
 			return m.handleConfirmKeys(msg)
 		}
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
 
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
 		case "up", "k":
+			m.selectionAnchor = -1
 			if m.cursor > 0 {
 				m.cursor--
 			}
 
 		case "down", "j":
-			filtered := m.getFilteredOrphans()
-			if m.cursor < len(filtered)-1 {
+			m.selectionAnchor = -1
+			if m.cursor < m.visibleCount()-1 {
 				m.cursor++
 			}
 
+		case "pgdown":
+			m.selectionAnchor = -1
+			m.cursor, m.scrollTop = layout.PageDown(m.cursor, m.scrollTop, m.maxVisible, m.visibleCount())
+
+		case "pgup":
+			m.selectionAnchor = -1
+			m.cursor, m.scrollTop = layout.PageUp(m.cursor, m.scrollTop, m.maxVisible)
+
+		case "shift+up":
+			m.extendSelection(-1)
+
+		case "shift+down":
+			m.extendSelection(1)
+
 		case " ":
 			filtered := m.getFilteredOrphans()
 			if m.cursor < len(filtered) {
@@ -154,26 +283,55 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.selected = make(map[string]bool)
 
 		case "d":
+			if m.showingTags() {
+				m.statusMsg = "Tag deletion is not supported; tags are listed for review"
+				return m, nil
+			}
 			return m.handleDelete()
 
+		case "u":
+			return m.handleUndo()
+
+		case "/":
+			m.searchActive = true
+
 		case "1":
 			m.filterType = nil
 			m.cursor = 0
+			m.selectionAnchor = -1
 
 		case "2":
 			t := orphans.OrphanTypeMergedPR
 			m.filterType = &t
 			m.cursor = 0
+			m.selectionAnchor = -1
 
 		case "3":
 			t := orphans.OrphanTypeClosedPR
 			m.filterType = &t
 			m.cursor = 0
+			m.selectionAnchor = -1
 
 		case "4":
 			t := orphans.OrphanTypeStale
 			m.filterType = &t
 			m.cursor = 0
+			m.selectionAnchor = -1
+
+		case "5":
+			t := orphans.OrphanTypeStaleTag
+			m.filterType = &t
+			m.cursor = 0
+			m.selectionAnchor = -1
+
+		case "6":
+			// Tags have no priority score, so fall back to all branches
+			if m.showingTags() {
+				m.filterType = nil
+			}
+			m.viewMode = ViewModeByPriority
+			m.cursor = 0
+			m.selectionAnchor = -1
 
 		case "v":
 			switch m.viewMode {
@@ -182,23 +340,89 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case ViewModeByType:
 				m.viewMode = ViewModeFlat
 			case ViewModeFlat:
+				m.viewMode = ViewModeByPriority
+			case ViewModeByPriority:
 				m.viewMode = ViewModeByRepo
 			}
 			m.cursor = 0
+			m.selectionAnchor = -1
 
 		case "r":
 			m.loading = true
 			m.result = nil
 			m.err = nil
 			m.cursor = 0
+			m.selectionAnchor = -1
 			m.selected = make(map[string]bool)
-			return m, m.startScan
+			m.spinner = m.spinner.Restart()
+			m.progress, m.total, m.scanning, m.orphansFound = 0, 0, "", 0
+			m.progressCh = make(chan orphans.ScanProgress, 1)
+			return m, tea.Batch(m.refreshScan, listenForProgress(m.progressCh), m.spinner.Tick())
 		}
+
+		m.scrollTop = layout.ClampScroll(m.cursor, m.scrollTop, m.maxVisible)
 	}
 
 	return m, nil
 }
 
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	m.scrollTop = 0
+	m.selectionAnchor = -1
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// extendSelection moves the cursor by delta and selects everything between the anchor and cursor
+func (m *Model) extendSelection(delta int) {
+	filtered := m.getFilteredOrphans()
+	if len(filtered) == 0 {
+		return
+	}
+
+	if m.selectionAnchor < 0 {
+		m.selectionAnchor = m.cursor
+	}
+
+	oldStart, oldEnd := orderedRange(m.selectionAnchor, m.cursor)
+
+	newCursor := m.cursor + delta
+	if newCursor < 0 || newCursor >= len(filtered) {
+		newCursor = m.cursor
+	}
+	m.cursor = newCursor
+
+	newStart, newEnd := orderedRange(m.selectionAnchor, m.cursor)
+
+	// Deselect rows that fell out of the range when it shrinks
+	for i := oldStart; i <= oldEnd && i < len(filtered); i++ {
+		if i < newStart || i > newEnd {
+			delete(m.selected, filtered[i].Key())
+		}
+	}
+
+	for i := newStart; i <= newEnd; i++ {
+		m.selected[filtered[i].Key()] = true
+	}
+}
+
+func orderedRange(a, b int) (int, int) {
+	if a > b {
+		return b, a
+	}
+	return a, b
+}
+
 func (m Model) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "y", "Y":
@@ -247,16 +471,16 @@ func (m Model) executeDelete() (tea.Model, tea.Cmd) {
 			ctx := context.Background()
 			client, err := github.NewClient(ctx)
 			if err != nil {
-				return deleteResultMsg{branch: orphan.Key(), err: err}
+				return deleteResultMsg{branch: orphan.Key(), orphan: orphan, err: err}
 			}
 
 			parts := strings.SplitN(orphan.Repository, "/", 2)
 			if len(parts) != 2 {
-				return deleteResultMsg{branch: orphan.Key(), err: fmt.Errorf("invalid repository: %s", orphan.Repository)}
+				return deleteResultMsg{branch: orphan.Key(), orphan: orphan, err: fmt.Errorf("invalid repository: %s", orphan.Repository)}
 			}
 
 			err = client.DeleteBranch(parts[0], parts[1], orphan.BranchName)
-			return deleteResultMsg{branch: orphan.Key(), err: err}
+			return deleteResultMsg{branch: orphan.Key(), orphan: orphan, err: err}
 		})
 	}
 
@@ -264,21 +488,127 @@ func (m Model) executeDelete() (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
-func (m *Model) removeOrphanFromResult(key string) {
-	if m.result == nil {
-		return
+// handleUndo restores the most recently deleted branch
+func (m Model) handleUndo() (tea.Model, tea.Cmd) {
+	if len(m.undoBuffer) == 0 {
+		m.statusMsg = "Nothing to undo"
+		return m, nil
+	}
+
+	orphan := m.undoBuffer[len(m.undoBuffer)-1]
+	m.undoBuffer = m.undoBuffer[:len(m.undoBuffer)-1]
+	m.statusMsg = fmt.Sprintf("Restoring %s...", orphan.Key())
+
+	return m, func() tea.Msg {
+		sha, err := restoreOrphan(orphan)
+		return restoreResultMsg{orphan: orphan, sha: sha, err: err}
+	}
+}
+
+// restoreOrphan recreates a deleted branch at its scanned SHA
+// When the scan did not record a SHA, it is recovered from the repository event log
+func restoreOrphan(orphan orphans.OrphanedBranch) (string, error) {
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.SplitN(orphan.Repository, "/", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("invalid repository: %s", orphan.Repository)
+	}
+	owner, repo := parts[0], parts[1]
+
+	if orphan.SHA == "" {
+		return client.RestoreDeletedBranch(owner, repo, orphan.BranchName, time.Now().Add(-github.DefaultRestoreWindow))
+	}
+
+	if err := client.CreateBranchRef(owner, repo, orphan.BranchName, orphan.SHA); err != nil {
+		return "", err
+	}
+	return orphan.SHA, nil
+}
+
+// shortSHA abbreviates a commit SHA for status messages
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+// renderScanErrors warns that results are partial and lists each repository that failed
+func (m Model) renderScanErrors() string {
+	var b strings.Builder
+
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
+	b.WriteString(warnStyle.Render(fmt.Sprintf("⚠ %d repos had scan errors - showing partial results", m.result.ErrorCount)))
+	b.WriteString("\n")
+
+	for _, failed := range m.result.FailedResults() {
+		b.WriteString(fmt.Sprintf("  %s %s: %v\n", warnStyle.Render("⚠"), failed.Repository.FullName, failed.Error))
 	}
 
-	for i := range m.result.Results {
-		result := &m.result.Results[i]
-		for j := len(result.Orphans) - 1; j >= 0; j-- {
-			if result.Orphans[j].Key() == key {
-				result.Orphans = append(result.Orphans[:j], result.Orphans[j+1:]...)
-				m.result.TotalOrphans--
-				break
+	return b.String()
+}
+
+// removeOrphanFromResult returns a copy of result without the orphan matching key
+// The input is left untouched so earlier model values never observe the removal
+func removeOrphanFromResult(result *orphans.NamespaceScanResult, key string) *orphans.NamespaceScanResult {
+	if result == nil {
+		return nil
+	}
+
+	updated := *result
+	updated.Results = make([]orphans.ScanResult, len(result.Results))
+	for i, repoResult := range result.Results {
+		kept := make([]orphans.OrphanedBranch, 0, len(repoResult.Orphans))
+		for _, orphan := range repoResult.Orphans {
+			if orphan.Key() == key {
+				updated.TotalOrphans--
+				continue
 			}
+			kept = append(kept, orphan)
+		}
+		repoResult.Orphans = kept
+		updated.Results[i] = repoResult
+	}
+
+	return &updated
+}
+
+// showingTags reports whether the tag filter is active
+func (m Model) showingTags() bool {
+	return m.filterType != nil && *m.filterType == orphans.OrphanTypeStaleTag
+}
+
+// visibleCount returns the number of rows in the current filter
+func (m Model) visibleCount() int {
+	if m.showingTags() {
+		return len(m.getOrphanedTags())
+	}
+	return len(m.getFilteredOrphans())
+}
+
+func (m Model) getOrphanedTags() []orphans.OrphanedTag {
+	if m.result == nil {
+		return nil
+	}
+
+	var tags []orphans.OrphanedTag
+	for _, tag := range m.result.AllOrphanedTags() {
+		if search.Matches(tag.Key(), m.filterQuery) {
+			tags = append(tags, tag)
 		}
 	}
+	sort.Slice(tags, func(i, j int) bool {
+		if m.viewMode == ViewModeFlat {
+			return tags[i].CreatedAt.Before(tags[j].CreatedAt)
+		}
+		return tags[i].Key() < tags[j].Key()
+	})
+
+	return tags
 }
 
 func (m Model) getFilteredOrphans() []orphans.OrphanedBranch {
@@ -292,6 +622,9 @@ func (m Model) getFilteredOrphans() []orphans.OrphanedBranch {
 		if m.filterType != nil && orphan.Type != *m.filterType {
 			continue
 		}
+		if !search.Matches(orphan.Key(), m.filterQuery) {
+			continue
+		}
 		filtered = append(filtered, orphan)
 	}
 
@@ -307,6 +640,8 @@ func (m Model) getFilteredOrphans() []orphans.OrphanedBranch {
 		sort.Slice(filtered, func(i, j int) bool {
 			return filtered[i].LastCommitDate.Before(filtered[j].LastCommitDate)
 		})
+	case ViewModeByPriority:
+		orphans.SortByPriority(filtered)
 	default:
 		sort.Slice(filtered, func(i, j int) bool {
 			if filtered[i].Repository != filtered[j].Repository {
@@ -319,13 +654,31 @@ func (m Model) getFilteredOrphans() []orphans.OrphanedBranch {
 	return filtered
 }
 
+// progressBarWidth is the number of cells in the scan progress bar
+const progressBarWidth = 30
+
+// renderProgressBar renders current/total as a bar like "[████░░] 67%"
+func renderProgressBar(current, total int) string {
+	filled := 0
+	if total > 0 {
+		filled = current * progressBarWidth / total
+	}
+	filled = max(0, min(filled, progressBarWidth))
+
+	pct := 0
+	if total > 0 {
+		pct = current * 100 / total
+	}
+	return fmt.Sprintf("[%s%s] %d%%", strings.Repeat("█", filled), strings.Repeat("░", progressBarWidth-filled), pct)
+}
+
 func (m Model) View() string {
 	if m.loading {
 		if m.total > 0 {
-			return fmt.Sprintf("Scanning repositories...\nProgress: %d/%d repos\nCurrently: %s\nOrphans found: %d\n",
-				m.progress, m.total, m.scanning, m.orphansFound)
+			return fmt.Sprintf("%s\n%s\nScanning: %d/%d repos | Orphans found: %d\nCurrently: %s\n",
+				m.spinner.View(), renderProgressBar(m.progress, m.total), m.progress, m.total, m.orphansFound, m.scanning)
 		}
-		return "Loading repositories...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -340,7 +693,17 @@ func (m Model) View() string {
 
 	b.WriteString(titleStyle.Render("Orphaned Branches"))
 	b.WriteString("\n")
-	b.WriteString(fmt.Sprintf("Namespace: %s\n\n", m.namespace))
+	b.WriteString(fmt.Sprintf("Namespace: %s\n", m.namespace))
+	if !m.cachedAt.IsZero() {
+		staleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
+		b.WriteString(staleStyle.Render(fmt.Sprintf("Cached results from %s ago (press r to refresh)",
+			time.Since(m.cachedAt).Round(time.Minute))))
+		b.WriteString("\n")
+	}
+	if m.result != nil && m.result.ErrorCount > 0 {
+		b.WriteString(m.renderScanErrors())
+	}
+	b.WriteString("\n")
 
 	if m.confirmDelete {
 		return m.renderConfirmDialog(&b)
@@ -379,19 +742,44 @@ func (m Model) View() string {
 	} else {
 		b.WriteString(inactiveTab.Render("[4] Stale"))
 	}
+	b.WriteString("  ")
+
+	if m.showingTags() {
+		b.WriteString(activeTab.Render("[5] Tags"))
+	} else {
+		b.WriteString(inactiveTab.Render("[5] Tags"))
+	}
+	b.WriteString("  ")
+
+	if m.viewMode == ViewModeByPriority && !m.showingTags() {
+		b.WriteString(activeTab.Render("[6] By Priority"))
+	} else {
+		b.WriteString(inactiveTab.Render("[6] By Priority"))
+	}
 	b.WriteString("\n\n")
 
 	summaryStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(summaryStyle.Render(fmt.Sprintf("Repos: %d | Orphans: %d | View: %s\n\n",
-		m.result.TotalRepos, m.result.TotalOrphans, m.viewMode)))
+	summary := fmt.Sprintf("Repos: %d | Orphans: %d | Tags: %d | View: %s",
+		m.result.TotalRepos, m.result.TotalOrphans, m.result.TotalOrphanedTags, m.viewMode)
+	if len(m.options.IncludePatterns) > 0 {
+		summary += fmt.Sprintf(" | Include patterns: %d", len(m.options.IncludePatterns))
+	}
+	b.WriteString(summaryStyle.Render(summary + "\n\n"))
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
 
 	filtered := m.getFilteredOrphans()
 
-	if len(filtered) == 0 {
+	if m.showingTags() {
+		b.WriteString(m.renderTags())
+	} else if len(filtered) == 0 {
 		b.WriteString("No orphaned branches in this view.\n")
 	} else {
 		currentRepo := ""
 		for i, orphan := range filtered {
+			if !layout.InWindow(i, m.scrollTop, m.maxVisible) {
+				continue
+			}
+
 			if m.viewMode == ViewModeByRepo && orphan.Repository != currentRepo {
 				currentRepo = orphan.Repository
 				repoStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#00FFFF"))
@@ -422,8 +810,14 @@ func (m Model) View() string {
 				prInfo = fmt.Sprintf(" #%d", *orphan.PRNumber)
 			}
 
-			line := fmt.Sprintf("%s%s %s ", cursor, selectMark, orphan.BranchName)
-			b.WriteString(lineStyle.Render(line))
+			if m.viewMode == ViewModeByPriority {
+				b.WriteString(lineStyle.Render(cursor + selectMark + " "))
+				b.WriteString(priorityBadge(orphans.PriorityScore(orphan)))
+				b.WriteString(lineStyle.Render(fmt.Sprintf(" %s/%s ", orphan.Repository, orphan.BranchName)))
+			} else {
+				line := fmt.Sprintf("%s%s %s ", cursor, selectMark, orphan.BranchName)
+				b.WriteString(lineStyle.Render(line))
+			}
 			b.WriteString(typeStyle.Render(fmt.Sprintf("[%s]", orphan.Type.Label())))
 			b.WriteString(fmt.Sprintf(" %dd%s\n", orphan.DaysSinceActivity, prInfo))
 		}
@@ -438,7 +832,120 @@ func (m Model) View() string {
 
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("j/k: navigate | space: select | a/n: all/none | d: delete | v: view mode | r: refresh | esc: back"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("j/k: navigate | pgup/pgdn: page | space: select | shift+↑/↓: range select | a/n: all/none | /: search | d: delete | u: undo delete | v: view mode | r: refresh | esc: back"))
+	}
+
+	return b.String()
+}
+
+// priorityBadge renders a cleanup priority score, colored from highest (red) to lowest (gray)
+func priorityBadge(score int) string {
+	color := "#777777"
+	switch {
+	case score >= 10:
+		color = "#FF0000"
+	case score >= 5:
+		color = "#FFFF00"
+	}
+	return lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(color)).Render(fmt.Sprintf("[P%d]", score))
+}
+
+func (m Model) renderTags() string {
+	if !m.options.IncludeTags {
+		return "Tag scanning is disabled (use --include-tags).\n"
+	}
+
+	tags := m.getOrphanedTags()
+	if len(tags) == 0 {
+		return "No stale tags found.\n"
+	}
+
+	var b strings.Builder
+	typeStyle := m.getTypeStyle(orphans.OrphanTypeStaleTag)
+
+	for i, tag := range tags {
+		if !layout.InWindow(i, m.scrollTop, m.maxVisible) {
+			continue
+		}
+
+		cursor := " "
+		lineStyle := lipgloss.NewStyle()
+		if m.cursor == i {
+			cursor = ">"
+			lineStyle = lineStyle.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		}
+
+		b.WriteString(lineStyle.Render(fmt.Sprintf("%s  %s@%s ", cursor, tag.Repository, tag.TagName)))
+		b.WriteString(typeStyle.Render(fmt.Sprintf("[%s]", tag.Type.Label())))
+		b.WriteString(fmt.Sprintf(" %dd\n", tag.DaysSinceCreated))
+	}
+
+	return b.String()
+}
+
+func (m Model) Cursor() int {
+	return m.cursor
+}
+
+func (m Model) RenderDetail(index int) string {
+	if m.showingTags() {
+		return m.renderTagDetail(index)
+	}
+
+	filtered := m.getFilteredOrphans()
+	if index < 0 || index >= len(filtered) {
+		return ""
+	}
+	orphan := filtered[index]
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Repository: %s\n", orphan.Repository))
+	b.WriteString(fmt.Sprintf("Branch:     %s\n", orphan.BranchName))
+	b.WriteString(fmt.Sprintf("SHA:        %s\n", orphan.SHA))
+	b.WriteString("Type:       ")
+	b.WriteString(m.getTypeStyle(orphan.Type).Render(orphan.Type.Label()))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("Inactive:   %d days\n", orphan.DaysSinceActivity))
+	b.WriteString(fmt.Sprintf("Priority:   %d\n", orphans.PriorityScore(orphan)))
+	if !orphan.LastCommitDate.IsZero() {
+		b.WriteString(fmt.Sprintf("Updated:    %s\n", orphan.LastCommitDate.Format("2006-01-02")))
+	}
+	if orphan.PRNumber != nil {
+		b.WriteString(fmt.Sprintf("\nPR #%d", *orphan.PRNumber))
+		if orphan.PRTitle != nil {
+			b.WriteString(fmt.Sprintf(": %s", *orphan.PRTitle))
+		}
+		b.WriteString("\n")
+	}
+	if orphan.Protected {
+		b.WriteString("\n⚠️  Branch is protected\n")
+	}
+
+	return b.String()
+}
+
+func (m Model) renderTagDetail(index int) string {
+	tags := m.getOrphanedTags()
+	if index < 0 || index >= len(tags) {
+		return ""
+	}
+	tag := tags[index]
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Repository: %s\n", tag.Repository))
+	b.WriteString(fmt.Sprintf("Tag:        %s\n", tag.TagName))
+	b.WriteString(fmt.Sprintf("SHA:        %s\n", tag.SHA))
+	b.WriteString("Type:       ")
+	b.WriteString(m.getTypeStyle(tag.Type).Render(tag.Type.Label()))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("Age:        %d days\n", tag.DaysSinceCreated))
+	if !tag.CreatedAt.IsZero() {
+		b.WriteString(fmt.Sprintf("Created:    %s\n", tag.CreatedAt.Format("2006-01-02")))
+	}
+	b.WriteString("\nNo release references this tag\n")
 
 	return b.String()
 }
@@ -470,6 +977,8 @@ func (m Model) getTypeStyle(t orphans.OrphanType) lipgloss.Style {
 		return lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
 	case orphans.OrphanTypeRecentNoPR:
 		return lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+	case orphans.OrphanTypeStaleTag:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#00FFFF"))
 	default:
 		return lipgloss.NewStyle()
 	}
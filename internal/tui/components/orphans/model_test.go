@@ -0,0 +1,481 @@
+package orphans
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newLoadedModel returns a model whose scan found branches branch-0..branch-(n-1)
+func newLoadedModel(t *testing.T, n int) Model {
+	t.Helper()
+
+	var found []orphans.OrphanedBranch
+	for i := 0; i < n; i++ {
+		found = append(found, orphans.OrphanedBranch{
+			Repository: "owner/repo",
+			BranchName: fmt.Sprintf("branch-%d", i),
+			Type:       orphans.OrphanTypeStale,
+		})
+	}
+
+	result := &orphans.NamespaceScanResult{
+		Namespace:    "owner",
+		Results:      []orphans.ScanResult{{Repository: github.Repository{FullName: "owner/repo"}, Orphans: found}},
+		TotalRepos:   1,
+		TotalOrphans: n,
+	}
+
+	updated, _ := NewModel("owner", orphans.DefaultScanOptions()).Update(scanCompleteMsg{result: result})
+	return updated.(Model)
+}
+
+func press(m Model, keys ...tea.KeyType) Model {
+	for _, key := range keys {
+		updated, _ := m.Update(tea.KeyMsg{Type: key})
+		m = updated.(Model)
+	}
+	return m
+}
+
+func selectedIndexes(m Model) []int {
+	var indexes []int
+	for i, orphan := range m.getFilteredOrphans() {
+		if m.selected[orphan.Key()] {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+func TestShiftDownSelectsRange(t *testing.T) {
+	m := newLoadedModel(t, 8)
+	m = press(m, tea.KeyDown, tea.KeyDown)
+
+	m = press(m, tea.KeyShiftDown, tea.KeyShiftDown, tea.KeyShiftDown)
+
+	if got := fmt.Sprint(selectedIndexes(m)); got != "[2 3 4 5]" {
+		t.Errorf("Expected items [2 3 4 5] selected, got %s", got)
+	}
+
+	if m.cursor != 5 {
+		t.Errorf("Expected cursor at 5, got %d", m.cursor)
+	}
+}
+
+func TestShiftUpShrinksAndReversesRange(t *testing.T) {
+	m := newLoadedModel(t, 8)
+	m = press(m, tea.KeyDown, tea.KeyDown)
+	m = press(m, tea.KeyShiftDown, tea.KeyShiftDown)
+
+	// Shrink back towards the anchor
+	m = press(m, tea.KeyShiftUp)
+	if got := fmt.Sprint(selectedIndexes(m)); got != "[2 3]" {
+		t.Errorf("Expected shrink to [2 3], got %s", got)
+	}
+
+	// Extend past the anchor in the other direction
+	m = press(m, tea.KeyShiftUp, tea.KeyShiftUp)
+	if got := fmt.Sprint(selectedIndexes(m)); got != "[1 2]" {
+		t.Errorf("Expected reversed range [1 2], got %s", got)
+	}
+}
+
+func TestPlainArrowClearsAnchor(t *testing.T) {
+	m := newLoadedModel(t, 8)
+	m = press(m, tea.KeyShiftDown)
+
+	m = press(m, tea.KeyDown)
+	if m.selectionAnchor != -1 {
+		t.Errorf("Expected anchor to be cleared, got %d", m.selectionAnchor)
+	}
+
+	// A new range starts from the current cursor and keeps earlier selections
+	m = press(m, tea.KeyShiftDown)
+	if got := fmt.Sprint(selectedIndexes(m)); got != "[0 1 2 3]" {
+		t.Errorf("Expected [0 1 2 3], got %s", got)
+	}
+}
+
+func TestRenderDetailFollowsCursor(t *testing.T) {
+	m := newLoadedModel(t, 3)
+
+	if detail := m.RenderDetail(m.Cursor()); !strings.Contains(detail, "branch-0") {
+		t.Errorf("Expected detail for branch-0, got:\n%s", detail)
+	}
+
+	m = press(m, tea.KeyDown)
+	if detail := m.RenderDetail(m.Cursor()); !strings.Contains(detail, "branch-1") {
+		t.Errorf("Expected detail for branch-1 after moving cursor, got:\n%s", detail)
+	}
+}
+
+func TestTagsFilterListsOrphanedTags(t *testing.T) {
+	options := orphans.DefaultScanOptions()
+	options.IncludeTags = true
+
+	result := &orphans.NamespaceScanResult{
+		Namespace: "owner",
+		Results: []orphans.ScanResult{{
+			Repository: github.Repository{FullName: "owner/repo"},
+			Orphans:    []orphans.OrphanedBranch{{Repository: "owner/repo", BranchName: "old-branch", Type: orphans.OrphanTypeStale}},
+			OrphanedTags: []orphans.OrphanedTag{
+				{Repository: "owner/repo", TagName: "deploy-1", SHA: "abc", Type: orphans.OrphanTypeStaleTag, DaysSinceCreated: 400},
+				{Repository: "owner/repo", TagName: "deploy-2", SHA: "def", Type: orphans.OrphanTypeStaleTag, DaysSinceCreated: 300},
+			},
+		}},
+		TotalRepos:        1,
+		TotalOrphans:      1,
+		TotalOrphanedTags: 2,
+	}
+
+	updated, _ := NewModel("owner", options).Update(scanCompleteMsg{result: result})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")})
+	m := updated.(Model)
+
+	view := m.View()
+	if !strings.Contains(view, "owner/repo@deploy-1") || strings.Contains(view, "old-branch") {
+		t.Errorf("Expected tags view to list only tags, got:\n%s", view)
+	}
+
+	m = press(m, tea.KeyDown, tea.KeyDown)
+	if m.cursor != 1 {
+		t.Errorf("Expected cursor to stop at last tag, got %d", m.cursor)
+	}
+
+	if detail := m.RenderDetail(m.cursor); !strings.Contains(detail, "deploy-2") {
+		t.Errorf("Expected detail for deploy-2, got:\n%s", detail)
+	}
+}
+
+func TestSummaryShowsIncludePatternCount(t *testing.T) {
+	options := orphans.DefaultScanOptions()
+	options.IncludePatterns = []string{"feature/*", "spike/*"}
+
+	result := &orphans.NamespaceScanResult{Namespace: "owner", TotalRepos: 1}
+	updated, _ := NewModel("owner", options).Update(scanCompleteMsg{result: result})
+
+	if view := updated.(Model).View(); !strings.Contains(view, "Include patterns: 2") {
+		t.Errorf("Expected include pattern count in summary, got:\n%s", view)
+	}
+
+	if view := newLoadedModel(t, 1).View(); strings.Contains(view, "Include patterns") {
+		t.Errorf("Expected no include pattern summary without patterns, got:\n%s", view)
+	}
+}
+
+func TestPageDownMovesByMaxVisible(t *testing.T) {
+	m := newLoadedModel(t, 40)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 22})
+	m = updated.(Model)
+
+	if m.maxVisible != 10 {
+		t.Fatalf("Expected maxVisible 10, got %d", m.maxVisible)
+	}
+
+	m = press(m, tea.KeyPgDown)
+	if m.cursor != m.maxVisible {
+		t.Errorf("Expected cursor %d after pgdown, got %d", m.maxVisible, m.cursor)
+	}
+	if m.cursor < m.scrollTop || m.cursor >= m.scrollTop+m.maxVisible {
+		t.Errorf("Expected cursor %d visible from scrollTop %d", m.cursor, m.scrollTop)
+	}
+	filtered := m.getFilteredOrphans()
+	view := m.View()
+	if !strings.Contains(view, filtered[10].BranchName+" ") || strings.Contains(view, filtered[9].BranchName+" ") {
+		t.Errorf("Expected view scrolled to the second page, got:\n%s", view)
+	}
+
+	m = press(m, tea.KeyPgDown, tea.KeyPgDown, tea.KeyPgDown, tea.KeyPgDown)
+	if m.cursor != 39 {
+		t.Errorf("Expected cursor clamped at 39, got %d", m.cursor)
+	}
+
+	m = press(m, tea.KeyPgUp)
+	if m.cursor != 29 {
+		t.Errorf("Expected cursor 29 after pgup, got %d", m.cursor)
+	}
+}
+
+func TestConcurrentDeleteResultsEachRemoveOneOrphan(t *testing.T) {
+	m := newLoadedModel(t, 4)
+	before := m.result
+	first := m.result.Results[0].Orphans[0].Key()
+	second := m.result.Results[0].Orphans[2].Key()
+
+	// Both deletes were batched together; apply their results back to back
+	msgs := []deleteResultMsg{{branch: first}, {branch: second}}
+	for i, msg := range msgs {
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+
+		want := 4 - (i + 1)
+		if got := len(m.result.Results[0].Orphans); got != want {
+			t.Fatalf("After delete %d expected %d orphans, got %d", i+1, want, got)
+		}
+		if m.result.TotalOrphans != want {
+			t.Errorf("After delete %d expected TotalOrphans %d, got %d", i+1, want, m.result.TotalOrphans)
+		}
+	}
+
+	for _, orphan := range m.result.AllOrphans() {
+		if orphan.Key() == first || orphan.Key() == second {
+			t.Errorf("Expected %s to be removed", orphan.Key())
+		}
+	}
+	if len(before.Results[0].Orphans) != 4 || before.TotalOrphans != 4 {
+		t.Errorf("Expected the original scan result to be left untouched, got %d orphans", len(before.Results[0].Orphans))
+	}
+}
+
+func TestUndoRestoresMostRecentDelete(t *testing.T) {
+	m := newLoadedModel(t, 3)
+	first := m.result.Results[0].Orphans[0]
+	second := m.result.Results[0].Orphans[1]
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	if cmd != nil || !strings.Contains(updated.(Model).statusMsg, "Nothing to undo") {
+		t.Fatalf("Expected nothing to undo before deleting, got %q", updated.(Model).statusMsg)
+	}
+
+	for _, orphan := range []orphans.OrphanedBranch{first, second} {
+		updated, _ = updated.Update(deleteResultMsg{branch: orphan.Key(), orphan: orphan})
+	}
+	updated, _ = updated.Update(deleteResultMsg{branch: "owner/repo/branch-2", err: errors.New("403")})
+	m = updated.(Model)
+	if len(m.undoBuffer) != 2 {
+		t.Fatalf("Expected only successful deletes in the undo buffer, got %d", len(m.undoBuffer))
+	}
+
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a restore command")
+	}
+	if len(m.undoBuffer) != 1 || m.undoBuffer[0].Key() != first.Key() {
+		t.Errorf("Expected %s popped from the undo buffer, left %+v", second.Key(), m.undoBuffer)
+	}
+
+	updated, _ = m.Update(restoreResultMsg{orphan: second, err: errors.New("no deletion found")})
+	m = updated.(Model)
+	if len(m.undoBuffer) != 2 || !strings.Contains(m.statusMsg, "Failed to restore") {
+		t.Errorf("Expected a failed restore to stay undoable, got %q with %d buffered", m.statusMsg, len(m.undoBuffer))
+	}
+
+	updated, _ = m.Update(restoreResultMsg{orphan: second, sha: "0123456789abcdef"})
+	if status := updated.(Model).statusMsg; !strings.Contains(status, "Restored: owner/repo/branch-1 at 0123456") {
+		t.Errorf("Expected restored status with short SHA, got %q", status)
+	}
+}
+
+func TestPriorityViewSortsByScoreWithBadge(t *testing.T) {
+	result := &orphans.NamespaceScanResult{
+		Namespace: "owner",
+		Results: []orphans.ScanResult{{
+			Repository: github.Repository{FullName: "owner/repo"},
+			Orphans: []orphans.OrphanedBranch{
+				{Repository: "owner/repo", BranchName: "a-recent", Type: orphans.OrphanTypeRecentNoPR, DaysSinceActivity: 2},
+				{Repository: "owner/repo", BranchName: "b-merged", Type: orphans.OrphanTypeMergedPR, DaysSinceActivity: 60},
+				{Repository: "owner/repo", BranchName: "c-stale", Type: orphans.OrphanTypeStale, DaysSinceActivity: 40},
+			},
+		}},
+		TotalRepos:   1,
+		TotalOrphans: 3,
+	}
+
+	updated, _ := NewModel("owner", orphans.DefaultScanOptions()).Update(scanCompleteMsg{result: result})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("6")})
+	m := updated.(Model)
+
+	var order []string
+	for _, orphan := range m.getFilteredOrphans() {
+		order = append(order, orphan.BranchName)
+	}
+	if strings.Join(order, ",") != "b-merged,c-stale,a-recent" {
+		t.Errorf("Expected priority order, got %v", order)
+	}
+
+	view := m.View()
+	if !strings.Contains(view, "[P10]") || !strings.Contains(view, "[P1]") {
+		t.Errorf("Expected priority badges, got:\n%s", view)
+	}
+}
+
+func TestCachedScanShowsStaleIndicator(t *testing.T) {
+	result := &orphans.NamespaceScanResult{Namespace: "owner"}
+	updated, _ := NewModel("owner", orphans.DefaultScanOptions()).Update(scanCompleteMsg{
+		result:   result,
+		cachedAt: time.Now().Add(-20 * time.Minute),
+	})
+
+	view := updated.(Model).View()
+	if !strings.Contains(view, "Cached results from 20m0s ago (press r to refresh)") {
+		t.Errorf("Expected stale indicator, got:\n%s", view)
+	}
+
+	updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if cmd == nil || !updated.(Model).loading {
+		t.Error("Expected r to start a refresh scan")
+	}
+
+	updated, _ = updated.Update(scanCompleteMsg{result: result})
+	if strings.Contains(updated.(Model).View(), "Cached results") {
+		t.Error("Expected a fresh scan to clear the stale indicator")
+	}
+}
+
+func TestPartialScanShowsErrorBanner(t *testing.T) {
+	result := &orphans.NamespaceScanResult{
+		Namespace: "owner",
+		Results: []orphans.ScanResult{
+			{Repository: github.Repository{FullName: "owner/api"}, Orphans: []orphans.OrphanedBranch{
+				{Repository: "owner/api", BranchName: "old-feature", Type: orphans.OrphanTypeStale},
+			}},
+			{Repository: github.Repository{FullName: "owner/web"}, Error: errors.New("403 Forbidden")},
+			{Repository: github.Repository{FullName: "owner/cli"}},
+		},
+		TotalRepos:   3,
+		TotalOrphans: 1,
+		ErrorCount:   1,
+	}
+
+	updated, _ := NewModel("owner", orphans.DefaultScanOptions()).Update(scanCompleteMsg{result: result})
+	view := updated.(Model).View()
+
+	if !strings.Contains(view, "⚠ 1 repos had scan errors - showing partial results") {
+		t.Errorf("Expected partial results banner, got:\n%s", view)
+	}
+	if !strings.Contains(view, "owner/web: 403 Forbidden") {
+		t.Errorf("Expected the failing repo to be listed, got:\n%s", view)
+	}
+	if !strings.Contains(view, "old-feature") {
+		t.Errorf("Expected orphans from the other repos, got:\n%s", view)
+	}
+}
+
+func TestScanProgressRendersBarAndKeepsListening(t *testing.T) {
+	m := NewModel("acme", orphans.DefaultScanOptions())
+
+	updated, cmd := m.Update(scanProgressMsg{current: 23, total: 87, currentRepo: "acme/api", orphans: 14})
+	if cmd == nil {
+		t.Fatal("Expected to keep listening for progress")
+	}
+
+	view := updated.(Model).View()
+	if !strings.Contains(view, "Scanning: 23/87 repos | Orphans found: 14") {
+		t.Errorf("Expected progress summary, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Currently: acme/api") {
+		t.Errorf("Expected current repo, got:\n%s", view)
+	}
+	if !strings.Contains(view, "] 26%") {
+		t.Errorf("Expected progress bar percentage, got:\n%s", view)
+	}
+}
+
+func TestListenForProgress(t *testing.T) {
+	progressCh := make(chan orphans.ScanProgress, 1)
+	progressCh <- orphans.ScanProgress{Current: 2, Total: 5, CurrentRepo: "acme/web", Orphans: 3}
+
+	msg := listenForProgress(progressCh)()
+	want := scanProgressMsg{current: 2, total: 5, currentRepo: "acme/web", orphans: 3}
+	if msg != want {
+		t.Errorf("listenForProgress() = %#v, want %#v", msg, want)
+	}
+
+	close(progressCh)
+	if msg := listenForProgress(progressCh)(); msg != nil {
+		t.Errorf("Expected nil once the scan closes the channel, got %#v", msg)
+	}
+}
+
+func TestRenderProgressBar(t *testing.T) {
+	tests := []struct {
+		current, total int
+		filled         int
+		pct            string
+	}{
+		{0, 10, 0, "0%"},
+		{5, 10, 15, "50%"},
+		{10, 10, progressBarWidth, "100%"},
+		{12, 10, progressBarWidth, "120%"},
+	}
+
+	for _, tt := range tests {
+		bar := renderProgressBar(tt.current, tt.total)
+		if got := strings.Count(bar, "█"); got != tt.filled {
+			t.Errorf("renderProgressBar(%d, %d) filled %d cells, want %d: %s", tt.current, tt.total, got, tt.filled, bar)
+		}
+		if !strings.HasSuffix(bar, "] "+tt.pct) {
+			t.Errorf("renderProgressBar(%d, %d) = %q, want %s", tt.current, tt.total, bar, tt.pct)
+		}
+	}
+}
+
+func typeText(m Model, text string) Model {
+	for _, r := range text {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(Model)
+	}
+	return m
+}
+
+func TestSearchFiltersOrphansWhileTyping(t *testing.T) {
+	m := newLoadedModel(t, 12)
+	m = press(m, tea.KeyDown, tea.KeyDown)
+
+	m = typeText(m, "/BRANCH-1")
+	if !m.Searching() {
+		t.Fatal("Expected / to open the search input")
+	}
+	if m.cursor != 0 {
+		t.Errorf("Expected the cursor to reset while typing, got %d", m.cursor)
+	}
+
+	// branch-1, branch-10, branch-11
+	if got := len(m.getFilteredOrphans()); got != 3 {
+		t.Fatalf("Expected 3 matches, got %d", got)
+	}
+
+	// j, k, and space are typed rather than navigating or selecting
+	m = typeText(m, "j k")
+	if m.filterQuery != "BRANCH-1j k" || len(m.selected) != 0 {
+		t.Errorf("Expected keys to edit the query, got %q with selection %v", m.filterQuery, m.selected)
+	}
+	if !strings.Contains(m.View(), "/BRANCH-1j k_") {
+		t.Errorf("Expected the search line while typing, got:\n%s", m.View())
+	}
+}
+
+func TestSearchEnterKeepsAndEscClearsFilter(t *testing.T) {
+	m := newLoadedModel(t, 12)
+
+	m = typeText(m, "/branch-1")
+	m = press(m, tea.KeyEnter)
+	if m.Searching() || len(m.getFilteredOrphans()) != 3 {
+		t.Fatalf("Expected enter to close the input and keep the filter, got %d orphans", len(m.getFilteredOrphans()))
+	}
+
+	// Navigation and type filters apply to the kept search
+	m = press(m, tea.KeyDown)
+	if m.cursor != 1 {
+		t.Errorf("Expected j to navigate after enter, got cursor %d", m.cursor)
+	}
+	m = typeText(m, "2")
+	if got := len(m.getFilteredOrphans()); got != 0 {
+		t.Errorf("Expected no merged orphans matching the search, got %d", got)
+	}
+	m = typeText(m, "1")
+
+	m = typeText(m, "/")
+	m = press(m, tea.KeyEsc)
+	if m.Searching() || m.filterQuery != "" || len(m.getFilteredOrphans()) != 12 {
+		t.Errorf("Expected esc to restore the full list, got query %q and %d orphans", m.filterQuery, len(m.getFilteredOrphans()))
+	}
+}
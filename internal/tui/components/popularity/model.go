@@ -0,0 +1,245 @@
+package popularity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Model represents the repository popularity TUI state
+type Model struct {
+	repos       []string
+	stats       map[string]*github.RepoStats
+	ranked      []string
+	hasPrevious map[string]bool // Repos with a saved snapshot, so StarDelta is meaningful
+	failed      []string
+	cursor      int
+	width       int
+	height      int
+	loading     bool
+	spinner     spinner.LoadingSpinner
+	err         error
+
+	filterQuery  string // Case-insensitive substring match on repository names
+	searchActive bool   // Set while the search query is being typed
+}
+
+// NewModel creates a new repository popularity model
+func NewModel(repos []string) Model {
+	return Model{
+		repos:   repos,
+		loading: true,
+		spinner: spinner.New("Loading repository stats..."),
+	}
+}
+
+type statsLoadedMsg struct {
+	stats       map[string]*github.RepoStats
+	hasPrevious map[string]bool
+	failed      []string
+	err         error
+}
+
+// Init initializes the model
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.loadStats, m.spinner.Tick())
+}
+
+func (m Model) loadStats() tea.Msg {
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		return statsLoadedMsg{
+			err: fmt.Errorf("failed to create GitHub client: %w", err),
+		}
+	}
+
+	// Star deltas are optional; without a cache every repo shows "-"
+	popularityCache, _ := cache.NewPopularityCache("")
+
+	stats := make(map[string]*github.RepoStats)
+	hasPrevious := make(map[string]bool)
+	var failed []string
+	for _, repoStr := range m.repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			continue
+		}
+
+		repoStats, err := client.GetRepoStats(parts[0], parts[1])
+		if err != nil {
+			failed = append(failed, repoStr)
+			continue
+		}
+
+		if popularityCache != nil {
+			hasPrevious[repoStr], _ = popularityCache.UpdateStarDelta(repoStats)
+		}
+		stats[repoStr] = repoStats
+	}
+
+	return statsLoadedMsg{
+		stats:       stats,
+		hasPrevious: hasPrevious,
+		failed:      failed,
+		err:         nil,
+	}
+}
+
+// Update handles messages
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case statsLoadedMsg:
+		m.loading = false
+		m.stats = msg.stats
+		m.ranked = github.RankReposByPopularity(msg.stats)
+		m.hasPrevious = msg.hasPrevious
+		m.failed = msg.failed
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+
+		case "/":
+			m.searchActive = true
+
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+
+		case "down", "j":
+			if m.cursor < len(m.filteredRanked())-1 {
+				m.cursor++
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// filteredRanked returns ranked repositories whose names match the search query
+func (m Model) filteredRanked() []string {
+	return search.Filter(m.ranked, m.filterQuery, func(repo string) string { return repo })
+}
+
+// View renders the model
+func (m Model) View() string {
+	if m.loading {
+		return m.spinner.View() + "\n"
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n", m.err)
+	}
+
+	var b strings.Builder
+
+	// Header
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#00FFFF"))
+
+	b.WriteString(titleStyle.Render("⭐ Popularity"))
+	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
+
+	ranked := m.filteredRanked()
+	if len(m.ranked) == 0 {
+		b.WriteString("No repository stats found.\n")
+	} else if len(ranked) == 0 {
+		b.WriteString("No repositories match the search.\n")
+	} else {
+		headerStyle := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#777777"))
+
+		b.WriteString(headerStyle.Render(fmt.Sprintf("  %-35s %8s %6s %7s %8s %7s %8s",
+			"Repository", "Stars", "Δ", "Forks", "Watchers", "Issues", "Network")))
+		b.WriteString("\n")
+
+		for i, name := range ranked {
+			s := m.stats[name]
+
+			cursor := " "
+			style := lipgloss.NewStyle()
+			if m.cursor == i {
+				cursor = ">"
+				style = style.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+			}
+
+			line := fmt.Sprintf("%s %-35s %8d %6s %7d %8d %7d %8d",
+				cursor, name, s.Stars, m.formatStarDelta(name), s.Forks, s.Watchers, s.OpenIssues, s.Network)
+			b.WriteString(style.Render(line))
+			b.WriteString("\n")
+		}
+	}
+
+	if len(m.failed) > 0 {
+		warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
+		b.WriteString("\n")
+		b.WriteString(warnStyle.Render(fmt.Sprintf("⚠️  Failed to load stats for %d repos", len(m.failed))))
+		b.WriteString("\n")
+	}
+
+	// Help
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("Δ: stars since the previous run | ↑/↓: navigate | /: search | q: quit"))
+	}
+
+	return b.String()
+}
+
+// formatStarDelta shows the signed star change, or "-" on the first run for a repo
+func (m Model) formatStarDelta(repo string) string {
+	if !m.hasPrevious[repo] {
+		return "-"
+	}
+	return fmt.Sprintf("%+d", m.stats[repo].StarDelta)
+}
@@ -0,0 +1,56 @@
+package popularity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newLoadedModel() Model {
+	m := NewModel([]string{"acme/api", "acme/web", "acme/cli"})
+	updated, _ := m.Update(statsLoadedMsg{
+		stats: map[string]*github.RepoStats{
+			"acme/api": {Repository: "acme/api", Stars: 12, Forks: 1},
+			"acme/web": {Repository: "acme/web", Stars: 340, Forks: 20, StarDelta: 15},
+		},
+		hasPrevious: map[string]bool{"acme/web": true},
+		failed:      []string{"acme/cli"},
+	})
+	return updated.(Model)
+}
+
+func TestViewRanksByStars(t *testing.T) {
+	view := newLoadedModel().View()
+
+	web, api := strings.Index(view, "acme/web"), strings.Index(view, "acme/api")
+	if web < 0 || api < 0 || web > api {
+		t.Errorf("Expected acme/web ranked above acme/api, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Failed to load stats for 1 repos") {
+		t.Errorf("Expected failed repo warning, got:\n%s", view)
+	}
+}
+
+func TestViewShowsStarDeltaOnlyWithSnapshot(t *testing.T) {
+	view := newLoadedModel().View()
+
+	for _, line := range strings.Split(view, "\n") {
+		switch {
+		case strings.Contains(line, "acme/web") && !strings.Contains(line, "+15"):
+			t.Errorf("Expected +15 star delta for acme/web, got %q", line)
+		case strings.Contains(line, "acme/api") && !strings.Contains(line, " - "):
+			t.Errorf("Expected no delta for acme/api without a snapshot, got %q", line)
+		}
+	}
+}
+
+func TestCursorMovesThroughRankedRepos(t *testing.T) {
+	updated, _ := newLoadedModel().Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	view := updated.(Model).View()
+
+	if !strings.Contains(view, "> acme/api") {
+		t.Errorf("Expected cursor on second-ranked repo, got:\n%s", view)
+	}
+}
@@ -3,60 +3,233 @@ package protection
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
 // Model represents the protection rules TUI state
 type Model struct {
-	repos    []string
-	rules    map[string]*github.ProtectionRule
-	baseline string
-	diffs    map[string][]string
-	cursor   int
-	width    int
-	height   int
-	loading  bool
-	err      error
+	repos       []string
+	rules       map[string]*github.ProtectionRule
+	baseline    string
+	diffs       map[string][]string
+	suggestions []github.ProtectionRemediation
+	cursor      int
+	width       int
+	height      int
+	loading     bool
+	spinner     spinner.LoadingSpinner
+	err         error
+
+	useGraphQL bool
+
+	org       string
+	orgPolicy *github.BranchProtectionPolicy
+	overrides []github.ProtectionOverride
+	orgErr    error
+
+	confirmApply bool
+	statusMsg    string
+	filterQuery  string // Case-insensitive substring match on repository names
+	searchActive bool   // Set while the search query is being typed
+}
+
+// Option configures the protection rules model
+type Option func(*Model)
+
+// WithGraphQL fetches all repos in a single batched GraphQL request
+func WithGraphQL(enabled bool) Option {
+	return func(m *Model) {
+		m.useGraphQL = enabled
+	}
+}
+
+// WithOrgPolicyAudit compares each repo against the org's default branch ruleset
+func WithOrgPolicyAudit(org string) Option {
+	return func(m *Model) {
+		m.org = org
+	}
 }
 
 // NewModel creates a new protection rules model
-func NewModel(repos []string, baseline string) Model {
-	return Model{
+func NewModel(repos []string, baseline string, opts ...Option) Model {
+	m := Model{
 		repos:    repos,
 		baseline: baseline,
 		rules:    make(map[string]*github.ProtectionRule),
 		diffs:    make(map[string][]string),
 		loading:  true,
+		spinner:  spinner.New("Loading protection rules..."),
 	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
 }
 
 type rulesLoadedMsg struct {
-	rules map[string]*github.ProtectionRule
-	diffs map[string][]string
-	err   error
+	rules       map[string]*github.ProtectionRule
+	diffs       map[string][]string
+	suggestions []github.ProtectionRemediation
+	orgPolicy   *github.BranchProtectionPolicy
+	overrides   []github.ProtectionOverride
+	orgErr      error
+	err         error
+}
+
+type baselineAppliedMsg struct {
+	applied []string
+	failed  map[string]error
 }
 
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return m.loadRules
+	return tea.Batch(m.loadRules, m.spinner.Tick())
 }
 
 func (m Model) loadRules() tea.Msg {
-	// Create GitHub client
-	ctx := context.Background()
-	client, err := github.NewClient(ctx)
+	var rules map[string]*github.ProtectionRule
+	var err error
+	if m.useGraphQL {
+		rules, err = m.fetchRulesGraphQL()
+	} else {
+		rules, err = m.fetchRulesREST()
+	}
 	if err != nil {
 		return rulesLoadedMsg{
 			rules: make(map[string]*github.ProtectionRule),
 			diffs: make(map[string][]string),
-			err:   fmt.Errorf("failed to create GitHub client: %w", err),
+			err:   err,
 		}
 	}
 
+	// Compare rules if baseline is specified
+	diffs := make(map[string][]string)
+	var suggestions []github.ProtectionRemediation
+	if m.baseline != "" {
+		baselineRule := rules[m.baseline]
+		if baselineRule != nil {
+			// CompareProtectionRules treats the first rule as the baseline
+			rulesSlice := []*github.ProtectionRule{baselineRule}
+			for repo, rule := range rules {
+				if repo != m.baseline {
+					rulesSlice = append(rulesSlice, rule)
+				}
+			}
+			diffs = github.CompareProtectionRules(rulesSlice)
+			suggestions = github.SuggestProtectionRemediations(diffs, baselineRule)
+		}
+	}
+
+	msg := rulesLoadedMsg{
+		rules:       rules,
+		diffs:       diffs,
+		suggestions: suggestions,
+		err:         nil,
+	}
+
+	if m.org != "" {
+		msg.orgPolicy, msg.orgErr = m.fetchOrgPolicy()
+		msg.overrides = github.FindWeakerOverrides(msg.orgPolicy, rules)
+	}
+
+	return msg
+}
+
+// applyTargets returns the repos showing drift from the baseline, including unprotected repos
+func (m Model) applyTargets() []string {
+	if m.baseline == "" {
+		return nil
+	}
+	return github.DriftedRepos(m.baseline, m.repos, m.rules)
+}
+
+// applyBaseline applies the baseline rule to each target repo's protected branch,
+// or to its default branch when it has no protection yet
+func (m Model) applyBaseline(targets []string) tea.Cmd {
+	baselineRule := m.rules[m.baseline]
+	branches := make(map[string]string, len(targets))
+	for _, repo := range targets {
+		if rule := m.rules[repo]; rule != nil {
+			branches[repo] = rule.Branch
+			if branches[repo] == "" {
+				branches[repo] = "main"
+			}
+		}
+	}
+
+	return func() tea.Msg {
+		msg := baselineAppliedMsg{failed: make(map[string]error)}
+
+		client, err := github.NewClient(context.Background())
+		if err != nil {
+			for _, repo := range targets {
+				msg.failed[repo] = fmt.Errorf("failed to create GitHub client: %w", err)
+			}
+			return msg
+		}
+
+		for _, repoStr := range targets {
+			owner, repo, ok := strings.Cut(repoStr, "/")
+			if !ok {
+				msg.failed[repoStr] = fmt.Errorf("expected owner/repo")
+				continue
+			}
+			branch, ok := branches[repoStr]
+			if !ok {
+				settings, err := client.GetRepoSettings(owner, repo)
+				if err != nil {
+					msg.failed[repoStr] = err
+					continue
+				}
+				branch = settings.DefaultBranch
+			}
+			if err := client.ApplyProtectionRule(owner, repo, branch, baselineRule); err != nil {
+				msg.failed[repoStr] = err
+				continue
+			}
+			msg.applied = append(msg.applied, repoStr)
+		}
+
+		return msg
+	}
+}
+
+func (m Model) fetchOrgPolicy() (*github.BranchProtectionPolicy, error) {
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
+	return client.GetOrgDefaultBranchProtection(m.org)
+}
+
+func (m Model) fetchRulesGraphQL() (map[string]*github.ProtectionRule, error) {
+	ctx := context.Background()
+	client, err := github.NewGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetBranchProtectionBatch(m.repos)
+}
+
+func (m Model) fetchRulesREST() (map[string]*github.ProtectionRule, error) {
+	// Create GitHub client
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
 	// Load protection rules for each repo
 	rules := make(map[string]*github.ProtectionRule)
 	for _, repoStr := range m.repos {
@@ -77,29 +250,20 @@ func (m Model) loadRules() tea.Msg {
 		rules[repoStr] = rule
 	}
 
-	// Compare rules if baseline is specified
-	diffs := make(map[string][]string)
-	if m.baseline != "" {
-		baselineRule := rules[m.baseline]
-		if baselineRule != nil {
-			rulesSlice := make([]*github.ProtectionRule, 0, len(rules))
-			for _, rule := range rules {
-				rulesSlice = append(rulesSlice, rule)
-			}
-			diffs = github.CompareProtectionRules(rulesSlice)
-		}
-	}
-
-	return rulesLoadedMsg{
-		rules: rules,
-		diffs: diffs,
-		err:   nil,
-	}
+	return rules, nil
 }
 
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -109,21 +273,56 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.rules = msg.rules
 		m.diffs = msg.diffs
+		m.suggestions = msg.suggestions
+		m.orgPolicy = msg.orgPolicy
+		m.overrides = msg.overrides
+		m.orgErr = msg.orgErr
 		m.err = msg.err
 		return m, nil
 
+	case baselineAppliedMsg:
+		m.statusMsg = fmt.Sprintf("Applied baseline to %d repo(s)", len(msg.applied))
+		if len(msg.failed) > 0 {
+			failed := make([]string, 0, len(msg.failed))
+			for repo, err := range msg.failed {
+				failed = append(failed, fmt.Sprintf("%s: %v", repo, err))
+			}
+			sort.Strings(failed)
+			m.statusMsg += fmt.Sprintf(", %d failed (%s)", len(msg.failed), strings.Join(failed, "; "))
+		}
+		m.loading = true
+		return m, tea.Batch(m.loadRules, m.spinner.Tick())
+
 	case tea.KeyMsg:
+		if m.confirmApply {
+			return m.handleConfirmKeys(msg)
+		}
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
+		case "/":
+			m.searchActive = true
+
+		case "s":
+			if len(m.applyTargets()) == 0 {
+				m.statusMsg = "No repos differ from the baseline"
+				return m, nil
+			}
+			m.confirmApply = true
+			m.statusMsg = ""
+
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
 			}
 
 		case "down", "j":
-			if m.cursor < len(m.repos)-1 {
+			if m.cursor < len(m.filteredRepos())-1 {
 				m.cursor++
 			}
 		}
@@ -132,16 +331,85 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// filteredRepos returns the repositories matching the search query
+func (m Model) filteredRepos() []string {
+	return search.Filter(m.repos, m.filterQuery, func(repo string) string { return repo })
+}
+
+func (m Model) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		m.confirmApply = false
+		targets := m.applyTargets()
+		m.statusMsg = fmt.Sprintf("Applying baseline to %d repo(s)...", len(targets))
+		return m, m.applyBaseline(targets)
+	case "n", "N", "esc":
+		m.confirmApply = false
+		m.statusMsg = "Apply cancelled"
+	}
+	return m, nil
+}
+
+// renderConfirmDialog lists the repos the baseline rule will be applied to
+func (m Model) renderConfirmDialog() string {
+	var b strings.Builder
+
+	warnStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FF0000"))
+
+	targets := m.applyTargets()
+	b.WriteString(warnStyle.Render(fmt.Sprintf("⚠️  Apply protection from %s to %d repo(s)?", m.baseline, len(targets))))
+	b.WriteString("\n\n")
+	for _, repo := range targets {
+		branch := "default branch"
+		if rule := m.rules[repo]; rule != nil {
+			branch = rule.Branch
+			if branch == "" {
+				branch = "main"
+			}
+		}
+		b.WriteString(fmt.Sprintf("  - %s (%s)\n", repo, branch))
+		baselineRule := m.rules[m.baseline]
+		for _, change := range github.ProtectionRuleChanges(m.rules[repo], baselineRule.WithRestrictionsFrom(m.rules[repo])) {
+			b.WriteString(fmt.Sprintf("      %s\n", change))
+		}
+	}
+	b.WriteString("\nExisting protection on these branches will be replaced.\n")
+	b.WriteString("Press 'y' to confirm, 'n' or 'esc' to cancel\n")
+
+	return b.String()
+}
+
 // View renders the model
 func (m Model) View() string {
 	if m.loading {
-		return "Loading protection rules...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
 		return fmt.Sprintf("Error: %v\n", m.err)
 	}
 
+	if m.confirmApply {
+		return m.renderConfirmDialog()
+	}
+
 	var b strings.Builder
 
 	// Header
@@ -153,11 +421,14 @@ func (m Model) View() string {
 	b.WriteString("\n\n")
 
 	if m.baseline != "" {
-		b.WriteString(fmt.Sprintf("Baseline: %s\n\n", m.baseline))
+		b.WriteString(fmt.Sprintf("Baseline: %s\n", m.baseline))
+		b.WriteString(fmt.Sprintf("Suggestions: %d (%s)\n\n", len(m.suggestions), m.suggestionSummary()))
 	}
 
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
+
 	// Repository list with rules
-	for i, repo := range m.repos {
+	for i, repo := range m.filteredRepos() {
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -198,10 +469,69 @@ func (m Model) View() string {
 		}
 	}
 
+	if m.org != "" {
+		b.WriteString(m.renderOrgPolicy())
+	}
+
+	if m.statusMsg != "" {
+		b.WriteString("\n")
+		b.WriteString(m.statusMsg)
+		b.WriteString("\n")
+	}
+
 	// Help
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("↑/↓: navigate | q: quit"))
+	help := "↑/↓: navigate | /: search | q: quit"
+	if m.baseline != "" {
+		help = "↑/↓: navigate | /: search | s: apply baseline to drifted repos | q: quit"
+	}
+	if m.searchActive {
+		help = search.Help
+	}
+	b.WriteString(helpStyle.Render(help))
+
+	return b.String()
+}
+
+// renderOrgPolicy shows the org default branch policy and repos that weaken it
+func (m Model) renderOrgPolicy() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("\nOrg policy (%s): ", m.org))
+	switch {
+	case m.orgErr != nil:
+		b.WriteString(fmt.Sprintf("error: %v\n", m.orgErr))
+		return b.String()
+	case m.orgPolicy == nil:
+		b.WriteString("no active ruleset covers default branches\n")
+		return b.String()
+	}
+
+	b.WriteString(fmt.Sprintf("%s | approvals: %d | status checks: %v\n",
+		m.orgPolicy.Pattern, m.orgPolicy.RequiredApprovals, m.orgPolicy.RequireStatusChecks))
+
+	if len(m.overrides) == 0 {
+		b.WriteString("  ✓ No repos weaker than the org policy\n")
+		return b.String()
+	}
+
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+	for _, override := range m.overrides {
+		b.WriteString(warnStyle.Render(fmt.Sprintf("  [WEAKER] %s: %s", override.Repository, strings.Join(override.Reasons, "; "))))
+		b.WriteString("\n")
+	}
 
 	return b.String()
 }
+
+// suggestionSummary counts suggestions per priority, e.g. "1 high, 2 medium, 0 low"
+func (m Model) suggestionSummary() string {
+	counts := make(map[string]int)
+	for _, s := range m.suggestions {
+		counts[s.Priority]++
+	}
+
+	return fmt.Sprintf("%d high, %d medium, %d low",
+		counts[github.PriorityHigh], counts[github.PriorityMedium], counts[github.PriorityLow])
+}
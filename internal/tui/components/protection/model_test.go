@@ -0,0 +1,141 @@
+package protection
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestViewShowsWeakerOrgOverrides(t *testing.T) {
+	rules := map[string]*github.ProtectionRule{
+		"acme/api": {Repository: "acme/api", RequiredReviews: 1},
+	}
+	policy := &github.BranchProtectionPolicy{Pattern: "~DEFAULT_BRANCH", RequiredApprovals: 2}
+
+	updated, _ := NewModel([]string{"acme/api"}, "", WithOrgPolicyAudit("acme")).Update(rulesLoadedMsg{
+		rules:     rules,
+		diffs:     map[string][]string{},
+		orgPolicy: policy,
+		overrides: github.FindWeakerOverrides(policy, rules),
+	})
+
+	view := updated.(Model).View()
+	if !strings.Contains(view, "Org policy (acme): ~DEFAULT_BRANCH | approvals: 2") {
+		t.Errorf("Expected org policy summary, got:\n%s", view)
+	}
+	if !strings.Contains(view, "[WEAKER] acme/api: requires 1 approval(s) (org policy: 2)") {
+		t.Errorf("Expected weaker override listed, got:\n%s", view)
+	}
+}
+
+func loadedBaselineModel() Model {
+	rules := map[string]*github.ProtectionRule{
+		"acme/base": {Repository: "acme/base", Branch: "main", RequiredReviews: 2},
+		"acme/api":  {Repository: "acme/api", Branch: "main", RequiredReviews: 1},
+		"acme/web":  {Repository: "acme/web", Branch: "trunk", RequiredReviews: 2},
+	}
+	updated, _ := NewModel([]string{"acme/base", "acme/api", "acme/web"}, "acme/base").Update(rulesLoadedMsg{
+		rules: rules,
+		diffs: map[string][]string{"RequiredReviews": {"acme/api: 1 (baseline: 2)"}},
+	})
+	return updated.(Model)
+}
+
+func TestApplyBaselineRequiresConfirmation(t *testing.T) {
+	m := loadedBaselineModel()
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m = updated.(Model)
+	if cmd != nil {
+		t.Error("Expected no command before confirmation")
+	}
+	if !m.confirmApply {
+		t.Fatal("Expected confirmation prompt after 's'")
+	}
+
+	view := m.View()
+	if !strings.Contains(view, "Apply protection from acme/base to 1 repo(s)?") || !strings.Contains(view, "acme/api (main)") {
+		t.Errorf("Expected drifted repo in confirmation, got:\n%s", view)
+	}
+	if strings.Contains(view, "acme/web") {
+		t.Errorf("Repo matching the baseline should not be a target:\n%s", view)
+	}
+
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.confirmApply || cmd != nil {
+		t.Error("Expected esc to cancel without applying")
+	}
+	if m.statusMsg != "Apply cancelled" {
+		t.Errorf("statusMsg = %q", m.statusMsg)
+	}
+}
+
+func TestApplyBaselineIncludesUnprotectedRepos(t *testing.T) {
+	rules := map[string]*github.ProtectionRule{
+		"acme/base": {Repository: "acme/base", Branch: "main", RequiredReviews: 2},
+	}
+	updated, _ := NewModel([]string{"acme/base", "acme/new"}, "acme/base").Update(rulesLoadedMsg{
+		rules: rules,
+		diffs: map[string][]string{},
+	})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m := updated.(Model)
+
+	if !m.confirmApply {
+		t.Fatal("Expected an unprotected repo to be an apply target")
+	}
+	view := m.View()
+	if !strings.Contains(view, "acme/new (default branch)") || !strings.Contains(view, "RequiredReviews: 0 → 2") {
+		t.Errorf("Expected unprotected repo and its changes in confirmation, got:\n%s", view)
+	}
+}
+
+func TestApplyBaselineConfirmReturnsCommand(t *testing.T) {
+	m := loadedBaselineModel()
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected apply command after 'y'")
+	}
+	if m.confirmApply {
+		t.Error("Expected confirmation to close after 'y'")
+	}
+}
+
+func TestApplyBaselineWithoutDrift(t *testing.T) {
+	updated, _ := NewModel([]string{"acme/base"}, "acme/base").Update(rulesLoadedMsg{
+		rules: map[string]*github.ProtectionRule{"acme/base": {Repository: "acme/base"}},
+		diffs: map[string][]string{},
+	})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m := updated.(Model)
+
+	if m.confirmApply {
+		t.Error("Expected no confirmation without drifted repos")
+	}
+	if !strings.Contains(m.View(), "No repos differ from the baseline") {
+		t.Errorf("Expected no-drift status, got:\n%s", m.View())
+	}
+}
+
+func TestBaselineAppliedReportsFailures(t *testing.T) {
+	m := loadedBaselineModel()
+
+	updated, cmd := m.Update(baselineAppliedMsg{
+		applied: []string{"acme/api"},
+		failed:  map[string]error{"acme/web": errors.New("403 Forbidden")},
+	})
+	m = updated.(Model)
+	if cmd == nil || !m.loading {
+		t.Error("Expected rules to reload after applying")
+	}
+	if m.statusMsg != "Applied baseline to 1 repo(s), 1 failed (acme/web: 403 Forbidden)" {
+		t.Errorf("statusMsg = %q", m.statusMsg)
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/KyleKing/gh-sweep/internal/config"
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/layout"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -20,19 +24,53 @@ type Model struct {
 	width    int
 	height   int
 	loading  bool
+	spinner  spinner.LoadingSpinner
 	err      error
 	viewMode string // "latest", "all", "outdated"
+	policy   config.ReleasePolicyConfig
+
+	// Changelog panel for the selected repo's latest release
+	detailRepo    string
+	detail        *github.ReleaseComparison
+	detailLoading bool
+	detailErr     error
+
+	scrollTop  int
+	maxVisible int
+
+	filterQuery  string // Case-insensitive substring match on repository names
+	searchActive bool   // Set while the search query is being typed
+}
+
+// Option configures the releases overview model
+type Option func(*Model)
+
+// WithReleasePolicy sets the per-bump age limits used by the Outdated tab
+func WithReleasePolicy(policy config.ReleasePolicyConfig) Option {
+	return func(m *Model) {
+		m.policy = policy
+	}
 }
 
 // NewModel creates a new releases overview model
-func NewModel(repos []string) Model {
-	return Model{
+func NewModel(repos []string, opts ...Option) Model {
+	m := Model{
 		repos:    repos,
 		releases: make(map[string][]github.Release),
 		latest:   make(map[string]*github.Release),
 		loading:  true,
+		spinner:  spinner.New("Loading releases..."),
 		viewMode: "latest",
+		policy:   config.DefaultReleasePolicy(),
+
+		maxVisible: layout.DefaultMaxVisible,
+	}
+
+	for _, opt := range opts {
+		opt(&m)
 	}
+
+	return m
 }
 
 type releasesLoadedMsg struct {
@@ -41,9 +79,15 @@ type releasesLoadedMsg struct {
 	err      error
 }
 
+type comparisonLoadedMsg struct {
+	repo       string
+	comparison *github.ReleaseComparison
+	err        error
+}
+
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return m.loadReleases
+	return tea.Batch(m.loadReleases, m.spinner.Tick())
 }
 
 func (m Model) loadReleases() tea.Msg {
@@ -93,12 +137,100 @@ func (m Model) loadReleases() tea.Msg {
 	}
 }
 
+// previousRelease returns the first published release older than tag
+// Releases are listed newest first, so this is the next non-draft entry
+func previousRelease(releases []github.Release, tag string) *github.Release {
+	for i, release := range releases {
+		if release.TagName != tag {
+			continue
+		}
+		for _, older := range releases[i+1:] {
+			if !older.Draft {
+				return &older
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func loadComparison(repoStr, fromTag, toTag string) tea.Cmd {
+	return func() tea.Msg {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			return comparisonLoadedMsg{repo: repoStr, err: fmt.Errorf("invalid repo format, expected owner/repo")}
+		}
+
+		client, err := github.NewClient(context.Background())
+		if err != nil {
+			return comparisonLoadedMsg{repo: repoStr, err: fmt.Errorf("failed to create GitHub client: %w", err)}
+		}
+
+		comparison, err := client.CompareReleases(parts[0], parts[1], fromTag, toTag)
+		return comparisonLoadedMsg{repo: repoStr, comparison: comparison, err: err}
+	}
+}
+
+// toggleDetail loads the changelog between the selected repo's latest and previous
+// releases, or closes the panel if it is already showing that repo
+func (m Model) toggleDetail() (Model, tea.Cmd) {
+	repos := m.filteredRepos()
+	if m.cursor >= len(repos) {
+		return m, nil
+	}
+
+	repo := repos[m.cursor]
+	if m.detailRepo == repo {
+		return m.closeDetail(), nil
+	}
+
+	m.detailRepo = repo
+	m.detail = nil
+	m.detailErr = nil
+
+	latest := m.latest[repo]
+	if latest == nil && len(m.releases[repo]) > 0 {
+		latest = &m.releases[repo][0]
+	}
+	if latest == nil {
+		m.detailErr = fmt.Errorf("no releases")
+		return m, nil
+	}
+
+	previous := previousRelease(m.releases[repo], latest.TagName)
+	if previous == nil {
+		m.detailErr = fmt.Errorf("no release before %s", latest.TagName)
+		return m, nil
+	}
+
+	m.detailLoading = true
+	return m, loadComparison(repo, previous.TagName, latest.TagName)
+}
+
+func (m Model) closeDetail() Model {
+	m.detailRepo = ""
+	m.detail = nil
+	m.detailErr = nil
+	m.detailLoading = false
+	return m
+}
+
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.maxVisible = layout.MaxVisibleForHeight(msg.Height, 8)
+		m.scrollTop = layout.ClampScroll(m.cursor, m.scrollTop, m.maxVisible)
 		return m, nil
 
 	case releasesLoadedMsg:
@@ -108,22 +240,52 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.err = msg.err
 		return m, nil
 
+	case comparisonLoadedMsg:
+		if msg.repo != m.detailRepo {
+			return m, nil
+		}
+		m.detailLoading = false
+		m.detail = msg.comparison
+		m.detailErr = msg.err
+		return m, nil
+
 	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
+		case "/":
+			m.searchActive = true
+
+		case "enter":
+			if m.viewMode == "latest" || m.viewMode == "all" {
+				return m.toggleDetail()
+			}
+
+		case "esc":
+			m = m.closeDetail()
+
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
 			}
 
 		case "down", "j":
-			maxCursor := len(m.repos) - 1
+			maxCursor := len(m.filteredRepos()) - 1
 			if m.cursor < maxCursor {
 				m.cursor++
 			}
 
+		case "pgdown":
+			m.cursor, m.scrollTop = layout.PageDown(m.cursor, m.scrollTop, m.maxVisible, len(m.filteredRepos()))
+
+		case "pgup":
+			m.cursor, m.scrollTop = layout.PageUp(m.cursor, m.scrollTop, m.maxVisible)
+
 		case "1":
 			m.viewMode = "latest"
 			m.cursor = 0
@@ -134,15 +296,38 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.viewMode = "outdated"
 			m.cursor = 0
 		}
+
+		m.scrollTop = layout.ClampScroll(m.cursor, m.scrollTop, m.maxVisible)
 	}
 
 	return m, nil
 }
 
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	m.scrollTop = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// filteredRepos returns the repositories matching the search query
+func (m Model) filteredRepos() []string {
+	return search.Filter(m.repos, m.filterQuery, func(repo string) string { return repo })
+}
+
 // View renders the model
 func (m Model) View() string {
 	if m.loading {
-		return "Loading releases...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -185,6 +370,7 @@ func (m Model) View() string {
 		b.WriteString(inactiveTab.Render("[3] Outdated"))
 	}
 	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
 
 	// Content based on view mode
 	switch m.viewMode {
@@ -196,10 +382,18 @@ func (m Model) View() string {
 		b.WriteString(m.renderOutdated())
 	}
 
+	if m.detailRepo != "" {
+		b.WriteString(m.renderDetail())
+	}
+
 	// Help
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("↑/↓: navigate | 1/2/3: switch view | q: quit"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | pgup/pgdn: page | /: search | 1/2/3: switch view | enter: changelog | esc: close | q: quit"))
+	}
 
 	return b.String()
 }
@@ -214,7 +408,11 @@ func (m Model) renderLatest() string {
 		return b.String()
 	}
 
-	for i, repo := range m.repos {
+	for i, repo := range m.filteredRepos() {
+		if !layout.InWindow(i, m.scrollTop, m.maxVisible) {
+			continue
+		}
+
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -262,7 +460,11 @@ func (m Model) renderAll() string {
 
 	b.WriteString("📋 All Releases\n\n")
 
-	for i, repo := range m.repos {
+	for i, repo := range m.filteredRepos() {
+		if !layout.InWindow(i, m.scrollTop, m.maxVisible) {
+			continue
+		}
+
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -298,21 +500,30 @@ func (m Model) renderAll() string {
 func (m Model) renderOutdated() string {
 	var b strings.Builder
 
-	b.WriteString("⚠️  Outdated Releases (>90 days)\n\n")
+	b.WriteString("⚠️  Outdated Releases (past semver age policy)\n\n")
+
+	categoryColors := map[github.ReleaseAgeCategory]string{
+		github.ReleaseAgeStale:    "#FFFF00",
+		github.ReleaseAgeCritical: "#FF0000",
+	}
 
 	outdatedCount := 0
-	for i, repo := range m.repos {
+	for i, repo := range m.filteredRepos() {
 		release := m.latest[repo]
 		if release == nil {
 			continue
 		}
 
-		daysSince := int(time.Since(release.PublishedAt).Hours() / 24)
-		if daysSince <= 90 {
+		category := github.ClassifyReleaseAge(*release, m.policy)
+		if category != github.ReleaseAgeStale && category != github.ReleaseAgeCritical {
 			continue
 		}
 
 		outdatedCount++
+		if !layout.InWindow(i, m.scrollTop, m.maxVisible) {
+			continue
+		}
+
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -323,12 +534,14 @@ func (m Model) renderOutdated() string {
 			releaseStyle = releaseStyle.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
 		}
 
-		warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+		warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(categoryColors[category]))
+		daysSince := int(time.Since(release.PublishedAt).Hours() / 24)
 
 		line := fmt.Sprintf("%s %s:\n", cursor, repo)
-		line += fmt.Sprintf("   Last Release: %s\n", release.TagName)
+		line += fmt.Sprintf("   Last Release: %s (%s)\n", release.TagName, github.ReleaseBumpForTag(release.TagName))
 		line += "   "
-		line += warningStyle.Render(fmt.Sprintf("⚠️  %d days old", daysSince))
+		line += warningStyle.Render(fmt.Sprintf("⚠️  [%s] %d days old (limit %d)",
+			category, daysSince, github.ReleaseMaxAgeDays(*release, m.policy)))
 		line += "\n"
 
 		b.WriteString(releaseStyle.Render(line))
@@ -343,3 +556,35 @@ func (m Model) renderOutdated() string {
 
 	return b.String()
 }
+
+func (m Model) renderDetail() string {
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#00FFFF"))
+	errStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+
+	switch {
+	case m.detailLoading:
+		b.WriteString(headerStyle.Render(fmt.Sprintf("📝 Changelog: %s", m.detailRepo)))
+		b.WriteString("\n\nComparing releases...\n")
+	case m.detailErr != nil:
+		b.WriteString(headerStyle.Render(fmt.Sprintf("📝 Changelog: %s", m.detailRepo)))
+		b.WriteString("\n\n")
+		b.WriteString(errStyle.Render(fmt.Sprintf("Error: %v", m.detailErr)))
+		b.WriteString("\n")
+	case m.detail != nil:
+		b.WriteString(headerStyle.Render(fmt.Sprintf("📝 Changelog: %s (%s → %s)",
+			m.detailRepo, m.detail.FromTag, m.detail.ToTag)))
+		b.WriteString("\n\n")
+		b.WriteString(fmt.Sprintf("Commits: %d | PRs: %d | Contributors: %d\n\n",
+			m.detail.CommitCount, m.detail.PRCount, len(m.detail.Contributors)))
+		for _, title := range m.detail.PRTitles {
+			b.WriteString(fmt.Sprintf("  - %s\n", title))
+		}
+		if len(m.detail.Contributors) > 0 {
+			b.WriteString(fmt.Sprintf("\nContributors: %s\n", strings.Join(m.detail.Contributors, ", ")))
+		}
+	}
+
+	return b.String()
+}
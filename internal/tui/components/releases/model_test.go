@@ -0,0 +1,100 @@
+package releases
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func loadedModel(t *testing.T) tea.Model {
+	t.Helper()
+
+	releases := []github.Release{
+		{TagName: "v1.2.0"},
+		{TagName: "v1.1.1-rc", Draft: true},
+		{TagName: "v1.1.0"},
+	}
+
+	var m tea.Model = NewModel([]string{"owner/repo"})
+	m, _ = m.Update(releasesLoadedMsg{
+		releases: map[string][]github.Release{"owner/repo": releases},
+		latest:   map[string]*github.Release{"owner/repo": &releases[0]},
+	})
+	return m
+}
+
+func TestPreviousReleaseSkipsDrafts(t *testing.T) {
+	releases := []github.Release{{TagName: "v2"}, {TagName: "v1.9", Draft: true}, {TagName: "v1.8"}}
+
+	if prev := previousRelease(releases, "v2"); prev == nil || prev.TagName != "v1.8" {
+		t.Errorf("Expected v1.8, got %+v", prev)
+	}
+	if prev := previousRelease(releases, "v1.8"); prev != nil {
+		t.Errorf("Expected no release before the oldest, got %+v", prev)
+	}
+}
+
+func TestEnterShowsChangelogPanel(t *testing.T) {
+	m, cmd := loadedModel(t).Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected a command to load the comparison")
+	}
+	if view := m.View(); !strings.Contains(view, "Comparing releases...") {
+		t.Errorf("Expected loading state, got:\n%s", view)
+	}
+
+	m, _ = m.Update(comparisonLoadedMsg{
+		repo: "owner/repo",
+		comparison: &github.ReleaseComparison{
+			FromTag:      "v1.1.0",
+			ToTag:        "v1.2.0",
+			CommitCount:  4,
+			PRCount:      2,
+			PRTitles:     []string{"Add caching", "Fix login redirect"},
+			Contributors: []string{"alice", "bob"},
+		},
+	})
+
+	view := m.View()
+	for _, want := range []string{"owner/repo (v1.1.0 → v1.2.0)", "Commits: 4 | PRs: 2", "- Fix login redirect", "Contributors: alice, bob"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in view, got:\n%s", want, view)
+		}
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if strings.Contains(m.View(), "Changelog") {
+		t.Error("Expected enter to close the panel")
+	}
+}
+
+func TestOutdatedTabUsesReleasePolicy(t *testing.T) {
+	minor := github.Release{TagName: "v1.4.0", PublishedAt: time.Now().AddDate(0, 0, -45)}
+	patch := github.Release{TagName: "v2.0.1", PublishedAt: time.Now().AddDate(0, 0, -45)}
+
+	var m tea.Model = NewModel([]string{"owner/minor", "owner/patch"}, WithReleasePolicy(config.ReleasePolicyConfig{
+		MajorMaxAgeDays: 365,
+		MinorMaxAgeDays: 30,
+		PatchMaxAgeDays: 90,
+	}))
+	m, _ = m.Update(releasesLoadedMsg{
+		releases: map[string][]github.Release{},
+		latest:   map[string]*github.Release{"owner/minor": &minor, "owner/patch": &patch},
+	})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+
+	view := m.View()
+	if !strings.Contains(view, "owner/minor") || !strings.Contains(view, "[stale] 45 days old (limit 30)") {
+		t.Errorf("Expected minor release flagged stale, got:\n%s", view)
+	}
+	if strings.Contains(view, "owner/patch") {
+		t.Errorf("Expected patch release within its limit to be omitted, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Found 1 repositories") {
+		t.Errorf("Expected one outdated repo, got:\n%s", view)
+	}
+}
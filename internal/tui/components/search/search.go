@@ -0,0 +1,71 @@
+package search
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Searcher is implemented by list views with a search input
+// While Searching is true the view expects every key, including esc
+type Searcher interface {
+	Searching() bool
+}
+
+// Help is the help line shown while the search input is open
+const Help = "type to search | enter: keep filter | esc: clear"
+
+// HandleKey edits query for a key typed while the search input is open
+// Pure function: returns the new query and whether the input stays open
+// Enter closes the input and keeps the query; esc closes it and clears the query
+func HandleKey(query string, msg tea.KeyMsg) (string, bool) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		return query, false
+	case tea.KeyEsc:
+		return "", false
+	case tea.KeyBackspace:
+		if runes := []rune(query); len(runes) > 0 {
+			return string(runes[:len(runes)-1]), true
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		return query + string(msg.Runes), true
+	}
+	return query, true
+}
+
+// Matches reports whether name contains query, ignoring case
+// Pure function: an empty query matches everything
+func Matches(name, query string) bool {
+	return query == "" || strings.Contains(strings.ToLower(name), strings.ToLower(query))
+}
+
+// Filter returns the items whose name contains query, ignoring case
+// Pure function: items keep their order, and an empty query returns items unchanged
+func Filter[T any](items []T, query string, name func(T) string) []T {
+	if query == "" {
+		return items
+	}
+
+	var matched []T
+	for _, item := range items {
+		if Matches(name(item), query) {
+			matched = append(matched, item)
+		}
+	}
+	return matched
+}
+
+// View renders the search line, e.g. "/main_" while typing, or "" when there is no search
+func View(query string, active bool) string {
+	if !active && query == "" {
+		return ""
+	}
+
+	line := "/" + query
+	if active {
+		line += "_"
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00")).Render(line) + "\n"
+}
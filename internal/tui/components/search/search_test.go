@@ -0,0 +1,86 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestHandleKey tests editing, keeping, and clearing the query
+func TestHandleKey(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		msg            tea.KeyMsg
+		expectedQuery  string
+		expectedActive bool
+	}{
+		{"type", "ma", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")}, "mai", true},
+		{"space", "a", tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}, "a ", true},
+		{"backspace", "mä", tea.KeyMsg{Type: tea.KeyBackspace}, "m", true},
+		{"backspace empty", "", tea.KeyMsg{Type: tea.KeyBackspace}, "", true},
+		{"enter keeps", "main", tea.KeyMsg{Type: tea.KeyEnter}, "main", false},
+		{"esc clears", "main", tea.KeyMsg{Type: tea.KeyEsc}, "", false},
+		{"other keys ignored", "main", tea.KeyMsg{Type: tea.KeyDown}, "main", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, active := HandleKey(tt.query, tt.msg)
+			if query != tt.expectedQuery || active != tt.expectedActive {
+				t.Errorf("Expected (%q, %v), got (%q, %v)", tt.expectedQuery, tt.expectedActive, query, active)
+			}
+		})
+	}
+}
+
+// TestMatches tests case-insensitive substring matching
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected bool
+	}{
+		{"feature/Login", "", true},
+		{"feature/Login", "login", true},
+		{"feature/Login", "FEAT", true},
+		{"feature/Login", "logout", false},
+	}
+
+	for _, tt := range tests {
+		if got := Matches(tt.name, tt.query); got != tt.expected {
+			t.Errorf("Matches(%q, %q) = %v, want %v", tt.name, tt.query, got, tt.expected)
+		}
+	}
+}
+
+// TestFilter tests keeping matching items in order
+func TestFilter(t *testing.T) {
+	repos := []string{"owner/api", "owner/web", "other/api-client"}
+	identity := func(s string) string { return s }
+
+	got := Filter(repos, "API", identity)
+	if len(got) != 2 || got[0] != "owner/api" || got[1] != "other/api-client" {
+		t.Errorf("Expected both api repos in order, got %v", got)
+	}
+	if got := Filter(repos, "", identity); len(got) != 3 {
+		t.Errorf("Expected an empty query to keep all items, got %v", got)
+	}
+	if got := Filter(repos, "missing", identity); len(got) != 0 {
+		t.Errorf("Expected no matches, got %v", got)
+	}
+}
+
+// TestView tests the search line while typing and after the input closes
+func TestView(t *testing.T) {
+	if got := View("", false); got != "" {
+		t.Errorf("Expected no search line, got %q", got)
+	}
+	if got := View("main", true); !strings.Contains(got, "/main_") {
+		t.Errorf("Expected a cursor while typing, got %q", got)
+	}
+	if got := View("main", false); !strings.Contains(got, "/main") || strings.Contains(got, "_") {
+		t.Errorf("Expected the kept filter without a cursor, got %q", got)
+	}
+}
@@ -3,9 +3,12 @@ package secrets
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -17,12 +20,20 @@ type Model struct {
 	orgSecrets []github.Secret
 	repoSecrets map[string][]github.Secret
 	unusedSecrets []string
+	scanErrors    int // Repos whose workflows could not be fetched
+	orgVariables  []github.Variable
+	repoVariables map[string][]github.Variable
+	graph         github.SecretGraph
 	cursor     int
 	width      int
 	height     int
 	loading    bool
+	spinner    spinner.LoadingSpinner
 	err        error
-	viewMode   string // "org", "repo", "unused"
+	viewMode   string // "org", "repo", "unused", "variables"
+
+	filterQuery  string // Case-insensitive substring match on secret, variable, or repository names
+	searchActive bool   // Set while the search query is being typed
 }
 
 // NewModel creates a new secrets audit model
@@ -31,7 +42,9 @@ func NewModel(org string, repos []string) Model {
 		org:         org,
 		repos:       repos,
 		repoSecrets: make(map[string][]github.Secret),
+		repoVariables: make(map[string][]github.Variable),
 		loading:     true,
+		spinner:     spinner.New("Loading secrets..."),
 		viewMode:    "org",
 	}
 }
@@ -40,12 +53,15 @@ type secretsLoadedMsg struct {
 	orgSecrets    []github.Secret
 	repoSecrets   map[string][]github.Secret
 	unusedSecrets []string
+	scanErrors    int
+	orgVariables  []github.Variable
+	repoVariables map[string][]github.Variable
 	err           error
 }
 
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return m.loadSecrets
+	return tea.Batch(m.loadSecrets, m.spinner.Tick())
 }
 
 func (m Model) loadSecrets() tea.Msg {
@@ -57,6 +73,7 @@ func (m Model) loadSecrets() tea.Msg {
 			orgSecrets:    []github.Secret{},
 			repoSecrets:   make(map[string][]github.Secret),
 			unusedSecrets: []string{},
+			repoVariables: make(map[string][]github.Variable),
 			err:           fmt.Errorf("failed to create GitHub client: %w", err),
 		}
 	}
@@ -71,8 +88,21 @@ func (m Model) loadSecrets() tea.Msg {
 		}
 	}
 
-	// Load repository secrets
+	// Load organization variables
+	var orgVariables []github.Variable
+	if m.org != "" {
+		orgVariables, err = client.ListOrgVariables(m.org)
+		if err != nil {
+			// Continue even if org variables fail
+			orgVariables = []github.Variable{}
+		}
+	}
+
+	// Load repository secrets, variables, and workflow secret references
 	repoSecrets := make(map[string][]github.Secret)
+	repoVariables := make(map[string][]github.Variable)
+	workflowRefs := make(map[string]map[string][]string)
+	scanErrors := 0
 	for _, repoStr := range m.repos {
 		parts := strings.Split(repoStr, "/")
 		if len(parts) != 2 {
@@ -80,6 +110,16 @@ func (m Model) loadSecrets() tea.Msg {
 		}
 		owner, repo := parts[0], parts[1]
 
+		if files, err := client.FetchWorkflowFiles(owner, repo); err == nil {
+			workflowRefs[repoStr] = workflowSecretRefs(files)
+		} else {
+			scanErrors++
+		}
+
+		if variables, err := client.ListRepoVariables(owner, repo); err == nil {
+			repoVariables[repoStr] = variables
+		}
+
 		secrets, err := client.ListRepoSecrets(owner, repo)
 		if err != nil {
 			// Skip repos on error
@@ -89,21 +129,95 @@ func (m Model) loadSecrets() tea.Msg {
 		repoSecrets[repoStr] = secrets
 	}
 
-	// Detect unused secrets (simplified - would need workflow file parsing for real detection)
-	// For now, just return empty list
-	unusedSecrets := []string{}
-
 	return secretsLoadedMsg{
 		orgSecrets:    orgSecrets,
 		repoSecrets:   repoSecrets,
-		unusedSecrets: unusedSecrets,
+		unusedSecrets: findUnusedSecrets(orgSecrets, repoSecrets, workflowRefs, scanErrors),
+		scanErrors:    scanErrors,
+		orgVariables:  orgVariables,
+		repoVariables: repoVariables,
 		err:           nil,
 	}
 }
 
+// workflowSecretRefs maps each secret referenced in files to the referencing workflow paths
+// Names are upper-cased, matching how GitHub stores them
+func workflowSecretRefs(files map[string]string) map[string][]string {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	refs := make(map[string][]string)
+	for _, path := range paths {
+		for _, name := range github.ScanWorkflowForSecrets(files[path]) {
+			name = strings.ToUpper(name)
+			refs[name] = append(refs[name], path)
+		}
+	}
+	return refs
+}
+
+// findUnusedSecrets lists secrets that no scanned workflow references, labelled by scope
+// Org secrets are checked against every scanned repo and repo secrets against their own
+// repo; secrets of repos whose workflows could not be scanned are not reported, and org
+// secrets are not reported when any repo failed to scan
+func findUnusedSecrets(orgSecrets []github.Secret, repoSecrets map[string][]github.Secret, workflowRefs map[string]map[string][]string, scanErrors int) []string {
+	unused := []string{}
+	if len(workflowRefs) == 0 {
+		return unused
+	}
+
+	allRefs := make(map[string][]string)
+	for repo, refs := range workflowRefs {
+		for name, paths := range refs {
+			for _, path := range paths {
+				allRefs[name] = append(allRefs[name], repo+":"+path)
+			}
+		}
+	}
+
+	if scanErrors == 0 {
+		for _, usage := range github.DetectUnusedSecrets(orgSecrets, allRefs) {
+			if usage.Unused {
+				unused = append(unused, fmt.Sprintf("%s (org)", usage.Name))
+			}
+		}
+	}
+
+	repos := make([]string, 0, len(repoSecrets))
+	for repo := range repoSecrets {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	for _, repo := range repos {
+		refs, ok := workflowRefs[repo]
+		if !ok {
+			continue
+		}
+		for _, usage := range github.DetectUnusedSecrets(repoSecrets[repo], refs) {
+			if usage.Unused {
+				unused = append(unused, fmt.Sprintf("%s (%s)", usage.Name, repo))
+			}
+		}
+	}
+
+	return unused
+}
+
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -114,25 +228,38 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.orgSecrets = msg.orgSecrets
 		m.repoSecrets = msg.repoSecrets
 		m.unusedSecrets = msg.unusedSecrets
+		m.scanErrors = msg.scanErrors
+		m.orgVariables = msg.orgVariables
+		m.repoVariables = msg.repoVariables
+		m.graph = github.BuildSecretDependencyGraph(msg.orgSecrets, msg.repoSecrets)
 		m.err = msg.err
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
+		case "/":
+			m.searchActive = true
+
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
 			}
 
 		case "down", "j":
-			maxCursor := len(m.orgSecrets) - 1
+			maxCursor := len(m.filteredOrgSecrets()) - 1
 			if m.viewMode == "repo" {
-				maxCursor = len(m.repos) - 1
+				maxCursor = len(m.filteredRepos()) - 1
 			} else if m.viewMode == "unused" {
-				maxCursor = len(m.unusedSecrets) - 1
+				maxCursor = len(m.filteredUnusedSecrets()) - 1
+			} else if m.viewMode == "variables" {
+				maxCursor = len(m.filteredOrgVariables()) + len(m.filteredRepos()) - 1
 			}
 			if m.cursor < maxCursor {
 				m.cursor++
@@ -147,16 +274,54 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "3":
 			m.viewMode = "unused"
 			m.cursor = 0
+		case "5":
+			m.viewMode = "variables"
+			m.cursor = 0
 		}
 	}
 
 	return m, nil
 }
 
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// filteredOrgSecrets returns org secrets whose names match the search query
+func (m Model) filteredOrgSecrets() []github.Secret {
+	return search.Filter(m.orgSecrets, m.filterQuery, func(s github.Secret) string { return s.Name })
+}
+
+// filteredRepos returns the repositories matching the search query
+func (m Model) filteredRepos() []string {
+	return search.Filter(m.repos, m.filterQuery, func(repo string) string { return repo })
+}
+
+// filteredUnusedSecrets returns unused secret labels matching the search query
+func (m Model) filteredUnusedSecrets() []string {
+	return search.Filter(m.unusedSecrets, m.filterQuery, func(label string) string { return label })
+}
+
+// filteredOrgVariables returns org variables whose names match the search query
+func (m Model) filteredOrgVariables() []github.Variable {
+	return search.Filter(m.orgVariables, m.filterQuery, func(v github.Variable) string { return v.Name })
+}
+
 // View renders the model
 func (m Model) View() string {
 	if m.loading {
-		return "Loading secrets...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -198,7 +363,14 @@ func (m Model) View() string {
 	} else {
 		b.WriteString(inactiveTab.Render("[3] Unused"))
 	}
+	b.WriteString("  ")
+	if m.viewMode == "variables" {
+		b.WriteString(activeTab.Render("[5] Variables"))
+	} else {
+		b.WriteString(inactiveTab.Render("[5] Variables"))
+	}
 	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
 
 	// Content based on view mode
 	switch m.viewMode {
@@ -208,12 +380,18 @@ func (m Model) View() string {
 		b.WriteString(m.renderRepoSecrets())
 	case "unused":
 		b.WriteString(m.renderUnusedSecrets())
+	case "variables":
+		b.WriteString(m.renderVariables())
 	}
 
 	// Help
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("↑/↓: navigate | 1/2/3: switch view | q: quit"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | /: search | 1-3,5: switch view | q: quit"))
+	}
 
 	return b.String()
 }
@@ -230,7 +408,7 @@ func (m Model) renderOrgSecrets() string {
 
 	b.WriteString(fmt.Sprintf("Total: %d secrets\n\n", len(m.orgSecrets)))
 
-	for i, secret := range m.orgSecrets {
+	for i, secret := range m.filteredOrgSecrets() {
 		if i >= m.height-10 {
 			break
 		}
@@ -245,11 +423,15 @@ func (m Model) renderOrgSecrets() string {
 			secretStyle = secretStyle.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
 		}
 
-		line := fmt.Sprintf("%s %s\n", cursor, secret.Name)
-		if secret.UpdatedAt == "" {
-			line += "   Updated: unknown\n"
-		} else {
-			line += fmt.Sprintf("   Updated: %s\n", secret.UpdatedAt)
+		repoCount := len(m.graph[secret.Name])
+		b.WriteString(secretStyle.Render(fmt.Sprintf("%s %s", cursor, secret.Name)))
+		b.WriteString(" ")
+		b.WriteString(blastRadiusStyle(repoCount).Render(fmt.Sprintf("(%d repos)", repoCount)))
+		b.WriteString("\n")
+
+		line := "   Updated: unknown\n"
+		if secret.UpdatedAt != "" {
+			line = fmt.Sprintf("   Updated: %s\n", secret.UpdatedAt)
 		}
 
 		b.WriteString(secretStyle.Render(line))
@@ -259,6 +441,72 @@ func (m Model) renderOrgSecrets() string {
 	return b.String()
 }
 
+// Cursor returns the index of the highlighted item
+func (m Model) Cursor() int {
+	return m.cursor
+}
+
+// RenderDetail describes the item at index in the current view
+func (m Model) RenderDetail(index int) string {
+	var b strings.Builder
+
+	switch m.viewMode {
+	case "org":
+		secrets := m.filteredOrgSecrets()
+		if index < 0 || index >= len(secrets) {
+			return ""
+		}
+		secret := secrets[index]
+		repos := m.graph[secret.Name]
+
+		b.WriteString(fmt.Sprintf("Secret:  %s\n", secret.Name))
+		b.WriteString(fmt.Sprintf("Scope:   %s\n", secret.Scope))
+		b.WriteString(fmt.Sprintf("Created: %s\n", valueOrUnknown(secret.CreatedAt)))
+		b.WriteString(fmt.Sprintf("Updated: %s\n\n", valueOrUnknown(secret.UpdatedAt)))
+		b.WriteString(blastRadiusStyle(len(repos)).Render(fmt.Sprintf("Exposed to %d repos:", len(repos))))
+		b.WriteString("\n")
+		for _, repo := range repos {
+			b.WriteString(fmt.Sprintf("  - %s\n", repo))
+		}
+
+	case "repo":
+		repos := m.filteredRepos()
+		if index < 0 || index >= len(repos) {
+			return ""
+		}
+		repo := repos[index]
+		secrets := m.repoSecrets[repo]
+
+		b.WriteString(fmt.Sprintf("Repository: %s\n", repo))
+		b.WriteString(fmt.Sprintf("Secrets:    %d\n\n", len(secrets)))
+		for _, secret := range secrets {
+			b.WriteString(fmt.Sprintf("  - %s (updated %s)\n", secret.Name, valueOrUnknown(secret.UpdatedAt)))
+		}
+	}
+
+	return b.String()
+}
+
+func valueOrUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
+
+// blastRadiusStyle colors a repo count by how many repos a leaked secret would expose
+func blastRadiusStyle(repoCount int) lipgloss.Style {
+	style := lipgloss.NewStyle()
+	switch {
+	case repoCount > 20:
+		return style.Foreground(lipgloss.Color("#FF0000"))
+	case repoCount >= 5:
+		return style.Foreground(lipgloss.Color("#FFFF00"))
+	default:
+		return style.Foreground(lipgloss.Color("#00FF00"))
+	}
+}
+
 func (m Model) renderRepoSecrets() string {
 	var b strings.Builder
 
@@ -269,7 +517,7 @@ func (m Model) renderRepoSecrets() string {
 		return b.String()
 	}
 
-	for i, repo := range m.repos {
+	for i, repo := range m.filteredRepos() {
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -305,13 +553,16 @@ func (m Model) renderUnusedSecrets() string {
 
 	b.WriteString("⚠️  Potentially Unused Secrets\n\n")
 
+	if m.scanErrors > 0 {
+		b.WriteString(fmt.Sprintf("(Workflows could not be fetched for %d repo(s); their secrets and org secrets are not checked)\n\n", m.scanErrors))
+	}
+
 	if len(m.unusedSecrets) == 0 {
-		b.WriteString("✅ All secrets appear to be in use.\n")
-		b.WriteString("(Full analysis requires workflow file parsing)\n")
+		b.WriteString("✅ All secrets are referenced by a workflow.\n")
 		return b.String()
 	}
 
-	for i, secret := range m.unusedSecrets {
+	for i, secret := range m.filteredUnusedSecrets() {
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -328,3 +579,61 @@ func (m Model) renderUnusedSecrets() string {
 
 	return b.String()
 }
+
+func (m Model) renderVariables() string {
+	var b strings.Builder
+
+	selectedStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+
+	b.WriteString(fmt.Sprintf("🏢 Organization Variables: %s\n\n", m.org))
+
+	if len(m.orgVariables) == 0 {
+		b.WriteString("No organization variables found.\n")
+	}
+
+	orgVariables := m.filteredOrgVariables()
+	for i, variable := range orgVariables {
+		cursor := " "
+		style := lipgloss.NewStyle()
+		if m.cursor == i {
+			cursor = ">"
+			style = selectedStyle
+		}
+
+		b.WriteString(style.Render(fmt.Sprintf("%s %s = %s\n", cursor, variable.Name, variable.Value)))
+	}
+
+	b.WriteString("\n📦 Repository Variables\n\n")
+
+	if len(m.repoVariables) == 0 {
+		b.WriteString("No repository variables found.\n")
+		return b.String()
+	}
+
+	for i, repo := range m.filteredRepos() {
+		idx := len(orgVariables) + i
+		cursor := " "
+		style := lipgloss.NewStyle()
+		if m.cursor == idx {
+			cursor = ">"
+			style = selectedStyle
+		}
+
+		variables := m.repoVariables[repo]
+		line := fmt.Sprintf("%s %s (%d variables):\n", cursor, repo, len(variables))
+
+		// Show first few variables
+		for j, variable := range variables {
+			if j >= 3 {
+				line += fmt.Sprintf("   ... and %d more\n", len(variables)-3)
+				break
+			}
+			line += fmt.Sprintf("   - %s = %s\n", variable.Name, variable.Value)
+		}
+
+		b.WriteString(style.Render(line))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
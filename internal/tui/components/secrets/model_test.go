@@ -0,0 +1,113 @@
+package secrets
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestWorkflowSecretRefs(t *testing.T) {
+	refs := workflowSecretRefs(map[string]string{
+		".github/workflows/release.yml": "run: echo ${{ secrets.NPM_TOKEN }} ${{ secrets.DEPLOY_KEY }}",
+		".github/workflows/ci.yml":      "env:\n  TOKEN: ${{ secrets.npm_token }}\n",
+	})
+
+	expected := map[string][]string{
+		"NPM_TOKEN":  {".github/workflows/ci.yml", ".github/workflows/release.yml"},
+		"DEPLOY_KEY": {".github/workflows/release.yml"},
+	}
+	if !reflect.DeepEqual(refs, expected) {
+		t.Errorf("workflowSecretRefs() = %v, want %v", refs, expected)
+	}
+}
+
+func TestFindUnusedSecrets(t *testing.T) {
+	orgSecrets := []github.Secret{
+		{Name: "NPM_TOKEN", Scope: "org"},
+		{Name: "SLACK_WEBHOOK", Scope: "org"},
+	}
+	repoSecrets := map[string][]github.Secret{
+		"acme/web": {{Name: "DEPLOY_KEY", Scope: "repo", Repository: "acme/web"}},
+		"acme/api": {
+			{Name: "DEPLOY_KEY", Scope: "repo", Repository: "acme/api"},
+			{Name: "OLD_TOKEN", Scope: "repo", Repository: "acme/api"},
+		},
+		"acme/unscanned": {{Name: "ANYTHING", Scope: "repo", Repository: "acme/unscanned"}},
+	}
+	workflowRefs := map[string]map[string][]string{
+		"acme/api": {
+			"NPM_TOKEN":  {".github/workflows/ci.yml"},
+			"DEPLOY_KEY": {".github/workflows/deploy.yml"},
+		},
+		"acme/web": {},
+	}
+
+	got := findUnusedSecrets(orgSecrets, repoSecrets, workflowRefs, 0)
+	expected := []string{"SLACK_WEBHOOK (org)", "OLD_TOKEN (acme/api)", "DEPLOY_KEY (acme/web)"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("findUnusedSecrets() = %v, want %v", got, expected)
+	}
+
+	got = findUnusedSecrets(orgSecrets, repoSecrets, workflowRefs, 1)
+	expected = []string{"OLD_TOKEN (acme/api)", "DEPLOY_KEY (acme/web)"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("findUnusedSecrets() with scan errors = %v, want %v", got, expected)
+	}
+}
+
+func TestFindUnusedSecretsIgnoresCase(t *testing.T) {
+	repoSecrets := map[string][]github.Secret{
+		"acme/api": {{Name: "NPM_TOKEN", Scope: "repo", Repository: "acme/api"}},
+	}
+	workflowRefs := map[string]map[string][]string{
+		"acme/api": {"npm_token": {".github/workflows/ci.yml"}},
+	}
+
+	if got := findUnusedSecrets(nil, repoSecrets, workflowRefs, 0); len(got) != 0 {
+		t.Errorf("Expected NPM_TOKEN to match secrets.npm_token, got %v", got)
+	}
+}
+
+func TestFindUnusedSecretsWithoutScannedRepos(t *testing.T) {
+	got := findUnusedSecrets([]github.Secret{{Name: "NPM_TOKEN", Scope: "org"}}, nil, map[string]map[string][]string{}, 0)
+	if len(got) != 0 {
+		t.Errorf("Expected no unused secrets when nothing was scanned, got %v", got)
+	}
+}
+
+func TestUnusedTabShowsScanResults(t *testing.T) {
+	updated, _ := NewModel("acme", []string{"acme/api"}).Update(secretsLoadedMsg{
+		orgSecrets:    []github.Secret{{Name: "SLACK_WEBHOOK", Scope: "org"}},
+		repoSecrets:   map[string][]github.Secret{},
+		unusedSecrets: []string{"SLACK_WEBHOOK (org)"},
+		scanErrors:    1,
+		repoVariables: map[string][]github.Variable{},
+	})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+
+	view := updated.(Model).View()
+	if !strings.Contains(view, "SLACK_WEBHOOK (org)") {
+		t.Errorf("Expected unused secret listed, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Workflows could not be fetched for 1 repo(s)") {
+		t.Errorf("Expected scan error note, got:\n%s", view)
+	}
+}
+
+func TestVariablesTabBoundToFive(t *testing.T) {
+	updated, _ := NewModel("acme", []string{"acme/api"}).Update(secretsLoadedMsg{
+		repoSecrets:   map[string][]github.Secret{},
+		repoVariables: map[string][]github.Variable{},
+	})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")})
+
+	if mode := updated.(Model).viewMode; mode != "variables" {
+		t.Errorf("Expected [5] to open the variables tab, got %q", mode)
+	}
+	if view := updated.(Model).View(); !strings.Contains(view, "[5] Variables") {
+		t.Errorf("Expected [5] Variables tab label, got:\n%s", view)
+	}
+}
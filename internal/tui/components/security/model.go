@@ -0,0 +1,438 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Model represents the security audit TUI state
+type Model struct {
+	repos     []string
+	minLength int
+	report    github.SecurityPolicyReport
+	dismissed []github.DependabotAlert
+	dismissal github.DismissalReport
+	trends    []alertTrendRow
+	cursor    int
+	width     int
+	height    int
+	loading   bool
+	spinner   spinner.LoadingSpinner
+	err       error
+	viewMode  string // "policy", "dismissed", "trend"
+
+	filterQuery  string // Case-insensitive substring match on repository names
+	searchActive bool   // Set while the search query is being typed
+}
+
+// alertTrendRow holds one repository's open alert count and change since the previous load
+type alertTrendRow struct {
+	repo        string
+	open        int
+	trend       github.AlertTrend
+	hasPrevious bool
+}
+
+// NewModel creates a new security audit model
+func NewModel(repos []string, minPolicyLength int) Model {
+	if minPolicyLength <= 0 {
+		minPolicyLength = github.DefaultMinSecurityPolicyLength
+	}
+
+	return Model{
+		repos:     repos,
+		minLength: minPolicyLength,
+		loading:   true,
+		spinner:   spinner.New("Loading security checks..."),
+		viewMode:  "policy",
+	}
+}
+
+type securityLoadedMsg struct {
+	report    github.SecurityPolicyReport
+	dismissed []github.DependabotAlert
+	trends    []alertTrendRow
+	err       error
+}
+
+// Init initializes the model
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.loadSecurity, m.spinner.Tick())
+}
+
+func (m Model) loadSecurity() tea.Msg {
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		return securityLoadedMsg{
+			err: fmt.Errorf("failed to create GitHub client: %w", err),
+		}
+	}
+
+	// Trends are unavailable without a cache; the tab then shows plain counts
+	alertCache, cacheErr := cache.NewAlertStateCache("")
+
+	policies := make(map[string]string)
+	var dismissed []github.DependabotAlert
+	var trends []alertTrendRow
+	for _, repoStr := range m.repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			continue
+		}
+
+		policy, err := client.GetSecurityPolicy(parts[0], parts[1])
+		if err != nil {
+			// Skip repos on error
+			continue
+		}
+		policies[repoStr] = policy
+
+		// Dependabot alerts may be disabled or inaccessible; skip on error
+		alerts, err := client.ListDismissedAlerts(parts[0], parts[1])
+		if err == nil {
+			dismissed = append(dismissed, alerts...)
+		}
+
+		openAlerts, err := client.ListOpenAlerts(parts[0], parts[1])
+		if err != nil {
+			continue
+		}
+		row := alertTrendRow{repo: repoStr, open: len(openAlerts)}
+		if cacheErr == nil {
+			row.trend, row.hasPrevious, _ = alertCache.UpdateTrend(parts[0], parts[1], openAlerts)
+		}
+		trends = append(trends, row)
+	}
+
+	sort.Slice(dismissed, func(i, j int) bool {
+		return dismissed[i].DismissedAt.Before(dismissed[j].DismissedAt)
+	})
+
+	return securityLoadedMsg{
+		report:    github.AuditSecurityPolicyWithMinLength(policies, m.minLength),
+		dismissed: dismissed,
+		trends:    trends,
+	}
+}
+
+// Update handles messages
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case securityLoadedMsg:
+		m.loading = false
+		m.report = msg.report
+		m.dismissed = msg.dismissed
+		m.trends = msg.trends
+		m.dismissal = github.BuildDismissalReport(msg.dismissed)
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+
+		case "/":
+			m.searchActive = true
+
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+
+		case "down", "j":
+			if m.cursor < m.rowCount()-1 {
+				m.cursor++
+			}
+
+		case "1":
+			m.viewMode = "policy"
+			m.cursor = 0
+
+		case "2":
+			m.viewMode = "dismissed"
+			m.cursor = 0
+
+		case "3":
+			m.viewMode = "trend"
+			m.cursor = 0
+		}
+	}
+
+	return m, nil
+}
+
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// filteredDismissed returns dismissed alerts whose repository or package matches the search query
+func (m Model) filteredDismissed() []github.DependabotAlert {
+	return search.Filter(m.dismissed, m.filterQuery, func(alert github.DependabotAlert) string {
+		return alert.Repository + " " + alert.Package
+	})
+}
+
+// filteredTrends returns alert trends for repositories matching the search query
+func (m Model) filteredTrends() []alertTrendRow {
+	return search.Filter(m.trends, m.filterQuery, func(row alertTrendRow) string { return row.repo })
+}
+
+func (m Model) rowCount() int {
+	switch m.viewMode {
+	case "dismissed":
+		return len(m.filteredDismissed())
+	case "trend":
+		return len(m.filteredTrends())
+	}
+	return len(m.policyRows())
+}
+
+type policyRow struct {
+	repo   string
+	status string
+	color  string
+}
+
+func (m Model) policyRows() []policyRow {
+	rows := []policyRow{}
+	for _, repo := range m.report.Missing {
+		rows = append(rows, policyRow{repo: repo, status: "missing", color: "#FF0000"})
+	}
+	for _, repo := range m.report.TooShort {
+		rows = append(rows, policyRow{repo: repo, status: "too short", color: "#FFFF00"})
+	}
+	for _, repo := range m.report.Compliant {
+		rows = append(rows, policyRow{repo: repo, status: "ok", color: "#00FF00"})
+	}
+	return search.Filter(rows, m.filterQuery, func(row policyRow) string { return row.repo })
+}
+
+// View renders the model
+func (m Model) View() string {
+	if m.loading {
+		return m.spinner.View() + "\n"
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n", m.err)
+	}
+
+	var b strings.Builder
+
+	// Header
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#00FFFF"))
+
+	b.WriteString(titleStyle.Render("🛡️  Security Audit"))
+	b.WriteString("\n\n")
+
+	// View mode tabs
+	activeTab := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FFFF00"))
+
+	inactiveTab := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#777777"))
+
+	if m.viewMode == "policy" {
+		b.WriteString(activeTab.Render("[1] Security Policy"))
+	} else {
+		b.WriteString(inactiveTab.Render("[1] Security Policy"))
+	}
+	b.WriteString("  ")
+	if m.viewMode == "dismissed" {
+		b.WriteString(activeTab.Render("[2] Dismissed Alerts"))
+	} else {
+		b.WriteString(inactiveTab.Render("[2] Dismissed Alerts"))
+	}
+	b.WriteString("  ")
+	if m.viewMode == "trend" {
+		b.WriteString(activeTab.Render("[3] Alert Trend"))
+	} else {
+		b.WriteString(inactiveTab.Render("[3] Alert Trend"))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
+
+	switch m.viewMode {
+	case "policy":
+		b.WriteString(m.renderPolicies())
+	case "dismissed":
+		b.WriteString(m.renderDismissed())
+	case "trend":
+		b.WriteString(m.renderTrends())
+	}
+
+	// Help
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | /: search | 1-3: switch view | q: quit"))
+	}
+
+	return b.String()
+}
+
+func (m Model) renderPolicies() string {
+	var b strings.Builder
+
+	rows := m.policyRows()
+	if len(rows) == 0 && m.filterQuery != "" {
+		b.WriteString("No repositories match the search.\n")
+		return b.String()
+	}
+	if len(rows) == 0 {
+		b.WriteString("No repositories checked.\n")
+		return b.String()
+	}
+
+	b.WriteString(fmt.Sprintf("Missing: %d | Too short (< %d chars): %d | OK: %d\n\n",
+		len(m.report.Missing), m.report.MinLength, len(m.report.TooShort), len(m.report.Compliant)))
+
+	for i, row := range rows {
+		cursor := " "
+		if m.cursor == i {
+			cursor = ">"
+		}
+
+		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(row.color))
+		repoStyle := lipgloss.NewStyle()
+		if m.cursor == i {
+			repoStyle = repoStyle.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		}
+
+		b.WriteString(repoStyle.Render(fmt.Sprintf("%s %-40s ", cursor, row.repo)))
+		b.WriteString(statusStyle.Render(row.status))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (m Model) renderDismissed() string {
+	var b strings.Builder
+
+	if m.dismissal.TotalDismissed == 0 {
+		b.WriteString("No dismissed Dependabot alerts.\n")
+		return b.String()
+	}
+
+	reasons := make([]string, 0, len(m.dismissal.ByReason))
+	for reason := range m.dismissal.ByReason {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+
+	parts := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		parts = append(parts, fmt.Sprintf("%s: %d", reason, m.dismissal.ByReason[reason]))
+	}
+
+	b.WriteString(fmt.Sprintf("Dismissed: %d | Oldest: %s\n",
+		m.dismissal.TotalDismissed, m.dismissal.OldestDismissal.Format("2006-01-02")))
+	b.WriteString(fmt.Sprintf("By reason: %s\n\n", strings.Join(parts, " | ")))
+
+	for i, alert := range m.filteredDismissed() {
+		cursor := " "
+		if m.cursor == i {
+			cursor = ">"
+		}
+
+		style := lipgloss.NewStyle()
+		if m.cursor == i {
+			style = style.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		}
+
+		b.WriteString(style.Render(fmt.Sprintf("%s %-30s #%-5d %-10s %-16s %s  %s",
+			cursor, alert.Repository, alert.Number, alert.Severity, alert.DismissedReason,
+			alert.DismissedAt.Format("2006-01-02"), alert.Package)))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (m Model) renderTrends() string {
+	var b strings.Builder
+
+	if len(m.trends) == 0 {
+		b.WriteString("No open Dependabot alerts loaded.\n")
+		return b.String()
+	}
+
+	b.WriteString("Open alerts compared with the previous load\n\n")
+
+	arrowColors := map[string]string{
+		github.AlertTrendWorsening: "#FF0000",
+		github.AlertTrendImproving: "#00FF00",
+		github.AlertTrendStable:    "#777777",
+	}
+
+	for i, row := range m.filteredTrends() {
+		cursor := " "
+		if m.cursor == i {
+			cursor = ">"
+		}
+
+		repoStyle := lipgloss.NewStyle()
+		if m.cursor == i {
+			repoStyle = repoStyle.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		}
+
+		b.WriteString(repoStyle.Render(fmt.Sprintf("%s %-40s %4d ", cursor, row.repo, row.open)))
+		if !row.hasPrevious {
+			b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#777777")).Render("(first load)"))
+			b.WriteString("\n")
+			continue
+		}
+
+		arrowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(arrowColors[row.trend.TrendDirection]))
+		b.WriteString(arrowStyle.Render(row.trend.Arrow()))
+		b.WriteString(fmt.Sprintf("  +%d new, -%d resolved", len(row.trend.NewAlerts), len(row.trend.ResolvedAlerts)))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
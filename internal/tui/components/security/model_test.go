@@ -0,0 +1,30 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTrendTabShowsArrows(t *testing.T) {
+	updated, _ := NewModel([]string{"acme/api", "acme/web", "acme/cli"}, 0).Update(securityLoadedMsg{
+		trends: []alertTrendRow{
+			{repo: "acme/api", open: 3, hasPrevious: true, trend: github.TrackAlertTrend(
+				[]github.DependabotAlert{{Number: 2}, {Number: 3}, {Number: 4}},
+				[]github.DependabotAlert{{Number: 1}, {Number: 2}},
+			)},
+			{repo: "acme/web", open: 0, hasPrevious: true, trend: github.TrackAlertTrend(nil, []github.DependabotAlert{{Number: 1}})},
+			{repo: "acme/cli", open: 5},
+		},
+	})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+
+	view := updated.(Model).View()
+	for _, want := range []string{"[3] Alert Trend", "↑  +2 new, -1 resolved", "↓  +0 new, -1 resolved", "(first load)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in view, got:\n%s", want, view)
+		}
+	}
+}
@@ -3,9 +3,12 @@ package settings
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -20,45 +23,139 @@ type Model struct {
 	width      int
 	height     int
 	loading    bool
+	spinner    spinner.LoadingSpinner
 	err        error
 	viewMode   string // "overview", "diff"
+	useGraphQL bool
+	allowed    []string            // Allowed merge strategies; empty disables enforcement
+	violations map[string][]string // Repo -> enabled strategies outside allowed
+
+	filterQuery  string // Case-insensitive substring match on repository names
+	searchActive bool   // Set while the search query is being typed
+
+	confirmApply bool
+	applyTarget  string // Repo the baseline settings will be applied to once confirmed
+	statusMsg    string
+}
+
+// Option configures the settings comparison model
+type Option func(*Model)
+
+// WithGraphQL fetches all repos in a single batched GraphQL request
+func WithGraphQL(enabled bool) Option {
+	return func(m *Model) {
+		m.useGraphQL = enabled
+	}
+}
+
+// WithAllowedMergeStrategies highlights repos enabling merge strategies outside allowed
+func WithAllowedMergeStrategies(allowed []string) Option {
+	return func(m *Model) {
+		m.allowed = allowed
+	}
 }
 
 // NewModel creates a new settings comparison model
-func NewModel(repos []string, baseline string) Model {
-	return Model{
+func NewModel(repos []string, baseline string, opts ...Option) Model {
+	m := Model{
 		repos:    repos,
 		baseline: baseline,
 		settings: make(map[string]*github.RepoSettings),
 		diffs:    make(map[string][]github.SettingsDiff),
 		loading:  true,
+		spinner:  spinner.New("Loading repository settings..."),
 		viewMode: "overview",
 	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
 }
 
 type settingsLoadedMsg struct {
 	settings map[string]*github.RepoSettings
+	baseline string
 	diffs    map[string][]github.SettingsDiff
 	err      error
 }
 
+type settingsAppliedMsg struct {
+	repo  string
+	patch map[string]interface{}
+	err   error
+}
+
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return m.loadSettings
+	return tea.Batch(m.loadSettings, m.spinner.Tick())
 }
 
 func (m Model) loadSettings() tea.Msg {
-	// Create GitHub client
-	ctx := context.Background()
-	client, err := github.NewClient(ctx)
+	var settings map[string]*github.RepoSettings
+	var err error
+	if m.useGraphQL {
+		settings, err = m.fetchSettingsGraphQL()
+	} else {
+		settings, err = m.fetchSettingsREST()
+	}
 	if err != nil {
 		return settingsLoadedMsg{
 			settings: make(map[string]*github.RepoSettings),
 			diffs:    make(map[string][]github.SettingsDiff),
-			err:      fmt.Errorf("failed to create GitHub client: %w", err),
+			err:      err,
 		}
 	}
 
+	// Fall back to the majority-aligned repo when no baseline is specified
+	baseline := m.baseline
+	if baseline == "" {
+		baseline, _ = github.SelectBaselineRepo(settings)
+	}
+
+	// Compare settings if a baseline is available
+	diffs := make(map[string][]github.SettingsDiff)
+	if baseline != "" {
+		baselineSettings := settings[baseline]
+		if baselineSettings != nil {
+			for repoStr, repoSettings := range settings {
+				if repoStr != baseline {
+					repoDiffs := github.CompareSettings(baselineSettings, repoSettings)
+					if len(repoDiffs) > 0 {
+						diffs[repoStr] = repoDiffs
+					}
+				}
+			}
+		}
+	}
+
+	return settingsLoadedMsg{
+		settings: settings,
+		baseline: baseline,
+		diffs:    diffs,
+		err:      nil,
+	}
+}
+
+func (m Model) fetchSettingsGraphQL() (map[string]*github.RepoSettings, error) {
+	ctx := context.Background()
+	client, err := github.NewGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetRepoSettingsBatch(m.repos)
+}
+
+func (m Model) fetchSettingsREST() (map[string]*github.RepoSettings, error) {
+	// Create GitHub client
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
 	// Load settings for each repo
 	settings := make(map[string]*github.RepoSettings)
 	for _, repoStr := range m.repos {
@@ -77,32 +174,52 @@ func (m Model) loadSettings() tea.Msg {
 		settings[repoStr] = repoSettings
 	}
 
-	// Compare settings if baseline is specified
-	diffs := make(map[string][]github.SettingsDiff)
-	if m.baseline != "" {
-		baselineSettings := settings[m.baseline]
-		if baselineSettings != nil {
-			for repoStr, repoSettings := range settings {
-				if repoStr != m.baseline {
-					repoDiffs := github.CompareSettings(baselineSettings, repoSettings)
-					if len(repoDiffs) > 0 {
-						diffs[repoStr] = repoDiffs
-					}
-				}
-			}
+	return settings, nil
+}
+
+// applySettingsCmd patches repo so its compared settings match the baseline
+func (m Model) applySettingsCmd(repo string) tea.Cmd {
+	current := m.settings[repo]
+	baseline := m.settings[m.baseline]
+
+	return func() tea.Msg {
+		owner, name, ok := strings.Cut(repo, "/")
+		if !ok {
+			return settingsAppliedMsg{repo: repo, err: fmt.Errorf("expected owner/repo")}
 		}
-	}
 
-	return settingsLoadedMsg{
-		settings: settings,
-		diffs:    diffs,
-		err:      nil,
+		client, err := github.NewClient(context.Background())
+		if err != nil {
+			return settingsAppliedMsg{repo: repo, err: fmt.Errorf("failed to create GitHub client: %w", err)}
+		}
+
+		patch, err := client.ApplyRepoSettings(owner, name, current, baseline, github.SettingsPatchOptions{})
+		return settingsAppliedMsg{repo: repo, patch: patch, err: err}
 	}
 }
 
+// withBaseline returns current with the fields applySettingsCmd patches copied from baseline
+// The default branch is never applied from the TUI, so it is kept
+func withBaseline(current, baseline *github.RepoSettings) *github.RepoSettings {
+	updated := *current
+	updated.DeleteBranchOnMerge = baseline.DeleteBranchOnMerge
+	updated.AllowMergeCommit = baseline.AllowMergeCommit
+	updated.AllowSquashMerge = baseline.AllowSquashMerge
+	updated.AllowRebaseMerge = baseline.AllowRebaseMerge
+	return &updated
+}
+
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -111,25 +228,64 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case settingsLoadedMsg:
 		m.loading = false
 		m.settings = msg.settings
+		if msg.baseline != "" {
+			m.baseline = msg.baseline
+		}
 		m.diffs = msg.diffs
+		m.violations = make(map[string][]string)
+		if len(m.allowed) > 0 {
+			for _, violation := range github.EnforceMergeStrategy(m.settings, m.allowed) {
+				m.violations[violation.Repository] = violation.Disallowed
+			}
+		}
 		m.err = msg.err
 		return m, nil
 
+	case settingsAppliedMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to apply baseline settings to %s: %v", msg.repo, msg.err)
+			return m, nil
+		}
+
+		m.settings[msg.repo] = withBaseline(m.settings[msg.repo], m.settings[m.baseline])
+		delete(m.diffs, msg.repo)
+		if len(m.allowed) > 0 {
+			delete(m.violations, msg.repo)
+			for _, violation := range github.EnforceMergeStrategy(map[string]*github.RepoSettings{msg.repo: m.settings[msg.repo]}, m.allowed) {
+				m.violations[violation.Repository] = violation.Disallowed
+			}
+		}
+		m.statusMsg = fmt.Sprintf("Applied baseline settings to %s (%d field(s))", msg.repo, len(msg.patch))
+		return m, nil
+
 	case tea.KeyMsg:
+		if m.confirmApply {
+			return m.handleConfirmKeys(msg)
+		}
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
+		case "/":
+			m.searchActive = true
+
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
 			}
 
 		case "down", "j":
-			if m.cursor < len(m.repos)-1 {
+			if m.cursor < len(m.filteredRepos())-1 {
 				m.cursor++
 			}
 
+		case "a":
+			return m.startApply()
+
 		case "1":
 			m.viewMode = "overview"
 		case "2":
@@ -140,16 +296,105 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// startApply asks to confirm applying the baseline settings to the repo under the cursor
+func (m Model) startApply() (tea.Model, tea.Cmd) {
+	repos := m.filteredRepos()
+	if m.cursor >= len(repos) {
+		return m, nil
+	}
+	repo := repos[m.cursor]
+
+	switch {
+	case m.baseline == "" || m.settings[m.baseline] == nil:
+		m.statusMsg = "No baseline settings to apply"
+	case repo == m.baseline:
+		m.statusMsg = fmt.Sprintf("%s is the baseline", repo)
+	case m.settings[repo] == nil:
+		m.statusMsg = fmt.Sprintf("No settings loaded for %s", repo)
+	case len(github.SettingsPatch(m.settings[repo], m.settings[m.baseline], github.SettingsPatchOptions{})) == 0:
+		m.statusMsg = fmt.Sprintf("%s already matches the baseline", repo)
+	default:
+		m.confirmApply = true
+		m.applyTarget = repo
+		m.statusMsg = ""
+	}
+
+	return m, nil
+}
+
+func (m Model) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		m.confirmApply = false
+		m.statusMsg = fmt.Sprintf("Applying baseline settings to %s...", m.applyTarget)
+		return m, m.applySettingsCmd(m.applyTarget)
+	case "n", "N", "esc":
+		m.confirmApply = false
+		m.statusMsg = "Apply cancelled"
+	}
+	return m, nil
+}
+
+// renderConfirmDialog lists the fields that will change on the apply target
+func (m Model) renderConfirmDialog() string {
+	var b strings.Builder
+
+	warnStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FF0000"))
+
+	b.WriteString(warnStyle.Render(fmt.Sprintf("⚠️  Apply settings from %s to %s?", m.baseline, m.applyTarget)))
+	b.WriteString("\n\n")
+
+	patch := github.SettingsPatch(m.settings[m.applyTarget], m.settings[m.baseline], github.SettingsPatchOptions{})
+	fields := make([]string, 0, len(patch))
+	for field := range patch {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		b.WriteString(fmt.Sprintf("  - %s: %v\n", field, patch[field]))
+	}
+
+	b.WriteString("\nPress 'y' to confirm, 'n' or 'esc' to cancel\n")
+
+	return b.String()
+}
+
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// filteredRepos returns the repositories matching the search query
+func (m Model) filteredRepos() []string {
+	return search.Filter(m.repos, m.filterQuery, func(repo string) string { return repo })
+}
+
 // View renders the model
 func (m Model) View() string {
 	if m.loading {
-		return "Loading repository settings...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
 		return fmt.Sprintf("Error: %v\n", m.err)
 	}
 
+	if m.confirmApply {
+		return m.renderConfirmDialog()
+	}
+
 	var b strings.Builder
 
 	// Header
@@ -184,6 +429,7 @@ func (m Model) View() string {
 		b.WriteString(inactiveTab.Render("[2] Differences"))
 	}
 	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
 
 	// Content based on view mode
 	switch m.viewMode {
@@ -193,10 +439,20 @@ func (m Model) View() string {
 		b.WriteString(m.renderDiff())
 	}
 
+	if m.statusMsg != "" {
+		b.WriteString("\n")
+		b.WriteString(m.statusMsg)
+		b.WriteString("\n")
+	}
+
 	// Help
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("↑/↓: navigate | 1/2: switch view | q: quit"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | /: search | a: apply baseline to repo | 1/2: switch view | q: quit"))
+	}
 
 	return b.String()
 }
@@ -206,7 +462,7 @@ func (m Model) renderOverview() string {
 
 	b.WriteString("📋 Repository Settings\n\n")
 
-	for i, repo := range m.repos {
+	for i, repo := range m.filteredRepos() {
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -240,6 +496,17 @@ func (m Model) renderOverview() string {
 func (m Model) renderDiff() string {
 	var b strings.Builder
 
+	violationStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+	if len(m.allowed) > 0 {
+		b.WriteString(fmt.Sprintf("Merge policy (%s): ", strings.Join(m.allowed, ", ")))
+		if len(m.violations) == 0 {
+			b.WriteString("all repositories comply\n\n")
+		} else {
+			b.WriteString(violationStyle.Render(fmt.Sprintf("%d repos violate", len(m.violations))))
+			b.WriteString("\n\n")
+		}
+	}
+
 	if len(m.diffs) == 0 {
 		b.WriteString("✅ No differences found - all repositories match baseline\n")
 		return b.String()
@@ -248,6 +515,9 @@ func (m Model) renderDiff() string {
 	b.WriteString("⚠️  Differences from Baseline\n\n")
 
 	for repo, diffs := range m.diffs {
+		if !search.Matches(repo, m.filterQuery) {
+			continue
+		}
 		b.WriteString(fmt.Sprintf("📦 %s:\n", repo))
 		for _, diff := range diffs {
 			severityColor := "#FFFF00" // warning
@@ -257,9 +527,14 @@ func (m Model) renderDiff() string {
 				severityColor = "#00FF00"
 			}
 
+			line := fmt.Sprintf("   [%s] %s: %v → %v", diff.Severity, diff.Field, diff.Baseline, diff.Current)
+			if disallowed := m.violations[repo]; diff.Field == "MergeStrategies" && len(disallowed) > 0 {
+				b.WriteString(violationStyle.Render(fmt.Sprintf("%s (violates policy: %s)\n", line, strings.Join(disallowed, ", "))))
+				continue
+			}
+
 			diffStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(severityColor))
-			b.WriteString(diffStyle.Render(fmt.Sprintf("   [%s] %s: %v → %v\n",
-				diff.Severity, diff.Field, diff.Baseline, diff.Current)))
+			b.WriteString(diffStyle.Render(line + "\n"))
 		}
 		b.WriteString("\n")
 	}
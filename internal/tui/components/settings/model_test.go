@@ -0,0 +1,125 @@
+package settings
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDiffViewFlagsMergeStrategyViolations(t *testing.T) {
+	baseline := &github.RepoSettings{Repository: "acme/template", DefaultBranch: "main", AllowSquashMerge: true}
+	rebase := &github.RepoSettings{Repository: "acme/api", DefaultBranch: "main", AllowSquashMerge: true, AllowRebaseMerge: true}
+	loaded := map[string]*github.RepoSettings{"acme/template": baseline, "acme/api": rebase}
+
+	m := NewModel([]string{"acme/template", "acme/api"}, "acme/template", WithAllowedMergeStrategies([]string{"squash"}))
+	updated, _ := m.Update(settingsLoadedMsg{
+		settings: loaded,
+		baseline: "acme/template",
+		diffs:    map[string][]github.SettingsDiff{"acme/api": github.CompareSettings(baseline, rebase)},
+	})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+
+	view := updated.(Model).View()
+	for _, want := range []string{"Merge policy (squash): 1 repos violate", "(violates policy: rebase)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in view, got:\n%s", want, view)
+		}
+	}
+}
+
+func loadedApplyModel() Model {
+	baseline := &github.RepoSettings{Repository: "acme/template", DefaultBranch: "main", AllowSquashMerge: true, DeleteBranchOnMerge: true}
+	drifted := &github.RepoSettings{Repository: "acme/api", DefaultBranch: "main", AllowSquashMerge: true, AllowMergeCommit: true}
+	synced := &github.RepoSettings{Repository: "acme/web", DefaultBranch: "main", AllowSquashMerge: true, DeleteBranchOnMerge: true}
+
+	m := NewModel([]string{"acme/template", "acme/api", "acme/web"}, "acme/template")
+	updated, _ := m.Update(settingsLoadedMsg{
+		settings: map[string]*github.RepoSettings{"acme/template": baseline, "acme/api": drifted, "acme/web": synced},
+		baseline: "acme/template",
+		diffs:    map[string][]github.SettingsDiff{"acme/api": github.CompareSettings(baseline, drifted)},
+	})
+	return updated.(Model)
+}
+
+func press(m tea.Model, key string) (tea.Model, tea.Cmd) {
+	return m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+}
+
+func TestApplyKeyConfirmsBeforeApplying(t *testing.T) {
+	updated, _ := press(loadedApplyModel(), "j")
+	updated, cmd := press(updated, "a")
+	if cmd != nil {
+		t.Fatal("Expected no command before confirmation")
+	}
+
+	m := updated.(Model)
+	if !m.confirmApply || m.applyTarget != "acme/api" {
+		t.Fatalf("Expected confirmation for acme/api, got confirm=%v target=%q", m.confirmApply, m.applyTarget)
+	}
+	view := m.View()
+	for _, want := range []string{"allow_merge_commit: false", "delete_branch_on_merge: true"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in confirm dialog, got:\n%s", want, view)
+		}
+	}
+
+	cancelled, _ := updated.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cancelled.(Model).confirmApply {
+		t.Error("Expected esc to cancel the apply")
+	}
+
+	_, cmd = press(updated, "y")
+	if cmd == nil {
+		t.Error("Expected 'y' to fire applySettingsCmd")
+	}
+}
+
+func TestApplyKeySkipsReposWithoutChanges(t *testing.T) {
+	tests := []struct {
+		name string
+		down int
+		want string
+	}{
+		{name: "baseline", down: 0, want: "acme/template is the baseline"},
+		{name: "in sync", down: 2, want: "acme/web already matches the baseline"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated tea.Model = loadedApplyModel()
+			for i := 0; i < tt.down; i++ {
+				updated, _ = press(updated, "j")
+			}
+			updated, _ = press(updated, "a")
+
+			m := updated.(Model)
+			if m.confirmApply {
+				t.Error("Expected no confirmation prompt")
+			}
+			if m.statusMsg != tt.want {
+				t.Errorf("Expected status %q, got %q", tt.want, m.statusMsg)
+			}
+		})
+	}
+}
+
+func TestSettingsAppliedMsgClearsDrift(t *testing.T) {
+	m := loadedApplyModel()
+	updated, _ := m.Update(settingsAppliedMsg{
+		repo:  "acme/api",
+		patch: map[string]interface{}{"allow_merge_commit": false, "delete_branch_on_merge": true},
+	})
+
+	got := updated.(Model)
+	if _, ok := got.diffs["acme/api"]; ok {
+		t.Errorf("Expected drift for acme/api to be cleared, got %v", got.diffs["acme/api"])
+	}
+	if got.settings["acme/api"].AllowMergeCommit || !got.settings["acme/api"].DeleteBranchOnMerge {
+		t.Errorf("Expected acme/api settings to match baseline, got %+v", got.settings["acme/api"])
+	}
+	if !strings.Contains(got.View(), "Applied baseline settings to acme/api (2 field(s))") {
+		t.Errorf("Expected applied status in view, got:\n%s", got.View())
+	}
+}
@@ -0,0 +1,81 @@
+package spinner
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Interval is how often the spinner advances a frame
+const Interval = 100 * time.Millisecond
+
+// Frames are the braille animation frames, in order
+var Frames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// lastID gives each spinner a unique ID so ticks only advance the spinner that scheduled them
+var lastID int64
+
+// TickMsg advances the spinner with the matching ID
+type TickMsg struct {
+	ID   int
+	Time time.Time
+}
+
+// LoadingSpinner shows an animated spinner, a task description, and the time spent waiting
+type LoadingSpinner struct {
+	id      int
+	message string
+	frame   int
+	start   time.Time
+	elapsed time.Duration
+}
+
+// New creates a spinner for message (e.g. "Loading workflow runs...") starting now
+func New(message string) LoadingSpinner {
+	return LoadingSpinner{
+		id:      int(atomic.AddInt64(&lastID, 1)),
+		message: message,
+		start:   time.Now(),
+	}
+}
+
+// Restart resets the frame and elapsed time, e.g. when a view reloads
+// Ticks scheduled before the restart are ignored
+func (s LoadingSpinner) Restart() LoadingSpinner {
+	return New(s.message)
+}
+
+// Tick schedules the next frame
+func (s LoadingSpinner) Tick() tea.Cmd {
+	id := s.id
+	return tea.Tick(Interval, func(t time.Time) tea.Msg {
+		return TickMsg{ID: id, Time: t}
+	})
+}
+
+// Update advances the frame on this spinner's ticks and schedules the next one
+func (s LoadingSpinner) Update(msg tea.Msg) (LoadingSpinner, tea.Cmd) {
+	tick, ok := msg.(TickMsg)
+	if !ok || tick.ID != s.id {
+		return s, nil
+	}
+
+	s.frame = (s.frame + 1) % len(Frames)
+	if elapsed := tick.Time.Sub(s.start); elapsed > s.elapsed {
+		s.elapsed = elapsed
+	}
+
+	return s, s.Tick()
+}
+
+// Elapsed returns the time since the spinner started, as of the latest tick
+func (s LoadingSpinner) Elapsed() time.Duration {
+	return s.elapsed
+}
+
+// View renders the spinner, message, and whole seconds elapsed, e.g. "⠙ Loading labels... (12s)"
+func (s LoadingSpinner) View() string {
+	return fmt.Sprintf("%s %s (%ds)", Frames[s.frame], s.message, int(s.elapsed.Seconds()))
+}
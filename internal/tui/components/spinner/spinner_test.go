@@ -0,0 +1,59 @@
+package spinner
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestElapsedIncrementsWithTicks(t *testing.T) {
+	s := New("Loading labels...")
+
+	for _, offset := range []time.Duration{500 * time.Millisecond, 1200 * time.Millisecond, 12 * time.Second} {
+		s, _ = s.Update(TickMsg{ID: s.id, Time: s.start.Add(offset)})
+		if s.Elapsed() != offset {
+			t.Errorf("Expected elapsed %v, got %v", offset, s.Elapsed())
+		}
+	}
+
+	if view := s.View(); !strings.HasSuffix(view, "Loading labels... (12s)") {
+		t.Errorf("Expected message and whole seconds, got %q", view)
+	}
+}
+
+func TestFramesCycle(t *testing.T) {
+	s := New("Loading...")
+	if !strings.HasPrefix(s.View(), Frames[0]) {
+		t.Fatalf("Expected first frame, got %q", s.View())
+	}
+
+	for i := 1; i <= len(Frames); i++ {
+		var cmd tea.Cmd
+		s, cmd = s.Update(TickMsg{ID: s.id, Time: s.start.Add(time.Duration(i) * Interval)})
+		if cmd == nil {
+			t.Fatal("Expected each tick to schedule the next")
+		}
+
+		want := Frames[i%len(Frames)]
+		if !strings.HasPrefix(s.View(), want) {
+			t.Errorf("Tick %d: expected frame %q, got %q", i, want, s.View())
+		}
+	}
+}
+
+func TestIgnoresOtherSpinnersTicks(t *testing.T) {
+	a := New("Loading a...")
+	b := New("Loading b...")
+
+	a, cmd := a.Update(TickMsg{ID: b.id, Time: time.Now().Add(time.Minute)})
+	if cmd != nil || a.frame != 0 || a.Elapsed() != 0 {
+		t.Errorf("Expected another spinner's tick to be ignored, got frame %d elapsed %v", a.frame, a.Elapsed())
+	}
+
+	restarted := a.Restart()
+	if _, cmd := restarted.Update(TickMsg{ID: a.id, Time: time.Now()}); cmd != nil {
+		t.Error("Expected ticks from before a restart to be ignored")
+	}
+}
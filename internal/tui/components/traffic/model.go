@@ -0,0 +1,238 @@
+package traffic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Model represents the repository traffic TUI state
+type Model struct {
+	repos   []string
+	traffic []github.RepoTraffic
+	failed  []string // Repos without traffic access
+	cursor  int
+	width   int
+	height  int
+	loading bool
+	spinner spinner.LoadingSpinner
+	err     error
+
+	filterQuery  string // Case-insensitive substring match on repository names
+	searchActive bool   // Set while the search query is being typed
+}
+
+// NewModel creates a new repository traffic model
+func NewModel(repos []string) Model {
+	return Model{
+		repos:   repos,
+		loading: true,
+		spinner: spinner.New("Loading traffic..."),
+	}
+}
+
+type trafficLoadedMsg struct {
+	traffic []github.RepoTraffic
+	failed  []string
+	err     error
+}
+
+// Init initializes the model
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.loadTraffic, m.spinner.Tick())
+}
+
+func (m Model) loadTraffic() tea.Msg {
+	ctx := context.Background()
+	client, err := github.NewClient(ctx)
+	if err != nil {
+		return trafficLoadedMsg{
+			err: fmt.Errorf("failed to create GitHub client: %w", err),
+		}
+	}
+
+	var traffic []github.RepoTraffic
+	var failed []string
+	for _, repoStr := range m.repos {
+		parts := strings.Split(repoStr, "/")
+		if len(parts) != 2 {
+			continue
+		}
+
+		repoTraffic, err := client.GetRepoTraffic(parts[0], parts[1])
+		if err != nil {
+			// Traffic requires push access
+			failed = append(failed, repoStr)
+			continue
+		}
+		traffic = append(traffic, *repoTraffic)
+	}
+
+	return trafficLoadedMsg{
+		traffic: github.RankReposByUniqueViews(traffic),
+		failed:  failed,
+		err:     nil,
+	}
+}
+
+// Update handles messages
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case trafficLoadedMsg:
+		m.loading = false
+		m.traffic = msg.traffic
+		m.failed = msg.failed
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+
+		case "/":
+			m.searchActive = true
+
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+
+		case "down", "j":
+			if m.cursor < len(m.filteredTraffic())-1 {
+				m.cursor++
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// filteredTraffic returns traffic for repositories matching the search query
+func (m Model) filteredTraffic() []github.RepoTraffic {
+	return search.Filter(m.traffic, m.filterQuery, func(t github.RepoTraffic) string { return t.Repository })
+}
+
+// View renders the model
+func (m Model) View() string {
+	if m.loading {
+		return m.spinner.View() + "\n"
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n", m.err)
+	}
+
+	var b strings.Builder
+
+	// Header
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#00FFFF"))
+
+	b.WriteString(titleStyle.Render("📈 Traffic (last 14 days)"))
+	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
+
+	traffic := m.filteredTraffic()
+	if len(m.traffic) == 0 {
+		b.WriteString("No traffic data found.\n")
+	} else if len(traffic) == 0 {
+		b.WriteString("No repositories match the search.\n")
+	} else {
+		headerStyle := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#777777"))
+
+		b.WriteString(headerStyle.Render(fmt.Sprintf("  %-35s %8s %8s %8s %8s",
+			"Repository", "Views", "Unique", "Clones", "Unique")))
+		b.WriteString("\n")
+
+		for i, t := range traffic {
+			cursor := " "
+			style := lipgloss.NewStyle()
+			if m.cursor == i {
+				cursor = ">"
+				style = style.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+			}
+
+			line := fmt.Sprintf("%s %-35s %8d %8d %8d %8d",
+				cursor, t.Repository, t.ViewsTotal, t.ViewsUnique, t.ClonesTotal, t.ClonesUnique)
+			b.WriteString(style.Render(line))
+			b.WriteString("\n")
+		}
+
+		if m.cursor < len(traffic) {
+			selected := traffic[m.cursor]
+			b.WriteString("\n")
+			b.WriteString(fmt.Sprintf("Top referrers: %s\n", joinOrNone(selected.TopReferrers, 5)))
+			b.WriteString(fmt.Sprintf("Top paths:     %s\n", joinOrNone(selected.TopPaths, 5)))
+		}
+	}
+
+	if len(m.failed) > 0 {
+		warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
+		b.WriteString("\n")
+		b.WriteString(warnStyle.Render(fmt.Sprintf("⚠️  No access to traffic for %d repos (requires push access)", len(m.failed))))
+		b.WriteString("\n")
+	}
+
+	// Help
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | /: search | q: quit"))
+	}
+
+	return b.String()
+}
+
+func joinOrNone(items []string, limit int) string {
+	if len(items) == 0 {
+		return "-"
+	}
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return strings.Join(items, ", ")
+}
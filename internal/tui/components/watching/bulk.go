@@ -0,0 +1,123 @@
+package watching
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// BulkWatchConcurrency bounds how many subscription updates run at once
+const BulkWatchConcurrency = 5
+
+// BulkWatchResult aggregates the outcome of a bulk subscription update
+type BulkWatchResult struct {
+	Succeeded []string
+	Failed    map[string]error
+	Duration  time.Duration
+}
+
+// subscriptionUpdater applies a subscription state to a single repo
+type subscriptionUpdater func(repo github.RepoBasic, subscribed, ignored bool) error
+
+// BulkWatch sets the subscription state for every repo concurrently
+// subscribed=false, ignored=false removes the subscription (unwatch)
+func BulkWatch(repos []github.RepoBasic, subscribed, ignored bool) BulkWatchResult {
+	return bulkWatch(repos, subscribed, ignored, nil)
+}
+
+func bulkWatch(repos []github.RepoBasic, subscribed, ignored bool, progress func(done int)) BulkWatchResult {
+	client, err := github.NewClient(context.Background())
+	if err != nil {
+		result := BulkWatchResult{Failed: make(map[string]error)}
+		for _, repo := range repos {
+			result.Failed[repo.FullName] = fmt.Errorf("failed to create GitHub client: %w", err)
+		}
+		return result
+	}
+
+	update := func(repo github.RepoBasic, subscribed, ignored bool) error {
+		if !subscribed && !ignored {
+			return client.DeleteRepoSubscription(repo.Owner, repo.Name)
+		}
+		_, err := client.SetRepoSubscription(repo.Owner, repo.Name, subscribed, ignored)
+		return err
+	}
+
+	return runBulkWatch(repos, subscribed, ignored, BulkWatchConcurrency, update, progress)
+}
+
+// runBulkWatch runs update for each repo with at most limit in flight, reporting completions to progress
+func runBulkWatch(repos []github.RepoBasic, subscribed, ignored bool, limit int, update subscriptionUpdater, progress func(done int)) BulkWatchResult {
+	start := time.Now()
+	result := BulkWatchResult{Failed: make(map[string]error)}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, limit)
+	done := 0
+
+	for _, repo := range repos {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(repo github.RepoBasic) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := update(repo, subscribed, ignored)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.Failed[repo.FullName] = err
+			} else {
+				result.Succeeded = append(result.Succeeded, repo.FullName)
+			}
+			done++
+			if progress != nil {
+				progress(done)
+			}
+		}(repo)
+	}
+
+	wg.Wait()
+	sort.Strings(result.Succeeded)
+	result.Duration = time.Since(start)
+
+	return result
+}
+
+type bulkProgressMsg struct {
+	done    int
+	updates <-chan tea.Msg
+}
+
+type bulkDoneMsg struct {
+	result     BulkWatchResult
+	subscribed bool
+}
+
+// startBulkWatch runs BulkWatch in the background, streaming progress messages
+func startBulkWatch(repos []github.RepoBasic, subscribed bool) tea.Cmd {
+	updates := make(chan tea.Msg, len(repos)+1)
+
+	go func() {
+		result := bulkWatch(repos, subscribed, false, func(done int) {
+			updates <- bulkProgressMsg{done: done, updates: updates}
+		})
+		updates <- bulkDoneMsg{result: result, subscribed: subscribed}
+		close(updates)
+	}()
+
+	return waitForBulk(updates)
+}
+
+func waitForBulk(updates <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-updates
+	}
+}
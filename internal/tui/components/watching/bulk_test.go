@@ -0,0 +1,126 @@
+package watching
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func testRepos(n int) []github.RepoBasic {
+	repos := make([]github.RepoBasic, n)
+	for i := range repos {
+		name := fmt.Sprintf("repo%02d", i)
+		repos[i] = github.RepoBasic{Owner: "owner", Name: name, FullName: "owner/" + name}
+	}
+	return repos
+}
+
+func TestRunBulkWatchBoundsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	update := func(repo github.RepoBasic, subscribed, ignored bool) error {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return nil
+	}
+
+	var progress []int
+	result := runBulkWatch(testRepos(20), true, false, BulkWatchConcurrency, update, func(done int) {
+		progress = append(progress, done)
+	})
+
+	if maxInFlight > BulkWatchConcurrency {
+		t.Errorf("Expected at most %d concurrent updates, got %d", BulkWatchConcurrency, maxInFlight)
+	}
+	if maxInFlight < 2 {
+		t.Errorf("Expected updates to run concurrently, max in flight was %d", maxInFlight)
+	}
+	if len(result.Succeeded) != 20 {
+		t.Errorf("Expected 20 successes, got %d", len(result.Succeeded))
+	}
+	if len(progress) != 20 || progress[19] != 20 {
+		t.Errorf("Expected a progress update per repo ending at 20, got %v", progress)
+	}
+}
+
+func TestRunBulkWatchAggregatesResults(t *testing.T) {
+	var mu sync.Mutex
+	var gotSubscribed, gotIgnored bool
+	update := func(repo github.RepoBasic, subscribed, ignored bool) error {
+		mu.Lock()
+		gotSubscribed, gotIgnored = gotSubscribed || subscribed, gotIgnored || ignored
+		mu.Unlock()
+		if strings.HasSuffix(repo.Name, "1") {
+			return errors.New("forbidden")
+		}
+		return nil
+	}
+
+	result := runBulkWatch(testRepos(12), false, false, 3, update, nil)
+
+	if gotSubscribed || gotIgnored {
+		t.Errorf("Expected unwatch arguments to be passed through, got %v/%v", gotSubscribed, gotIgnored)
+	}
+	if len(result.Failed) != 2 || result.Failed["owner/repo01"] == nil || result.Failed["owner/repo11"] == nil {
+		t.Errorf("Expected repo01 and repo11 to fail, got %v", result.Failed)
+	}
+	if len(result.Succeeded) != 10 || result.Succeeded[0] != "owner/repo00" {
+		t.Errorf("Expected 10 sorted successes, got %v", result.Succeeded)
+	}
+	if result.Duration <= 0 {
+		t.Error("Expected duration to be recorded")
+	}
+}
+
+func TestBulkMessagesUpdateModel(t *testing.T) {
+	m := NewModel()
+	m.loading = false
+	m.userRepos = testRepos(3)
+	m.bulkRunning = true
+	m.bulkTotal = 3
+
+	updated, cmd := m.Update(bulkProgressMsg{done: 2, updates: make(chan tea.Msg)})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Error("Expected to keep waiting for bulk updates")
+	}
+	if view := m.View(); !strings.Contains(view, "Updating subscriptions... 2/3") {
+		t.Errorf("Expected progress counter, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(bulkDoneMsg{
+		result: BulkWatchResult{
+			Succeeded: []string{"owner/repo00", "owner/repo01"},
+			Failed:    map[string]error{"owner/repo02": errors.New("forbidden")},
+		},
+		subscribed: true,
+	})
+	m = updated.(Model)
+
+	if m.bulkRunning {
+		t.Error("Expected bulk operation to finish")
+	}
+	if m.subscriptions["owner/repo00"].State != github.WatchStateSubscribed {
+		t.Errorf("Expected owner/repo00 to be watched")
+	}
+	if view := m.View(); !strings.Contains(view, "Watched 2 repos (1 failed)") {
+		t.Errorf("Expected summary status, got:\n%s", view)
+	}
+}
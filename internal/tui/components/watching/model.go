@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -13,24 +16,50 @@ import (
 type Model struct {
 	username      string
 	userRepos     []github.RepoBasic
+	org           string
+	orgRepos      []github.Repository
+	orgLoading    bool
 	subscriptions map[string]*github.Subscription
 	cursor        int
 	width         int
 	height        int
 	loading       bool
+	spinner       spinner.LoadingSpinner
 	err           error
 	viewMode      string
 	selected      map[int]bool
 	statusMsg     string
+	filterQuery   string // Case-insensitive substring match on repository names
+	searchActive  bool   // Set while the search query is being typed
+
+	bulkRunning bool
+	bulkDone    int
+	bulkTotal   int
+}
+
+// Option configures the watch status model
+type Option func(*Model)
+
+// WithOrg also loads repositories from org that the user can access but doesn't own
+func WithOrg(org string) Option {
+	return func(m *Model) {
+		m.org = org
+	}
 }
 
-func NewModel() Model {
-	return Model{
+func NewModel(opts ...Option) Model {
+	m := Model{
 		subscriptions: make(map[string]*github.Subscription),
 		selected:      make(map[int]bool),
 		loading:       true,
+		spinner:       spinner.New("Loading watch status..."),
 		viewMode:      "unwatched",
 	}
+	for _, opt := range opts {
+		opt(&m)
+	}
+	m.orgLoading = m.org != ""
+	return m
 }
 
 type dataLoadedMsg struct {
@@ -40,6 +69,13 @@ type dataLoadedMsg struct {
 	err           error
 }
 
+type orgReposLoadedMsg struct {
+	org           string
+	repos         []github.Repository
+	subscriptions map[string]*github.Subscription
+	err           error
+}
+
 type watchResultMsg struct {
 	repo string
 	err  error
@@ -50,8 +86,17 @@ type unwatchResultMsg struct {
 	err  error
 }
 
+type ignoreResultMsg struct {
+	repo    string
+	ignored bool
+	err     error
+}
+
 func (m Model) Init() tea.Cmd {
-	return m.loadData
+	if m.org != "" {
+		return tea.Batch(m.loadData, m.LoadOrgRepos(m.org), m.spinner.Tick())
+	}
+	return tea.Batch(m.loadData, m.spinner.Tick())
 }
 
 func (m Model) loadData() tea.Msg {
@@ -88,6 +133,61 @@ func (m Model) loadData() tea.Msg {
 	}
 }
 
+// LoadOrgRepos lists the repositories in org along with their watch status
+func (m Model) LoadOrgRepos(org string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		client, err := github.NewClient(ctx)
+		if err != nil {
+			return orgReposLoadedMsg{org: org, err: fmt.Errorf("failed to create GitHub client: %w", err)}
+		}
+
+		repos, err := client.ListOrgRepositories(org)
+		if err != nil {
+			return orgReposLoadedMsg{org: org, err: fmt.Errorf("failed to list org repos: %w", err)}
+		}
+
+		subscriptions := make(map[string]*github.Subscription)
+		for _, repo := range repos {
+			sub, err := client.GetRepoSubscription(repo.Owner, repo.Name)
+			if err != nil {
+				continue
+			}
+			subscriptions[repo.FullName] = sub
+		}
+
+		return orgReposLoadedMsg{
+			org:           org,
+			repos:         repos,
+			subscriptions: subscriptions,
+		}
+	}
+}
+
+// OrgOnlyRepos converts orgRepos to RepoBasic, dropping repos owned by username or already in userRepos
+// Pure function: no API calls
+func OrgOnlyRepos(userRepos []github.RepoBasic, orgRepos []github.Repository, username string) []github.RepoBasic {
+	owned := make(map[string]bool, len(userRepos))
+	for _, repo := range userRepos {
+		owned[repo.FullName] = true
+	}
+
+	var repos []github.RepoBasic
+	for _, repo := range orgRepos {
+		if owned[repo.FullName] || (username != "" && repo.Owner == username) {
+			continue
+		}
+		owned[repo.FullName] = true
+		repos = append(repos, github.RepoBasic{
+			Name:     repo.Name,
+			FullName: repo.FullName,
+			Owner:    repo.Owner,
+			Private:  repo.Private,
+		})
+	}
+	return repos
+}
+
 func (m Model) watchRepo(repo github.RepoBasic) tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
@@ -122,8 +222,43 @@ func (m Model) unwatchRepo(repo github.RepoBasic) tea.Cmd {
 	}
 }
 
+func (m Model) toggleIgnoreRepo(repo github.RepoBasic) tea.Cmd {
+	ignored := false
+	if sub := m.subscriptions[repo.FullName]; sub != nil {
+		ignored = sub.State == github.WatchStateIgnored
+	}
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		client, err := github.NewClient(ctx)
+		if err != nil {
+			return ignoreResultMsg{repo: repo.FullName, ignored: ignored, err: err}
+		}
+
+		// Un-ignoring falls back to GitHub's default participating notifications
+		if ignored {
+			err = client.DeleteRepoSubscription(repo.Owner, repo.Name)
+		} else {
+			err = client.SetWatchReason(repo.Owner, repo.Name, string(github.WatchStateIgnored))
+		}
+		if err != nil {
+			return ignoreResultMsg{repo: repo.FullName, ignored: ignored, err: err}
+		}
+
+		return ignoreResultMsg{repo: repo.FullName, ignored: !ignored, err: nil}
+	}
+}
+
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -133,10 +268,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.username = msg.username
 		m.userRepos = msg.userRepos
-		m.subscriptions = msg.subscriptions
+		m.subscriptions = mergeSubscriptions(m.subscriptions, msg.subscriptions)
 		m.err = msg.err
 		return m, nil
 
+	case orgReposLoadedMsg:
+		m.orgLoading = false
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to load %s repos: %v", msg.org, msg.err)
+			return m, nil
+		}
+		m.orgRepos = msg.repos
+		m.subscriptions = mergeSubscriptions(m.subscriptions, msg.subscriptions)
+		return m, nil
+
 	case watchResultMsg:
 		if msg.err != nil {
 			m.statusMsg = fmt.Sprintf("Failed to watch %s: %v", msg.repo, msg.err)
@@ -162,11 +307,76 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case ignoreResultMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to update ignore for %s: %v", msg.repo, msg.err)
+			return m, nil
+		}
+
+		sub, ok := m.subscriptions[msg.repo]
+		if !ok {
+			sub = &github.Subscription{Repository: msg.repo}
+			m.subscriptions[msg.repo] = sub
+		}
+		sub.Subscribed = false
+		sub.Ignored = msg.ignored
+		if msg.ignored {
+			m.statusMsg = fmt.Sprintf("Ignoring %s", msg.repo)
+			sub.State = github.WatchStateIgnored
+		} else {
+			m.statusMsg = fmt.Sprintf("No longer ignoring %s", msg.repo)
+			sub.State = github.WatchStateNotWatching
+		}
+		return m, nil
+
+	case bulkProgressMsg:
+		m.bulkDone = msg.done
+		return m, waitForBulk(msg.updates)
+
+	case bulkDoneMsg:
+		m.bulkRunning = false
+		for _, repo := range msg.result.Succeeded {
+			sub, ok := m.subscriptions[repo]
+			if !ok {
+				sub = &github.Subscription{Repository: repo}
+				m.subscriptions[repo] = sub
+			}
+			sub.Subscribed = msg.subscribed
+			sub.Ignored = false
+			if msg.subscribed {
+				sub.State = github.WatchStateSubscribed
+			} else {
+				sub.State = github.WatchStateNotWatching
+			}
+		}
+
+		action := "Unwatched"
+		if msg.subscribed {
+			action = "Watched"
+		}
+		m.statusMsg = fmt.Sprintf("%s %d repos (%d failed) in %s",
+			action, len(msg.result.Succeeded), len(msg.result.Failed), msg.result.Duration.Round(time.Millisecond))
+		m.cursor = 0
+		return m, nil
+
 	case tea.KeyMsg:
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
+		case "/":
+			m.searchActive = true
+
+		case "ctrl+w":
+			return m.handleBulk(true)
+
+		case "ctrl+u":
+			return m.handleBulk(false)
+
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
@@ -193,6 +403,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.cursor = 0
 			m.selected = make(map[int]bool)
 
+		case "4":
+			m.viewMode = "org"
+			m.cursor = 0
+			m.selected = make(map[int]bool)
+
+		case "5":
+			m.viewMode = "ignored"
+			m.cursor = 0
+			m.selected = make(map[int]bool)
+
 		case " ":
 			m.selected[m.cursor] = !m.selected[m.cursor]
 
@@ -201,15 +421,64 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case "u":
 			return m.handleUnwatch()
+
+		case "i":
+			filtered := m.getFilteredRepos()
+			if m.cursor < len(filtered) {
+				return m, m.toggleIgnoreRepo(filtered[m.cursor])
+			}
 		}
 	}
 
 	return m, nil
 }
 
+// mergeSubscriptions adds loaded into existing, since user and org repos load independently
+func mergeSubscriptions(existing, loaded map[string]*github.Subscription) map[string]*github.Subscription {
+	if existing == nil {
+		existing = make(map[string]*github.Subscription)
+	}
+	for repo, sub := range loaded {
+		existing[repo] = sub
+	}
+	return existing
+}
+
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+// Selections are positions in the filtered list, so they are cleared as the query changes
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	query := m.filterQuery
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	if m.filterQuery != query {
+		m.cursor = 0
+		m.selected = make(map[int]bool)
+	}
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// getFilteredRepos returns the repos in the current view mode that match the search query
 func (m Model) getFilteredRepos() []github.RepoBasic {
+	return search.Filter(m.reposInViewMode(), m.filterQuery, func(repo github.RepoBasic) string {
+		return repo.FullName
+	})
+}
+
+func (m Model) reposInViewMode() []github.RepoBasic {
+	orgRepos := OrgOnlyRepos(m.userRepos, m.orgRepos, m.username)
+	if m.viewMode == "org" {
+		return orgRepos
+	}
+
 	var filtered []github.RepoBasic
-	for _, repo := range m.userRepos {
+	for _, repo := range append(append([]github.RepoBasic{}, m.userRepos...), orgRepos...) {
 		sub := m.subscriptions[repo.FullName]
 		switch m.viewMode {
 		case "unwatched":
@@ -220,6 +489,10 @@ func (m Model) getFilteredRepos() []github.RepoBasic {
 			if sub != nil && sub.State == github.WatchStateSubscribed {
 				filtered = append(filtered, repo)
 			}
+		case "ignored":
+			if sub != nil && sub.State == github.WatchStateIgnored {
+				filtered = append(filtered, repo)
+			}
 		case "all":
 			filtered = append(filtered, repo)
 		}
@@ -267,9 +540,27 @@ func (m Model) handleUnwatch() (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+func (m Model) handleBulk(subscribed bool) (tea.Model, tea.Cmd) {
+	if m.bulkRunning {
+		return m, nil
+	}
+
+	filtered := m.getFilteredRepos()
+	if len(filtered) == 0 {
+		m.statusMsg = "No repositories in this view"
+		return m, nil
+	}
+
+	m.bulkRunning = true
+	m.bulkDone = 0
+	m.bulkTotal = len(filtered)
+	m.selected = make(map[int]bool)
+	return m, startBulkWatch(filtered, subscribed)
+}
+
 func (m Model) View() string {
 	if m.loading {
-		return "Loading watch status...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -310,11 +601,28 @@ func (m Model) View() string {
 	} else {
 		b.WriteString(inactiveTab.Render("[3] All"))
 	}
+	b.WriteString("  ")
+	if m.viewMode == "org" {
+		b.WriteString(activeTab.Render("[4] Org"))
+	} else {
+		b.WriteString(inactiveTab.Render("[4] Org"))
+	}
+	b.WriteString("  ")
+	if m.viewMode == "ignored" {
+		b.WriteString(activeTab.Render("[5] Ignored"))
+	} else {
+		b.WriteString(inactiveTab.Render("[5] Ignored"))
+	}
 	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
 
 	filtered := m.getFilteredRepos()
 
-	if len(filtered) == 0 {
+	if m.viewMode == "org" && m.org == "" {
+		b.WriteString("No organization configured (set default_org).\n")
+	} else if m.viewMode == "org" && m.orgLoading {
+		b.WriteString(fmt.Sprintf("Loading %s repositories...\n", m.org))
+	} else if len(filtered) == 0 {
 		b.WriteString("No repositories in this view.\n")
 	} else {
 		for i, repo := range filtered {
@@ -354,7 +662,12 @@ func (m Model) View() string {
 		}
 	}
 
-	if m.statusMsg != "" {
+	if m.bulkRunning {
+		b.WriteString("\n")
+		progressStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00"))
+		b.WriteString(progressStyle.Render(fmt.Sprintf("Updating subscriptions... %d/%d", m.bulkDone, m.bulkTotal)))
+		b.WriteString("\n")
+	} else if m.statusMsg != "" {
 		b.WriteString("\n")
 		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FFFF"))
 		b.WriteString(statusStyle.Render(m.statusMsg))
@@ -363,7 +676,11 @@ func (m Model) View() string {
 
 	b.WriteString("\n")
 	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("j/k: navigate | space: select | w: watch | u: unwatch | 1/2/3: view mode | esc: back"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else {
+		b.WriteString(helpStyle.Render("j/k: navigate | space: select | /: search | w: watch | u: unwatch | i: toggle ignore | ctrl+w/ctrl+u: watch/unwatch all | 1-5: view mode | esc: back"))
+	}
 
 	return b.String()
 }
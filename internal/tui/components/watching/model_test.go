@@ -0,0 +1,170 @@
+package watching
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func keyMsg(key string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+}
+
+func loadedOrgModel(t *testing.T) Model {
+	t.Helper()
+
+	m := NewModel(WithOrg("acme"))
+	updated, _ := m.Update(dataLoadedMsg{
+		username: "me",
+		userRepos: []github.RepoBasic{
+			{Owner: "me", Name: "tool", FullName: "me/tool"},
+			{Owner: "acme", Name: "shared", FullName: "acme/shared"},
+		},
+		subscriptions: map[string]*github.Subscription{
+			"me/tool":     {Repository: "me/tool", State: github.WatchStateNotWatching},
+			"acme/shared": {Repository: "acme/shared", State: github.WatchStateSubscribed},
+		},
+	})
+	updated, _ = updated.Update(orgReposLoadedMsg{
+		org: "acme",
+		repos: []github.Repository{
+			{Owner: "acme", Name: "api", FullName: "acme/api"},
+			{Owner: "acme", Name: "shared", FullName: "acme/shared"},
+			{Owner: "acme", Name: "web", FullName: "acme/web"},
+		},
+		subscriptions: map[string]*github.Subscription{
+			"acme/api": {Repository: "acme/api", State: github.WatchStateSubscribed},
+			"acme/web": {Repository: "acme/web", State: github.WatchStateNotWatching},
+		},
+	})
+	return updated.(Model)
+}
+
+func repoNames(repos []github.RepoBasic) []string {
+	names := make([]string, len(repos))
+	for i, repo := range repos {
+		names[i] = repo.FullName
+	}
+	return names
+}
+
+func TestOrgTabShowsOnlyReposNotOwned(t *testing.T) {
+	m := loadedOrgModel(t)
+
+	updated, _ := m.Update(keyMsg("4"))
+	m = updated.(Model)
+
+	got := strings.Join(repoNames(m.getFilteredRepos()), ",")
+	if got != "acme/api,acme/web" {
+		t.Errorf("org tab repos = %q, want acme/api,acme/web", got)
+	}
+
+	view := m.View()
+	if !strings.Contains(view, "[4] Org") {
+		t.Errorf("view missing Org tab:\n%s", view)
+	}
+	if strings.Contains(view, "me/tool") || strings.Contains(view, "acme/shared") {
+		t.Errorf("org tab should not list user-owned repos:\n%s", view)
+	}
+}
+
+func TestOrgReposMergeIntoOtherTabsWithoutDuplicates(t *testing.T) {
+	m := loadedOrgModel(t)
+
+	updated, _ := m.Update(keyMsg("3"))
+	m = updated.(Model)
+	got := strings.Join(repoNames(m.getFilteredRepos()), ",")
+	if got != "me/tool,acme/shared,acme/api,acme/web" {
+		t.Errorf("all tab repos = %q", got)
+	}
+
+	updated, _ = m.Update(keyMsg("2"))
+	m = updated.(Model)
+	got = strings.Join(repoNames(m.getFilteredRepos()), ",")
+	if got != "acme/shared,acme/api" {
+		t.Errorf("watched tab repos = %q", got)
+	}
+}
+
+func TestOrgReposLoadedBeforeUserRepos(t *testing.T) {
+	m := NewModel(WithOrg("acme"))
+	updated, _ := m.Update(orgReposLoadedMsg{
+		org:   "acme",
+		repos: []github.Repository{{Owner: "acme", Name: "api", FullName: "acme/api"}},
+		subscriptions: map[string]*github.Subscription{
+			"acme/api": {Repository: "acme/api", State: github.WatchStateSubscribed},
+		},
+	})
+	updated, _ = updated.Update(dataLoadedMsg{
+		username:      "me",
+		userRepos:     []github.RepoBasic{{Owner: "me", Name: "tool", FullName: "me/tool"}},
+		subscriptions: map[string]*github.Subscription{},
+	})
+	m = updated.(Model)
+
+	if sub := m.subscriptions["acme/api"]; sub == nil || sub.State != github.WatchStateSubscribed {
+		t.Errorf("org subscription lost after user repos loaded: %+v", sub)
+	}
+}
+
+func TestOrgTabWithoutOrgConfigured(t *testing.T) {
+	m := NewModel()
+	updated, _ := m.Update(dataLoadedMsg{username: "me"})
+	updated, _ = updated.Update(keyMsg("4"))
+
+	view := updated.(Model).View()
+	if !strings.Contains(view, "No organization configured") {
+		t.Errorf("expected missing org hint:\n%s", view)
+	}
+}
+
+func TestIgnoredTabMovedToFive(t *testing.T) {
+	m := NewModel()
+	updated, _ := m.Update(keyMsg("5"))
+	if mode := updated.(Model).viewMode; mode != "ignored" {
+		t.Errorf("viewMode = %q, want ignored", mode)
+	}
+}
+
+func TestOrgOnlyRepos(t *testing.T) {
+	userRepos := []github.RepoBasic{{Owner: "acme", Name: "shared", FullName: "acme/shared"}}
+	orgRepos := []github.Repository{
+		{Owner: "acme", Name: "shared", FullName: "acme/shared"},
+		{Owner: "me", Name: "fork", FullName: "me/fork"},
+		{Owner: "acme", Name: "api", FullName: "acme/api", Private: true},
+		{Owner: "acme", Name: "api", FullName: "acme/api", Private: true},
+	}
+
+	got := OrgOnlyRepos(userRepos, orgRepos, "me")
+	if len(got) != 1 {
+		t.Fatalf("OrgOnlyRepos returned %d repos, want 1: %+v", len(got), got)
+	}
+	want := github.RepoBasic{Owner: "acme", Name: "api", FullName: "acme/api", Private: true}
+	if got[0] != want {
+		t.Errorf("OrgOnlyRepos()[0] = %+v, want %+v", got[0], want)
+	}
+}
+
+func TestSearchNarrowsReposAndClearsSelection(t *testing.T) {
+	m := loadedOrgModel(t)
+	updated, _ := m.Update(keyMsg("3"))
+	updated, _ = updated.Update(keyMsg(" "))
+	m = updated.(Model)
+
+	for _, key := range []string{"/", "a", "c", "m", "e"} {
+		updated, _ = m.Update(keyMsg(key))
+		m = updated.(Model)
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	got := strings.Join(repoNames(m.getFilteredRepos()), ",")
+	if got != "acme/shared,acme/api,acme/web" {
+		t.Errorf("Expected acme repos only, got %s", got)
+	}
+	if len(m.selected) != 0 {
+		t.Errorf("Expected the selection to be cleared when the query changes, got %v", m.selected)
+	}
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/KyleKing/gh-sweep/internal/config"
 	"github.com/KyleKing/gh-sweep/internal/github"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -13,34 +17,115 @@ import (
 // Model represents the webhook management TUI state
 type Model struct {
 	repos    []string
-	webhooks map[string][]github.Webhook              // repo -> webhooks
+	webhooks map[string][]github.Webhook             // repo -> webhooks
 	health   map[string]map[int]github.WebhookHealth // repo -> webhook ID -> health
+	required []string                                // events every repo should have a webhook for
 	cursor   int
 	width    int
 	height   int
 	loading  bool
+	spinner  spinner.LoadingSpinner
 	err      error
+	viewMode string // "webhooks", "coverage", "recommendations", "live"
+
+	confirmDisable bool
+	statusMsg      string
+	filterQuery    string // Case-insensitive substring match on repository names
+	searchActive   bool   // Set while the search query is being typed
+
+	// Follow mode polls for new deliveries and appends them to a live log
+	follow      bool
+	followSince map[int]time.Time // webhook ID -> newest delivery seen
+	deliveryLog []deliveryLogEntry
+	seen        map[int]bool // delivery IDs already in the log
+	pollErr     error
+}
+
+// followInterval is how often follow mode polls for new deliveries
+const followInterval = 5 * time.Second
+
+// maxLogEntries caps the live log so long sessions don't grow without bound
+const maxLogEntries = 500
+
+// deliveryLogEntry is one line in the live delivery log
+type deliveryLogEntry struct {
+	Repository string
+	WebhookID  int
+	Delivery   github.WebhookDelivery
+}
+
+// Option configures the webhook management model
+type Option func(*Model)
+
+// WithRequiredEvents overrides the events checked in the coverage view
+func WithRequiredEvents(events []string) Option {
+	return func(m *Model) {
+		if len(events) > 0 {
+			m.required = events
+		}
+	}
+}
+
+// WithFollow tails new deliveries in the live log, polling every 5 seconds
+func WithFollow(enabled bool) Option {
+	return func(m *Model) {
+		m.follow = enabled
+		if enabled {
+			m.viewMode = "live"
+		}
+	}
 }
 
 // NewModel creates a new webhook management model
-func NewModel(repos []string) Model {
-	return Model{
-		repos:    repos,
-		webhooks: make(map[string][]github.Webhook),
-		health:   make(map[string]map[int]github.WebhookHealth),
-		loading:  true,
+func NewModel(repos []string, opts ...Option) Model {
+	m := Model{
+		repos:       repos,
+		webhooks:    make(map[string][]github.Webhook),
+		health:      make(map[string]map[int]github.WebhookHealth),
+		required:    config.DefaultRequiredWebhookEvents(),
+		loading:     true,
+		spinner:     spinner.New("Loading webhooks..."),
+		viewMode:    "webhooks",
+		followSince: make(map[int]time.Time),
+		seen:        make(map[int]bool),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
 	}
+
+	return m
 }
 
 type webhooksLoadedMsg struct {
 	webhooks map[string][]github.Webhook
 	health   map[string]map[int]github.WebhookHealth
+	loadedAt time.Time
 	err      error
 }
 
+type followTickMsg time.Time
+
+type webhookDisabledMsg struct {
+	repo      string
+	webhookID int
+	err       error
+}
+
+// recommendationRow is a webhook recommendation with the repository it belongs to
+type recommendationRow struct {
+	Repository string
+	github.WebhookRecommendation
+}
+
+type deliveriesPolledMsg struct {
+	entries []deliveryLogEntry
+	err     error
+}
+
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return m.loadWebhooks
+	return tea.Batch(m.loadWebhooks, m.spinner.Tick())
 }
 
 func (m Model) loadWebhooks() tea.Msg {
@@ -82,7 +167,7 @@ func (m Model) loadWebhooks() tea.Msg {
 				// Skip health metrics on error
 				continue
 			}
-			webhookHealth := github.AnalyzeWebhookHealth(deliveries)
+			webhookHealth := github.AnalyzeWebhookHealth(webhook, deliveries)
 			repoHealth[webhook.ID] = webhookHealth
 		}
 		health[repoStr] = repoHealth
@@ -91,13 +176,120 @@ func (m Model) loadWebhooks() tea.Msg {
 	return webhooksLoadedMsg{
 		webhooks: webhooks,
 		health:   health,
+		loadedAt: time.Now(),
 		err:      nil,
 	}
 }
 
+func followTick() tea.Cmd {
+	return tea.Tick(followInterval, func(t time.Time) tea.Msg {
+		return followTickMsg(t)
+	})
+}
+
+// pollDeliveries fetches deliveries newer than the last one seen for each active webhook
+func (m Model) pollDeliveries() tea.Cmd {
+	since := make(map[int]time.Time, len(m.followSince))
+	for id, t := range m.followSince {
+		since[id] = t
+	}
+	webhooks := m.webhooks
+
+	return func() tea.Msg {
+		client, err := github.NewClient(context.Background())
+		if err != nil {
+			return deliveriesPolledMsg{err: fmt.Errorf("failed to create GitHub client: %w", err)}
+		}
+
+		var entries []deliveryLogEntry
+		var pollErr error
+		for repoStr, repoWebhooks := range webhooks {
+			parts := strings.Split(repoStr, "/")
+			if len(parts) != 2 {
+				continue
+			}
+
+			for _, webhook := range repoWebhooks {
+				if !webhook.Active {
+					continue
+				}
+
+				deliveries, err := client.PollWebhookDeliveries(parts[0], parts[1], webhook.ID, since[webhook.ID])
+				if err != nil {
+					pollErr = err
+					continue
+				}
+
+				for _, d := range deliveries {
+					entries = append(entries, deliveryLogEntry{Repository: repoStr, WebhookID: webhook.ID, Delivery: d})
+				}
+			}
+		}
+
+		return deliveriesPolledMsg{entries: entries, err: pollErr}
+	}
+}
+
+// recommendations lists the recommendation for every active webhook, grouped by repository
+func (m Model) recommendations() []recommendationRow {
+	var rows []recommendationRow
+	for _, repo := range m.filteredRepos() {
+		recs := github.RecommendWebhookActions(m.webhooks[repo], m.health[repo],
+			github.DefaultWebhookInactiveDays, github.DefaultWebhookMinSuccessRate)
+		for _, rec := range recs {
+			rows = append(rows, recommendationRow{Repository: repo, WebhookRecommendation: rec})
+		}
+	}
+	return rows
+}
+
+func disableWebhook(repo string, webhookID int) tea.Cmd {
+	return func() tea.Msg {
+		parts := strings.Split(repo, "/")
+		if len(parts) != 2 {
+			return webhookDisabledMsg{repo: repo, webhookID: webhookID, err: fmt.Errorf("invalid repository %q", repo)}
+		}
+
+		client, err := github.NewClient(context.Background())
+		if err != nil {
+			return webhookDisabledMsg{repo: repo, webhookID: webhookID, err: fmt.Errorf("failed to create GitHub client: %w", err)}
+		}
+
+		err = client.DisableWebhook(parts[0], parts[1], webhookID)
+		return webhookDisabledMsg{repo: repo, webhookID: webhookID, err: err}
+	}
+}
+
+// appendDeliveries adds unseen deliveries to the log and advances each webhook's poll cursor
+func (m *Model) appendDeliveries(entries []deliveryLogEntry) {
+	for _, entry := range entries {
+		if at, err := time.Parse(time.RFC3339, entry.Delivery.Timestamp); err == nil && at.After(m.followSince[entry.WebhookID]) {
+			m.followSince[entry.WebhookID] = at
+		}
+
+		if m.seen[entry.Delivery.ID] {
+			continue
+		}
+		m.seen[entry.Delivery.ID] = true
+		m.deliveryLog = append(m.deliveryLog, entry)
+	}
+
+	if overflow := len(m.deliveryLog) - maxLogEntries; overflow > 0 {
+		m.deliveryLog = m.deliveryLog[overflow:]
+	}
+}
+
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -108,32 +300,131 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.webhooks = msg.webhooks
 		m.health = msg.health
 		m.err = msg.err
+		if !m.follow || m.err != nil {
+			return m, nil
+		}
+
+		// Only tail deliveries made after the initial load
+		for _, repoWebhooks := range m.webhooks {
+			for _, webhook := range repoWebhooks {
+				m.followSince[webhook.ID] = msg.loadedAt
+			}
+		}
+		return m, followTick()
+
+	case followTickMsg:
+		return m, m.pollDeliveries()
+
+	case deliveriesPolledMsg:
+		m.pollErr = msg.err
+		m.appendDeliveries(msg.entries)
+		return m, followTick()
+
+	case webhookDisabledMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to disable webhook %d in %s: %v", msg.webhookID, msg.repo, msg.err)
+			return m, nil
+		}
+
+		for i, webhook := range m.webhooks[msg.repo] {
+			if webhook.ID == msg.webhookID {
+				m.webhooks[msg.repo][i].Active = false
+			}
+		}
+		m.statusMsg = fmt.Sprintf("Disabled webhook %d in %s", msg.webhookID, msg.repo)
+		if rows := m.recommendations(); m.cursor >= len(rows) && m.cursor > 0 {
+			m.cursor = len(rows) - 1
+		}
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.confirmDisable {
+			switch msg.String() {
+			case "y", "Y":
+				m.confirmDisable = false
+				rows := m.recommendations()
+				if m.cursor < len(rows) {
+					return m, disableWebhook(rows[m.cursor].Repository, rows[m.cursor].WebhookID)
+				}
+			case "n", "N", "esc":
+				m.confirmDisable = false
+			}
+			return m, nil
+		}
+		if m.searchActive {
+			return m.handleSearchKeys(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
+		case "/":
+			m.searchActive = true
+
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
 			}
 
 		case "down", "j":
-			if m.cursor < len(m.repos)-1 {
+			limit := len(m.filteredRepos())
+			if m.viewMode == "recommendations" {
+				limit = len(m.recommendations())
+			}
+			if m.cursor < limit-1 {
 				m.cursor++
 			}
+
+		case "d":
+			if m.viewMode == "recommendations" && m.cursor < len(m.recommendations()) {
+				m.confirmDisable = true
+			}
+
+		case "1":
+			m.viewMode = "webhooks"
+			m.cursor = 0
+		case "2":
+			m.viewMode = "coverage"
+			m.cursor = 0
+		case "3":
+			m.viewMode = "recommendations"
+			m.cursor = 0
+		case "4":
+			if m.follow {
+				m.viewMode = "live"
+				m.cursor = 0
+			}
 		}
 	}
 
 	return m, nil
 }
 
+// handleSearchKeys edits the search query until enter keeps it or esc clears it
+func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.filterQuery, m.searchActive = search.HandleKey(m.filterQuery, msg)
+	m.cursor = 0
+	return m, nil
+}
+
+// Searching reports whether the search input is open
+func (m Model) Searching() bool {
+	return m.searchActive
+}
+
+// filteredRepos returns the repositories matching the search query
+func (m Model) filteredRepos() []string {
+	return search.Filter(m.repos, m.filterQuery, func(repo string) string { return repo })
+}
+
 // View renders the model
 func (m Model) View() string {
 	if m.loading {
-		return "Loading webhooks...\n"
+		return m.spinner.View() + "\n"
 	}
 
 	if m.err != nil {
@@ -148,13 +439,85 @@ func (m Model) View() string {
 		Foreground(lipgloss.Color("#00FFFF"))
 
 	b.WriteString(titleStyle.Render("🔔 Webhooks"))
+	if insecure := github.CountInsecureWebhooks(m.webhooks); insecure > 0 {
+		badgeStyle := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FFFFFF")).
+			Background(lipgloss.Color("#FF0000"))
+		b.WriteString("  ")
+		b.WriteString(badgeStyle.Render(fmt.Sprintf(" %d INSECURE SSL ", insecure)))
+	}
+	b.WriteString("\n\n")
+
+	// View mode tabs
+	activeTab := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FFFF00"))
+
+	inactiveTab := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#777777"))
+
+	if m.viewMode == "webhooks" {
+		b.WriteString(activeTab.Render("[1] Webhooks"))
+	} else {
+		b.WriteString(inactiveTab.Render("[1] Webhooks"))
+	}
+	b.WriteString("  ")
+	if m.viewMode == "coverage" {
+		b.WriteString(activeTab.Render("[2] Coverage"))
+	} else {
+		b.WriteString(inactiveTab.Render("[2] Coverage"))
+	}
+	b.WriteString("  ")
+	if m.viewMode == "recommendations" {
+		b.WriteString(activeTab.Render("[3] Recommendations"))
+	} else {
+		b.WriteString(inactiveTab.Render("[3] Recommendations"))
+	}
+	if m.follow {
+		b.WriteString("  ")
+		if m.viewMode == "live" {
+			b.WriteString(activeTab.Render("[4] Live"))
+		} else {
+			b.WriteString(inactiveTab.Render("[4] Live"))
+		}
+	}
 	b.WriteString("\n\n")
+	b.WriteString(search.View(m.filterQuery, m.searchActive))
+
+	switch m.viewMode {
+	case "webhooks":
+		b.WriteString(m.renderWebhooks())
+	case "coverage":
+		b.WriteString(m.renderCoverage())
+	case "recommendations":
+		b.WriteString(m.renderRecommendations())
+	case "live":
+		b.WriteString(m.renderLive())
+	}
+
+	// Help
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+	if m.searchActive {
+		b.WriteString(helpStyle.Render(search.Help))
+	} else if m.follow {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | /: search | d: disable | 1-4: switch view | q: quit"))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓: navigate | /: search | d: disable | 1-3: switch view | q: quit"))
+	}
+
+	return b.String()
+}
+
+func (m Model) renderWebhooks() string {
+	var b strings.Builder
 
 	// Webhook list by repository
 	if len(m.webhooks) == 0 {
 		b.WriteString("No webhooks found.\n")
 	} else {
-		for i, repo := range m.repos {
+		for i, repo := range m.filteredRepos() {
 			cursor := " "
 			if m.cursor == i {
 				cursor = ">"
@@ -176,6 +539,10 @@ func (m Model) View() string {
 				}
 
 				line += fmt.Sprintf("   ID: %d | %s\n", webhook.ID, webhook.URL)
+				if webhook.InsecureSSL {
+					insecureStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+					line += insecureStyle.Render("   ⚠ SSL verification disabled") + "\n"
+				}
 				line += fmt.Sprintf("   Events: %s\n", strings.Join(webhook.Events, ", "))
 
 				// Add health metrics if available
@@ -203,10 +570,135 @@ func (m Model) View() string {
 		}
 	}
 
-	// Help
+	return b.String()
+}
+
+func (m Model) renderCoverage() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("Required events: %s\n\n", strings.Join(m.required, ", ")))
+
+	okStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00"))
+	missingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+
+	for i, repo := range m.filteredRepos() {
+		cursor := " "
+		repoStyle := lipgloss.NewStyle()
+		if m.cursor == i {
+			cursor = ">"
+			repoStyle = repoStyle.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		}
+
+		report := github.DetectWebhookCoverage(m.webhooks[repo], m.required)
+
+		b.WriteString(repoStyle.Render(fmt.Sprintf("%s %s", cursor, repo)))
+		b.WriteString("  ")
+		if len(report.MissingEvents) == 0 {
+			b.WriteString(okStyle.Render("✓ all required events covered"))
+		} else {
+			b.WriteString(missingStyle.Render("✗ missing: " + strings.Join(report.MissingEvents, ", ")))
+		}
+		b.WriteString("\n")
+
+		if len(report.CoveredEvents) > 0 {
+			b.WriteString(fmt.Sprintf("   Covered: %s\n", strings.Join(report.CoveredEvents, ", ")))
+		}
+	}
+
+	return b.String()
+}
+
+func (m Model) renderRecommendations() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("Active webhooks with no deliveries in %d+ days and under %d%% success are flagged for disabling\n\n",
+		github.DefaultWebhookInactiveDays, github.DefaultWebhookMinSuccessRate))
+
+	rows := m.recommendations()
+	if len(rows) == 0 {
+		b.WriteString("No active webhooks found.\n")
+	}
+
+	actionStyles := map[string]lipgloss.Style{
+		github.WebhookActionDisable:     lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000")),
+		github.WebhookActionInvestigate: lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFF00")),
+		github.WebhookActionKeep:        lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")),
+	}
+
+	for i, row := range rows {
+		cursor := " "
+		lineStyle := lipgloss.NewStyle()
+		if m.cursor == i {
+			cursor = ">"
+			lineStyle = lineStyle.Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		}
+
+		b.WriteString(lineStyle.Render(fmt.Sprintf("%s %-30s %-8d", cursor, row.Repository, row.WebhookID)))
+		b.WriteString(" ")
+		b.WriteString(actionStyles[row.Action].Render(fmt.Sprintf("%-11s", row.Action)))
+		b.WriteString(fmt.Sprintf(" %s\n", row.Reason))
+		b.WriteString(fmt.Sprintf("   %s\n", row.URL))
+	}
+
+	if m.confirmDisable && m.cursor < len(rows) {
+		row := rows[m.cursor]
+		warnStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FFFF00"))
+		b.WriteString("\n")
+		b.WriteString(warnStyle.Render(fmt.Sprintf("Disable webhook %d (%s) in %s?", row.WebhookID, row.URL, row.Repository)))
+		b.WriteString("\n")
+		b.WriteString("Press 'y' to confirm, 'n' or 'esc' to cancel\n")
+	} else if m.statusMsg != "" {
+		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FFFF"))
+		b.WriteString("\n")
+		b.WriteString(statusStyle.Render(m.statusMsg))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (m Model) renderLive() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("Following deliveries (polling every %s)\n", followInterval))
+	if m.pollErr != nil {
+		errStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+		b.WriteString(errStyle.Render(fmt.Sprintf("Last poll failed: %v", m.pollErr)))
+		b.WriteString("\n")
+	}
 	b.WriteString("\n")
-	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
-	b.WriteString(helpStyle.Render("↑/↓: navigate | q: quit"))
+
+	if len(m.deliveryLog) == 0 {
+		b.WriteString("Waiting for deliveries...\n")
+		return b.String()
+	}
+
+	// Show the newest entries that fit, leaving room for the header and help
+	visible := len(m.deliveryLog)
+	if m.height > 10 && visible > m.height-10 {
+		visible = m.height - 10
+	}
+
+	okStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00"))
+	failStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+
+	for _, entry := range m.deliveryLog[len(m.deliveryLog)-visible:] {
+		d := entry.Delivery
+
+		timestamp := d.Timestamp
+		if at, err := time.Parse(time.RFC3339, d.Timestamp); err == nil {
+			timestamp = at.Local().Format("15:04:05")
+		}
+
+		statusStyle := okStyle
+		if d.Status < 200 || d.Status >= 300 {
+			statusStyle = failStyle
+		}
+
+		b.WriteString(fmt.Sprintf("%-10s %-30s %-20s ", timestamp, entry.Repository, d.Event))
+		b.WriteString(statusStyle.Render(fmt.Sprintf("%3d", d.Status)))
+		b.WriteString(fmt.Sprintf(" %6dms\n", d.Duration))
+	}
 
 	return b.String()
 }
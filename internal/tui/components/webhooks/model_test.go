@@ -0,0 +1,148 @@
+package webhooks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newFollowingModel(t *testing.T) Model {
+	t.Helper()
+
+	var m tea.Model = NewModel([]string{"owner/repo"}, WithFollow(true))
+	m, cmd := m.Update(webhooksLoadedMsg{
+		webhooks: map[string][]github.Webhook{
+			"owner/repo": {{ID: 7, Repository: "owner/repo", Active: true}},
+		},
+		health:   map[string]map[int]github.WebhookHealth{},
+		loadedAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+	})
+
+	if cmd == nil {
+		t.Fatal("Expected follow mode to schedule a poll after loading")
+	}
+
+	return m.(Model)
+}
+
+func TestFollowAppendsNewDeliveries(t *testing.T) {
+	m := newFollowingModel(t)
+
+	first := deliveryLogEntry{
+		Repository: "owner/repo",
+		WebhookID:  7,
+		Delivery:   github.WebhookDelivery{ID: 1, Event: "push", Status: 200, Timestamp: "2024-01-01T10:00:00Z"},
+	}
+	second := deliveryLogEntry{
+		Repository: "owner/repo",
+		WebhookID:  7,
+		Delivery:   github.WebhookDelivery{ID: 2, Event: "pull_request", Status: 500, Timestamp: "2024-01-01T10:00:05Z"},
+	}
+
+	var updated tea.Model = m
+	updated, cmd := updated.Update(deliveriesPolledMsg{entries: []deliveryLogEntry{first}})
+	if cmd == nil {
+		t.Error("Expected another poll to be scheduled")
+	}
+
+	// The second poll overlaps the first, e.g. when deliveries share a timestamp
+	updated, _ = updated.Update(deliveriesPolledMsg{entries: []deliveryLogEntry{first, second}})
+
+	log := updated.(Model).deliveryLog
+	if len(log) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d", len(log))
+	}
+	if log[0].Delivery.ID != 1 || log[1].Delivery.ID != 2 {
+		t.Errorf("Expected deliveries [1 2] in order, got [%d %d]", log[0].Delivery.ID, log[1].Delivery.ID)
+	}
+
+	expectedSince := time.Date(2024, 1, 1, 10, 0, 5, 0, time.UTC)
+	if since := updated.(Model).followSince[7]; !since.Equal(expectedSince) {
+		t.Errorf("Expected poll cursor %v, got %v", expectedSince, since)
+	}
+
+	view := updated.View()
+	if !strings.Contains(view, "pull_request") || !strings.Contains(view, "500") {
+		t.Errorf("Expected live log to show the new delivery, got:\n%s", view)
+	}
+}
+
+func TestFollowDisabledDoesNotPoll(t *testing.T) {
+	var m tea.Model = NewModel([]string{"owner/repo"})
+	_, cmd := m.Update(webhooksLoadedMsg{
+		webhooks: map[string][]github.Webhook{},
+		health:   map[string]map[int]github.WebhookHealth{},
+	})
+
+	if cmd != nil {
+		t.Error("Expected no poll without follow mode")
+	}
+}
+
+func TestViewFlagsInsecureWebhooks(t *testing.T) {
+	var m tea.Model = NewModel([]string{"owner/repo"})
+	m, _ = m.Update(webhooksLoadedMsg{
+		webhooks: map[string][]github.Webhook{
+			"owner/repo": {
+				{ID: 1, Repository: "owner/repo", URL: "https://ci.example.com/hook", Active: true},
+				{ID: 2, Repository: "owner/repo", URL: "https://self-signed.example.com/hook", Active: true, InsecureSSL: true},
+			},
+		},
+		health: map[string]map[int]github.WebhookHealth{},
+	})
+
+	view := m.View()
+	if !strings.Contains(view, "1 INSECURE SSL") {
+		t.Errorf("Expected insecure badge, got:\n%s", view)
+	}
+	if strings.Count(view, "SSL verification disabled") != 1 {
+		t.Errorf("Expected only the insecure webhook marked, got:\n%s", view)
+	}
+}
+
+func TestRecommendationsTabFlagsDeadWebhook(t *testing.T) {
+	stale := time.Now().AddDate(0, 0, -60)
+	var m tea.Model = NewModel([]string{"owner/repo"})
+	m, _ = m.Update(webhooksLoadedMsg{
+		webhooks: map[string][]github.Webhook{
+			"owner/repo": {
+				{ID: 1, Repository: "owner/repo", URL: "https://dead.example.com/hook", Active: true},
+				{ID: 2, Repository: "owner/repo", URL: "https://ci.example.com/hook", Active: true},
+			},
+		},
+		health: map[string]map[int]github.WebhookHealth{
+			"owner/repo": {
+				1: {WebhookID: 1, SuccessRate: 20, TotalDeliveries: 5, Failures: 4, LastDelivery: stale},
+				2: {WebhookID: 2, SuccessRate: 100, TotalDeliveries: 9, LastDelivery: time.Now()},
+			},
+		},
+	})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+
+	view := m.View()
+	if !strings.Contains(view, "[3] Recommendations") {
+		t.Errorf("Expected recommendations tab, got:\n%s", view)
+	}
+	if !strings.Contains(view, "disable") || !strings.Contains(view, "20% success rate") {
+		t.Errorf("Expected dead webhook flagged for disabling, got:\n%s", view)
+	}
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if cmd != nil {
+		t.Error("Expected confirmation before disabling")
+	}
+	if view := m.View(); !strings.Contains(view, "Disable webhook 1") {
+		t.Errorf("Expected confirmation prompt, got:\n%s", view)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	m, _ = m.Update(webhookDisabledMsg{repo: "owner/repo", webhookID: 1})
+
+	rows := m.(Model).recommendations()
+	if len(rows) != 1 || rows[0].WebhookID != 2 {
+		t.Errorf("Expected disabled webhook dropped from recommendations, got %+v", rows)
+	}
+}
@@ -1,17 +1,34 @@
 package tui
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/cache"
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/git"
+	"github.com/KyleKing/gh-sweep/internal/github"
 	"github.com/KyleKing/gh-sweep/internal/orphans"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/analytics"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/branches"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/breadcrumb"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/collaborators"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/comments"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/ghaperf"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/labels"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/layout"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/milestones"
 	orphanstui "github.com/KyleKing/gh-sweep/internal/tui/components/orphans"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/popularity"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/protection"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/releases"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/search"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/secrets"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/security"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/settings"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/traffic"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/watching"
 	"github.com/KyleKing/gh-sweep/internal/tui/components/webhooks"
 	tea "github.com/charmbracelet/bubbletea"
@@ -35,8 +52,47 @@ const (
 	ViewSecrets
 	ViewReleases
 	ViewOrphans
+	ViewSecurity
+	ViewMilestones
+	ViewTraffic
+	ViewLabels
+	ViewPopularity
+	ViewGroups
+	// ViewDetail is the split-pane detail level on the navigation stack, not a standalone view
+	ViewDetail
 )
 
+var viewModeNames = map[ViewMode]string{
+	ViewHome:          "Home",
+	ViewBranches:      "Branches",
+	ViewProtection:    "Protection",
+	ViewComments:      "Comments",
+	ViewAnalytics:     "Analytics",
+	ViewGHAPerf:       "GHA Performance",
+	ViewSettings:      "Settings",
+	ViewWatching:      "Watching",
+	ViewWebhooks:      "Webhooks",
+	ViewCollaborators: "Collaborators",
+	ViewSecrets:       "Secrets",
+	ViewReleases:      "Releases",
+	ViewOrphans:       "Orphans",
+	ViewSecurity:      "Security",
+	ViewMilestones:    "Milestones",
+	ViewTraffic:       "Traffic",
+	ViewLabels:        "Labels",
+	ViewPopularity:    "Popularity",
+	ViewGroups:        "Groups",
+	ViewDetail:        "Detail",
+}
+
+// String returns the view's breadcrumb label
+func (v ViewMode) String() string {
+	if name, ok := viewModeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("View(%d)", int(v))
+}
+
 // MainModel represents the main TUI application state with navigation
 type MainModel struct {
 	width  int
@@ -44,17 +100,29 @@ type MainModel struct {
 	ready  bool
 	mode   ViewMode
 
+	// Levels from Home to the active view; esc pops one level
+	NavigationStack []ViewMode
+
+	// Split-pane detail view, toggled with ctrl+d
+	splitPane   bool
+	splitLayout layout.SplitPaneLayout
+
 	// Sub-models for each view
 	analyticsModel     analytics.Model
 	branchesModel      branches.Model
 	collaboratorsModel collaborators.Model
 	commentsModel      comments.Model
 	ghaPerfModel       ghaperf.Model
+	labelsModel        labels.Model
+	milestonesModel    milestones.Model
 	orphansModel       orphanstui.Model
+	popularityModel    popularity.Model
 	protectionModel    protection.Model
 	releasesModel      releases.Model
 	secretsModel       secrets.Model
+	securityModel      security.Model
 	settingsModel      settings.Model
+	trafficModel       traffic.Model
 	watchingModel      watching.Model
 	webhooksModel      webhooks.Model
 
@@ -63,15 +131,100 @@ type MainModel struct {
 	repos    []string
 	baseline string
 	org      string
+
+	releasePolicy config.ReleasePolicyConfig
+	orphansTTL    time.Duration
+
+	regressionThreshold float64
+	ghaPerfCacheMaxSize int64
+	ghaPerfCacheTTL     time.Duration
+	ghaPerfRemote       cache.RemoteCacheBackend
+
+	allowedMergeStrategies []string
+	requiredWebhookEvents  []string
+
+	// Repository groups from config; selecting one scopes repos to that group
+	groups      map[string][]string
+	groupNames  []string
+	groupCursor int
+	activeGroup string
+
+	// Long-running view loads; ctrl+z moves the active view's load to the background
+	tasks             map[ViewMode]*BackgroundTask
+	nextTaskID        int
+	backgroundResults BackgroundResults
+}
+
+// localCheckout returns path when its origin remote is repo, or "" so local branch details are
+// never read from an unrelated clone
+func localCheckout(path, repo string) string {
+	detected, err := git.DetectRepo(path)
+	if err != nil || !strings.EqualFold(detected, repo) {
+		return ""
+	}
+	return path
+}
+
+// MainOption configures the main TUI model
+type MainOption func(*MainModel)
+
+// WithConfig seeds the repo list, org, and groups from configuration
+func WithConfig(cfg *config.Config) MainOption {
+	return func(m *MainModel) {
+		m.repos = cfg.Repositories
+		m.org = cfg.DefaultOrg
+		m.releasePolicy = cfg.Releases
+		m.orphansTTL = cfg.Cache.OrphansTTLDuration()
+		m.regressionThreshold = cfg.GHAPerf.RegressionThreshold
+		m.ghaPerfCacheMaxSize = cfg.Cache.MaxSizeBytes
+		m.ghaPerfCacheTTL = cfg.GHAPerf.CacheTTLDuration()
+		// An unusable remote falls back to the local cache, like the gha-perf command
+		if remote, err := cache.NewRemoteCacheBackend(cfg.Cache.Backend, cfg.Cache.RemoteURL); err == nil {
+			m.ghaPerfRemote = remote
+		}
+		m.allowedMergeStrategies = cfg.Settings.AllowedMergeStrategies
+		m.requiredWebhookEvents = cfg.Webhooks.RequiredWebhookEvents
+		m.groups = cfg.Groups
+		m.groupNames = cfg.GroupNames()
+	}
+}
+
+// WithGroup starts the TUI scoped to a group, skipping the group selector
+func WithGroup(name string, repos []string) MainOption {
+	return func(m *MainModel) {
+		m.activeGroup = name
+		m.repos = repos
+	}
 }
 
 // NewMainModel creates a new main TUI model
-func NewMainModel(repo string) MainModel {
-	return MainModel{
-		ready: false,
-		mode:  ViewHome,
-		repo:  repo,
+func NewMainModel(repo string, opts ...MainOption) MainModel {
+	m := MainModel{
+		ready:       false,
+		mode:        ViewHome,
+		repo:        repo,
+		splitLayout: layout.NewSplitPaneLayout(layout.DefaultListPercent),
+
+		NavigationStack: []ViewMode{ViewHome},
+
+		releasePolicy: config.DefaultReleasePolicy(),
+
+		regressionThreshold: ghaperf.DefaultRegressionThreshold,
+		ghaPerfCacheTTL:     config.DefaultConfig().GHAPerf.CacheTTLDuration(),
+
+		tasks:             make(map[ViewMode]*BackgroundTask),
+		backgroundResults: make(BackgroundResults, backgroundResultsBuffer),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
 	}
+
+	if len(m.groupNames) > 0 && m.activeGroup == "" {
+		m.mode = ViewGroups
+	}
+
+	return m
 }
 
 // Init initializes the model
@@ -86,6 +239,7 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 		m.ready = true
+		m.splitLayout.SetSize(msg.Width, msg.Height)
 
 		// Forward to sub-models
 		var newModel tea.Model
@@ -113,173 +267,526 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.watchingModel = newModel.(watching.Model)
 		newModel, _ = m.orphansModel.Update(msg)
 		m.orphansModel = newModel.(orphanstui.Model)
+		newModel, _ = m.securityModel.Update(msg)
+		m.securityModel = newModel.(security.Model)
+		newModel, _ = m.milestonesModel.Update(msg)
+		m.milestonesModel = newModel.(milestones.Model)
+		newModel, _ = m.trafficModel.Update(msg)
+		m.trafficModel = newModel.(traffic.Model)
+		newModel, _ = m.labelsModel.Update(msg)
+		m.labelsModel = newModel.(labels.Model)
+		newModel, _ = m.popularityModel.Update(msg)
+		m.popularityModel = newModel.(popularity.Model)
 
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.mode == ViewGroups {
+			return m.updateGroups(msg)
+		}
+
 		// Handle navigation in home view
 		if m.mode == ViewHome {
+			// Reattach to a view whose load is still running in the background
+			if view, ok := homeKeyViews[msg.String()]; ok && m.runningInBackground(view) {
+				return m.navigateTo(view), nil
+			}
+
 			switch msg.String() {
 			case "ctrl+c", "q":
 				return m, tea.Quit
 
+			case "g":
+				if len(m.groupNames) > 0 {
+					m.mode = ViewGroups
+				}
+				return m, nil
+
 			case "0":
-				m.mode = ViewWatching
-				m.watchingModel = watching.NewModel()
-				return m, m.watchingModel.Init()
+				m = m.navigateTo(ViewWatching)
+				m.watchingModel = watching.NewModel(watching.WithOrg(m.org))
+				return m.startTask(ViewWatching, m.watchingModel.Init())
 
 			case "1":
-				m.mode = ViewBranches
+				m = m.navigateTo(ViewBranches)
 				if m.repo != "" {
-					m.branchesModel = branches.NewModel(m.repo, "main")
-					return m, m.branchesModel.Init()
+					m.branchesModel = branches.NewModel(m.repo, "main", branches.WithLocalPath(localCheckout(".", m.repo)))
+					return m.startTask(ViewBranches, m.branchesModel.Init())
 				}
 
 			case "2":
-				m.mode = ViewProtection
+				m = m.navigateTo(ViewProtection)
 				if len(m.repos) > 0 {
-					m.protectionModel = protection.NewModel(m.repos, m.baseline)
-					return m, m.protectionModel.Init()
+					var opts []protection.Option
+					if m.org != "" {
+						opts = append(opts, protection.WithOrgPolicyAudit(m.org))
+					}
+					m.protectionModel = protection.NewModel(m.repos, m.baseline, opts...)
+					return m.startTask(ViewProtection, m.protectionModel.Init())
 				}
 
 			case "3":
-				m.mode = ViewComments
+				m = m.navigateTo(ViewComments)
 				if m.repo != "" {
 					m.commentsModel = comments.NewModel(m.repo)
-					return m, m.commentsModel.Init()
+					return m.startTask(ViewComments, m.commentsModel.Init())
 				}
 
 			case "4":
-				m.mode = ViewAnalytics
+				m = m.navigateTo(ViewAnalytics)
 				if m.repo != "" {
 					m.analyticsModel = analytics.NewModel(m.repo)
-					return m, m.analyticsModel.Init()
+					return m.startTask(ViewAnalytics, m.analyticsModel.Init())
 				}
 
 			case "p":
-				m.mode = ViewGHAPerf
+				m = m.navigateTo(ViewGHAPerf)
 				if m.repo != "" {
-					m.ghaPerfModel = ghaperf.NewModel(m.repo)
-					return m, m.ghaPerfModel.Init()
+					m.ghaPerfModel = ghaperf.NewModel(m.repo, ghaperf.WithRegressionThreshold(m.regressionThreshold), ghaperf.WithCacheMaxSize(m.ghaPerfCacheMaxSize), ghaperf.WithCacheTTL(m.ghaPerfCacheTTL), ghaperf.WithRemoteCache(m.ghaPerfRemote))
+					return m.startTask(ViewGHAPerf, m.ghaPerfModel.Init())
 				}
 
 			case "5":
-				m.mode = ViewSettings
+				m = m.navigateTo(ViewSettings)
 				if len(m.repos) > 0 {
-					m.settingsModel = settings.NewModel(m.repos, m.baseline)
-					return m, m.settingsModel.Init()
+					m.settingsModel = settings.NewModel(m.repos, m.baseline, settings.WithAllowedMergeStrategies(m.allowedMergeStrategies))
+					return m.startTask(ViewSettings, m.settingsModel.Init())
 				}
 
 			case "6":
-				m.mode = ViewWebhooks
+				m = m.navigateTo(ViewWebhooks)
 				if len(m.repos) > 0 {
-					m.webhooksModel = webhooks.NewModel(m.repos)
-					return m, m.webhooksModel.Init()
+					m.webhooksModel = webhooks.NewModel(m.repos, webhooks.WithRequiredEvents(m.requiredWebhookEvents))
+					return m.startTask(ViewWebhooks, m.webhooksModel.Init())
 				}
 
 			case "7":
-				m.mode = ViewCollaborators
+				m = m.navigateTo(ViewCollaborators)
 				if len(m.repos) > 0 {
 					m.collaboratorsModel = collaborators.NewModel(m.repos)
-					return m, m.collaboratorsModel.Init()
+					return m.startTask(ViewCollaborators, m.collaboratorsModel.Init())
 				}
 
 			case "8":
-				m.mode = ViewSecrets
+				m = m.navigateTo(ViewSecrets)
 				if m.org != "" && len(m.repos) > 0 {
 					m.secretsModel = secrets.NewModel(m.org, m.repos)
-					return m, m.secretsModel.Init()
+					return m.startTask(ViewSecrets, m.secretsModel.Init())
+				}
+
+			case "s":
+				m = m.navigateTo(ViewSecurity)
+				if len(m.repos) > 0 {
+					m.securityModel = security.NewModel(m.repos, github.DefaultMinSecurityPolicyLength)
+					return m.startTask(ViewSecurity, m.securityModel.Init())
 				}
 
 			case "9":
-				m.mode = ViewReleases
+				m = m.navigateTo(ViewReleases)
+				if len(m.repos) > 0 {
+					m.releasesModel = releases.NewModel(m.repos, releases.WithReleasePolicy(m.releasePolicy))
+					return m.startTask(ViewReleases, m.releasesModel.Init())
+				}
+
+			case "m":
+				m = m.navigateTo(ViewMilestones)
+				if len(m.repos) > 0 {
+					m.milestonesModel = milestones.NewModel(m.repos)
+					return m.startTask(ViewMilestones, m.milestonesModel.Init())
+				}
+
+			case "t":
+				m = m.navigateTo(ViewTraffic)
+				if len(m.repos) > 0 {
+					m.trafficModel = traffic.NewModel(m.repos)
+					return m.startTask(ViewTraffic, m.trafficModel.Init())
+				}
+
+			case "l":
+				m = m.navigateTo(ViewLabels)
 				if len(m.repos) > 0 {
-					m.releasesModel = releases.NewModel(m.repos)
-					return m, m.releasesModel.Init()
+					m.labelsModel = labels.NewModel(m.repos)
+					return m.startTask(ViewLabels, m.labelsModel.Init())
+				}
+
+			case "*":
+				m = m.navigateTo(ViewPopularity)
+				if len(m.repos) > 0 {
+					m.popularityModel = popularity.NewModel(m.repos)
+					return m.startTask(ViewPopularity, m.popularityModel.Init())
 				}
 
 			case "o":
-				m.mode = ViewOrphans
+				m = m.navigateTo(ViewOrphans)
 				namespace := m.org
 				if namespace == "" {
 					namespace = ""
 				}
-				m.orphansModel = orphanstui.NewModel(namespace, orphans.DefaultScanOptions())
-				return m, m.orphansModel.Init()
+				m.orphansModel = orphanstui.NewModel(namespace, orphans.DefaultScanOptions(), orphanstui.WithCacheTTL(m.orphansTTL))
+				return m.startTask(ViewOrphans, m.orphansModel.Init())
 			}
 		} else {
+			// Keys, including esc, belong to the view while its search input is open
+			if m.activeSearching() {
+				return m.updateView(m.mode, msg)
+			}
+
 			// Handle back navigation
 			if msg.String() == "esc" {
-				m.mode = ViewHome
+				return m.navigateBack(), nil
+			}
+
+			if msg.String() == "ctrl+d" {
+				m.splitPane = !m.splitPane
+				if m.splitPane && m.activeDetailRenderer() != nil {
+					m = m.pushView(ViewDetail)
+				} else if !m.splitPane && m.topView() == ViewDetail {
+					m.NavigationStack = m.NavigationStack[:len(m.NavigationStack)-1]
+				}
 				return m, nil
 			}
 
-			// Forward to active sub-model
-			var cmd tea.Cmd
-			switch m.mode {
-			case ViewBranches:
-				var newModel tea.Model
-				newModel, cmd = m.branchesModel.Update(msg)
-				m.branchesModel = newModel.(branches.Model)
-
-			case ViewProtection:
-				var newModel tea.Model
-				newModel, cmd = m.protectionModel.Update(msg)
-				m.protectionModel = newModel.(protection.Model)
-
-			case ViewComments:
-				var newModel tea.Model
-				newModel, cmd = m.commentsModel.Update(msg)
-				m.commentsModel = newModel.(comments.Model)
-
-			case ViewAnalytics:
-				var newModel tea.Model
-				newModel, cmd = m.analyticsModel.Update(msg)
-				m.analyticsModel = newModel.(analytics.Model)
-
-			case ViewGHAPerf:
-				var newModel tea.Model
-				newModel, cmd = m.ghaPerfModel.Update(msg)
-				m.ghaPerfModel = newModel.(ghaperf.Model)
-
-			case ViewSettings:
-				var newModel tea.Model
-				newModel, cmd = m.settingsModel.Update(msg)
-				m.settingsModel = newModel.(settings.Model)
-
-			case ViewWebhooks:
-				var newModel tea.Model
-				newModel, cmd = m.webhooksModel.Update(msg)
-				m.webhooksModel = newModel.(webhooks.Model)
-
-			case ViewCollaborators:
-				var newModel tea.Model
-				newModel, cmd = m.collaboratorsModel.Update(msg)
-				m.collaboratorsModel = newModel.(collaborators.Model)
-
-			case ViewSecrets:
-				var newModel tea.Model
-				newModel, cmd = m.secretsModel.Update(msg)
-				m.secretsModel = newModel.(secrets.Model)
-
-			case ViewReleases:
-				var newModel tea.Model
-				newModel, cmd = m.releasesModel.Update(msg)
-				m.releasesModel = newModel.(releases.Model)
-
-			case ViewWatching:
-				var newModel tea.Model
-				newModel, cmd = m.watchingModel.Update(msg)
-				m.watchingModel = newModel.(watching.Model)
-
-			case ViewOrphans:
-				var newModel tea.Model
-				newModel, cmd = m.orphansModel.Update(msg)
-				m.orphansModel = newModel.(orphanstui.Model)
+			if msg.String() == "ctrl+z" {
+				return m.detachActive()
 			}
 
+			// Forward to active sub-model
+			return m.updateView(m.mode, msg)
+		}
+
+	case taskResultMsg:
+		return m.finishTask(msg)
+
+	case taskTickMsg:
+		return m.forwardTaskTick(msg)
+
+	case spinner.TickMsg:
+		return m.routeSpinnerTick(msg)
+
+	case backgroundPollMsg:
+		return m.collectBackground()
+
+	default:
+		// Follow-up messages from the active view (e.g. pagination, polling)
+		return m.updateView(m.mode, msg)
+	}
+
+	return m, nil
+}
+
+// homeKeyViews maps home menu keys to the view they open
+var homeKeyViews = map[string]ViewMode{
+	"0": ViewWatching,
+	"1": ViewBranches,
+	"2": ViewProtection,
+	"3": ViewComments,
+	"4": ViewAnalytics,
+	"p": ViewGHAPerf,
+	"5": ViewSettings,
+	"6": ViewWebhooks,
+	"7": ViewCollaborators,
+	"8": ViewSecrets,
+	"s": ViewSecurity,
+	"9": ViewReleases,
+	"m": ViewMilestones,
+	"t": ViewTraffic,
+	"l": ViewLabels,
+	"*": ViewPopularity,
+	"o": ViewOrphans,
+}
+
+// navigateTo opens a view from the home menu
+func (m MainModel) navigateTo(view ViewMode) MainModel {
+	m.mode = view
+	m.NavigationStack = []ViewMode{ViewHome, view}
+	return m
+}
+
+// pushView adds a level on top of the navigation stack
+func (m MainModel) pushView(view ViewMode) MainModel {
+	stack := make([]ViewMode, len(m.NavigationStack), len(m.NavigationStack)+1)
+	copy(stack, m.NavigationStack)
+	m.NavigationStack = append(stack, view)
+	return m
+}
+
+// topView returns the innermost navigation level
+func (m MainModel) topView() ViewMode {
+	if len(m.NavigationStack) == 0 {
+		return ViewHome
+	}
+	return m.NavigationStack[len(m.NavigationStack)-1]
+}
+
+// navigateBack pops one level: closing the detail pane keeps the view's state, otherwise the previous view is shown
+func (m MainModel) navigateBack() MainModel {
+	if len(m.NavigationStack) <= 2 {
+		return m.goHome()
+	}
+
+	popped := m.topView()
+	m.NavigationStack = m.NavigationStack[:len(m.NavigationStack)-1]
+	if popped == ViewDetail {
+		m.splitPane = false
+		return m
+	}
+	m.mode = m.topView()
+	return m
+}
+
+// goHome returns to the home menu and resets the navigation stack
+func (m MainModel) goHome() MainModel {
+	m.mode = ViewHome
+	m.splitPane = false
+	m.NavigationStack = []ViewMode{ViewHome}
+	return m
+}
+
+// startTask runs a view's load command as a task that can be detached with ctrl+z
+func (m MainModel) startTask(view ViewMode, cmd tea.Cmd) (MainModel, tea.Cmd) {
+	if cmd == nil {
+		return m, nil
+	}
+
+	m.nextTaskID++
+	task := newBackgroundTask(m.nextTaskID, view, cmd, m.backgroundResults)
+	m.tasks[view] = task
+
+	return m, task.wait()
+}
+
+// finishTask routes a task result to the view that started it, dropping results from replaced tasks
+// A partial result leaves the task running and, in the foreground, keeps waiting for the rest
+func (m MainModel) finishTask(msg taskResultMsg) (MainModel, tea.Cmd) {
+	task, ok := m.tasks[msg.view]
+	if !ok || task.ID != msg.taskID {
+		return m, nil
+	}
+	if msg.partial {
+		m, cmd := m.updateView(msg.view, msg.msg)
+		if task.Detached() {
 			return m, cmd
 		}
+		return m, tea.Batch(cmd, task.wait())
+	}
+	delete(m.tasks, msg.view)
+
+	return m.updateView(msg.view, msg.msg)
+}
+
+// forwardTaskTick starts a loading view's spinner and keeps waiting for the task's result
+func (m MainModel) forwardTaskTick(msg taskTickMsg) (MainModel, tea.Cmd) {
+	task, ok := m.tasks[msg.view]
+	if !ok || task.ID != msg.taskID {
+		return m, nil
+	}
+
+	m, cmd := m.updateView(msg.view, msg.msg)
+	return m, tea.Batch(cmd, task.wait())
+}
+
+// routeSpinnerTick sends a spinner tick to the active view and every view with a running task
+// Spinners ignore ticks scheduled by other spinners, so only the owner advances
+func (m MainModel) routeSpinnerTick(msg spinner.TickMsg) (MainModel, tea.Cmd) {
+	views := []ViewMode{m.mode}
+	for view := range m.tasks {
+		if view != m.mode {
+			views = append(views, view)
+		}
+	}
+
+	var cmds []tea.Cmd
+	for _, view := range views {
+		var cmd tea.Cmd
+		m, cmd = m.updateView(view, msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// detachActive moves the active view's in-flight load to the background and returns home
+func (m MainModel) detachActive() (MainModel, tea.Cmd) {
+	task, ok := m.tasks[m.mode]
+	if !ok {
+		return m, nil
+	}
+
+	if task.Detached() {
+		return m.goHome(), nil
+	}
+
+	if !task.Detach() {
+		// Already finished; the result is on its way to the foreground
+		return m, nil
+	}
+
+	m = m.goHome()
+	if m.backgroundCount() == 1 {
+		return m, pollBackground()
+	}
+	return m, nil
+}
+
+// collectBackground drains finished background results, polling again while tasks remain
+func (m MainModel) collectBackground() (MainModel, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	for drained := false; !drained; {
+		select {
+		case msg := <-m.backgroundResults:
+			var cmd tea.Cmd
+			m, cmd = m.finishTask(msg.(taskResultMsg))
+			cmds = append(cmds, cmd)
+		default:
+			drained = true
+		}
+	}
+
+	if m.backgroundCount() > 0 {
+		cmds = append(cmds, pollBackground())
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// backgroundCount returns the number of detached tasks still running
+func (m MainModel) backgroundCount() int {
+	count := 0
+	for _, task := range m.tasks {
+		if task.Detached() {
+			count++
+		}
+	}
+	return count
+}
+
+func (m MainModel) runningInBackground(view ViewMode) bool {
+	task, ok := m.tasks[view]
+	return ok && task.Detached()
+}
+
+// updateView forwards msg to the sub-model for view
+func (m MainModel) updateView(view ViewMode, msg tea.Msg) (MainModel, tea.Cmd) {
+	var cmd tea.Cmd
+	switch view {
+	case ViewBranches:
+		var newModel tea.Model
+		newModel, cmd = m.branchesModel.Update(msg)
+		m.branchesModel = newModel.(branches.Model)
+
+	case ViewProtection:
+		var newModel tea.Model
+		newModel, cmd = m.protectionModel.Update(msg)
+		m.protectionModel = newModel.(protection.Model)
+
+	case ViewComments:
+		var newModel tea.Model
+		newModel, cmd = m.commentsModel.Update(msg)
+		m.commentsModel = newModel.(comments.Model)
+
+	case ViewAnalytics:
+		var newModel tea.Model
+		newModel, cmd = m.analyticsModel.Update(msg)
+		m.analyticsModel = newModel.(analytics.Model)
+
+	case ViewGHAPerf:
+		var newModel tea.Model
+		newModel, cmd = m.ghaPerfModel.Update(msg)
+		m.ghaPerfModel = newModel.(ghaperf.Model)
+
+	case ViewSettings:
+		var newModel tea.Model
+		newModel, cmd = m.settingsModel.Update(msg)
+		m.settingsModel = newModel.(settings.Model)
+
+	case ViewWebhooks:
+		var newModel tea.Model
+		newModel, cmd = m.webhooksModel.Update(msg)
+		m.webhooksModel = newModel.(webhooks.Model)
+
+	case ViewCollaborators:
+		var newModel tea.Model
+		newModel, cmd = m.collaboratorsModel.Update(msg)
+		m.collaboratorsModel = newModel.(collaborators.Model)
+
+	case ViewSecrets:
+		var newModel tea.Model
+		newModel, cmd = m.secretsModel.Update(msg)
+		m.secretsModel = newModel.(secrets.Model)
+
+	case ViewReleases:
+		var newModel tea.Model
+		newModel, cmd = m.releasesModel.Update(msg)
+		m.releasesModel = newModel.(releases.Model)
+
+	case ViewWatching:
+		var newModel tea.Model
+		newModel, cmd = m.watchingModel.Update(msg)
+		m.watchingModel = newModel.(watching.Model)
+
+	case ViewOrphans:
+		var newModel tea.Model
+		newModel, cmd = m.orphansModel.Update(msg)
+		m.orphansModel = newModel.(orphanstui.Model)
+
+	case ViewSecurity:
+		var newModel tea.Model
+		newModel, cmd = m.securityModel.Update(msg)
+		m.securityModel = newModel.(security.Model)
+
+	case ViewMilestones:
+		var newModel tea.Model
+		newModel, cmd = m.milestonesModel.Update(msg)
+		m.milestonesModel = newModel.(milestones.Model)
+
+	case ViewTraffic:
+		var newModel tea.Model
+		newModel, cmd = m.trafficModel.Update(msg)
+		m.trafficModel = newModel.(traffic.Model)
+
+	case ViewLabels:
+		var newModel tea.Model
+		newModel, cmd = m.labelsModel.Update(msg)
+		m.labelsModel = newModel.(labels.Model)
+
+	case ViewPopularity:
+		var newModel tea.Model
+		newModel, cmd = m.popularityModel.Update(msg)
+		m.popularityModel = newModel.(popularity.Model)
+	}
+
+	return m, cmd
+}
+
+// updateGroups handles key presses on the group selector
+func (m MainModel) updateGroups(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "up", "k":
+		if m.groupCursor > 0 {
+			m.groupCursor--
+		}
+
+	case "down", "j":
+		if m.groupCursor < len(m.groupNames)-1 {
+			m.groupCursor++
+		}
+
+	case "enter":
+		if len(m.groupNames) > 0 {
+			m.activeGroup = m.groupNames[m.groupCursor]
+			m.repos = m.groups[m.activeGroup]
+			m = m.goHome()
+		}
+
+	case "esc":
+		// Leave the selector without changing the active group
+		if m.activeGroup != "" {
+			m = m.goHome()
+		}
 	}
 
 	return m, nil
@@ -291,7 +798,94 @@ func (m MainModel) View() string {
 		return "Initializing..."
 	}
 
-	// Render active view
+	if m.splitPane {
+		if r := m.activeDetailRenderer(); r != nil {
+			return m.splitLayout.RenderWithDetail(m.activeView(), r) + m.renderStatusBar()
+		}
+	}
+
+	return m.activeView() + m.renderStatusBar()
+}
+
+// renderStatusBar shows the breadcrumb trail outside home and how many detached tasks are still running
+func (m MainModel) renderStatusBar() string {
+	var parts []string
+
+	if m.mode != ViewHome && m.mode != ViewGroups && len(m.NavigationStack) > 1 {
+		crumbStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#777777"))
+		parts = append(parts, crumbStyle.Render(breadcrumb.Render(m.NavigationStack)))
+	}
+
+	if count := m.backgroundCount(); count > 0 {
+		statusStyle := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FFFF00"))
+		parts = append(parts, statusStyle.Render(fmt.Sprintf("[B] Background: %d running", count)))
+	}
+
+	if len(parts) == 0 {
+		return ""
+	}
+	return "\n" + strings.Join(parts, "  ")
+}
+
+// activeDetailRenderer returns the active sub-model if it supports split-pane details
+func (m MainModel) activeDetailRenderer() layout.DetailRenderer {
+	switch m.mode {
+	case ViewBranches:
+		return m.branchesModel
+	case ViewSecrets:
+		return m.secretsModel
+	case ViewOrphans:
+		return m.orphansModel
+	default:
+		return nil
+	}
+}
+
+// activeSearching reports whether the active view's search input is open
+func (m MainModel) activeSearching() bool {
+	var view search.Searcher
+	switch m.mode {
+	case ViewBranches:
+		view = m.branchesModel
+	case ViewCollaborators:
+		view = m.collaboratorsModel
+	case ViewComments:
+		view = m.commentsModel
+	case ViewGHAPerf:
+		view = m.ghaPerfModel
+	case ViewLabels:
+		view = m.labelsModel
+	case ViewMilestones:
+		view = m.milestonesModel
+	case ViewOrphans:
+		view = m.orphansModel
+	case ViewPopularity:
+		view = m.popularityModel
+	case ViewProtection:
+		view = m.protectionModel
+	case ViewReleases:
+		view = m.releasesModel
+	case ViewSecrets:
+		view = m.secretsModel
+	case ViewSecurity:
+		view = m.securityModel
+	case ViewSettings:
+		view = m.settingsModel
+	case ViewTraffic:
+		view = m.trafficModel
+	case ViewWatching:
+		view = m.watchingModel
+	case ViewWebhooks:
+		view = m.webhooksModel
+	default:
+		return false
+	}
+	return view.Searching()
+}
+
+func (m MainModel) activeView() string {
 	switch m.mode {
 	case ViewBranches:
 		return m.branchesModel.View()
@@ -317,6 +911,18 @@ func (m MainModel) View() string {
 		return m.watchingModel.View()
 	case ViewOrphans:
 		return m.orphansModel.View()
+	case ViewSecurity:
+		return m.securityModel.View()
+	case ViewMilestones:
+		return m.milestonesModel.View()
+	case ViewTraffic:
+		return m.trafficModel.View()
+	case ViewLabels:
+		return m.labelsModel.View()
+	case ViewPopularity:
+		return m.popularityModel.View()
+	case ViewGroups:
+		return m.renderGroups()
 	default:
 		return m.renderHome()
 	}
@@ -343,6 +949,10 @@ func (m MainModel) renderHome() string {
 	content := titleStyle.Render("🧹 gh-sweep") + "\n"
 	content += titleStyle.Render("GitHub Repository Management TUI") + "\n\n"
 
+	if m.activeGroup != "" {
+		content += sectionStyle.Render(fmt.Sprintf("Group: %s (%d repos)", m.activeGroup, len(m.repos))) + "\n\n"
+	}
+
 	// Namespace Audit
 	content += sectionStyle.Render("Namespace Audit") + "\n"
 	content += menuItemStyle.Render("[0] 👁️  Watch Status")
@@ -368,7 +978,13 @@ func (m MainModel) renderHome() string {
 	content += menuItemStyle.Render("[5] ⚙️  Settings Comparison")
 	content += " - Cross-repo settings diff\n"
 	content += menuItemStyle.Render("[6] 🔔 Webhooks")
-	content += " - Webhook health monitoring\n\n"
+	content += " - Webhook health monitoring\n"
+	content += menuItemStyle.Render("[t] 📈 Traffic")
+	content += " - Views and clones by repository\n"
+	content += menuItemStyle.Render("[l] 🏷️  Labels")
+	content += " - Cross-repo label consistency\n"
+	content += menuItemStyle.Render("[*] ⭐ Popularity")
+	content += " - Stars, forks, and star growth\n\n"
 
 	// Phase 3: Access & Releases
 	content += sectionStyle.Render("Phase 3: Access & Releases") + "\n"
@@ -376,14 +992,50 @@ func (m MainModel) renderHome() string {
 	content += " - Manage repository access\n"
 	content += menuItemStyle.Render("[8] 🔐 Secrets Audit")
 	content += " - Review secrets usage (read-only)\n"
+	content += menuItemStyle.Render("[s] 🛡️  Security Audit")
+	content += " - Security policy coverage\n"
 	content += menuItemStyle.Render("[9] 📦 Releases")
-	content += " - Release version overview\n\n"
+	content += " - Release version overview\n"
+	content += menuItemStyle.Render("[m] 🎯 Milestones")
+	content += " - Milestone progress and due dates\n\n"
 
 	if m.repo == "" && len(m.repos) == 0 {
 		content += helpStyle.Render("💡 Configure with --repo flag or .gh-sweep.yaml\n\n")
 	}
 
-	content += helpStyle.Render("Press 0-9/o/p to select a view | q to quit")
+	help := "Press 0-9/l/m/o/p/s/t to select a view | ctrl+d: toggle detail pane | ctrl+z: background a loading view | q to quit"
+	if len(m.groupNames) > 0 {
+		help = "Press 0-9/l/m/o/p/s/t to select a view | g: switch group | ctrl+d: toggle detail pane | ctrl+z: background a loading view | q to quit"
+	}
+	content += helpStyle.Render(help)
+
+	return content
+}
+
+func (m MainModel) renderGroups() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#00FFFF")).
+		Padding(1, 0)
+
+	selectedStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FFFF00"))
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#777777"))
+
+	content := titleStyle.Render("🧹 gh-sweep: Select a Repository Group") + "\n\n"
+
+	for i, name := range m.groupNames {
+		line := fmt.Sprintf("  %s (%d repos)", name, len(m.groups[name]))
+		if i == m.groupCursor {
+			line = selectedStyle.Render(fmt.Sprintf("> %s (%d repos)", name, len(m.groups[name])))
+		}
+		content += line + "\n"
+	}
+
+	content += "\n" + helpStyle.Render("↑/↓: navigate | enter: select group | q: quit")
 
 	return content
 }
@@ -0,0 +1,423 @@
+package tui
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KyleKing/gh-sweep/internal/config"
+	"github.com/KyleKing/gh-sweep/internal/orphans"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/branches"
+	orphanstui "github.com/KyleKing/gh-sweep/internal/tui/components/orphans"
+	"github.com/KyleKing/gh-sweep/internal/tui/components/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMainModelSplitPaneToggle(t *testing.T) {
+	var m tea.Model = NewMainModel("")
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	main := m.(MainModel)
+	main.mode = ViewOrphans
+	main.orphansModel = orphanstui.NewModel("owner", orphans.DefaultScanOptions())
+	m = main
+
+	if strings.Contains(m.View(), "No item selected") {
+		t.Error("Expected split pane to be off by default")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if !m.(MainModel).splitPane {
+		t.Fatal("Expected ctrl+d to enable split pane")
+	}
+
+	if !strings.Contains(m.View(), "No item selected") {
+		t.Errorf("Expected detail pane in split view, got:\n%s", m.View())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if m.(MainModel).splitPane {
+		t.Error("Expected second ctrl+d to disable split pane")
+	}
+}
+
+func TestMainModelSplitPaneIgnoredWithoutDetails(t *testing.T) {
+	var m tea.Model = NewMainModel("")
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	main := m.(MainModel)
+	main.mode = ViewTraffic
+	main.splitPane = true
+
+	if strings.Contains(main.View(), "No item selected") {
+		t.Error("Expected views without RenderDetail to render full width")
+	}
+}
+
+func TestMainModelGroupSelector(t *testing.T) {
+	cfg := &config.Config{
+		Repositories: []string{"owner/ui", "owner/api"},
+		Groups: map[string][]string{
+			"frontend": {"owner/ui", "owner/web"},
+			"backend":  {"owner/api", "owner/db"},
+		},
+	}
+
+	var m tea.Model = NewMainModel("", WithConfig(cfg))
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	if m.(MainModel).mode != ViewGroups {
+		t.Fatal("Expected group selector as the first screen when groups are defined")
+	}
+
+	// Groups are sorted, so backend comes first and frontend is one row down
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	main := m.(MainModel)
+	if main.mode != ViewHome {
+		t.Errorf("Expected enter to open the home view, got mode %d", main.mode)
+	}
+	if main.activeGroup != "frontend" {
+		t.Errorf("Expected active group 'frontend', got '%s'", main.activeGroup)
+	}
+	if strings.Join(main.repos, ",") != "owner/ui,owner/web" {
+		t.Errorf("Expected frontend repos, got %v", main.repos)
+	}
+}
+
+func TestMainModelWithGroupSkipsSelector(t *testing.T) {
+	cfg := &config.Config{
+		Groups: map[string][]string{"backend": {"owner/api"}},
+	}
+
+	m := NewMainModel("", WithConfig(cfg), WithGroup("backend", cfg.Groups["backend"]))
+
+	if m.mode != ViewHome {
+		t.Errorf("Expected home view when a group is preselected, got mode %d", m.mode)
+	}
+	if len(m.repos) != 1 || m.repos[0] != "owner/api" {
+		t.Errorf("Expected backend repos, got %v", m.repos)
+	}
+}
+
+func TestMainModelWithoutGroups(t *testing.T) {
+	m := NewMainModel("", WithConfig(&config.Config{Repositories: []string{"owner/api"}}))
+
+	if m.mode != ViewHome {
+		t.Errorf("Expected home view without groups, got mode %d", m.mode)
+	}
+}
+
+func TestMainModelRequiredWebhookEventsFromConfig(t *testing.T) {
+	cfg := &config.Config{
+		Repositories: []string{"owner/api"},
+		Webhooks:     config.WebhookConfig{RequiredWebhookEvents: []string{"release"}},
+	}
+	m := NewMainModel("", WithConfig(cfg))
+
+	if len(m.requiredWebhookEvents) != 1 || m.requiredWebhookEvents[0] != "release" {
+		t.Errorf("Expected configured webhook events, got %v", m.requiredWebhookEvents)
+	}
+}
+
+type scanFinishedMsg struct{}
+
+func TestMainModelDetachRunningScan(t *testing.T) {
+	var m tea.Model = NewMainModel("")
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	release := make(chan struct{})
+	main := m.(MainModel)
+	main.mode = ViewOrphans
+	main.orphansModel = orphanstui.NewModel("owner", orphans.DefaultScanOptions())
+	main, wait := main.startTask(ViewOrphans, func() tea.Msg {
+		<-release
+		return scanFinishedMsg{}
+	})
+	m = main
+
+	m, poll := m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if poll == nil {
+		t.Fatal("Expected detaching to start polling for background results")
+	}
+	if m.(MainModel).mode != ViewHome {
+		t.Errorf("Expected detaching to return home, got mode %d", m.(MainModel).mode)
+	}
+	if msg := wait(); msg != nil {
+		t.Errorf("Expected the foreground wait to be released on detach, got %T", msg)
+	}
+	if !strings.Contains(m.View(), "[B] Background: 1 running") {
+		t.Errorf("Expected background indicator, got:\n%s", m.View())
+	}
+
+	// Navigating while the scan runs must not block on it
+	navigated := make(chan tea.Model)
+	go func() {
+		next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+		navigated <- next
+	}()
+	select {
+	case m = <-navigated:
+	case <-time.After(time.Second):
+		t.Fatal("Navigation blocked on the background scan")
+	}
+	if m.(MainModel).mode != ViewTraffic {
+		t.Errorf("Expected traffic view, got mode %d", m.(MainModel).mode)
+	}
+
+	// Nothing has finished yet, so polling continues
+	m, poll = m.Update(backgroundPollMsg{})
+	if poll == nil {
+		t.Error("Expected polling to continue while the scan runs")
+	}
+
+	close(release)
+
+	deadline := time.Now().Add(time.Second)
+	for m.(MainModel).backgroundCount() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Background result never arrived")
+		}
+		time.Sleep(10 * time.Millisecond)
+		m, poll = m.Update(backgroundPollMsg{})
+	}
+
+	if _, running := m.(MainModel).tasks[ViewOrphans]; running {
+		t.Error("Expected the finished scan to be removed")
+	}
+	if poll != nil {
+		t.Error("Expected polling to stop once no background tasks remain")
+	}
+	if strings.Contains(m.View(), "[B] Background") {
+		t.Errorf("Expected indicator to clear, got:\n%s", m.View())
+	}
+}
+
+func TestMainModelReattachBackgroundView(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	main := NewMainModel("")
+	main.mode = ViewOrphans
+	main, _ = main.startTask(ViewOrphans, func() tea.Msg {
+		<-release
+		return scanFinishedMsg{}
+	})
+
+	var m tea.Model = main
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+
+	if m.(MainModel).mode != ViewOrphans {
+		t.Errorf("Expected to reattach to the orphans view, got mode %d", m.(MainModel).mode)
+	}
+	if cmd != nil {
+		t.Error("Expected reattaching not to restart the scan")
+	}
+	if m.(MainModel).tasks[ViewOrphans] == nil {
+		t.Error("Expected the background scan to keep running")
+	}
+}
+
+func TestMainModelForegroundTaskResult(t *testing.T) {
+	main := NewMainModel("")
+	main.mode = ViewOrphans
+	main, wait := main.startTask(ViewOrphans, func() tea.Msg { return scanFinishedMsg{} })
+
+	var m tea.Model = main
+	m, _ = m.Update(wait())
+
+	if _, running := m.(MainModel).tasks[ViewOrphans]; running {
+		t.Error("Expected foreground result to finish the task")
+	}
+}
+
+func TestMainModelForwardsSpinnerTicksWhileLoading(t *testing.T) {
+	release := make(chan struct{})
+
+	main := NewMainModel("")
+	main.mode = ViewOrphans
+	main, wait := main.startTask(ViewOrphans, tea.Batch(func() tea.Msg {
+		<-release
+		return scanFinishedMsg{}
+	}, spinner.New("Loading...").Tick()))
+
+	tick, ok := wait().(taskTickMsg)
+	if !ok {
+		t.Fatal("Expected the spinner tick before the load finishes")
+	}
+	if _, ok := tick.msg.(spinner.TickMsg); !ok {
+		t.Errorf("Expected a spinner tick, got %T", tick.msg)
+	}
+
+	var m tea.Model = main
+	m, next := m.Update(tick)
+	if next == nil {
+		t.Fatal("Expected to keep waiting for the load")
+	}
+	if m.(MainModel).tasks[ViewOrphans] == nil {
+		t.Error("Expected a tick not to finish the task")
+	}
+
+	close(release)
+	result, ok := m.(MainModel).tasks[ViewOrphans].wait()().(taskResultMsg)
+	if !ok {
+		t.Fatal("Expected the load result after the tick")
+	}
+	if _, ok := result.msg.(scanFinishedMsg); !ok {
+		t.Errorf("Expected the load's message as the task result, got %T", result.msg)
+	}
+}
+
+type progressMsg struct{}
+
+func TestMainModelDeliversPartialBatchResults(t *testing.T) {
+	release := make(chan struct{})
+
+	main := NewMainModel("")
+	main.mode = ViewOrphans
+	main, wait := main.startTask(ViewOrphans, tea.Batch(func() tea.Msg {
+		<-release
+		return scanFinishedMsg{}
+	}, func() tea.Msg {
+		return progressMsg{}
+	}))
+
+	partial, ok := wait().(taskResultMsg)
+	if !ok || !partial.partial {
+		t.Fatalf("Expected a partial result before the load finishes, got %#v", partial)
+	}
+	if _, ok := partial.msg.(progressMsg); !ok {
+		t.Errorf("Expected the early command's message, got %T", partial.msg)
+	}
+
+	var m tea.Model = main
+	m, next := m.Update(partial)
+	if next == nil {
+		t.Fatal("Expected to keep waiting for the load")
+	}
+	if m.(MainModel).tasks[ViewOrphans] == nil {
+		t.Fatal("Expected a partial result not to finish the task")
+	}
+
+	close(release)
+	result, ok := m.(MainModel).tasks[ViewOrphans].wait()().(taskResultMsg)
+	if !ok || result.partial {
+		t.Fatalf("Expected the final result, got %#v", result)
+	}
+	if _, ok := result.msg.(scanFinishedMsg); !ok {
+		t.Errorf("Expected the load's message as the final result, got %T", result.msg)
+	}
+
+	m, _ = m.Update(result)
+	if _, running := m.(MainModel).tasks[ViewOrphans]; running {
+		t.Error("Expected the final result to finish the task")
+	}
+}
+
+func TestMainModelEscPopsNavigationStack(t *testing.T) {
+	var m tea.Model = NewMainModel("")
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+
+	main := m.(MainModel)
+	main.branchesModel = branches.NewModel("owner/repo", "main")
+	m = main
+	branchesView := m.(MainModel).activeView()
+
+	if !strings.Contains(m.View(), "Home > Branches") {
+		t.Errorf("Expected breadcrumb in status bar, got:\n%s", m.View())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if !strings.Contains(m.View(), "Home > Branches > Detail") {
+		t.Errorf("Expected detail level in breadcrumb, got:\n%s", m.View())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	main = m.(MainModel)
+	if main.mode != ViewBranches || main.splitPane {
+		t.Fatalf("Expected esc to close the detail pane and stay on branches, got mode %s (split pane %v)", main.mode, main.splitPane)
+	}
+	if main.activeView() != branchesView {
+		t.Errorf("Expected branches state to be kept, got:\n%s", main.activeView())
+	}
+	if len(main.NavigationStack) != 2 {
+		t.Errorf("Expected Home > Branches, got %v", main.NavigationStack)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	main = m.(MainModel)
+	if main.mode != ViewHome || len(main.NavigationStack) != 1 {
+		t.Errorf("Expected second esc to return home, got mode %s with stack %v", main.mode, main.NavigationStack)
+	}
+	if strings.Contains(m.View(), "Home > ") {
+		t.Errorf("Expected no breadcrumb on the home view, got:\n%s", m.View())
+	}
+}
+
+func TestMainModelForwardsEscToOpenSearch(t *testing.T) {
+	var m tea.Model = NewMainModel("")
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+
+	main := m.(MainModel)
+	main.branchesModel = branches.NewModel("owner/repo", "main")
+	m = main
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	if !m.(MainModel).branchesModel.Searching() {
+		t.Fatal("Expected / to open the branches search")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	main = m.(MainModel)
+	if main.mode != ViewBranches || main.branchesModel.Searching() {
+		t.Fatalf("Expected esc to close the search and stay on branches, got mode %s", main.mode)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.(MainModel).mode != ViewHome {
+		t.Errorf("Expected a second esc to navigate back, got mode %s", m.(MainModel).mode)
+	}
+}
+
+func TestLocalCheckoutRequiresMatchingOrigin(t *testing.T) {
+	dir := t.TempDir()
+	if got := localCheckout(dir, "owner/api"); got != "" {
+		t.Errorf("Expected no local checkout outside a git repo, got %q", got)
+	}
+
+	for _, args := range [][]string{
+		{"init"},
+		{"remote", "add", "origin", "git@github.com:owner/api.git"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if err := cmd.Run(); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+
+	if got := localCheckout(dir, "Owner/API"); got != dir {
+		t.Errorf("Expected matching origin to use %q, got %q", dir, got)
+	}
+	if got := localCheckout(dir, "owner/other"); got != "" {
+		t.Errorf("Expected a different repo to skip the local checkout, got %q", got)
+	}
+}
+
+func TestMainModelGHAPerfRemoteCacheFromConfig(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret")
+	cfg := &config.Config{Cache: config.CacheConfig{Backend: "s3", RemoteURL: "s3://bucket/gh-sweep"}}
+
+	if m := NewMainModel("", WithConfig(cfg)); m.ghaPerfRemote == nil {
+		t.Error("Expected the configured remote cache to be shared with the gha-perf view")
+	}
+	if m := NewMainModel("", WithConfig(&config.Config{})); m.ghaPerfRemote != nil {
+		t.Errorf("Expected no remote cache by default, got %v", m.ghaPerfRemote)
+	}
+}
@@ -0,0 +1,14 @@
+package tui
+
+import (
+	"os"
+
+	"github.com/mattn/go-isatty"
+)
+
+// IsTTY reports whether stdout is an interactive terminal
+// Returns false in CI and when output is piped or redirected
+func IsTTY() bool {
+	fd := os.Stdout.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}